@@ -0,0 +1,66 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+type codePointAge struct {
+	lo, hi       rune
+	major, minor uint8
+}
+
+// sequenceAge is the version of an emoji listed in the latest emoji-test.txt but not in the package metadata
+type sequenceAge struct {
+	sequence     string
+	major, minor uint8
+}
+
+// Age returns the emoji version that introduced s, such as 13, 0 for 🥲
+// s is either a single emoji code point, as listed in emoji-data.txt, or an emoji sequence listed in emoji-test.txt
+// including emoji newer than DefaultVersion up to the latest of Versions
+// components such as regional indicators or the zero width joiner have the version 0.0
+func Age(s string) (major, minor int, ok bool) {
+	r, n := utf8.DecodeRuneInString(s)
+	if n > 0 && s[n:] == string(textVS) {
+		// a text presentation sequence has the version of its character
+		s = s[:n]
+	}
+	if n > 0 && n == len(s) {
+		i := sort.Search(len(codePointAges), func(i int) bool { return codePointAges[i].hi >= r })
+		if i < len(codePointAges) && codePointAges[i].lo <= r {
+			return int(codePointAges[i].major), int(codePointAges[i].minor), true
+		}
+	}
+	if e, ok := lookup(s); ok {
+		v, err := ParseVersion(e.version)
+		return v.Major, v.Minor, err == nil
+	}
+	i := sort.Search(len(sequenceAges), func(i int) bool { return sequenceAges[i].sequence >= s })
+	if i < len(sequenceAges) && sequenceAges[i].sequence == s {
+		return int(sequenceAges[i].major), int(sequenceAges[i].minor), true
+	}
+	return 0, 0, false
+}
+
+// FilterByMaxVersion replaces every emoji of s introduced after the version v with fallback(g)
+// s is split in grapheme clusters, so emoji newer than DefaultVersion are found and replaced too
+// and clusters whose version is unknown are replaced if they are possible emoji, such as non RGI zwj sequences
+func FilterByMaxVersion(s string, v Version, fallback func(g string) string) string {
+	var b strings.Builder
+	for len(s) > 0 {
+		g, n := DecodeGraphemeString(s)
+		s = s[n:]
+		major, minor, ok := Age(g)
+		switch {
+		case ok && !v.Less(Version{Major: major, Minor: minor}):
+			b.WriteString(g)
+		case ok || PossibleGlyphString(g):
+			b.WriteString(fallback(g))
+		default:
+			b.WriteString(g)
+		}
+	}
+	return b.String()
+}
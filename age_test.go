@@ -0,0 +1,71 @@
+package emoji
+
+import "testing"
+
+func Test_Age(t *testing.T) {
+	tests := []struct {
+		s            string
+		major, minor int
+		ok           bool
+	}{
+		{"😀", 1, 0, true},
+		{"©", 0, 6, true},
+		{"©️", 0, 6, true},
+		{"©︎", 0, 6, true},
+		{"🥲", 13, 0, true},
+		{"🫠", 14, 0, true},
+		{"🫨", 15, 0, true},
+		{"🐦‍🔥", 15, 1, true},
+		{"👍🏽", 1, 0, true},
+		{"🫶🏽", 14, 0, true},
+		{"🧔‍♀️", 13, 1, true},
+		{"🇯🇵", 0, 6, true},
+		{"🏴‍☠️", 11, 0, true},
+		{"🇦", 0, 0, true},
+		{"‍", 0, 0, true},
+		{"🏻", 1, 0, true},
+		{"a", 0, 0, false},
+		{"", 0, 0, false},
+		{"👩‍🦲‍🦰", 0, 0, false},
+	}
+	for _, test := range tests {
+		major, minor, ok := Age(test.s)
+		if major != test.major || minor != test.minor || ok != test.ok {
+			t.Errorf("Age(%q) returned %d.%d %v not %d.%d %v", test.s, major, minor, ok, test.major, test.minor, test.ok)
+		}
+	}
+	// every listed emoji has a version
+	for _, e := range metadata {
+		if _, _, ok := Age(e.sequence); !ok {
+			t.Errorf("Age(%q) is unknown", e.sequence)
+		}
+	}
+	for _, s := range Tables15_1.rgi {
+		if _, _, ok := Age(s); !ok {
+			t.Errorf("Age(%q) is unknown", s)
+		}
+	}
+}
+
+func Test_FilterByMaxVersion(t *testing.T) {
+	fallback := func(string) string { return "□" }
+	tests := []struct {
+		s        string
+		version  Version
+		expected string
+	}{
+		{"hi 😀🥲🫠", Version{15, 1}, "hi 😀🥲🫠"},
+		{"hi 😀🥲🫠", Version{13, 0}, "hi 😀🥲□"},
+		{"hi 😀🥲🫠", Version{12, 1}, "hi 😀□□"},
+		{"hi 😀🥲🫠", Version{0, 6}, "hi □□□"},
+		{"🐦‍🔥 and 🐦", Version{15, 0}, "□ and 🐦"},
+		{"👩‍🦲‍🦰", Version{15, 1}, "□"},
+		{"☺︎ ☺️ ★ 1", Version{0, 6}, "☺︎ ☺️ ★ 1"},
+		{"🇯🇵🇫🇷", Version{0, 6}, "🇯🇵🇫🇷"},
+	}
+	for _, test := range tests {
+		if s := FilterByMaxVersion(test.s, test.version, fallback); s != test.expected {
+			t.Errorf("FilterByMaxVersion(%q, %v) returned %q not %q", test.s, test.version, s, test.expected)
+		}
+	}
+}
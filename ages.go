@@ -0,0 +1,1284 @@
+// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+var codePointAges = []codePointAge{
+	{0x23, 0x23, 0, 0},
+	{0x2a, 0x2a, 0, 0},
+	{0x30, 0x39, 0, 0},
+	{0xa9, 0xa9, 0, 6},
+	{0xae, 0xae, 0, 6},
+	{0x200d, 0x200d, 0, 0},
+	{0x203c, 0x203c, 0, 6},
+	{0x2049, 0x2049, 0, 6},
+	{0x20e3, 0x20e3, 0, 0},
+	{0x2122, 0x2122, 0, 6},
+	{0x2139, 0x2139, 0, 6},
+	{0x2194, 0x2199, 0, 6},
+	{0x21a9, 0x21aa, 0, 6},
+	{0x231a, 0x231b, 0, 6},
+	{0x2328, 0x2328, 1, 0},
+	{0x23cf, 0x23cf, 1, 0},
+	{0x23e9, 0x23ec, 0, 6},
+	{0x23ed, 0x23ee, 0, 7},
+	{0x23ef, 0x23ef, 1, 0},
+	{0x23f0, 0x23f0, 0, 6},
+	{0x23f1, 0x23f2, 1, 0},
+	{0x23f3, 0x23f3, 0, 6},
+	{0x23f8, 0x23fa, 0, 7},
+	{0x24c2, 0x24c2, 0, 6},
+	{0x25aa, 0x25ab, 0, 6},
+	{0x25b6, 0x25b6, 0, 6},
+	{0x25c0, 0x25c0, 0, 6},
+	{0x25fb, 0x25fe, 0, 6},
+	{0x2600, 0x2601, 0, 6},
+	{0x2602, 0x2603, 0, 7},
+	{0x2604, 0x2604, 1, 0},
+	{0x260e, 0x260e, 0, 6},
+	{0x2611, 0x2611, 0, 6},
+	{0x2614, 0x2615, 0, 6},
+	{0x2618, 0x2618, 1, 0},
+	{0x261d, 0x261d, 0, 6},
+	{0x2620, 0x2620, 1, 0},
+	{0x2622, 0x2623, 1, 0},
+	{0x2626, 0x2626, 1, 0},
+	{0x262a, 0x262a, 0, 7},
+	{0x262e, 0x262e, 1, 0},
+	{0x262f, 0x262f, 0, 7},
+	{0x2638, 0x2639, 0, 7},
+	{0x263a, 0x263a, 0, 6},
+	{0x2640, 0x2640, 4, 0},
+	{0x2642, 0x2642, 4, 0},
+	{0x2648, 0x2653, 0, 6},
+	{0x265f, 0x265f, 11, 0},
+	{0x2660, 0x2660, 0, 6},
+	{0x2663, 0x2663, 0, 6},
+	{0x2665, 0x2666, 0, 6},
+	{0x2668, 0x2668, 0, 6},
+	{0x267b, 0x267b, 0, 6},
+	{0x267e, 0x267e, 11, 0},
+	{0x267f, 0x267f, 0, 6},
+	{0x2692, 0x2692, 1, 0},
+	{0x2693, 0x2693, 0, 6},
+	{0x2694, 0x2694, 1, 0},
+	{0x2695, 0x2695, 4, 0},
+	{0x2696, 0x2697, 1, 0},
+	{0x2699, 0x2699, 1, 0},
+	{0x269b, 0x269c, 1, 0},
+	{0x26a0, 0x26a1, 0, 6},
+	{0x26a7, 0x26a7, 13, 0},
+	{0x26aa, 0x26ab, 0, 6},
+	{0x26b0, 0x26b1, 1, 0},
+	{0x26bd, 0x26be, 0, 6},
+	{0x26c4, 0x26c5, 0, 6},
+	{0x26c8, 0x26c8, 0, 7},
+	{0x26ce, 0x26ce, 0, 6},
+	{0x26cf, 0x26cf, 0, 7},
+	{0x26d1, 0x26d1, 0, 7},
+	{0x26d3, 0x26d3, 0, 7},
+	{0x26d4, 0x26d4, 0, 6},
+	{0x26e9, 0x26e9, 0, 7},
+	{0x26ea, 0x26ea, 0, 6},
+	{0x26f0, 0x26f1, 0, 7},
+	{0x26f2, 0x26f3, 0, 6},
+	{0x26f4, 0x26f4, 0, 7},
+	{0x26f5, 0x26f5, 0, 6},
+	{0x26f7, 0x26f9, 0, 7},
+	{0x26fa, 0x26fa, 0, 6},
+	{0x26fd, 0x26fd, 0, 6},
+	{0x2702, 0x2702, 0, 6},
+	{0x2705, 0x2705, 0, 6},
+	{0x2708, 0x270c, 0, 6},
+	{0x270d, 0x270d, 0, 7},
+	{0x270f, 0x270f, 0, 6},
+	{0x2712, 0x2712, 0, 6},
+	{0x2714, 0x2714, 0, 6},
+	{0x2716, 0x2716, 0, 6},
+	{0x271d, 0x271d, 0, 7},
+	{0x2721, 0x2721, 0, 7},
+	{0x2728, 0x2728, 0, 6},
+	{0x2733, 0x2734, 0, 6},
+	{0x2744, 0x2744, 0, 6},
+	{0x2747, 0x2747, 0, 6},
+	{0x274c, 0x274c, 0, 6},
+	{0x274e, 0x274e, 0, 6},
+	{0x2753, 0x2755, 0, 6},
+	{0x2757, 0x2757, 0, 6},
+	{0x2763, 0x2763, 1, 0},
+	{0x2764, 0x2764, 0, 6},
+	{0x2795, 0x2797, 0, 6},
+	{0x27a1, 0x27a1, 0, 6},
+	{0x27b0, 0x27b0, 0, 6},
+	{0x27bf, 0x27bf, 1, 0},
+	{0x2934, 0x2935, 0, 6},
+	{0x2b05, 0x2b07, 0, 6},
+	{0x2b1b, 0x2b1c, 0, 6},
+	{0x2b50, 0x2b50, 0, 6},
+	{0x2b55, 0x2b55, 0, 6},
+	{0x3030, 0x3030, 0, 6},
+	{0x303d, 0x303d, 0, 6},
+	{0x3297, 0x3297, 0, 6},
+	{0x3299, 0x3299, 0, 6},
+	{0xfe0f, 0xfe0f, 0, 0},
+	{0x1f004, 0x1f004, 0, 6},
+	{0x1f0cf, 0x1f0cf, 0, 6},
+	{0x1f170, 0x1f171, 0, 6},
+	{0x1f17e, 0x1f17f, 0, 6},
+	{0x1f18e, 0x1f18e, 0, 6},
+	{0x1f191, 0x1f19a, 0, 6},
+	{0x1f1e6, 0x1f1ff, 0, 0},
+	{0x1f201, 0x1f202, 0, 6},
+	{0x1f21a, 0x1f21a, 0, 6},
+	{0x1f22f, 0x1f22f, 0, 6},
+	{0x1f232, 0x1f23a, 0, 6},
+	{0x1f250, 0x1f251, 0, 6},
+	{0x1f300, 0x1f30c, 0, 6},
+	{0x1f30d, 0x1f30e, 0, 7},
+	{0x1f30f, 0x1f30f, 0, 6},
+	{0x1f310, 0x1f310, 1, 0},
+	{0x1f311, 0x1f311, 0, 6},
+	{0x1f312, 0x1f312, 1, 0},
+	{0x1f313, 0x1f315, 0, 6},
+	{0x1f316, 0x1f318, 1, 0},
+	{0x1f319, 0x1f319, 0, 6},
+	{0x1f31a, 0x1f31a, 1, 0},
+	{0x1f31b, 0x1f31b, 0, 6},
+	{0x1f31c, 0x1f31c, 0, 7},
+	{0x1f31d, 0x1f31e, 1, 0},
+	{0x1f31f, 0x1f320, 0, 6},
+	{0x1f321, 0x1f321, 0, 7},
+	{0x1f324, 0x1f32c, 0, 7},
+	{0x1f32d, 0x1f32f, 1, 0},
+	{0x1f330, 0x1f331, 0, 6},
+	{0x1f332, 0x1f333, 1, 0},
+	{0x1f334, 0x1f335, 0, 6},
+	{0x1f336, 0x1f336, 0, 7},
+	{0x1f337, 0x1f34a, 0, 6},
+	{0x1f34b, 0x1f34b, 1, 0},
+	{0x1f34c, 0x1f34f, 0, 6},
+	{0x1f350, 0x1f350, 1, 0},
+	{0x1f351, 0x1f37b, 0, 6},
+	{0x1f37c, 0x1f37c, 1, 0},
+	{0x1f37d, 0x1f37d, 0, 7},
+	{0x1f37e, 0x1f37f, 1, 0},
+	{0x1f380, 0x1f393, 0, 6},
+	{0x1f396, 0x1f397, 0, 7},
+	{0x1f399, 0x1f39b, 0, 7},
+	{0x1f39e, 0x1f39f, 0, 7},
+	{0x1f3a0, 0x1f3c4, 0, 6},
+	{0x1f3c5, 0x1f3c5, 1, 0},
+	{0x1f3c6, 0x1f3c6, 0, 6},
+	{0x1f3c7, 0x1f3c7, 1, 0},
+	{0x1f3c8, 0x1f3c8, 0, 6},
+	{0x1f3c9, 0x1f3c9, 1, 0},
+	{0x1f3ca, 0x1f3ca, 0, 6},
+	{0x1f3cb, 0x1f3ce, 0, 7},
+	{0x1f3cf, 0x1f3d3, 1, 0},
+	{0x1f3d4, 0x1f3df, 0, 7},
+	{0x1f3e0, 0x1f3e3, 0, 6},
+	{0x1f3e4, 0x1f3e4, 1, 0},
+	{0x1f3e5, 0x1f3f0, 0, 6},
+	{0x1f3f3, 0x1f3f3, 0, 7},
+	{0x1f3f4, 0x1f3f4, 1, 0},
+	{0x1f3f5, 0x1f3f5, 0, 7},
+	{0x1f3f7, 0x1f3f7, 0, 7},
+	{0x1f3f8, 0x1f407, 1, 0},
+	{0x1f408, 0x1f408, 0, 7},
+	{0x1f409, 0x1f40b, 1, 0},
+	{0x1f40c, 0x1f40e, 0, 6},
+	{0x1f40f, 0x1f410, 1, 0},
+	{0x1f411, 0x1f412, 0, 6},
+	{0x1f413, 0x1f413, 1, 0},
+	{0x1f414, 0x1f414, 0, 6},
+	{0x1f415, 0x1f415, 0, 7},
+	{0x1f416, 0x1f416, 1, 0},
+	{0x1f417, 0x1f429, 0, 6},
+	{0x1f42a, 0x1f42a, 1, 0},
+	{0x1f42b, 0x1f43e, 0, 6},
+	{0x1f43f, 0x1f43f, 0, 7},
+	{0x1f440, 0x1f440, 0, 6},
+	{0x1f441, 0x1f441, 0, 7},
+	{0x1f442, 0x1f464, 0, 6},
+	{0x1f465, 0x1f465, 1, 0},
+	{0x1f466, 0x1f46b, 0, 6},
+	{0x1f46c, 0x1f46d, 1, 0},
+	{0x1f46e, 0x1f4ac, 0, 6},
+	{0x1f4ad, 0x1f4ad, 1, 0},
+	{0x1f4ae, 0x1f4b5, 0, 6},
+	{0x1f4b6, 0x1f4b7, 1, 0},
+	{0x1f4b8, 0x1f4eb, 0, 6},
+	{0x1f4ec, 0x1f4ed, 0, 7},
+	{0x1f4ee, 0x1f4ee, 0, 6},
+	{0x1f4ef, 0x1f4ef, 1, 0},
+	{0x1f4f0, 0x1f4f4, 0, 6},
+	{0x1f4f5, 0x1f4f5, 1, 0},
+	{0x1f4f6, 0x1f4f7, 0, 6},
+	{0x1f4f8, 0x1f4f8, 1, 0},
+	{0x1f4f9, 0x1f4fc, 0, 6},
+	{0x1f4fd, 0x1f4fd, 0, 7},
+	{0x1f4ff, 0x1f502, 1, 0},
+	{0x1f503, 0x1f503, 0, 6},
+	{0x1f504, 0x1f507, 1, 0},
+	{0x1f508, 0x1f508, 0, 7},
+	{0x1f509, 0x1f509, 1, 0},
+	{0x1f50a, 0x1f514, 0, 6},
+	{0x1f515, 0x1f515, 1, 0},
+	{0x1f516, 0x1f52b, 0, 6},
+	{0x1f52c, 0x1f52d, 1, 0},
+	{0x1f52e, 0x1f53d, 0, 6},
+	{0x1f549, 0x1f54a, 0, 7},
+	{0x1f54b, 0x1f54e, 1, 0},
+	{0x1f550, 0x1f55b, 0, 6},
+	{0x1f55c, 0x1f567, 0, 7},
+	{0x1f56f, 0x1f570, 0, 7},
+	{0x1f573, 0x1f579, 0, 7},
+	{0x1f57a, 0x1f57a, 3, 0},
+	{0x1f587, 0x1f587, 0, 7},
+	{0x1f58a, 0x1f58d, 0, 7},
+	{0x1f590, 0x1f590, 0, 7},
+	{0x1f595, 0x1f596, 1, 0},
+	{0x1f5a4, 0x1f5a4, 3, 0},
+	{0x1f5a5, 0x1f5a5, 0, 7},
+	{0x1f5a8, 0x1f5a8, 0, 7},
+	{0x1f5b1, 0x1f5b2, 0, 7},
+	{0x1f5bc, 0x1f5bc, 0, 7},
+	{0x1f5c2, 0x1f5c4, 0, 7},
+	{0x1f5d1, 0x1f5d3, 0, 7},
+	{0x1f5dc, 0x1f5de, 0, 7},
+	{0x1f5e1, 0x1f5e1, 0, 7},
+	{0x1f5e3, 0x1f5e3, 0, 7},
+	{0x1f5e8, 0x1f5e8, 2, 0},
+	{0x1f5ef, 0x1f5ef, 0, 7},
+	{0x1f5f3, 0x1f5f3, 0, 7},
+	{0x1f5fa, 0x1f5fa, 0, 7},
+	{0x1f5fb, 0x1f5ff, 0, 6},
+	{0x1f600, 0x1f600, 1, 0},
+	{0x1f601, 0x1f606, 0, 6},
+	{0x1f607, 0x1f608, 1, 0},
+	{0x1f609, 0x1f60d, 0, 6},
+	{0x1f60e, 0x1f60e, 1, 0},
+	{0x1f60f, 0x1f60f, 0, 6},
+	{0x1f610, 0x1f610, 0, 7},
+	{0x1f611, 0x1f611, 1, 0},
+	{0x1f612, 0x1f614, 0, 6},
+	{0x1f615, 0x1f615, 1, 0},
+	{0x1f616, 0x1f616, 0, 6},
+	{0x1f617, 0x1f617, 1, 0},
+	{0x1f618, 0x1f618, 0, 6},
+	{0x1f619, 0x1f619, 1, 0},
+	{0x1f61a, 0x1f61a, 0, 6},
+	{0x1f61b, 0x1f61b, 1, 0},
+	{0x1f61c, 0x1f61e, 0, 6},
+	{0x1f61f, 0x1f61f, 1, 0},
+	{0x1f620, 0x1f625, 0, 6},
+	{0x1f626, 0x1f627, 1, 0},
+	{0x1f628, 0x1f62b, 0, 6},
+	{0x1f62c, 0x1f62c, 1, 0},
+	{0x1f62d, 0x1f62d, 0, 6},
+	{0x1f62e, 0x1f62f, 1, 0},
+	{0x1f630, 0x1f633, 0, 6},
+	{0x1f634, 0x1f634, 1, 0},
+	{0x1f635, 0x1f635, 0, 6},
+	{0x1f636, 0x1f636, 1, 0},
+	{0x1f637, 0x1f640, 0, 6},
+	{0x1f641, 0x1f644, 1, 0},
+	{0x1f645, 0x1f64f, 0, 6},
+	{0x1f680, 0x1f680, 0, 6},
+	{0x1f681, 0x1f682, 1, 0},
+	{0x1f683, 0x1f685, 0, 6},
+	{0x1f686, 0x1f686, 1, 0},
+	{0x1f687, 0x1f687, 0, 6},
+	{0x1f688, 0x1f688, 1, 0},
+	{0x1f689, 0x1f689, 0, 6},
+	{0x1f68a, 0x1f68b, 1, 0},
+	{0x1f68c, 0x1f68c, 0, 6},
+	{0x1f68d, 0x1f68d, 0, 7},
+	{0x1f68e, 0x1f68e, 1, 0},
+	{0x1f68f, 0x1f68f, 0, 6},
+	{0x1f690, 0x1f690, 1, 0},
+	{0x1f691, 0x1f693, 0, 6},
+	{0x1f694, 0x1f694, 0, 7},
+	{0x1f695, 0x1f695, 0, 6},
+	{0x1f696, 0x1f696, 1, 0},
+	{0x1f697, 0x1f697, 0, 6},
+	{0x1f698, 0x1f698, 0, 7},
+	{0x1f699, 0x1f69a, 0, 6},
+	{0x1f69b, 0x1f6a1, 1, 0},
+	{0x1f6a2, 0x1f6a2, 0, 6},
+	{0x1f6a3, 0x1f6a3, 1, 0},
+	{0x1f6a4, 0x1f6a5, 0, 6},
+	{0x1f6a6, 0x1f6a6, 1, 0},
+	{0x1f6a7, 0x1f6ad, 0, 6},
+	{0x1f6ae, 0x1f6b1, 1, 0},
+	{0x1f6b2, 0x1f6b2, 0, 6},
+	{0x1f6b3, 0x1f6b5, 1, 0},
+	{0x1f6b6, 0x1f6b6, 0, 6},
+	{0x1f6b7, 0x1f6b8, 1, 0},
+	{0x1f6b9, 0x1f6be, 0, 6},
+	{0x1f6bf, 0x1f6bf, 1, 0},
+	{0x1f6c0, 0x1f6c0, 0, 6},
+	{0x1f6c1, 0x1f6c5, 1, 0},
+	{0x1f6cb, 0x1f6cb, 0, 7},
+	{0x1f6cc, 0x1f6cc, 1, 0},
+	{0x1f6cd, 0x1f6cf, 0, 7},
+	{0x1f6d0, 0x1f6d0, 1, 0},
+	{0x1f6d1, 0x1f6d2, 3, 0},
+	{0x1f6d5, 0x1f6d5, 12, 0},
+	{0x1f6d6, 0x1f6d7, 13, 0},
+	{0x1f6dc, 0x1f6dc, 15, 0},
+	{0x1f6dd, 0x1f6df, 14, 0},
+	{0x1f6e0, 0x1f6e5, 0, 7},
+	{0x1f6e9, 0x1f6e9, 0, 7},
+	{0x1f6eb, 0x1f6ec, 1, 0},
+	{0x1f6f0, 0x1f6f0, 0, 7},
+	{0x1f6f3, 0x1f6f3, 0, 7},
+	{0x1f6f4, 0x1f6f6, 3, 0},
+	{0x1f6f7, 0x1f6f8, 5, 0},
+	{0x1f6f9, 0x1f6f9, 11, 0},
+	{0x1f6fa, 0x1f6fa, 12, 0},
+	{0x1f6fb, 0x1f6fc, 13, 0},
+	{0x1f7e0, 0x1f7eb, 12, 0},
+	{0x1f7f0, 0x1f7f0, 14, 0},
+	{0x1f90c, 0x1f90c, 13, 0},
+	{0x1f90d, 0x1f90f, 12, 0},
+	{0x1f910, 0x1f918, 1, 0},
+	{0x1f919, 0x1f91e, 3, 0},
+	{0x1f91f, 0x1f91f, 5, 0},
+	{0x1f920, 0x1f927, 3, 0},
+	{0x1f928, 0x1f92f, 5, 0},
+	{0x1f930, 0x1f930, 3, 0},
+	{0x1f931, 0x1f932, 5, 0},
+	{0x1f933, 0x1f93a, 3, 0},
+	{0x1f93c, 0x1f93e, 3, 0},
+	{0x1f93f, 0x1f93f, 12, 0},
+	{0x1f940, 0x1f945, 3, 0},
+	{0x1f947, 0x1f94b, 3, 0},
+	{0x1f94c, 0x1f94c, 5, 0},
+	{0x1f94d, 0x1f94f, 11, 0},
+	{0x1f950, 0x1f95e, 3, 0},
+	{0x1f95f, 0x1f96b, 5, 0},
+	{0x1f96c, 0x1f970, 11, 0},
+	{0x1f971, 0x1f971, 12, 0},
+	{0x1f972, 0x1f972, 13, 0},
+	{0x1f973, 0x1f976, 11, 0},
+	{0x1f977, 0x1f978, 13, 0},
+	{0x1f979, 0x1f979, 14, 0},
+	{0x1f97a, 0x1f97a, 11, 0},
+	{0x1f97b, 0x1f97b, 12, 0},
+	{0x1f97c, 0x1f97f, 11, 0},
+	{0x1f980, 0x1f984, 1, 0},
+	{0x1f985, 0x1f991, 3, 0},
+	{0x1f992, 0x1f997, 5, 0},
+	{0x1f998, 0x1f9a2, 11, 0},
+	{0x1f9a3, 0x1f9a4, 13, 0},
+	{0x1f9a5, 0x1f9aa, 12, 0},
+	{0x1f9ab, 0x1f9ad, 13, 0},
+	{0x1f9ae, 0x1f9af, 12, 0},
+	{0x1f9b0, 0x1f9b9, 11, 0},
+	{0x1f9ba, 0x1f9bf, 12, 0},
+	{0x1f9c0, 0x1f9c0, 1, 0},
+	{0x1f9c1, 0x1f9c2, 11, 0},
+	{0x1f9c3, 0x1f9ca, 12, 0},
+	{0x1f9cb, 0x1f9cb, 13, 0},
+	{0x1f9cc, 0x1f9cc, 14, 0},
+	{0x1f9cd, 0x1f9cf, 12, 0},
+	{0x1f9d0, 0x1f9e6, 5, 0},
+	{0x1f9e7, 0x1f9ff, 11, 0},
+	{0x1fa70, 0x1fa73, 12, 0},
+	{0x1fa74, 0x1fa74, 13, 0},
+	{0x1fa75, 0x1fa77, 15, 0},
+	{0x1fa78, 0x1fa7a, 12, 0},
+	{0x1fa7b, 0x1fa7c, 14, 0},
+	{0x1fa80, 0x1fa82, 12, 0},
+	{0x1fa83, 0x1fa86, 13, 0},
+	{0x1fa87, 0x1fa88, 15, 0},
+	{0x1fa90, 0x1fa95, 12, 0},
+	{0x1fa96, 0x1faa8, 13, 0},
+	{0x1faa9, 0x1faac, 14, 0},
+	{0x1faad, 0x1faaf, 15, 0},
+	{0x1fab0, 0x1fab6, 13, 0},
+	{0x1fab7, 0x1faba, 14, 0},
+	{0x1fabb, 0x1fabd, 15, 0},
+	{0x1fabf, 0x1fabf, 15, 0},
+	{0x1fac0, 0x1fac2, 13, 0},
+	{0x1fac3, 0x1fac5, 14, 0},
+	{0x1face, 0x1facf, 15, 0},
+	{0x1fad0, 0x1fad6, 13, 0},
+	{0x1fad7, 0x1fad9, 14, 0},
+	{0x1fada, 0x1fadb, 15, 0},
+	{0x1fae0, 0x1fae7, 14, 0},
+	{0x1fae8, 0x1fae8, 15, 0},
+	{0x1faf0, 0x1faf6, 14, 0},
+	{0x1faf7, 0x1faf8, 15, 0},
+	{0xe0020, 0xe007f, 0, 0},
+}
+
+var sequenceAges = []sequenceAge{
+	{"⛓\u200d💥", 15, 1},
+	{"⛓️\u200d💥", 15, 1},
+	{"❤\u200d🔥", 13, 1},
+	{"❤\u200d🩹", 13, 1},
+	{"❤️\u200d🔥", 13, 1},
+	{"❤️\u200d🩹", 13, 1},
+	{"🍄\u200d🟫", 15, 1},
+	{"🍋\u200d🟩", 15, 1},
+	{"🏃\u200d♀\u200d➡", 15, 1},
+	{"🏃\u200d♀\u200d➡️", 15, 1},
+	{"🏃\u200d♀️\u200d➡", 15, 1},
+	{"🏃\u200d♀️\u200d➡️", 15, 1},
+	{"🏃\u200d♂\u200d➡", 15, 1},
+	{"🏃\u200d♂\u200d➡️", 15, 1},
+	{"🏃\u200d♂️\u200d➡", 15, 1},
+	{"🏃\u200d♂️\u200d➡️", 15, 1},
+	{"🏃\u200d➡", 15, 1},
+	{"🏃\u200d➡️", 15, 1},
+	{"🏃🏻\u200d♀\u200d➡", 15, 1},
+	{"🏃🏻\u200d♀\u200d➡️", 15, 1},
+	{"🏃🏻\u200d♀️\u200d➡", 15, 1},
+	{"🏃🏻\u200d♀️\u200d➡️", 15, 1},
+	{"🏃🏻\u200d♂\u200d➡", 15, 1},
+	{"🏃🏻\u200d♂\u200d➡️", 15, 1},
+	{"🏃🏻\u200d♂️\u200d➡", 15, 1},
+	{"🏃🏻\u200d♂️\u200d➡️", 15, 1},
+	{"🏃🏻\u200d➡", 15, 1},
+	{"🏃🏻\u200d➡️", 15, 1},
+	{"🏃🏼\u200d♀\u200d➡", 15, 1},
+	{"🏃🏼\u200d♀\u200d➡️", 15, 1},
+	{"🏃🏼\u200d♀️\u200d➡", 15, 1},
+	{"🏃🏼\u200d♀️\u200d➡️", 15, 1},
+	{"🏃🏼\u200d♂\u200d➡", 15, 1},
+	{"🏃🏼\u200d♂\u200d➡️", 15, 1},
+	{"🏃🏼\u200d♂️\u200d➡", 15, 1},
+	{"🏃🏼\u200d♂️\u200d➡️", 15, 1},
+	{"🏃🏼\u200d➡", 15, 1},
+	{"🏃🏼\u200d➡️", 15, 1},
+	{"🏃🏽\u200d♀\u200d➡", 15, 1},
+	{"🏃🏽\u200d♀\u200d➡️", 15, 1},
+	{"🏃🏽\u200d♀️\u200d➡", 15, 1},
+	{"🏃🏽\u200d♀️\u200d➡️", 15, 1},
+	{"🏃🏽\u200d♂\u200d➡", 15, 1},
+	{"🏃🏽\u200d♂\u200d➡️", 15, 1},
+	{"🏃🏽\u200d♂️\u200d➡", 15, 1},
+	{"🏃🏽\u200d♂️\u200d➡️", 15, 1},
+	{"🏃🏽\u200d➡", 15, 1},
+	{"🏃🏽\u200d➡️", 15, 1},
+	{"🏃🏾\u200d♀\u200d➡", 15, 1},
+	{"🏃🏾\u200d♀\u200d➡️", 15, 1},
+	{"🏃🏾\u200d♀️\u200d➡", 15, 1},
+	{"🏃🏾\u200d♀️\u200d➡️", 15, 1},
+	{"🏃🏾\u200d♂\u200d➡", 15, 1},
+	{"🏃🏾\u200d♂\u200d➡️", 15, 1},
+	{"🏃🏾\u200d♂️\u200d➡", 15, 1},
+	{"🏃🏾\u200d♂️\u200d➡️", 15, 1},
+	{"🏃🏾\u200d➡", 15, 1},
+	{"🏃🏾\u200d➡️", 15, 1},
+	{"🏃🏿\u200d♀\u200d➡", 15, 1},
+	{"🏃🏿\u200d♀\u200d➡️", 15, 1},
+	{"🏃🏿\u200d♀️\u200d➡", 15, 1},
+	{"🏃🏿\u200d♀️\u200d➡️", 15, 1},
+	{"🏃🏿\u200d♂\u200d➡", 15, 1},
+	{"🏃🏿\u200d♂\u200d➡️", 15, 1},
+	{"🏃🏿\u200d♂️\u200d➡", 15, 1},
+	{"🏃🏿\u200d♂️\u200d➡️", 15, 1},
+	{"🏃🏿\u200d➡", 15, 1},
+	{"🏃🏿\u200d➡️", 15, 1},
+	{"🐦\u200d⬛", 15, 0},
+	{"🐦\u200d🔥", 15, 1},
+	{"👨\u200d🦯\u200d➡", 15, 1},
+	{"👨\u200d🦯\u200d➡️", 15, 1},
+	{"👨\u200d🦼\u200d➡", 15, 1},
+	{"👨\u200d🦼\u200d➡️", 15, 1},
+	{"👨\u200d🦽\u200d➡", 15, 1},
+	{"👨\u200d🦽\u200d➡️", 15, 1},
+	{"👨🏻\u200d❤\u200d👨🏻", 13, 1},
+	{"👨🏻\u200d❤\u200d👨🏼", 13, 1},
+	{"👨🏻\u200d❤\u200d👨🏽", 13, 1},
+	{"👨🏻\u200d❤\u200d👨🏾", 13, 1},
+	{"👨🏻\u200d❤\u200d👨🏿", 13, 1},
+	{"👨🏻\u200d❤\u200d💋\u200d👨🏻", 13, 1},
+	{"👨🏻\u200d❤\u200d💋\u200d👨🏼", 13, 1},
+	{"👨🏻\u200d❤\u200d💋\u200d👨🏽", 13, 1},
+	{"👨🏻\u200d❤\u200d💋\u200d👨🏾", 13, 1},
+	{"👨🏻\u200d❤\u200d💋\u200d👨🏿", 13, 1},
+	{"👨🏻\u200d❤️\u200d👨🏻", 13, 1},
+	{"👨🏻\u200d❤️\u200d👨🏼", 13, 1},
+	{"👨🏻\u200d❤️\u200d👨🏽", 13, 1},
+	{"👨🏻\u200d❤️\u200d👨🏾", 13, 1},
+	{"👨🏻\u200d❤️\u200d👨🏿", 13, 1},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏻", 13, 1},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏼", 13, 1},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏽", 13, 1},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏾", 13, 1},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏿", 13, 1},
+	{"👨🏻\u200d🦯\u200d➡", 15, 1},
+	{"👨🏻\u200d🦯\u200d➡️", 15, 1},
+	{"👨🏻\u200d🦼\u200d➡", 15, 1},
+	{"👨🏻\u200d🦼\u200d➡️", 15, 1},
+	{"👨🏻\u200d🦽\u200d➡", 15, 1},
+	{"👨🏻\u200d🦽\u200d➡️", 15, 1},
+	{"👨🏼\u200d❤\u200d👨🏻", 13, 1},
+	{"👨🏼\u200d❤\u200d👨🏼", 13, 1},
+	{"👨🏼\u200d❤\u200d👨🏽", 13, 1},
+	{"👨🏼\u200d❤\u200d👨🏾", 13, 1},
+	{"👨🏼\u200d❤\u200d👨🏿", 13, 1},
+	{"👨🏼\u200d❤\u200d💋\u200d👨🏻", 13, 1},
+	{"👨🏼\u200d❤\u200d💋\u200d👨🏼", 13, 1},
+	{"👨🏼\u200d❤\u200d💋\u200d👨🏽", 13, 1},
+	{"👨🏼\u200d❤\u200d💋\u200d👨🏾", 13, 1},
+	{"👨🏼\u200d❤\u200d💋\u200d👨🏿", 13, 1},
+	{"👨🏼\u200d❤️\u200d👨🏻", 13, 1},
+	{"👨🏼\u200d❤️\u200d👨🏼", 13, 1},
+	{"👨🏼\u200d❤️\u200d👨🏽", 13, 1},
+	{"👨🏼\u200d❤️\u200d👨🏾", 13, 1},
+	{"👨🏼\u200d❤️\u200d👨🏿", 13, 1},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏻", 13, 1},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏼", 13, 1},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏽", 13, 1},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏾", 13, 1},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏿", 13, 1},
+	{"👨🏼\u200d🦯\u200d➡", 15, 1},
+	{"👨🏼\u200d🦯\u200d➡️", 15, 1},
+	{"👨🏼\u200d🦼\u200d➡", 15, 1},
+	{"👨🏼\u200d🦼\u200d➡️", 15, 1},
+	{"👨🏼\u200d🦽\u200d➡", 15, 1},
+	{"👨🏼\u200d🦽\u200d➡️", 15, 1},
+	{"👨🏽\u200d❤\u200d👨🏻", 13, 1},
+	{"👨🏽\u200d❤\u200d👨🏼", 13, 1},
+	{"👨🏽\u200d❤\u200d👨🏽", 13, 1},
+	{"👨🏽\u200d❤\u200d👨🏾", 13, 1},
+	{"👨🏽\u200d❤\u200d👨🏿", 13, 1},
+	{"👨🏽\u200d❤\u200d💋\u200d👨🏻", 13, 1},
+	{"👨🏽\u200d❤\u200d💋\u200d👨🏼", 13, 1},
+	{"👨🏽\u200d❤\u200d💋\u200d👨🏽", 13, 1},
+	{"👨🏽\u200d❤\u200d💋\u200d👨🏾", 13, 1},
+	{"👨🏽\u200d❤\u200d💋\u200d👨🏿", 13, 1},
+	{"👨🏽\u200d❤️\u200d👨🏻", 13, 1},
+	{"👨🏽\u200d❤️\u200d👨🏼", 13, 1},
+	{"👨🏽\u200d❤️\u200d👨🏽", 13, 1},
+	{"👨🏽\u200d❤️\u200d👨🏾", 13, 1},
+	{"👨🏽\u200d❤️\u200d👨🏿", 13, 1},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏻", 13, 1},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏼", 13, 1},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏽", 13, 1},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏾", 13, 1},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏿", 13, 1},
+	{"👨🏽\u200d🦯\u200d➡", 15, 1},
+	{"👨🏽\u200d🦯\u200d➡️", 15, 1},
+	{"👨🏽\u200d🦼\u200d➡", 15, 1},
+	{"👨🏽\u200d🦼\u200d➡️", 15, 1},
+	{"👨🏽\u200d🦽\u200d➡", 15, 1},
+	{"👨🏽\u200d🦽\u200d➡️", 15, 1},
+	{"👨🏾\u200d❤\u200d👨🏻", 13, 1},
+	{"👨🏾\u200d❤\u200d👨🏼", 13, 1},
+	{"👨🏾\u200d❤\u200d👨🏽", 13, 1},
+	{"👨🏾\u200d❤\u200d👨🏾", 13, 1},
+	{"👨🏾\u200d❤\u200d👨🏿", 13, 1},
+	{"👨🏾\u200d❤\u200d💋\u200d👨🏻", 13, 1},
+	{"👨🏾\u200d❤\u200d💋\u200d👨🏼", 13, 1},
+	{"👨🏾\u200d❤\u200d💋\u200d👨🏽", 13, 1},
+	{"👨🏾\u200d❤\u200d💋\u200d👨🏾", 13, 1},
+	{"👨🏾\u200d❤\u200d💋\u200d👨🏿", 13, 1},
+	{"👨🏾\u200d❤️\u200d👨🏻", 13, 1},
+	{"👨🏾\u200d❤️\u200d👨🏼", 13, 1},
+	{"👨🏾\u200d❤️\u200d👨🏽", 13, 1},
+	{"👨🏾\u200d❤️\u200d👨🏾", 13, 1},
+	{"👨🏾\u200d❤️\u200d👨🏿", 13, 1},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏻", 13, 1},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏼", 13, 1},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏽", 13, 1},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏾", 13, 1},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏿", 13, 1},
+	{"👨🏾\u200d🦯\u200d➡", 15, 1},
+	{"👨🏾\u200d🦯\u200d➡️", 15, 1},
+	{"👨🏾\u200d🦼\u200d➡", 15, 1},
+	{"👨🏾\u200d🦼\u200d➡️", 15, 1},
+	{"👨🏾\u200d🦽\u200d➡", 15, 1},
+	{"👨🏾\u200d🦽\u200d➡️", 15, 1},
+	{"👨🏿\u200d❤\u200d👨🏻", 13, 1},
+	{"👨🏿\u200d❤\u200d👨🏼", 13, 1},
+	{"👨🏿\u200d❤\u200d👨🏽", 13, 1},
+	{"👨🏿\u200d❤\u200d👨🏾", 13, 1},
+	{"👨🏿\u200d❤\u200d👨🏿", 13, 1},
+	{"👨🏿\u200d❤\u200d💋\u200d👨🏻", 13, 1},
+	{"👨🏿\u200d❤\u200d💋\u200d👨🏼", 13, 1},
+	{"👨🏿\u200d❤\u200d💋\u200d👨🏽", 13, 1},
+	{"👨🏿\u200d❤\u200d💋\u200d👨🏾", 13, 1},
+	{"👨🏿\u200d❤\u200d💋\u200d👨🏿", 13, 1},
+	{"👨🏿\u200d❤️\u200d👨🏻", 13, 1},
+	{"👨🏿\u200d❤️\u200d👨🏼", 13, 1},
+	{"👨🏿\u200d❤️\u200d👨🏽", 13, 1},
+	{"👨🏿\u200d❤️\u200d👨🏾", 13, 1},
+	{"👨🏿\u200d❤️\u200d👨🏿", 13, 1},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏻", 13, 1},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏼", 13, 1},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏽", 13, 1},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏾", 13, 1},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏿", 13, 1},
+	{"👨🏿\u200d🦯\u200d➡", 15, 1},
+	{"👨🏿\u200d🦯\u200d➡️", 15, 1},
+	{"👨🏿\u200d🦼\u200d➡", 15, 1},
+	{"👨🏿\u200d🦼\u200d➡️", 15, 1},
+	{"👨🏿\u200d🦽\u200d➡", 15, 1},
+	{"👨🏿\u200d🦽\u200d➡️", 15, 1},
+	{"👩\u200d🦯\u200d➡", 15, 1},
+	{"👩\u200d🦯\u200d➡️", 15, 1},
+	{"👩\u200d🦼\u200d➡", 15, 1},
+	{"👩\u200d🦼\u200d➡️", 15, 1},
+	{"👩\u200d🦽\u200d➡", 15, 1},
+	{"👩\u200d🦽\u200d➡️", 15, 1},
+	{"👩🏻\u200d❤\u200d👨🏻", 13, 1},
+	{"👩🏻\u200d❤\u200d👨🏼", 13, 1},
+	{"👩🏻\u200d❤\u200d👨🏽", 13, 1},
+	{"👩🏻\u200d❤\u200d👨🏾", 13, 1},
+	{"👩🏻\u200d❤\u200d👨🏿", 13, 1},
+	{"👩🏻\u200d❤\u200d👩🏻", 13, 1},
+	{"👩🏻\u200d❤\u200d👩🏼", 13, 1},
+	{"👩🏻\u200d❤\u200d👩🏽", 13, 1},
+	{"👩🏻\u200d❤\u200d👩🏾", 13, 1},
+	{"👩🏻\u200d❤\u200d👩🏿", 13, 1},
+	{"👩🏻\u200d❤\u200d💋\u200d👨🏻", 13, 1},
+	{"👩🏻\u200d❤\u200d💋\u200d👨🏼", 13, 1},
+	{"👩🏻\u200d❤\u200d💋\u200d👨🏽", 13, 1},
+	{"👩🏻\u200d❤\u200d💋\u200d👨🏾", 13, 1},
+	{"👩🏻\u200d❤\u200d💋\u200d👨🏿", 13, 1},
+	{"👩🏻\u200d❤\u200d💋\u200d👩🏻", 13, 1},
+	{"👩🏻\u200d❤\u200d💋\u200d👩🏼", 13, 1},
+	{"👩🏻\u200d❤\u200d💋\u200d👩🏽", 13, 1},
+	{"👩🏻\u200d❤\u200d💋\u200d👩🏾", 13, 1},
+	{"👩🏻\u200d❤\u200d💋\u200d👩🏿", 13, 1},
+	{"👩🏻\u200d❤️\u200d👨🏻", 13, 1},
+	{"👩🏻\u200d❤️\u200d👨🏼", 13, 1},
+	{"👩🏻\u200d❤️\u200d👨🏽", 13, 1},
+	{"👩🏻\u200d❤️\u200d👨🏾", 13, 1},
+	{"👩🏻\u200d❤️\u200d👨🏿", 13, 1},
+	{"👩🏻\u200d❤️\u200d👩🏻", 13, 1},
+	{"👩🏻\u200d❤️\u200d👩🏼", 13, 1},
+	{"👩🏻\u200d❤️\u200d👩🏽", 13, 1},
+	{"👩🏻\u200d❤️\u200d👩🏾", 13, 1},
+	{"👩🏻\u200d❤️\u200d👩🏿", 13, 1},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏻", 13, 1},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏼", 13, 1},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏽", 13, 1},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏾", 13, 1},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏿", 13, 1},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏻", 13, 1},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏼", 13, 1},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏽", 13, 1},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏾", 13, 1},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏿", 13, 1},
+	{"👩🏻\u200d🦯\u200d➡", 15, 1},
+	{"👩🏻\u200d🦯\u200d➡️", 15, 1},
+	{"👩🏻\u200d🦼\u200d➡", 15, 1},
+	{"👩🏻\u200d🦼\u200d➡️", 15, 1},
+	{"👩🏻\u200d🦽\u200d➡", 15, 1},
+	{"👩🏻\u200d🦽\u200d➡️", 15, 1},
+	{"👩🏼\u200d❤\u200d👨🏻", 13, 1},
+	{"👩🏼\u200d❤\u200d👨🏼", 13, 1},
+	{"👩🏼\u200d❤\u200d👨🏽", 13, 1},
+	{"👩🏼\u200d❤\u200d👨🏾", 13, 1},
+	{"👩🏼\u200d❤\u200d👨🏿", 13, 1},
+	{"👩🏼\u200d❤\u200d👩🏻", 13, 1},
+	{"👩🏼\u200d❤\u200d👩🏼", 13, 1},
+	{"👩🏼\u200d❤\u200d👩🏽", 13, 1},
+	{"👩🏼\u200d❤\u200d👩🏾", 13, 1},
+	{"👩🏼\u200d❤\u200d👩🏿", 13, 1},
+	{"👩🏼\u200d❤\u200d💋\u200d👨🏻", 13, 1},
+	{"👩🏼\u200d❤\u200d💋\u200d👨🏼", 13, 1},
+	{"👩🏼\u200d❤\u200d💋\u200d👨🏽", 13, 1},
+	{"👩🏼\u200d❤\u200d💋\u200d👨🏾", 13, 1},
+	{"👩🏼\u200d❤\u200d💋\u200d👨🏿", 13, 1},
+	{"👩🏼\u200d❤\u200d💋\u200d👩🏻", 13, 1},
+	{"👩🏼\u200d❤\u200d💋\u200d👩🏼", 13, 1},
+	{"👩🏼\u200d❤\u200d💋\u200d👩🏽", 13, 1},
+	{"👩🏼\u200d❤\u200d💋\u200d👩🏾", 13, 1},
+	{"👩🏼\u200d❤\u200d💋\u200d👩🏿", 13, 1},
+	{"👩🏼\u200d❤️\u200d👨🏻", 13, 1},
+	{"👩🏼\u200d❤️\u200d👨🏼", 13, 1},
+	{"👩🏼\u200d❤️\u200d👨🏽", 13, 1},
+	{"👩🏼\u200d❤️\u200d👨🏾", 13, 1},
+	{"👩🏼\u200d❤️\u200d👨🏿", 13, 1},
+	{"👩🏼\u200d❤️\u200d👩🏻", 13, 1},
+	{"👩🏼\u200d❤️\u200d👩🏼", 13, 1},
+	{"👩🏼\u200d❤️\u200d👩🏽", 13, 1},
+	{"👩🏼\u200d❤️\u200d👩🏾", 13, 1},
+	{"👩🏼\u200d❤️\u200d👩🏿", 13, 1},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏻", 13, 1},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏼", 13, 1},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏽", 13, 1},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏾", 13, 1},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏿", 13, 1},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏻", 13, 1},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏼", 13, 1},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏽", 13, 1},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏾", 13, 1},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏿", 13, 1},
+	{"👩🏼\u200d🦯\u200d➡", 15, 1},
+	{"👩🏼\u200d🦯\u200d➡️", 15, 1},
+	{"👩🏼\u200d🦼\u200d➡", 15, 1},
+	{"👩🏼\u200d🦼\u200d➡️", 15, 1},
+	{"👩🏼\u200d🦽\u200d➡", 15, 1},
+	{"👩🏼\u200d🦽\u200d➡️", 15, 1},
+	{"👩🏽\u200d❤\u200d👨🏻", 13, 1},
+	{"👩🏽\u200d❤\u200d👨🏼", 13, 1},
+	{"👩🏽\u200d❤\u200d👨🏽", 13, 1},
+	{"👩🏽\u200d❤\u200d👨🏾", 13, 1},
+	{"👩🏽\u200d❤\u200d👨🏿", 13, 1},
+	{"👩🏽\u200d❤\u200d👩🏻", 13, 1},
+	{"👩🏽\u200d❤\u200d👩🏼", 13, 1},
+	{"👩🏽\u200d❤\u200d👩🏽", 13, 1},
+	{"👩🏽\u200d❤\u200d👩🏾", 13, 1},
+	{"👩🏽\u200d❤\u200d👩🏿", 13, 1},
+	{"👩🏽\u200d❤\u200d💋\u200d👨🏻", 13, 1},
+	{"👩🏽\u200d❤\u200d💋\u200d👨🏼", 13, 1},
+	{"👩🏽\u200d❤\u200d💋\u200d👨🏽", 13, 1},
+	{"👩🏽\u200d❤\u200d💋\u200d👨🏾", 13, 1},
+	{"👩🏽\u200d❤\u200d💋\u200d👨🏿", 13, 1},
+	{"👩🏽\u200d❤\u200d💋\u200d👩🏻", 13, 1},
+	{"👩🏽\u200d❤\u200d💋\u200d👩🏼", 13, 1},
+	{"👩🏽\u200d❤\u200d💋\u200d👩🏽", 13, 1},
+	{"👩🏽\u200d❤\u200d💋\u200d👩🏾", 13, 1},
+	{"👩🏽\u200d❤\u200d💋\u200d👩🏿", 13, 1},
+	{"👩🏽\u200d❤️\u200d👨🏻", 13, 1},
+	{"👩🏽\u200d❤️\u200d👨🏼", 13, 1},
+	{"👩🏽\u200d❤️\u200d👨🏽", 13, 1},
+	{"👩🏽\u200d❤️\u200d👨🏾", 13, 1},
+	{"👩🏽\u200d❤️\u200d👨🏿", 13, 1},
+	{"👩🏽\u200d❤️\u200d👩🏻", 13, 1},
+	{"👩🏽\u200d❤️\u200d👩🏼", 13, 1},
+	{"👩🏽\u200d❤️\u200d👩🏽", 13, 1},
+	{"👩🏽\u200d❤️\u200d👩🏾", 13, 1},
+	{"👩🏽\u200d❤️\u200d👩🏿", 13, 1},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏻", 13, 1},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏼", 13, 1},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏽", 13, 1},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏾", 13, 1},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏿", 13, 1},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏻", 13, 1},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏼", 13, 1},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏽", 13, 1},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏾", 13, 1},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏿", 13, 1},
+	{"👩🏽\u200d🦯\u200d➡", 15, 1},
+	{"👩🏽\u200d🦯\u200d➡️", 15, 1},
+	{"👩🏽\u200d🦼\u200d➡", 15, 1},
+	{"👩🏽\u200d🦼\u200d➡️", 15, 1},
+	{"👩🏽\u200d🦽\u200d➡", 15, 1},
+	{"👩🏽\u200d🦽\u200d➡️", 15, 1},
+	{"👩🏾\u200d❤\u200d👨🏻", 13, 1},
+	{"👩🏾\u200d❤\u200d👨🏼", 13, 1},
+	{"👩🏾\u200d❤\u200d👨🏽", 13, 1},
+	{"👩🏾\u200d❤\u200d👨🏾", 13, 1},
+	{"👩🏾\u200d❤\u200d👨🏿", 13, 1},
+	{"👩🏾\u200d❤\u200d👩🏻", 13, 1},
+	{"👩🏾\u200d❤\u200d👩🏼", 13, 1},
+	{"👩🏾\u200d❤\u200d👩🏽", 13, 1},
+	{"👩🏾\u200d❤\u200d👩🏾", 13, 1},
+	{"👩🏾\u200d❤\u200d👩🏿", 13, 1},
+	{"👩🏾\u200d❤\u200d💋\u200d👨🏻", 13, 1},
+	{"👩🏾\u200d❤\u200d💋\u200d👨🏼", 13, 1},
+	{"👩🏾\u200d❤\u200d💋\u200d👨🏽", 13, 1},
+	{"👩🏾\u200d❤\u200d💋\u200d👨🏾", 13, 1},
+	{"👩🏾\u200d❤\u200d💋\u200d👨🏿", 13, 1},
+	{"👩🏾\u200d❤\u200d💋\u200d👩🏻", 13, 1},
+	{"👩🏾\u200d❤\u200d💋\u200d👩🏼", 13, 1},
+	{"👩🏾\u200d❤\u200d💋\u200d👩🏽", 13, 1},
+	{"👩🏾\u200d❤\u200d💋\u200d👩🏾", 13, 1},
+	{"👩🏾\u200d❤\u200d💋\u200d👩🏿", 13, 1},
+	{"👩🏾\u200d❤️\u200d👨🏻", 13, 1},
+	{"👩🏾\u200d❤️\u200d👨🏼", 13, 1},
+	{"👩🏾\u200d❤️\u200d👨🏽", 13, 1},
+	{"👩🏾\u200d❤️\u200d👨🏾", 13, 1},
+	{"👩🏾\u200d❤️\u200d👨🏿", 13, 1},
+	{"👩🏾\u200d❤️\u200d👩🏻", 13, 1},
+	{"👩🏾\u200d❤️\u200d👩🏼", 13, 1},
+	{"👩🏾\u200d❤️\u200d👩🏽", 13, 1},
+	{"👩🏾\u200d❤️\u200d👩🏾", 13, 1},
+	{"👩🏾\u200d❤️\u200d👩🏿", 13, 1},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏻", 13, 1},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏼", 13, 1},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏽", 13, 1},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏾", 13, 1},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏿", 13, 1},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏻", 13, 1},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏼", 13, 1},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏽", 13, 1},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏾", 13, 1},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏿", 13, 1},
+	{"👩🏾\u200d🦯\u200d➡", 15, 1},
+	{"👩🏾\u200d🦯\u200d➡️", 15, 1},
+	{"👩🏾\u200d🦼\u200d➡", 15, 1},
+	{"👩🏾\u200d🦼\u200d➡️", 15, 1},
+	{"👩🏾\u200d🦽\u200d➡", 15, 1},
+	{"👩🏾\u200d🦽\u200d➡️", 15, 1},
+	{"👩🏿\u200d❤\u200d👨🏻", 13, 1},
+	{"👩🏿\u200d❤\u200d👨🏼", 13, 1},
+	{"👩🏿\u200d❤\u200d👨🏽", 13, 1},
+	{"👩🏿\u200d❤\u200d👨🏾", 13, 1},
+	{"👩🏿\u200d❤\u200d👨🏿", 13, 1},
+	{"👩🏿\u200d❤\u200d👩🏻", 13, 1},
+	{"👩🏿\u200d❤\u200d👩🏼", 13, 1},
+	{"👩🏿\u200d❤\u200d👩🏽", 13, 1},
+	{"👩🏿\u200d❤\u200d👩🏾", 13, 1},
+	{"👩🏿\u200d❤\u200d👩🏿", 13, 1},
+	{"👩🏿\u200d❤\u200d💋\u200d👨🏻", 13, 1},
+	{"👩🏿\u200d❤\u200d💋\u200d👨🏼", 13, 1},
+	{"👩🏿\u200d❤\u200d💋\u200d👨🏽", 13, 1},
+	{"👩🏿\u200d❤\u200d💋\u200d👨🏾", 13, 1},
+	{"👩🏿\u200d❤\u200d💋\u200d👨🏿", 13, 1},
+	{"👩🏿\u200d❤\u200d💋\u200d👩🏻", 13, 1},
+	{"👩🏿\u200d❤\u200d💋\u200d👩🏼", 13, 1},
+	{"👩🏿\u200d❤\u200d💋\u200d👩🏽", 13, 1},
+	{"👩🏿\u200d❤\u200d💋\u200d👩🏾", 13, 1},
+	{"👩🏿\u200d❤\u200d💋\u200d👩🏿", 13, 1},
+	{"👩🏿\u200d❤️\u200d👨🏻", 13, 1},
+	{"👩🏿\u200d❤️\u200d👨🏼", 13, 1},
+	{"👩🏿\u200d❤️\u200d👨🏽", 13, 1},
+	{"👩🏿\u200d❤️\u200d👨🏾", 13, 1},
+	{"👩🏿\u200d❤️\u200d👨🏿", 13, 1},
+	{"👩🏿\u200d❤️\u200d👩🏻", 13, 1},
+	{"👩🏿\u200d❤️\u200d👩🏼", 13, 1},
+	{"👩🏿\u200d❤️\u200d👩🏽", 13, 1},
+	{"👩🏿\u200d❤️\u200d👩🏾", 13, 1},
+	{"👩🏿\u200d❤️\u200d👩🏿", 13, 1},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏻", 13, 1},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏼", 13, 1},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏽", 13, 1},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏾", 13, 1},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏿", 13, 1},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏻", 13, 1},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏼", 13, 1},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏽", 13, 1},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏾", 13, 1},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏿", 13, 1},
+	{"👩🏿\u200d🦯\u200d➡", 15, 1},
+	{"👩🏿\u200d🦯\u200d➡️", 15, 1},
+	{"👩🏿\u200d🦼\u200d➡", 15, 1},
+	{"👩🏿\u200d🦼\u200d➡️", 15, 1},
+	{"👩🏿\u200d🦽\u200d➡", 15, 1},
+	{"👩🏿\u200d🦽\u200d➡️", 15, 1},
+	{"💏🏻", 13, 1},
+	{"💏🏼", 13, 1},
+	{"💏🏽", 13, 1},
+	{"💏🏾", 13, 1},
+	{"💏🏿", 13, 1},
+	{"💑🏻", 13, 1},
+	{"💑🏼", 13, 1},
+	{"💑🏽", 13, 1},
+	{"💑🏾", 13, 1},
+	{"💑🏿", 13, 1},
+	{"😮\u200d💨", 13, 1},
+	{"😵\u200d💫", 13, 1},
+	{"😶\u200d🌫", 13, 1},
+	{"😶\u200d🌫️", 13, 1},
+	{"🙂\u200d↔", 15, 1},
+	{"🙂\u200d↔️", 15, 1},
+	{"🙂\u200d↕", 15, 1},
+	{"🙂\u200d↕️", 15, 1},
+	{"🚶\u200d♀\u200d➡", 15, 1},
+	{"🚶\u200d♀\u200d➡️", 15, 1},
+	{"🚶\u200d♀️\u200d➡", 15, 1},
+	{"🚶\u200d♀️\u200d➡️", 15, 1},
+	{"🚶\u200d♂\u200d➡", 15, 1},
+	{"🚶\u200d♂\u200d➡️", 15, 1},
+	{"🚶\u200d♂️\u200d➡", 15, 1},
+	{"🚶\u200d♂️\u200d➡️", 15, 1},
+	{"🚶\u200d➡", 15, 1},
+	{"🚶\u200d➡️", 15, 1},
+	{"🚶🏻\u200d♀\u200d➡", 15, 1},
+	{"🚶🏻\u200d♀\u200d➡️", 15, 1},
+	{"🚶🏻\u200d♀️\u200d➡", 15, 1},
+	{"🚶🏻\u200d♀️\u200d➡️", 15, 1},
+	{"🚶🏻\u200d♂\u200d➡", 15, 1},
+	{"🚶🏻\u200d♂\u200d➡️", 15, 1},
+	{"🚶🏻\u200d♂️\u200d➡", 15, 1},
+	{"🚶🏻\u200d♂️\u200d➡️", 15, 1},
+	{"🚶🏻\u200d➡", 15, 1},
+	{"🚶🏻\u200d➡️", 15, 1},
+	{"🚶🏼\u200d♀\u200d➡", 15, 1},
+	{"🚶🏼\u200d♀\u200d➡️", 15, 1},
+	{"🚶🏼\u200d♀️\u200d➡", 15, 1},
+	{"🚶🏼\u200d♀️\u200d➡️", 15, 1},
+	{"🚶🏼\u200d♂\u200d➡", 15, 1},
+	{"🚶🏼\u200d♂\u200d➡️", 15, 1},
+	{"🚶🏼\u200d♂️\u200d➡", 15, 1},
+	{"🚶🏼\u200d♂️\u200d➡️", 15, 1},
+	{"🚶🏼\u200d➡", 15, 1},
+	{"🚶🏼\u200d➡️", 15, 1},
+	{"🚶🏽\u200d♀\u200d➡", 15, 1},
+	{"🚶🏽\u200d♀\u200d➡️", 15, 1},
+	{"🚶🏽\u200d♀️\u200d➡", 15, 1},
+	{"🚶🏽\u200d♀️\u200d➡️", 15, 1},
+	{"🚶🏽\u200d♂\u200d➡", 15, 1},
+	{"🚶🏽\u200d♂\u200d➡️", 15, 1},
+	{"🚶🏽\u200d♂️\u200d➡", 15, 1},
+	{"🚶🏽\u200d♂️\u200d➡️", 15, 1},
+	{"🚶🏽\u200d➡", 15, 1},
+	{"🚶🏽\u200d➡️", 15, 1},
+	{"🚶🏾\u200d♀\u200d➡", 15, 1},
+	{"🚶🏾\u200d♀\u200d➡️", 15, 1},
+	{"🚶🏾\u200d♀️\u200d➡", 15, 1},
+	{"🚶🏾\u200d♀️\u200d➡️", 15, 1},
+	{"🚶🏾\u200d♂\u200d➡", 15, 1},
+	{"🚶🏾\u200d♂\u200d➡️", 15, 1},
+	{"🚶🏾\u200d♂️\u200d➡", 15, 1},
+	{"🚶🏾\u200d♂️\u200d➡️", 15, 1},
+	{"🚶🏾\u200d➡", 15, 1},
+	{"🚶🏾\u200d➡️", 15, 1},
+	{"🚶🏿\u200d♀\u200d➡", 15, 1},
+	{"🚶🏿\u200d♀\u200d➡️", 15, 1},
+	{"🚶🏿\u200d♀️\u200d➡", 15, 1},
+	{"🚶🏿\u200d♀️\u200d➡️", 15, 1},
+	{"🚶🏿\u200d♂\u200d➡", 15, 1},
+	{"🚶🏿\u200d♂\u200d➡️", 15, 1},
+	{"🚶🏿\u200d♂️\u200d➡", 15, 1},
+	{"🚶🏿\u200d♂️\u200d➡️", 15, 1},
+	{"🚶🏿\u200d➡", 15, 1},
+	{"🚶🏿\u200d➡️", 15, 1},
+	{"🛜", 15, 0},
+	{"🛝", 14, 0},
+	{"🛞", 14, 0},
+	{"🛟", 14, 0},
+	{"🟰", 14, 0},
+	{"🤝🏻", 14, 0},
+	{"🤝🏼", 14, 0},
+	{"🤝🏽", 14, 0},
+	{"🤝🏾", 14, 0},
+	{"🤝🏿", 14, 0},
+	{"🥹", 14, 0},
+	{"🧌", 14, 0},
+	{"🧎\u200d♀\u200d➡", 15, 1},
+	{"🧎\u200d♀\u200d➡️", 15, 1},
+	{"🧎\u200d♀️\u200d➡", 15, 1},
+	{"🧎\u200d♀️\u200d➡️", 15, 1},
+	{"🧎\u200d♂\u200d➡", 15, 1},
+	{"🧎\u200d♂\u200d➡️", 15, 1},
+	{"🧎\u200d♂️\u200d➡", 15, 1},
+	{"🧎\u200d♂️\u200d➡️", 15, 1},
+	{"🧎\u200d➡", 15, 1},
+	{"🧎\u200d➡️", 15, 1},
+	{"🧎🏻\u200d♀\u200d➡", 15, 1},
+	{"🧎🏻\u200d♀\u200d➡️", 15, 1},
+	{"🧎🏻\u200d♀️\u200d➡", 15, 1},
+	{"🧎🏻\u200d♀️\u200d➡️", 15, 1},
+	{"🧎🏻\u200d♂\u200d➡", 15, 1},
+	{"🧎🏻\u200d♂\u200d➡️", 15, 1},
+	{"🧎🏻\u200d♂️\u200d➡", 15, 1},
+	{"🧎🏻\u200d♂️\u200d➡️", 15, 1},
+	{"🧎🏻\u200d➡", 15, 1},
+	{"🧎🏻\u200d➡️", 15, 1},
+	{"🧎🏼\u200d♀\u200d➡", 15, 1},
+	{"🧎🏼\u200d♀\u200d➡️", 15, 1},
+	{"🧎🏼\u200d♀️\u200d➡", 15, 1},
+	{"🧎🏼\u200d♀️\u200d➡️", 15, 1},
+	{"🧎🏼\u200d♂\u200d➡", 15, 1},
+	{"🧎🏼\u200d♂\u200d➡️", 15, 1},
+	{"🧎🏼\u200d♂️\u200d➡", 15, 1},
+	{"🧎🏼\u200d♂️\u200d➡️", 15, 1},
+	{"🧎🏼\u200d➡", 15, 1},
+	{"🧎🏼\u200d➡️", 15, 1},
+	{"🧎🏽\u200d♀\u200d➡", 15, 1},
+	{"🧎🏽\u200d♀\u200d➡️", 15, 1},
+	{"🧎🏽\u200d♀️\u200d➡", 15, 1},
+	{"🧎🏽\u200d♀️\u200d➡️", 15, 1},
+	{"🧎🏽\u200d♂\u200d➡", 15, 1},
+	{"🧎🏽\u200d♂\u200d➡️", 15, 1},
+	{"🧎🏽\u200d♂️\u200d➡", 15, 1},
+	{"🧎🏽\u200d♂️\u200d➡️", 15, 1},
+	{"🧎🏽\u200d➡", 15, 1},
+	{"🧎🏽\u200d➡️", 15, 1},
+	{"🧎🏾\u200d♀\u200d➡", 15, 1},
+	{"🧎🏾\u200d♀\u200d➡️", 15, 1},
+	{"🧎🏾\u200d♀️\u200d➡", 15, 1},
+	{"🧎🏾\u200d♀️\u200d➡️", 15, 1},
+	{"🧎🏾\u200d♂\u200d➡", 15, 1},
+	{"🧎🏾\u200d♂\u200d➡️", 15, 1},
+	{"🧎🏾\u200d♂️\u200d➡", 15, 1},
+	{"🧎🏾\u200d♂️\u200d➡️", 15, 1},
+	{"🧎🏾\u200d➡", 15, 1},
+	{"🧎🏾\u200d➡️", 15, 1},
+	{"🧎🏿\u200d♀\u200d➡", 15, 1},
+	{"🧎🏿\u200d♀\u200d➡️", 15, 1},
+	{"🧎🏿\u200d♀️\u200d➡", 15, 1},
+	{"🧎🏿\u200d♀️\u200d➡️", 15, 1},
+	{"🧎🏿\u200d♂\u200d➡", 15, 1},
+	{"🧎🏿\u200d♂\u200d➡️", 15, 1},
+	{"🧎🏿\u200d♂️\u200d➡", 15, 1},
+	{"🧎🏿\u200d♂️\u200d➡️", 15, 1},
+	{"🧎🏿\u200d➡", 15, 1},
+	{"🧎🏿\u200d➡️", 15, 1},
+	{"🧑\u200d🦯\u200d➡", 15, 1},
+	{"🧑\u200d🦯\u200d➡️", 15, 1},
+	{"🧑\u200d🦼\u200d➡", 15, 1},
+	{"🧑\u200d🦼\u200d➡️", 15, 1},
+	{"🧑\u200d🦽\u200d➡", 15, 1},
+	{"🧑\u200d🦽\u200d➡️", 15, 1},
+	{"🧑\u200d🧑\u200d🧒", 15, 1},
+	{"🧑\u200d🧑\u200d🧒\u200d🧒", 15, 1},
+	{"🧑\u200d🧒", 15, 1},
+	{"🧑\u200d🧒\u200d🧒", 15, 1},
+	{"🧑🏻\u200d❤\u200d💋\u200d🧑🏼", 13, 1},
+	{"🧑🏻\u200d❤\u200d💋\u200d🧑🏽", 13, 1},
+	{"🧑🏻\u200d❤\u200d💋\u200d🧑🏾", 13, 1},
+	{"🧑🏻\u200d❤\u200d💋\u200d🧑🏿", 13, 1},
+	{"🧑🏻\u200d❤\u200d🧑🏼", 13, 1},
+	{"🧑🏻\u200d❤\u200d🧑🏽", 13, 1},
+	{"🧑🏻\u200d❤\u200d🧑🏾", 13, 1},
+	{"🧑🏻\u200d❤\u200d🧑🏿", 13, 1},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", 13, 1},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", 13, 1},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", 13, 1},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", 13, 1},
+	{"🧑🏻\u200d❤️\u200d🧑🏼", 13, 1},
+	{"🧑🏻\u200d❤️\u200d🧑🏽", 13, 1},
+	{"🧑🏻\u200d❤️\u200d🧑🏾", 13, 1},
+	{"🧑🏻\u200d❤️\u200d🧑🏿", 13, 1},
+	{"🧑🏻\u200d🦯\u200d➡", 15, 1},
+	{"🧑🏻\u200d🦯\u200d➡️", 15, 1},
+	{"🧑🏻\u200d🦼\u200d➡", 15, 1},
+	{"🧑🏻\u200d🦼\u200d➡️", 15, 1},
+	{"🧑🏻\u200d🦽\u200d➡", 15, 1},
+	{"🧑🏻\u200d🦽\u200d➡️", 15, 1},
+	{"🧑🏼\u200d❤\u200d💋\u200d🧑🏻", 13, 1},
+	{"🧑🏼\u200d❤\u200d💋\u200d🧑🏽", 13, 1},
+	{"🧑🏼\u200d❤\u200d💋\u200d🧑🏾", 13, 1},
+	{"🧑🏼\u200d❤\u200d💋\u200d🧑🏿", 13, 1},
+	{"🧑🏼\u200d❤\u200d🧑🏻", 13, 1},
+	{"🧑🏼\u200d❤\u200d🧑🏽", 13, 1},
+	{"🧑🏼\u200d❤\u200d🧑🏾", 13, 1},
+	{"🧑🏼\u200d❤\u200d🧑🏿", 13, 1},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", 13, 1},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", 13, 1},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", 13, 1},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", 13, 1},
+	{"🧑🏼\u200d❤️\u200d🧑🏻", 13, 1},
+	{"🧑🏼\u200d❤️\u200d🧑🏽", 13, 1},
+	{"🧑🏼\u200d❤️\u200d🧑🏾", 13, 1},
+	{"🧑🏼\u200d❤️\u200d🧑🏿", 13, 1},
+	{"🧑🏼\u200d🦯\u200d➡", 15, 1},
+	{"🧑🏼\u200d🦯\u200d➡️", 15, 1},
+	{"🧑🏼\u200d🦼\u200d➡", 15, 1},
+	{"🧑🏼\u200d🦼\u200d➡️", 15, 1},
+	{"🧑🏼\u200d🦽\u200d➡", 15, 1},
+	{"🧑🏼\u200d🦽\u200d➡️", 15, 1},
+	{"🧑🏽\u200d❤\u200d💋\u200d🧑🏻", 13, 1},
+	{"🧑🏽\u200d❤\u200d💋\u200d🧑🏼", 13, 1},
+	{"🧑🏽\u200d❤\u200d💋\u200d🧑🏾", 13, 1},
+	{"🧑🏽\u200d❤\u200d💋\u200d🧑🏿", 13, 1},
+	{"🧑🏽\u200d❤\u200d🧑🏻", 13, 1},
+	{"🧑🏽\u200d❤\u200d🧑🏼", 13, 1},
+	{"🧑🏽\u200d❤\u200d🧑🏾", 13, 1},
+	{"🧑🏽\u200d❤\u200d🧑🏿", 13, 1},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", 13, 1},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", 13, 1},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", 13, 1},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", 13, 1},
+	{"🧑🏽\u200d❤️\u200d🧑🏻", 13, 1},
+	{"🧑🏽\u200d❤️\u200d🧑🏼", 13, 1},
+	{"🧑🏽\u200d❤️\u200d🧑🏾", 13, 1},
+	{"🧑🏽\u200d❤️\u200d🧑🏿", 13, 1},
+	{"🧑🏽\u200d🦯\u200d➡", 15, 1},
+	{"🧑🏽\u200d🦯\u200d➡️", 15, 1},
+	{"🧑🏽\u200d🦼\u200d➡", 15, 1},
+	{"🧑🏽\u200d🦼\u200d➡️", 15, 1},
+	{"🧑🏽\u200d🦽\u200d➡", 15, 1},
+	{"🧑🏽\u200d🦽\u200d➡️", 15, 1},
+	{"🧑🏾\u200d❤\u200d💋\u200d🧑🏻", 13, 1},
+	{"🧑🏾\u200d❤\u200d💋\u200d🧑🏼", 13, 1},
+	{"🧑🏾\u200d❤\u200d💋\u200d🧑🏽", 13, 1},
+	{"🧑🏾\u200d❤\u200d💋\u200d🧑🏿", 13, 1},
+	{"🧑🏾\u200d❤\u200d🧑🏻", 13, 1},
+	{"🧑🏾\u200d❤\u200d🧑🏼", 13, 1},
+	{"🧑🏾\u200d❤\u200d🧑🏽", 13, 1},
+	{"🧑🏾\u200d❤\u200d🧑🏿", 13, 1},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", 13, 1},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", 13, 1},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", 13, 1},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", 13, 1},
+	{"🧑🏾\u200d❤️\u200d🧑🏻", 13, 1},
+	{"🧑🏾\u200d❤️\u200d🧑🏼", 13, 1},
+	{"🧑🏾\u200d❤️\u200d🧑🏽", 13, 1},
+	{"🧑🏾\u200d❤️\u200d🧑🏿", 13, 1},
+	{"🧑🏾\u200d🦯\u200d➡", 15, 1},
+	{"🧑🏾\u200d🦯\u200d➡️", 15, 1},
+	{"🧑🏾\u200d🦼\u200d➡", 15, 1},
+	{"🧑🏾\u200d🦼\u200d➡️", 15, 1},
+	{"🧑🏾\u200d🦽\u200d➡", 15, 1},
+	{"🧑🏾\u200d🦽\u200d➡️", 15, 1},
+	{"🧑🏿\u200d❤\u200d💋\u200d🧑🏻", 13, 1},
+	{"🧑🏿\u200d❤\u200d💋\u200d🧑🏼", 13, 1},
+	{"🧑🏿\u200d❤\u200d💋\u200d🧑🏽", 13, 1},
+	{"🧑🏿\u200d❤\u200d💋\u200d🧑🏾", 13, 1},
+	{"🧑🏿\u200d❤\u200d🧑🏻", 13, 1},
+	{"🧑🏿\u200d❤\u200d🧑🏼", 13, 1},
+	{"🧑🏿\u200d❤\u200d🧑🏽", 13, 1},
+	{"🧑🏿\u200d❤\u200d🧑🏾", 13, 1},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", 13, 1},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", 13, 1},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", 13, 1},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", 13, 1},
+	{"🧑🏿\u200d❤️\u200d🧑🏻", 13, 1},
+	{"🧑🏿\u200d❤️\u200d🧑🏼", 13, 1},
+	{"🧑🏿\u200d❤️\u200d🧑🏽", 13, 1},
+	{"🧑🏿\u200d❤️\u200d🧑🏾", 13, 1},
+	{"🧑🏿\u200d🦯\u200d➡", 15, 1},
+	{"🧑🏿\u200d🦯\u200d➡️", 15, 1},
+	{"🧑🏿\u200d🦼\u200d➡", 15, 1},
+	{"🧑🏿\u200d🦼\u200d➡️", 15, 1},
+	{"🧑🏿\u200d🦽\u200d➡", 15, 1},
+	{"🧑🏿\u200d🦽\u200d➡️", 15, 1},
+	{"🧔\u200d♀", 13, 1},
+	{"🧔\u200d♀️", 13, 1},
+	{"🧔\u200d♂", 13, 1},
+	{"🧔\u200d♂️", 13, 1},
+	{"🧔🏻\u200d♀", 13, 1},
+	{"🧔🏻\u200d♀️", 13, 1},
+	{"🧔🏻\u200d♂", 13, 1},
+	{"🧔🏻\u200d♂️", 13, 1},
+	{"🧔🏼\u200d♀", 13, 1},
+	{"🧔🏼\u200d♀️", 13, 1},
+	{"🧔🏼\u200d♂", 13, 1},
+	{"🧔🏼\u200d♂️", 13, 1},
+	{"🧔🏽\u200d♀", 13, 1},
+	{"🧔🏽\u200d♀️", 13, 1},
+	{"🧔🏽\u200d♂", 13, 1},
+	{"🧔🏽\u200d♂️", 13, 1},
+	{"🧔🏾\u200d♀", 13, 1},
+	{"🧔🏾\u200d♀️", 13, 1},
+	{"🧔🏾\u200d♂", 13, 1},
+	{"🧔🏾\u200d♂️", 13, 1},
+	{"🧔🏿\u200d♀", 13, 1},
+	{"🧔🏿\u200d♀️", 13, 1},
+	{"🧔🏿\u200d♂", 13, 1},
+	{"🧔🏿\u200d♂️", 13, 1},
+	{"🩵", 15, 0},
+	{"🩶", 15, 0},
+	{"🩷", 15, 0},
+	{"🩻", 14, 0},
+	{"🩼", 14, 0},
+	{"🪇", 15, 0},
+	{"🪈", 15, 0},
+	{"🪩", 14, 0},
+	{"🪪", 14, 0},
+	{"🪫", 14, 0},
+	{"🪬", 14, 0},
+	{"🪭", 15, 0},
+	{"🪮", 15, 0},
+	{"🪯", 15, 0},
+	{"🪷", 14, 0},
+	{"🪸", 14, 0},
+	{"🪹", 14, 0},
+	{"🪺", 14, 0},
+	{"🪻", 15, 0},
+	{"🪼", 15, 0},
+	{"🪽", 15, 0},
+	{"🪿", 15, 0},
+	{"🫃", 14, 0},
+	{"🫃🏻", 14, 0},
+	{"🫃🏼", 14, 0},
+	{"🫃🏽", 14, 0},
+	{"🫃🏾", 14, 0},
+	{"🫃🏿", 14, 0},
+	{"🫄", 14, 0},
+	{"🫄🏻", 14, 0},
+	{"🫄🏼", 14, 0},
+	{"🫄🏽", 14, 0},
+	{"🫄🏾", 14, 0},
+	{"🫄🏿", 14, 0},
+	{"🫅", 14, 0},
+	{"🫅🏻", 14, 0},
+	{"🫅🏼", 14, 0},
+	{"🫅🏽", 14, 0},
+	{"🫅🏾", 14, 0},
+	{"🫅🏿", 14, 0},
+	{"🫎", 15, 0},
+	{"🫏", 15, 0},
+	{"🫗", 14, 0},
+	{"🫘", 14, 0},
+	{"🫙", 14, 0},
+	{"🫚", 15, 0},
+	{"🫛", 15, 0},
+	{"🫠", 14, 0},
+	{"🫡", 14, 0},
+	{"🫢", 14, 0},
+	{"🫣", 14, 0},
+	{"🫤", 14, 0},
+	{"🫥", 14, 0},
+	{"🫦", 14, 0},
+	{"🫧", 14, 0},
+	{"🫨", 15, 0},
+	{"🫰", 14, 0},
+	{"🫰🏻", 14, 0},
+	{"🫰🏼", 14, 0},
+	{"🫰🏽", 14, 0},
+	{"🫰🏾", 14, 0},
+	{"🫰🏿", 14, 0},
+	{"🫱", 14, 0},
+	{"🫱🏻", 14, 0},
+	{"🫱🏻\u200d🫲🏼", 14, 0},
+	{"🫱🏻\u200d🫲🏽", 14, 0},
+	{"🫱🏻\u200d🫲🏾", 14, 0},
+	{"🫱🏻\u200d🫲🏿", 14, 0},
+	{"🫱🏼", 14, 0},
+	{"🫱🏼\u200d🫲🏻", 14, 0},
+	{"🫱🏼\u200d🫲🏽", 14, 0},
+	{"🫱🏼\u200d🫲🏾", 14, 0},
+	{"🫱🏼\u200d🫲🏿", 14, 0},
+	{"🫱🏽", 14, 0},
+	{"🫱🏽\u200d🫲🏻", 14, 0},
+	{"🫱🏽\u200d🫲🏼", 14, 0},
+	{"🫱🏽\u200d🫲🏾", 14, 0},
+	{"🫱🏽\u200d🫲🏿", 14, 0},
+	{"🫱🏾", 14, 0},
+	{"🫱🏾\u200d🫲🏻", 14, 0},
+	{"🫱🏾\u200d🫲🏼", 14, 0},
+	{"🫱🏾\u200d🫲🏽", 14, 0},
+	{"🫱🏾\u200d🫲🏿", 14, 0},
+	{"🫱🏿", 14, 0},
+	{"🫱🏿\u200d🫲🏻", 14, 0},
+	{"🫱🏿\u200d🫲🏼", 14, 0},
+	{"🫱🏿\u200d🫲🏽", 14, 0},
+	{"🫱🏿\u200d🫲🏾", 14, 0},
+	{"🫲", 14, 0},
+	{"🫲🏻", 14, 0},
+	{"🫲🏼", 14, 0},
+	{"🫲🏽", 14, 0},
+	{"🫲🏾", 14, 0},
+	{"🫲🏿", 14, 0},
+	{"🫳", 14, 0},
+	{"🫳🏻", 14, 0},
+	{"🫳🏼", 14, 0},
+	{"🫳🏽", 14, 0},
+	{"🫳🏾", 14, 0},
+	{"🫳🏿", 14, 0},
+	{"🫴", 14, 0},
+	{"🫴🏻", 14, 0},
+	{"🫴🏼", 14, 0},
+	{"🫴🏽", 14, 0},
+	{"🫴🏾", 14, 0},
+	{"🫴🏿", 14, 0},
+	{"🫵", 14, 0},
+	{"🫵🏻", 14, 0},
+	{"🫵🏼", 14, 0},
+	{"🫵🏽", 14, 0},
+	{"🫵🏾", 14, 0},
+	{"🫵🏿", 14, 0},
+	{"🫶", 14, 0},
+	{"🫶🏻", 14, 0},
+	{"🫶🏼", 14, 0},
+	{"🫶🏽", 14, 0},
+	{"🫶🏾", 14, 0},
+	{"🫶🏿", 14, 0},
+	{"🫷", 15, 0},
+	{"🫷🏻", 15, 0},
+	{"🫷🏼", 15, 0},
+	{"🫷🏽", 15, 0},
+	{"🫷🏾", 15, 0},
+	{"🫷🏿", 15, 0},
+	{"🫸", 15, 0},
+	{"🫸🏻", 15, 0},
+	{"🫸🏼", 15, 0},
+	{"🫸🏽", 15, 0},
+	{"🫸🏾", 15, 0},
+	{"🫸🏿", 15, 0},
+}
@@ -0,0 +1,58 @@
+//go:build go1.23
+
+package emoji
+
+import "iter"
+
+// All returns an iterator over the emoji of b and their byte offset
+//
+//	for i, g := range emoji.All(b) {
+//		fmt.Println(i, string(g))
+//	}
+func All(b []byte) iter.Seq2[int, []byte] {
+	return func(yield func(int, []byte) bool) {
+		it := NewIterator(b)
+		for it.Next() {
+			if it.IsEmoji() && !yield(it.Offset(), it.Segment()) {
+				return
+			}
+		}
+	}
+}
+
+// AllString is the string equivalent of All
+func AllString(s string) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		it := NewStringIterator(s)
+		for it.Next() {
+			if it.IsEmoji() && !yield(it.Offset(), it.Segment()) {
+				return
+			}
+		}
+	}
+}
+
+// Segments returns an iterator over every segment of b, emoji or text, and whether it is an emoji
+// concatenating the segments gives back b
+func Segments(b []byte) iter.Seq2[[]byte, bool] {
+	return func(yield func([]byte, bool) bool) {
+		it := NewIterator(b)
+		for it.Next() {
+			if !yield(it.Segment(), it.IsEmoji()) {
+				return
+			}
+		}
+	}
+}
+
+// SegmentsString is the string equivalent of Segments
+func SegmentsString(s string) iter.Seq2[string, bool] {
+	return func(yield func(string, bool) bool) {
+		it := NewStringIterator(s)
+		for it.Next() {
+			if !yield(it.Segment(), it.IsEmoji()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+//go:build go1.23
+
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_All(t *testing.T) {
+	for _, test := range iteratorTests {
+		var expected, segments, stringSegments []segment
+		for _, s := range test.segments {
+			if s.emoji {
+				expected = append(expected, s)
+			}
+		}
+		for i, g := range All([]byte(test.s)) {
+			segments = append(segments, segment{i, string(g), true})
+		}
+		for i, g := range AllString(test.s) {
+			stringSegments = append(stringSegments, segment{i, g, true})
+		}
+		if !reflect.DeepEqual(segments, expected) {
+			t.Errorf("All(%q) returned %v not %v", test.s, segments, expected)
+		}
+		if !reflect.DeepEqual(stringSegments, expected) {
+			t.Errorf("AllString(%q) returned %v not %v", test.s, stringSegments, expected)
+		}
+	}
+	for range All([]byte("😀😀")) {
+		break
+	}
+}
+
+func Test_Segments(t *testing.T) {
+	for _, test := range iteratorTests {
+		var s string
+		var emoji int
+		for g, ok := range SegmentsString(test.s) {
+			s += g
+			if ok {
+				emoji++
+			}
+		}
+		for g := range Segments([]byte(test.s)) {
+			s += string(g)
+		}
+		if s != test.s+test.s {
+			t.Errorf("Segments(%q) did not cover the input, got %q", test.s, s)
+		}
+		if emoji != len(FindString(test.s, -1)) {
+			t.Errorf("SegmentsString(%q) returned %d emoji", test.s, emoji)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+// Command emoji finds, counts, replaces and describes emoji
+//
+// Usage:
+//
+//	emoji find [files]                     list the emoji with their file:line:column, column in bytes
+//	emoji stats [-top n] [-group-skin-tones] [-normalize] [files]
+//	                                       print the most frequent emoji
+//	emoji replace [-w] [-with text] [-shortcodes dialect] [files]
+//	                                       remove the emoji, or replace them with text or their shortcode
+//	emoji describe emoji...                print the code points, name and properties of emoji
+//
+// files default to the standard input
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Succo/emoji"
+	"golang.org/x/text/transform"
+)
+
+const usage = `usage:
+	emoji find [files]
+	emoji stats [-top n] [-group-skin-tones] [-normalize] [files]
+	emoji replace [-w] [-with text] [-shortcodes dialect] [files]
+	emoji describe emoji...
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	var err error
+	switch args[0] {
+	case "find":
+		err = find(args[1:], stdin, stdout)
+	case "stats":
+		err = stats(args[1:], stdin, stdout)
+	case "replace":
+		err = replace(args[1:], stdin, stdout)
+	case "describe":
+		err = describe(args[1:], stdout)
+	default:
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "emoji %s: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
+
+// input is a named reader, a file or the standard input
+type input struct {
+	name string
+	r    io.Reader
+}
+
+// eachInput calls f with every file of names, or the standard input if there are none
+func eachInput(names []string, stdin io.Reader, f func(input) error) error {
+	if len(names) == 0 {
+		return f(input{"-", stdin})
+	}
+	for _, name := range names {
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		err = f(input{name, file})
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func find(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("find", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	w := bufio.NewWriter(stdout)
+	defer w.Flush()
+	return eachInput(flags.Args(), stdin, func(in input) error {
+		reader := bufio.NewReader(in.r)
+		for line := 1; ; line++ {
+			l, err := reader.ReadBytes('\n')
+			it := emoji.NewIterator(l)
+			for it.Next() {
+				if !it.IsEmoji() {
+					continue
+				}
+				g := string(it.Segment())
+				fmt.Fprintf(w, "%s:%d:%d: %s %s", in.name, line, it.Offset()+1, g, codePoints(g))
+				if info, ok := emoji.Lookup(g); ok {
+					fmt.Fprintf(w, " %s", info.Name)
+				}
+				fmt.Fprintln(w)
+			}
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	})
+}
+
+func stats(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("stats", flag.ContinueOnError)
+	top := flags.Int("top", 10, "number of emoji to print, -1 for all")
+	skinTones := flags.Bool("group-skin-tones", false, "count 👍🏽 as 👍")
+	normalize := flags.Bool("normalize", false, "count ☺ as ☺️")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	counter := emoji.NewCounter(emoji.CounterOptions{StripSkinTone: *skinTones, Normalize: *normalize})
+	err := eachInput(flags.Args(), stdin, func(in input) error {
+		reader := bufio.NewReader(in.r)
+		for {
+			l, err := reader.ReadBytes('\n')
+			counter.Add(l)
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(stdout)
+	defer w.Flush()
+	for _, e := range counter.Top(*top) {
+		fmt.Fprintf(w, "%d\t%s\t%s\n", e.Count, e.Emoji, codePoints(e.Emoji))
+	}
+	fmt.Fprintf(w, "%d emoji, %d distinct\n", counter.Total(), counter.Len())
+	return nil
+}
+
+func replace(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("replace", flag.ContinueOnError)
+	inPlace := flags.Bool("w", false, "write the result to the files instead of the standard output")
+	with := flags.String("with", "", "text replacing every emoji, the emoji are removed by default")
+	shortcodes := flags.String("shortcodes", "", "replace every emoji with its shortcode in this dialect: cldr or github, emoji without one are replaced by -with")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	f := func(g []byte) []byte { return []byte(*with) }
+	if *shortcodes != "" {
+		dialect, err := emoji.ParseDialect(*shortcodes)
+		if err != nil {
+			return err
+		}
+		f = func(g []byte) []byte {
+			if code, ok := emoji.Shortcode(string(g), dialect); ok {
+				return []byte(code)
+			}
+			return []byte(*with)
+		}
+	}
+	if *inPlace && len(flags.Args()) == 0 {
+		return fmt.Errorf("-w requires files")
+	}
+	return eachInput(flags.Args(), stdin, func(in input) error {
+		if !*inPlace {
+			_, err := io.Copy(stdout, transform.NewReader(in.r, emoji.ReplaceTransformer(f)))
+			return err
+		}
+		return replaceFile(in, f)
+	})
+}
+
+// replaceFile writes the replaced content of in to a temporary file renamed over in
+// so the file is never left half written, it is left untouched if it has no emoji
+func replaceFile(in input, f func(g []byte) []byte) error {
+	info, err := os.Stat(in.name)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(in.name), "."+filepath.Base(in.name)+".*")
+	if err != nil {
+		return err
+	}
+	var changed bool
+	replacer := emoji.ReplaceTransformer(func(g []byte) []byte {
+		changed = true
+		return f(g)
+	})
+	_, err = io.Copy(tmp, transform.NewReader(in.r, replacer))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && changed {
+		err = os.Chmod(tmp.Name(), info.Mode())
+	}
+	if err == nil && changed {
+		err = os.Rename(tmp.Name(), in.name)
+	}
+	if err != nil || !changed {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func describe(args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no emoji to describe")
+	}
+	w := bufio.NewWriter(stdout)
+	defer w.Flush()
+	for i, s := range args {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s\t%s\n", s, codePoints(s))
+		if info, ok := emoji.Lookup(s); ok {
+			fmt.Fprintf(w, "name:\t%s\n", info.Name)
+			fmt.Fprintf(w, "group:\t%s / %s\n", info.Group, info.Subgroup)
+			fmt.Fprintf(w, "status:\t%s\n", info.Status)
+		} else if !emoji.PossibleGlyphString(s) {
+			fmt.Fprintf(w, "status:\tnot a single emoji\n")
+		} else {
+			fmt.Fprintf(w, "status:\t%s\n", emoji.Qualification(s))
+		}
+		if major, minor, ok := emoji.Age(s); ok {
+			fmt.Fprintf(w, "version:\tE%d.%d\n", major, minor)
+		}
+		fmt.Fprintf(w, "rgi:\t%v\n", emoji.IsRGIString(s))
+		if code, ok := emoji.Shortcode(s, emoji.CLDR); ok {
+			fmt.Fprintf(w, "shortcode:\t%s\n", code)
+		}
+		for _, info := range emoji.Describe(s) {
+			fmt.Fprintf(w, "%U\t%s\t%s\n", info.Rune, info.Role, strings.Join(properties(info), ", "))
+		}
+	}
+	return nil
+}
+
+// codePoints formats the code points of s such as "U+1F44D U+1F3FD"
+func codePoints(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%U", r)
+	}
+	return b.String()
+}
+
+// properties lists the emoji properties of a code point
+func properties(info emoji.CodePointInfo) []string {
+	var props []string
+	for _, p := range []struct {
+		name string
+		is   bool
+	}{
+		{"Emoji", info.Emoji},
+		{"Emoji_Presentation", info.EmojiPresentation},
+		{"Emoji_Modifier", info.EmojiModifier},
+		{"Emoji_Modifier_Base", info.EmojiModifierBase},
+		{"Emoji_Component", info.EmojiComponent},
+		{"Extended_Pictographic", info.ExtendedPictographic},
+		{"Regional_Indicator", info.RegionalIndicator},
+		{"Tag", info.Tag},
+	} {
+		if p.is {
+			props = append(props, p.name)
+		}
+	}
+	if len(props) == 0 {
+		props = append(props, "no emoji property")
+	}
+	return props
+}
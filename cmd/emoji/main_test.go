@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runString(t *testing.T, stdin string, args ...string) (string, int) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return stdout.String() + stderr.String(), code
+}
+
+func Test_Find(t *testing.T) {
+	out, code := runString(t, "hello 👋\nI ❤️ 🇯🇵\n", "find")
+	expected := "-:1:7: 👋 U+1F44B waving hand\n-:2:3: ❤️ U+2764 U+FE0F red heart\n-:2:10: 🇯🇵 U+1F1EF U+1F1F5 flag: Japan\n"
+	if code != 0 || out != expected {
+		t.Errorf("find returned %d %q not %q", code, out, expected)
+	}
+}
+
+func Test_Stats(t *testing.T) {
+	out, code := runString(t, "🍕🍕 👍🏽 👍", "stats", "-top", "2", "-group-skin-tones")
+	expected := "2\t🍕\tU+1F355\n2\t👍\tU+1F44D\n4 emoji, 2 distinct\n"
+	if code != 0 || out != expected {
+		t.Errorf("stats returned %d %q not %q", code, out, expected)
+	}
+}
+
+func Test_Replace(t *testing.T) {
+	out, code := runString(t, "I ❤️ 🍕!", "replace")
+	if code != 0 || out != "I  !" {
+		t.Errorf("replace returned %d %q", code, out)
+	}
+	out, code = runString(t, "I ❤️ 🍕!", "replace", "-shortcodes", "github")
+	if code != 0 || out != "I :heart: :pizza:!" {
+		t.Errorf("replace returned %d %q", code, out)
+	}
+	if _, code = runString(t, "", "replace", "-shortcodes", "irc"); code != 1 {
+		t.Errorf("replace with an unknown dialect returned %d", code)
+	}
+
+	file := filepath.Join(t.TempDir(), "text.txt")
+	if err := os.WriteFile(file, []byte("ok 👍\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if out, code = runString(t, "", "replace", "-w", "-with", "[emoji]", file); code != 0 || out != "" {
+		t.Errorf("replace -w returned %d %q", code, out)
+	}
+	if b, err := os.ReadFile(file); err != nil || string(b) != "ok [emoji]\n" {
+		t.Errorf("replace -w wrote %q %v", b, err)
+	}
+	if info, err := os.Stat(file); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("replace -w changed the mode of the file %v %v", info.Mode(), err)
+	}
+	if _, code = runString(t, "", "replace", "-w", file); code != 0 {
+		t.Errorf("replace -w without emoji returned %d", code)
+	}
+	if entries, err := os.ReadDir(filepath.Dir(file)); err != nil || len(entries) != 1 {
+		t.Errorf("replace -w left temporary files %v %v", entries, err)
+	}
+}
+
+func Test_Describe(t *testing.T) {
+	out, code := runString(t, "", "describe", "👍🏽")
+	for _, expected := range []string{
+		"👍🏽\tU+1F44D U+1F3FD\n",
+		"name:\tthumbs up: medium skin tone\n",
+		"group:\tPeople & Body / hand-fingers-closed\n",
+		"status:\tfully-qualified\n",
+		"version:\tE1.0\n",
+		"rgi:\ttrue\n",
+		"U+1F3FD\tmodifier\tEmoji, Emoji_Presentation, Emoji_Modifier, Emoji_Component\n",
+	} {
+		if code != 0 || !strings.Contains(out, expected) {
+			t.Errorf("describe returned %d %q without %q", code, out, expected)
+		}
+	}
+}
+
+func Test_Usage(t *testing.T) {
+	if _, code := runString(t, ""); code != 2 {
+		t.Errorf("no command returned %d", code)
+	}
+	if _, code := runString(t, "", "nope"); code != 2 {
+		t.Errorf("unknown command returned %d", code)
+	}
+}
@@ -0,0 +1,138 @@
+package emoji
+
+import (
+	"sort"
+	"sync"
+)
+
+// CounterOptions changes how a Counter groups emoji
+type CounterOptions struct {
+	// StripSkinTone counts 👍🏽 as 👍
+	StripSkinTone bool
+	// Normalize counts unqualified and minimally-qualified emoji as their fully-qualified form, ☺ as ☺️
+	Normalize bool
+}
+
+// EmojiCount is an emoji and its number of occurrences
+type EmojiCount struct {
+	Emoji string
+	Count int
+}
+
+// Counter counts emoji occurrences across many texts
+// it is safe for concurrent use
+type Counter struct {
+	options CounterOptions
+	mu      sync.Mutex
+	counts  map[string]int
+	total   int
+}
+
+// NewCounter returns an empty Counter
+func NewCounter(options CounterOptions) *Counter {
+	return &Counter{options: options, counts: make(map[string]int)}
+}
+
+func (c *Counter) key(g string) string {
+	if c.options.StripSkinTone {
+		g = stripSkinTone(g)
+	}
+	if c.options.Normalize {
+		g = Qualify(g)
+	}
+	return g
+}
+
+// Add counts every emoji of b
+func (c *Counter) Add(b []byte) {
+	emojis := Find(b, -1)
+	if len(emojis) == 0 {
+		return
+	}
+	keys := make([]string, len(emojis))
+	for i, g := range emojis {
+		keys[i] = c.key(string(g))
+	}
+	c.add(keys)
+}
+
+// AddString counts every emoji of s
+func (c *Counter) AddString(s string) {
+	emojis := FindString(s, -1)
+	if len(emojis) == 0 {
+		return
+	}
+	for i, g := range emojis {
+		emojis[i] = c.key(g)
+	}
+	c.add(emojis)
+}
+
+func (c *Counter) add(keys []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, k := range keys {
+		c.counts[k]++
+	}
+	c.total += len(keys)
+}
+
+// Merge adds the counts of o to c, such as counters filled by parallel workers
+// the emoji of o are grouped with the options of c
+func (c *Counter) Merge(o *Counter) {
+	counts := o.snapshot()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range counts {
+		c.counts[c.key(e.Emoji)] += e.Count
+		c.total += e.Count
+	}
+}
+
+func (c *Counter) snapshot() []EmojiCount {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counts := make([]EmojiCount, 0, len(c.counts))
+	for e, n := range c.counts {
+		counts = append(counts, EmojiCount{e, n})
+	}
+	return counts
+}
+
+// Count returns the number of occurrences of the emoji g
+func (c *Counter) Count(g string) int {
+	k := c.key(g)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.counts[k]
+}
+
+// Total returns the number of emoji counted
+func (c *Counter) Total() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.total
+}
+
+// Len returns the number of distinct emoji counted
+func (c *Counter) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.counts)
+}
+
+// Top returns the k most frequent emoji, most frequent first and ties in emoji order
+// or all of thems if k == -1
+func (c *Counter) Top(k int) []EmojiCount {
+	counts := c.snapshot()
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Emoji < counts[j].Emoji
+	})
+	if k >= 0 && k < len(counts) {
+		counts = counts[:k]
+	}
+	return counts
+}
@@ -0,0 +1,75 @@
+package emoji
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func Test_Counter(t *testing.T) {
+	c := NewCounter(CounterOptions{})
+	c.AddString("I ❤️ 🍕 and 🍕, 👍🏽👍")
+	c.Add([]byte("🍕 ❤ no emoji"))
+	c.AddString("")
+
+	if c.Total() != 7 || c.Len() != 5 {
+		t.Errorf("Counter has %d emoji, %d distinct", c.Total(), c.Len())
+	}
+	if n := c.Count("🍕"); n != 3 {
+		t.Errorf("Count(🍕) returned %d", n)
+	}
+	expected := []EmojiCount{{"🍕", 3}, {"❤", 1}, {"❤️", 1}}
+	if top := c.Top(3); !reflect.DeepEqual(top, expected) {
+		t.Errorf("Top(3) returned %v not %v", top, expected)
+	}
+	if top := c.Top(-1); len(top) != 5 {
+		t.Errorf("Top(-1) returned %v", top)
+	}
+	if top := c.Top(0); len(top) != 0 {
+		t.Errorf("Top(0) returned %v", top)
+	}
+}
+
+func Test_CounterOptions(t *testing.T) {
+	c := NewCounter(CounterOptions{StripSkinTone: true, Normalize: true})
+	c.AddString("☺️ ☺ 👍🏽👍👍🏿 👩🏻‍🤝‍👩🏼")
+	expected := []EmojiCount{{"👍", 3}, {"☺️", 2}, {"👭", 1}}
+	if top := c.Top(-1); !reflect.DeepEqual(top, expected) {
+		t.Errorf("Top(-1) returned %v not %v", top, expected)
+	}
+	if n := c.Count("👍🏻"); n != 3 {
+		t.Errorf("Count(👍🏻) returned %d", n)
+	}
+}
+
+func Test_CounterMerge(t *testing.T) {
+	total := NewCounter(CounterOptions{Normalize: true})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker := NewCounter(CounterOptions{})
+			for j := 0; j < 100; j++ {
+				worker.AddString("😀 ☺ 🇯🇵")
+			}
+			total.Merge(worker)
+		}()
+	}
+	// concurrent adds on the shared counter
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			total.AddString("😀")
+		}()
+	}
+	wg.Wait()
+	expected := []EmojiCount{{"😀", 808}, {"☺️", 800}, {"🇯🇵", 800}}
+	if top := total.Top(-1); !reflect.DeepEqual(top, expected) {
+		t.Errorf("Top(-1) returned %v not %v", top, expected)
+	}
+	if total.Total() != 2408 {
+		t.Errorf("Total returned %d", total.Total())
+	}
+}
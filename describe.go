@@ -0,0 +1,113 @@
+package emoji
+
+import "unicode"
+
+// Role is the structural role of a code point in an emoji
+// as defined in https://www.unicode.org/reports/tr51/#EBNF_and_Regex
+type Role uint8
+
+const (
+	// BaseRole is the first code point of an emoji or of a zwj element, such as 👍 in 👍🏽 or both letters of a flag
+	BaseRole Role = iota + 1
+	// ModifierRole is a skin tone modifying the base before it
+	ModifierRole
+	// VariationSelectorRole is \x{FE0F} or \x{FE0E} selecting the emoji or text presentation
+	VariationSelectorRole
+	// ZeroWidthJoinerRole is \x{200D} joining the elements of a zwj sequence
+	ZeroWidthJoinerRole
+	// KeycapRole is \x{20E3} enclosing a keycap base
+	KeycapRole
+	// TagRole is a tag character of a tag sequence such as the "gbsct" of 🏴󠁧󠁢󠁳󠁣󠁴󠁿
+	TagRole
+	// TerminatorRole is the cancel tag \x{E007F} ending a tag sequence
+	TerminatorRole
+)
+
+func (r Role) String() string {
+	switch r {
+	case BaseRole:
+		return "base"
+	case ModifierRole:
+		return "modifier"
+	case VariationSelectorRole:
+		return "variation selector"
+	case ZeroWidthJoinerRole:
+		return "zero width joiner"
+	case KeycapRole:
+		return "keycap"
+	case TagRole:
+		return "tag"
+	case TerminatorRole:
+		return "terminator"
+	}
+	return "unknown role"
+}
+
+// CodePointInfo describes a code point of a glyph
+type CodePointInfo struct {
+	Rune rune
+	// Offset is the byte offset of Rune in the described string
+	Offset int
+	Role   Role
+	// membership in the tables of the same name
+	Emoji                bool
+	EmojiPresentation    bool
+	EmojiModifier        bool
+	EmojiModifierBase    bool
+	EmojiComponent       bool
+	ExtendedPictographic bool
+	RegionalIndicator    bool
+	Tag                  bool
+}
+
+// Describe returns the properties and the role of every code point of s
+// s is usually a single glyph, for longer strings the roles are given glyph by glyph as returned by DecodeString
+func Describe(s string) []CodePointInfo {
+	var infos []CodePointInfo
+	var offset int
+	for offset < len(s) {
+		_, _, n := DecodeString(s[offset:])
+		first := true
+		for i, r := range s[offset : offset+n] {
+			infos = append(infos, describeRune(r, offset+i, first))
+			first = r == zeroWidthJoiner
+		}
+		offset += n
+	}
+	return infos
+}
+
+// describeRune describes r, first is true at the start of a glyph or a zwj element
+func describeRune(r rune, offset int, first bool) CodePointInfo {
+	info := CodePointInfo{
+		Rune:                 r,
+		Offset:               offset,
+		Emoji:                unicode.Is(Emoji, r),
+		EmojiPresentation:    unicode.Is(EmojiPresentation, r),
+		EmojiModifier:        unicode.Is(EmojiModifier, r),
+		EmojiModifierBase:    unicode.Is(EmojiModifierBase, r),
+		EmojiComponent:       unicode.Is(EmojiComponent, r),
+		ExtendedPictographic: unicode.Is(ExtendedPictographic, r),
+		RegionalIndicator:    isRegionalIndicator(r),
+		Tag:                  isTag(r),
+	}
+	switch {
+	case first:
+		info.Role = BaseRole
+	case r == zeroWidthJoiner:
+		info.Role = ZeroWidthJoinerRole
+	case r == emojiVS || r == textVS:
+		info.Role = VariationSelectorRole
+	case r == enclosingKeycap:
+		info.Role = KeycapRole
+	case r == termTag:
+		info.Role = TerminatorRole
+	case isTag(r):
+		info.Role = TagRole
+	case isEmod(r):
+		info.Role = ModifierRole
+	default:
+		info.Role = BaseRole
+	}
+	return info
+}
@@ -0,0 +1,63 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func Test_DescribeRoles(t *testing.T) {
+	for _, test := range []struct {
+		s     string
+		roles []Role
+	}{
+		{"👍", []Role{BaseRole}},
+		{"👍🏽", []Role{BaseRole, ModifierRole}},
+		{"1️⃣", []Role{BaseRole, VariationSelectorRole, KeycapRole}},
+		{"☺︎", []Role{BaseRole, VariationSelectorRole}},
+		{"🇯🇵", []Role{BaseRole, BaseRole}},
+		{"🏴󠁧󠁢󠁳󠁣󠁴󠁿", []Role{BaseRole, TagRole, TagRole, TagRole, TagRole, TagRole, TerminatorRole}},
+		{"👩‍❤️‍👨", []Role{BaseRole, ZeroWidthJoinerRole, BaseRole, VariationSelectorRole, ZeroWidthJoinerRole, BaseRole}},
+		{"👩🏽‍🦰", []Role{BaseRole, ModifierRole, ZeroWidthJoinerRole, BaseRole}},
+		{"a👍", []Role{BaseRole, BaseRole}},
+		{"", nil},
+	} {
+		infos := Describe(test.s)
+		if len(infos) != len(test.roles) {
+			t.Errorf("%q is described as %d code points instead of %d", test.s, len(infos), len(test.roles))
+			continue
+		}
+		for i, info := range infos {
+			if info.Role != test.roles[i] {
+				t.Errorf("%U of %q has role %s instead of %s", info.Rune, test.s, info.Role, test.roles[i])
+			}
+		}
+	}
+}
+
+func Test_DescribeProperties(t *testing.T) {
+	infos := Describe("🇯🇵👍🏽")
+	expected := []CodePointInfo{
+		{Rune: 0x1F1EF, Offset: 0, Role: BaseRole, Emoji: true, EmojiPresentation: true, EmojiComponent: true, RegionalIndicator: true},
+		{Rune: 0x1F1F5, Offset: 4, Role: BaseRole, Emoji: true, EmojiPresentation: true, EmojiComponent: true, RegionalIndicator: true},
+		{Rune: 0x1F44D, Offset: 8, Role: BaseRole, Emoji: true, EmojiPresentation: true, EmojiModifierBase: true, ExtendedPictographic: true},
+		{Rune: 0x1F3FD, Offset: 12, Role: ModifierRole, Emoji: true, EmojiPresentation: true, EmojiModifier: true, EmojiComponent: true},
+	}
+	if len(infos) != len(expected) {
+		t.Fatalf("described %d code points instead of %d", len(infos), len(expected))
+	}
+	for i := range infos {
+		if infos[i] != expected[i] {
+			t.Errorf("%U is described as %+v instead of %+v", expected[i].Rune, infos[i], expected[i])
+		}
+	}
+
+	tag := Describe("🏴󠁧󠁢󠁳󠁣󠁴󠁿")
+	if !tag[1].Tag || tag[1].Emoji || tag[6].Tag || tag[6].Role != TerminatorRole {
+		t.Errorf("tag sequence is described as %+v", tag)
+	}
+}
+
+func Test_RoleString(t *testing.T) {
+	if BaseRole.String() != "base" || TerminatorRole.String() != "terminator" || Role(0).String() != "unknown role" {
+		t.Errorf("unexpected role names %s %s %s", BaseRole, TerminatorRole, Role(0))
+	}
+}
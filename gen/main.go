@@ -2,23 +2,155 @@ package main
 
 import (
 	"bufio"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 
+	"golang.org/x/text/unicode/norm"
 	"golang.org/x/text/unicode/rangetable"
 )
 
 const maxR16 = 1 << 16
 
+// versions lists the emoji versions with versioned tables and the directory of their data files
+// the first one is the version of the package level tables
+var versions = []struct {
+	version string
+	dir     string
+}{
+	{"13.0", "."},
+	{"15.1", "data/15.1"},
+}
+
+var (
+	version = flag.String("version", "", "only generate the versioned tables of this emoji version, such as 15.1")
+	dataDir = flag.String("data", "", "directory holding emoji-test.txt, emoji-sequences.txt, emoji-zwj-sequences.txt and optionally emoji-data.txt of -version")
+)
+
 func main() {
-	data, err := os.Open("emoji-data.txt")
+	flag.Parse()
+	if *version != "" {
+		if *dataDir == "" {
+			log.Fatal("-version requires -data")
+		}
+		generateTables(*version, *dataDir)
+		return
+	}
+
+	generateProperties()
+	generateSequences()
+	entries := generateMetadata()
+	generateVariations()
+	generateShortcodes(entries)
+	generateGraphemeBreaks()
+	generateAges(entries)
+	for _, v := range versions {
+		generateTables(v.version, v.dir)
+	}
+}
+
+// generateProperties builds emoji.go, the property tables, from emoji-data.txt
+func generateProperties() {
+	p := readProperties("emoji-data.txt")
+
+	res, err := os.Create("emoji.go")
+	if err != nil {
+		log.Fatalf("create emoji.go %v", err)
+	}
+
+	_, err = res.Write([]byte(`// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+import "unicode"
+
+`))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+
+	_, err = res.Write([]byte("\nvar Emoji = "))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+	_, err = fmt.Fprintf(res, "%#v\n", p.emoji)
+	if err != nil {
+		log.Fatalf("Fprintf %v", err)
+	}
+
+	_, err = res.Write([]byte("\nvar EmojiPresentation = "))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+	_, err = fmt.Fprintf(res, "%#v\n", p.emojiPresentation)
+	if err != nil {
+		log.Fatalf("Fprintf %v", err)
+	}
+
+	_, err = res.Write([]byte("\nvar EmojiModifier = "))
 	if err != nil {
-		log.Fatalf("open emoji-data.txt %v", err)
+		log.Fatalf("Write %v", err)
+	}
+	_, err = fmt.Fprintf(res, "%#v\n", p.emojiModifier)
+	if err != nil {
+		log.Fatalf("Fprintf %v", err)
 	}
+
+	_, err = res.Write([]byte("\nvar EmojiModifierBase = "))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+	_, err = fmt.Fprintf(res, "%#v\n", p.emojiModifierBase)
+	if err != nil {
+		log.Fatalf("Fprintf %v", err)
+	}
+
+	_, err = res.Write([]byte("\nvar EmojiComponent = "))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+	_, err = fmt.Fprintf(res, "%#v\n", p.emojiComponent)
+	if err != nil {
+		log.Fatalf("Fprintf %v", err)
+	}
+
+	_, err = res.Write([]byte("\nvar ExtendedPictographic = "))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+	_, err = fmt.Fprintf(res, "%#v\n", p.extendedPictographic)
+	if err != nil {
+		log.Fatalf("Fprintf %v", err)
+	}
+}
+
+type properties struct {
+	emoji                *unicode.RangeTable
+	emojiPresentation    *unicode.RangeTable
+	emojiModifier        *unicode.RangeTable
+	emojiModifierBase    *unicode.RangeTable
+	emojiComponent       *unicode.RangeTable
+	extendedPictographic *unicode.RangeTable
+	// ages is the emoji version of every Emoji and Emoji_Component code point, when listed
+	ages map[rune]string
+}
+
+// readProperties reads the property tables of an emoji-data.txt file
+func readProperties(file string) properties {
+	data, err := os.Open(file)
+	if err != nil {
+		log.Fatalf("open %s %v", file, err)
+	}
+	defer data.Close()
 	reader := bufio.NewReader(data)
 
 	emoji := &unicode.RangeTable{}
@@ -27,6 +159,7 @@ func main() {
 	emojiModifierBase := &unicode.RangeTable{}
 	emojiComponent := &unicode.RangeTable{}
 	extendedPictographic := &unicode.RangeTable{}
+	ages := make(map[rune]string)
 
 	for {
 		l, err := reader.ReadString('\n')
@@ -49,6 +182,13 @@ func main() {
 		if err != nil {
 			log.Fatalf("Sscanf property %q %v", l, err)
 		}
+		// the comment starts with the emoji version of the range such as E0.6
+		var age string
+		if comment := strings.IndexRune(l, '#'); comment != -1 {
+			if fields := strings.Fields(l[comment+1:]); len(fields) > 0 && strings.HasPrefix(fields[0], "E") {
+				age = fields[0]
+			}
+		}
 		l = l[:split]
 		var lo uint32
 		var hi uint32
@@ -78,12 +218,20 @@ func main() {
 			table = emojiModifierBase
 		case "Emoji_Component":
 			table = emojiComponent
-		case "Extended_Pictographic#": // mising space in file
+		case "Extended_Pictographic", "Extended_Pictographic#": // mising space in the 13.0 file
 			table = extendedPictographic
 		default:
 			log.Fatalf("unknown table %s", property)
 		}
 
+		if age != "" && (property == "Emoji" || property == "Emoji_Component") {
+			for r := lo; r <= hi; r++ {
+				if _, ok := ages[rune(r)]; !ok {
+					ages[rune(r)] = age
+				}
+			}
+		}
+
 		if lo < maxR16 && hi > maxR16 {
 			log.Fatal("pair on the border")
 		}
@@ -96,16 +244,27 @@ func main() {
 			table.R32 = append(table.R32, unicode.Range32{lo, hi, 1})
 		}
 	}
-	emoji = rangetable.Merge(emoji)
-	emojiPresentation = rangetable.Merge(emojiPresentation)
-	emojiModifier = rangetable.Merge(emojiModifier)
-	emojiModifierBase = rangetable.Merge(emojiModifierBase)
-	emojiComponent = rangetable.Merge(emojiComponent)
-	extendedPictographic = rangetable.Merge(extendedPictographic)
+	return properties{
+		emoji:                rangetable.Merge(emoji),
+		emojiPresentation:    rangetable.Merge(emojiPresentation),
+		emojiModifier:        rangetable.Merge(emojiModifier),
+		emojiModifierBase:    rangetable.Merge(emojiModifierBase),
+		emojiComponent:       rangetable.Merge(emojiComponent),
+		extendedPictographic: rangetable.Merge(extendedPictographic),
+		ages:                 ages,
+	}
 
-	res, err := os.Create("emoji.go")
+}
+
+// generateSequences builds sequences.go, the sorted list of all RGI emoji of the package version,
+// from the emoji-sequences.txt and emoji-zwj-sequences.txt of the latest version
+func generateSequences() {
+	// the package level tables share the sequence files of the latest version
+	sequences := readRGI(versions[len(versions)-1].dir, versions[0].version)
+
+	res, err := os.Create("sequences.go")
 	if err != nil {
-		log.Fatalf("create emoji.go %v", err)
+		log.Fatalf("create sequences.go %v", err)
 	}
 
 	_, err = res.Write([]byte(`// DO NOT EDIT
@@ -113,64 +272,930 @@ func main() {
 
 package emoji
 
-import "unicode"
-
 `))
 	if err != nil {
 		log.Fatalf("Write %v", err)
 	}
 
-	_, err = res.Write([]byte("\nvar Emoji = "))
+	_, err = res.Write([]byte("\nvar rgiSequences = "))
 	if err != nil {
 		log.Fatalf("Write %v", err)
 	}
-	_, err = fmt.Fprintf(res, "%#v\n", emoji)
+	_, err = fmt.Fprintf(res, "%#v\n", sequences)
 	if err != nil {
 		log.Fatalf("Fprintf %v", err)
 	}
+}
 
-	_, err = res.Write([]byte("\nvar EmojiPresentation = "))
+// readRGI returns the sorted list of all RGI emoji listed in the sequence files of dir
+// up to the emoji version X.Y
+func readRGI(dir, version string) []string {
+	major, minor := parseAge("E" + version)
+	var sequences []string
+	sequences = readSequences(filepath.Join(dir, "emoji-sequences.txt"), major, minor, sequences)
+	sequences = readSequences(filepath.Join(dir, "emoji-zwj-sequences.txt"), major, minor, sequences)
+	sort.Strings(sequences)
+	return sequences
+}
+
+// readSequences appends to sequences every emoji listed in the file up to the emoji version major.minor
+// a line is formated as "code_point(s) ; type_field ; description # emoji_version comments"
+// where code_point(s) is either a range of single code point or a sequence
+func readSequences(file string, major, minor int, sequences []string) []string {
+	data, err := os.Open(file)
+	if err != nil {
+		log.Fatalf("open %s %v", file, err)
+	}
+	defer data.Close()
+	reader := bufio.NewReader(data)
+
+	for {
+		l, err := reader.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("readline %v", err)
+		}
+		l = strings.TrimSpace(l)
+		if strings.HasPrefix(l, "#") {
+			continue
+		}
+		if len(l) == 0 {
+			continue
+		}
+		split := strings.IndexRune(l, ';')
+		if split == -1 {
+			log.Fatalf("missing type field %q", l)
+		}
+		comment := strings.IndexRune(l, '#')
+		if comment == -1 {
+			log.Fatalf("missing emoji version %q", l)
+		}
+		// the version is not always followed by a space, such as #E13.1[1]
+		age := strings.FieldsFunc(l[comment+1:], func(r rune) bool { return r == ' ' || r == '[' })
+		if len(age) == 0 {
+			log.Fatalf("missing emoji version %q", l)
+		}
+		if ageMajor, ageMinor := parseAge(age[0]); ageMajor > major || (ageMajor == major && ageMinor > minor) {
+			continue
+		}
+		l = strings.TrimSpace(l[:split])
+		if strings.IndexRune(l, '.') != -1 {
+			var lo, hi rune
+			n, err := fmt.Sscanf(l, "%X..%X", &lo, &hi)
+			if err != nil || n != 2 {
+				log.Fatalf("Sscanf %q %v", l, err)
+			}
+			for r := lo; r <= hi; r++ {
+				sequences = append(sequences, string(r))
+			}
+			continue
+		}
+		var b strings.Builder
+		for _, field := range strings.Fields(l) {
+			var r rune
+			n, err := fmt.Sscanf(field, "%X", &r)
+			if err != nil || n != 1 {
+				log.Fatalf("Sscanf %q %v", field, err)
+			}
+			b.WriteRune(r)
+		}
+		sequences = append(sequences, b.String())
+	}
+	return sequences
+}
+
+type metadata struct {
+	sequence  string
+	name      string
+	group     int
+	subgroup  int
+	version   string
+	status    string
+	qualified string
+}
+
+var statuses = map[string]string{
+	"component":           "Component",
+	"fully-qualified":     "FullyQualified",
+	"minimally-qualified": "MinimallyQualified",
+	"unqualified":         "Unqualified",
+}
+
+// generateMetadata builds metadata.go, the name, group, subgroup, version, status
+// and fully-qualified form of every emoji sorted by sequence, from the emoji-test.txt of the latest version
+// restricted to the emoji of the package version, so groups and subgroups follow the layout of the latest version
+func generateMetadata() []metadata {
+	groups, subgroups, entries := readMetadata(filepath.Join(versions[len(versions)-1].dir, "emoji-test.txt"))
+	groups, subgroups, entries = filterMetadata(versions[0].version, groups, subgroups, entries)
+
+	res, err := os.Create("metadata.go")
+	if err != nil {
+		log.Fatalf("create metadata.go %v", err)
+	}
+
+	_, err = res.Write([]byte(`// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+`))
 	if err != nil {
 		log.Fatalf("Write %v", err)
 	}
-	_, err = fmt.Fprintf(res, "%#v\n", emojiPresentation)
+
+	_, err = fmt.Fprintf(res, "\nvar groups = %#v\n", groups)
+	if err != nil {
+		log.Fatalf("Fprintf %v", err)
+	}
+	_, err = fmt.Fprintf(res, "\nvar subgroups = %#v\n", subgroups)
 	if err != nil {
 		log.Fatalf("Fprintf %v", err)
 	}
 
-	_, err = res.Write([]byte("\nvar EmojiModifier = "))
+	_, err = res.Write([]byte("\nvar metadata = []entry{\n"))
 	if err != nil {
 		log.Fatalf("Write %v", err)
 	}
-	_, err = fmt.Fprintf(res, "%#v\n", emojiModifier)
+	for _, e := range entries {
+		_, err = fmt.Fprintf(res, "{%q, %q, %d, %d, %q, %s, %q},\n", e.sequence, e.name, e.group, e.subgroup, e.version, e.status, e.qualified)
+		if err != nil {
+			log.Fatalf("Fprintf %v", err)
+		}
+	}
+	_, err = res.Write([]byte("}\n"))
 	if err != nil {
-		log.Fatalf("Fprintf %v", err)
+		log.Fatalf("Write %v", err)
 	}
+	return entries
+}
 
-	_, err = res.Write([]byte("\nvar EmojiModifierBase = "))
+// readMetadata reads the groups, subgroups and emoji of an emoji-test.txt file, sorted by sequence
+func readMetadata(file string) ([]string, []string, []metadata) {
+	data, err := os.Open(file)
+	if err != nil {
+		log.Fatalf("open %s %v", file, err)
+	}
+	defer data.Close()
+	reader := bufio.NewReader(data)
+
+	var groups, subgroups []string
+	var entries []metadata
+	var lastQualified metadata
+	for {
+		l, err := reader.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("readline %v", err)
+		}
+		l = strings.TrimSpace(l)
+		if strings.HasPrefix(l, "# group:") {
+			groups = append(groups, strings.TrimSpace(strings.TrimPrefix(l, "# group:")))
+			continue
+		}
+		if strings.HasPrefix(l, "# subgroup:") {
+			subgroups = append(subgroups, strings.TrimSpace(strings.TrimPrefix(l, "# subgroup:")))
+			continue
+		}
+		if strings.HasPrefix(l, "#") {
+			continue
+		}
+		if len(l) == 0 {
+			continue
+		}
+		// format: code points; status # emoji version name
+		split := strings.IndexRune(l, ';')
+		comment := strings.IndexRune(l, '#')
+		if split == -1 || comment < split {
+			log.Fatalf("malformed line %q", l)
+		}
+		var b strings.Builder
+		for _, field := range strings.Fields(l[:split]) {
+			var r rune
+			n, err := fmt.Sscanf(field, "%X", &r)
+			if err != nil || n != 1 {
+				log.Fatalf("Sscanf %q %v", field, err)
+			}
+			b.WriteRune(r)
+		}
+		status, ok := statuses[strings.TrimSpace(l[split+1:comment])]
+		if !ok {
+			log.Fatalf("unknown status %q", l)
+		}
+		fields := strings.Fields(l[comment+1:])
+		if len(fields) < 3 || !strings.HasPrefix(fields[1], "E") {
+			log.Fatalf("malformed comment %q", l)
+		}
+		if len(groups) == 0 || len(subgroups) == 0 {
+			log.Fatalf("emoji outside of a group %q", l)
+		}
+		e := metadata{
+			sequence: b.String(),
+			name:     strings.Join(fields[2:], " "),
+			group:    len(groups) - 1,
+			subgroup: len(subgroups) - 1,
+			version:  fields[1],
+			status:   status,
+		}
+		// the file lists unqualified and minimally-qualified forms
+		// right after the fully-qualified one
+		switch status {
+		case "FullyQualified":
+			lastQualified = e
+		case "MinimallyQualified", "Unqualified":
+			if lastQualified.name != e.name {
+				log.Fatalf("no fully-qualified form for %q", l)
+			}
+			e.qualified = lastQualified.sequence
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].sequence < entries[j].sequence })
+	return groups, subgroups, entries
+}
+
+// filterMetadata keeps the emoji up to the emoji version X.Y and the groups and subgroups that still have some
+func filterMetadata(version string, groups, subgroups []string, entries []metadata) ([]string, []string, []metadata) {
+	major, minor := parseAge("E" + version)
+	groupIndex := make(map[int]int)
+	subgroupIndex := make(map[int]int)
+	var kept []metadata
+	for _, e := range entries {
+		if ageMajor, ageMinor := parseAge(e.version); ageMajor > major || (ageMajor == major && ageMinor > minor) {
+			continue
+		}
+		groupIndex[e.group] = 0
+		subgroupIndex[e.subgroup] = 0
+		kept = append(kept, e)
+	}
+	var keptGroups, keptSubgroups []string
+	for i, g := range groups {
+		if _, ok := groupIndex[i]; ok {
+			groupIndex[i] = len(keptGroups)
+			keptGroups = append(keptGroups, g)
+		}
+	}
+	for i, g := range subgroups {
+		if _, ok := subgroupIndex[i]; ok {
+			subgroupIndex[i] = len(keptSubgroups)
+			keptSubgroups = append(keptSubgroups, g)
+		}
+	}
+	for i := range kept {
+		kept[i].group = groupIndex[kept[i].group]
+		kept[i].subgroup = subgroupIndex[kept[i].subgroup]
+	}
+	return keptGroups, keptSubgroups, kept
+}
+
+// generateVariations builds variation.go, the table of characters that have
+// both a text and an emoji presentation sequence, from emoji-variation-sequences.txt
+// or from variation-bases.txt until it is vendored
+// every base of an RGI emoji presentation sequence of the package, such as ☺️, must be listed
+func generateVariations() {
+	file := "emoji-variation-sequences.txt"
+	if _, err := os.Stat(file); err != nil {
+		file = "variation-bases.txt"
+	}
+	data, err := os.Open(file)
+	if err != nil {
+		log.Fatalf("open %s %v", file, err)
+	}
+	defer data.Close()
+	reader := bufio.NewReader(data)
+
+	emoji := readProperties("emoji-data.txt").emoji
+	var runes []rune
+	listed := make(map[rune]bool)
+	for {
+		l, err := reader.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("readline %v", err)
+		}
+		if comment := strings.IndexRune(l, '#'); comment != -1 {
+			l = l[:comment]
+		}
+		l = strings.TrimSpace(l)
+		if len(l) == 0 {
+			continue
+		}
+		// format: code_point ( FE0E | FE0F )? ; description? ;
+		var r rune
+		n, err := fmt.Sscanf(l, "%X", &r)
+		if err != nil || n != 1 {
+			log.Fatalf("Sscanf %q %v", l, err)
+		}
+		if !unicode.Is(emoji, r) {
+			log.Fatalf("%X is not an emoji", r)
+		}
+		if !listed[r] {
+			listed[r] = true
+			runes = append(runes, r)
+		}
+	}
+	for _, seq := range readRGI(versions[len(versions)-1].dir, versions[0].version) {
+		r := []rune(seq)
+		if len(r) == 2 && r[1] == 0xFE0F && !listed[r[0]] {
+			log.Fatalf("%X has an emoji presentation sequence but is not in %s", r[0], file)
+		}
+	}
+	variations := rangetable.New(runes...)
+
+	res, err := os.Create("variation.go")
+	if err != nil {
+		log.Fatalf("create variation.go %v", err)
+	}
+
+	_, err = res.Write([]byte(`// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+import "unicode"
+
+`))
 	if err != nil {
 		log.Fatalf("Write %v", err)
 	}
-	_, err = fmt.Fprintf(res, "%#v\n", emojiModifierBase)
+
+	_, err = res.Write([]byte("\nvar variationBase = "))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+	_, err = fmt.Fprintf(res, "%#v\n", variations)
 	if err != nil {
 		log.Fatalf("Fprintf %v", err)
 	}
+}
 
-	_, err = res.Write([]byte("\nvar EmojiComponent = "))
+type shortcode struct {
+	sequence string
+	code     string
+	dialect  string
+}
+
+// gemoji is an emoji of data/github/emoji.json, the gemoji aliases that https://api.github.com/emojis knows
+// as extracted by the _tools of github.com/yuin/goldmark-emoji v1.0.6
+type gemoji struct {
+	Name       string
+	ShortNames []string
+	// Unicode are the code points of the emoji in decimal
+	Unicode []string
+}
+
+// generateShortcodes builds shortcodes.go, the CLDR shortcode of every fully-qualified emoji
+// derived from its name, and the GitHub aliases of data/github/emoji.json
+func generateShortcodes(entries []metadata) {
+	qualified := make(map[string]bool)
+	cldr := make(map[string]string)
+	var codes []shortcode
+	for _, e := range entries {
+		if e.status != "FullyQualified" {
+			continue
+		}
+		qualified[e.sequence] = true
+		code := cldrShortcode(e.name)
+		if other, ok := cldr[code]; ok {
+			log.Fatalf("shortcode %q used by %q and %q", code, other, e.sequence)
+		}
+		cldr[code] = e.sequence
+		codes = append(codes, shortcode{e.sequence, code, "CLDR"})
+	}
+
+	// gemoji also names emoji newer than the package version
+	newer := make(map[string]bool)
+	_, _, latest := readMetadata(filepath.Join(versions[len(versions)-1].dir, "emoji-test.txt"))
+	for _, e := range latest {
+		if e.status == "FullyQualified" && !qualified[e.sequence] {
+			newer[e.sequence] = true
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join("data", "github", "emoji.json"))
+	if err != nil {
+		log.Fatalf("read data/github/emoji.json %v", err)
+	}
+	var github struct {
+		Data []gemoji `json:"data"`
+	}
+	err = json.Unmarshal(data, &github)
+	if err != nil {
+		log.Fatalf("Unmarshal data/github/emoji.json %v", err)
+	}
+	seen := make(map[string]bool)
+	for _, g := range github.Data {
+		var b strings.Builder
+		for _, field := range g.Unicode {
+			r, err := strconv.Atoi(field)
+			if err != nil {
+				log.Fatalf("Atoi %q %v", field, err)
+			}
+			b.WriteRune(rune(r))
+		}
+		if newer[b.String()] {
+			continue
+		}
+		if !qualified[b.String()] {
+			log.Fatalf("%q is not a fully-qualified emoji", g.Name)
+		}
+		for _, code := range g.ShortNames {
+			if seen[code] {
+				log.Fatalf("duplicated shortcode %q", code)
+			}
+			seen[code] = true
+			codes = append(codes, shortcode{b.String(), code, "GitHub"})
+		}
+	}
+	// the order of the aliases is kept for a given emoji and dialect, the first code is the preferred one
+	sort.SliceStable(codes, func(i, j int) bool {
+		if codes[i].sequence != codes[j].sequence {
+			return codes[i].sequence < codes[j].sequence
+		}
+		return codes[i].dialect < codes[j].dialect
+	})
+
+	res, err := os.Create("shortcodes.go")
+	if err != nil {
+		log.Fatalf("create shortcodes.go %v", err)
+	}
+
+	_, err = res.Write([]byte(`// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+`))
 	if err != nil {
 		log.Fatalf("Write %v", err)
 	}
-	_, err = fmt.Fprintf(res, "%#v\n", emojiComponent)
+
+	_, err = res.Write([]byte("\nvar shortcodes = []shortcode{\n"))
 	if err != nil {
-		log.Fatalf("Fprintf %v", err)
+		log.Fatalf("Write %v", err)
+	}
+	for _, c := range codes {
+		_, err = fmt.Fprintf(res, "{%q, %q, %s},\n", c.sequence, c.code, c.dialect)
+		if err != nil {
+			log.Fatalf("Fprintf %v", err)
+		}
+	}
+	_, err = res.Write([]byte("}\n"))
+	if err != nil {
+		log.Fatalf("Write %v", err)
 	}
+}
 
-	_, err = res.Write([]byte("\nvar ExtendedPictographic = "))
+// cldrShortcode turns a name such as "flag: Côte d’Ivoire" into "flag_cote_divoire"
+func cldrShortcode(name string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(name)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '#':
+			b.WriteString("hash")
+		case r == '*':
+			b.WriteString("asterisk")
+		case r == '&':
+			b.WriteString("and")
+		case r == ' ', r == ':', r == ',', r == '-':
+			b.WriteRune('_')
+		}
+	}
+	code := b.String()
+	for strings.Contains(code, "__") {
+		code = strings.ReplaceAll(code, "__", "_")
+	}
+	return strings.Trim(code, "_")
+}
+
+// graphemeBreaks maps the Grapheme_Cluster_Break and InCB values
+// to the name of their table in grapheme_break.go
+// ucdDir holds the Unicode Character Database files of the grapheme cluster breaker
+// ppucd.txt is the preparsed UCD of ICU, https://github.com/unicode-org/icu/blob/main/icu4c/source/data/unidata/ppucd.txt
+const ucdDir = "data/17.0"
+
+// graphemeBreaks maps the properties of the grapheme cluster breaker, as aliased in ppucd.txt, to their table
+var graphemeBreaks = map[string]string{
+	"GCB=PP":  "graphemePrepend",
+	"GCB=CR":  "graphemeCR",
+	"GCB=LF":  "graphemeLF",
+	"GCB=CN":  "graphemeControl",
+	"GCB=EX":  "graphemeExtend",
+	"GCB=RI":  "graphemeRegionalIndicator",
+	"GCB=SM":  "graphemeSpacingMark",
+	"GCB=L":   "graphemeL",
+	"GCB=V":   "graphemeV",
+	"GCB=T":   "graphemeT",
+	"GCB=LV":  "graphemeLV",
+	"GCB=LVT": "graphemeLVT",
+	"GCB=ZWJ": "graphemeZWJ",
+	// the InCB values are read from DerivedCoreProperties.txt
+	"InCB=Linker":    "conjunctLinker",
+	"InCB=Consonant": "conjunctConsonant",
+	"InCB=Extend":    "conjunctExtend",
+	// GB11 follows the Extended_Pictographic of the grapheme break version, not the one of the package
+	"ExtPict": "graphemeExtendedPictographic",
+}
+
+// generateGraphemeBreaks builds grapheme_break.go, the tables used by the grapheme cluster breaker,
+// from the Grapheme_Cluster_Break and Extended_Pictographic properties of ppucd.txt
+// and the Indic_Conjunct_Break property of DerivedCoreProperties.txt
+func generateGraphemeBreaks() {
+	runes := make(map[string][]rune)
+	for r, props := range readPPUCD() {
+		if gcb := props["GCB"]; gcb != "XX" {
+			property := "GCB=" + gcb
+			if _, ok := graphemeBreaks[property]; !ok {
+				log.Fatalf("unknown property %s of %X", property, r)
+			}
+			runes[property] = append(runes[property], rune(r))
+		}
+		if props["ExtPict"] == "Y" {
+			runes["ExtPict"] = append(runes["ExtPict"], rune(r))
+		}
+	}
+	readConjunctBreaks(filepath.Join(ucdDir, "DerivedCoreProperties.txt"), runes)
+
+	var properties []string
+	for property := range graphemeBreaks {
+		if len(runes[property]) == 0 {
+			log.Fatalf("no code point for %s", property)
+		}
+		properties = append(properties, property)
+	}
+	sort.Strings(properties)
+
+	res, err := os.Create("grapheme_break.go")
+	if err != nil {
+		log.Fatalf("create grapheme_break.go %v", err)
+	}
+
+	_, err = res.Write([]byte(`// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+import "unicode"
+
+`))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+
+	for _, property := range properties {
+		_, err = fmt.Fprintf(res, "\nvar %s = %#v\n", graphemeBreaks[property], rangetable.New(runes[property]...))
+		if err != nil {
+			log.Fatalf("Fprintf %v", err)
+		}
+	}
+}
+
+// readPPUCD returns the properties of every code point listed in the ppucd.txt of ucdDir,
+// binary properties have the value "Y" when set
+// each block line sets the properties of its range over the defaults line,
+// cp lines override the properties of their block and unassigned lines the defaults
+func readPPUCD() []map[string]string {
+	file := filepath.Join(ucdDir, "ppucd.txt")
+	data, err := os.Open(file)
+	if err != nil {
+		log.Fatalf("open %s %v", file, err)
+	}
+	defer data.Close()
+	reader := bufio.NewReader(data)
+
+	res := make([]map[string]string, unicode.MaxRune+1)
+	var defaults, block map[string]string
+	var blockLo, blockHi rune
+	for {
+		l, err := reader.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("readline %v", err)
+		}
+		if comment := strings.IndexRune(l, '#'); comment != -1 {
+			l = l[:comment]
+		}
+		l = strings.TrimSpace(l)
+		if len(l) == 0 {
+			continue
+		}
+		// format: type;code_point(s);property=value;binary_property;-binary_property...
+		fields := strings.Split(l, ";")
+		var inherited map[string]string
+		switch fields[0] {
+		case "ucd":
+			if !strings.HasPrefix(fields[1], filepath.Base(ucdDir)+".") {
+				log.Fatalf("%s is for Unicode %s, not %s", file, fields[1], filepath.Base(ucdDir))
+			}
+			continue
+		case "defaults":
+		case "block", "unassigned":
+			inherited = defaults
+		case "cp":
+			inherited = block
+		default:
+			continue
+		}
+		var lo, hi rune
+		n, err := fmt.Sscanf(fields[1], "%X..%X", &lo, &hi)
+		if err != nil && n != 1 {
+			log.Fatalf("Sscanf %q %v", l, err)
+		}
+		if n == 1 {
+			hi = lo
+		}
+		if fields[0] == "cp" && (lo < blockLo || hi > blockHi) {
+			log.Fatalf("%q is outside of its block", l)
+		}
+		props := make(map[string]string)
+		for k, v := range inherited {
+			props[k] = v
+		}
+		for _, f := range fields[2:] {
+			if k, v, ok := strings.Cut(f, "="); ok {
+				props[k] = v
+			} else if strings.HasPrefix(f, "-") {
+				delete(props, f[1:])
+			} else {
+				props[f] = "Y"
+			}
+		}
+		switch fields[0] {
+		case "defaults":
+			defaults = props
+		case "block":
+			block, blockLo, blockHi = props, lo, hi
+		}
+		for r := lo; r <= hi; r++ {
+			res[r] = props
+		}
+	}
+	return res
+}
+
+// readConjunctBreaks adds to runes the code points of the InCB values listed in a DerivedCoreProperties.txt file
+func readConjunctBreaks(file string, runes map[string][]rune) {
+	data, err := os.Open(file)
+	if err != nil {
+		log.Fatalf("open %s %v", file, err)
+	}
+	defer data.Close()
+	reader := bufio.NewReader(data)
+
+	for {
+		l, err := reader.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("readline %v", err)
+		}
+		if comment := strings.IndexRune(l, '#'); comment != -1 {
+			l = l[:comment]
+		}
+		// format: code_point(s) ; property ; value?
+		fields := strings.Split(l, ";")
+		if len(fields) != 3 || strings.TrimSpace(fields[1]) != "InCB" {
+			continue
+		}
+		property := "InCB=" + strings.TrimSpace(fields[2])
+		if _, ok := graphemeBreaks[property]; !ok {
+			log.Fatalf("unknown property %q", l)
+		}
+		var lo, hi rune
+		n, err := fmt.Sscanf(strings.TrimSpace(fields[0]), "%X..%X", &lo, &hi)
+		if err != nil && n != 1 {
+			log.Fatalf("Sscanf %q %v", l, err)
+		}
+		if n == 1 {
+			hi = lo
+		}
+		for r := lo; r <= hi; r++ {
+			runes[property] = append(runes[property], r)
+		}
+	}
+}
+
+// generateTables builds tablesX_Y.go, the Tables of the emoji version X.Y, from the data files of dir
+// the tables of the first of versions refer to the package level tables
+func generateTables(version, dir string) {
+	var major, minor int
+	n, err := fmt.Sscanf(version, "%d.%d", &major, &minor)
+	if err != nil || n != 2 {
+		log.Fatalf("invalid version %q %v", version, err)
+	}
+	name := fmt.Sprintf("Tables%d_%d", major, minor)
+	file := fmt.Sprintf("tables%d_%d.go", major, minor)
+
+	res, err := os.Create(file)
+	if err != nil {
+		log.Fatalf("create %s %v", file, err)
+	}
+
+	header := `// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+`
+	if version != versions[0].version {
+		header += "import \"unicode\"\n\n"
+	}
+	_, err = res.Write([]byte(header))
 	if err != nil {
 		log.Fatalf("Write %v", err)
 	}
-	_, err = fmt.Fprintf(res, "%#v\n", extendedPictographic)
+
+	_, err = fmt.Fprintf(res, "\n// %s holds the tables of emoji version %s\nvar %s = &Tables{\nVersion: Version{Major: %d, Minor: %d},\n", name, version, name, major, minor)
 	if err != nil {
 		log.Fatalf("Fprintf %v", err)
 	}
+	if version == versions[0].version {
+		_, err = res.Write([]byte(`Emoji: Emoji,
+EmojiPresentation: EmojiPresentation,
+EmojiModifier: EmojiModifier,
+EmojiModifierBase: EmojiModifierBase,
+EmojiComponent: EmojiComponent,
+ExtendedPictographic: ExtendedPictographic,
+rgi: rgiSequences,
+}
+`))
+		if err != nil {
+			log.Fatalf("Write %v", err)
+		}
+	} else {
+		p := propertiesOf(dir, version)
+		_, err = fmt.Fprintf(res, "Emoji: %#v,\nEmojiPresentation: %#v,\nEmojiModifier: %#v,\nEmojiModifierBase: %#v,\nEmojiComponent: %#v,\nExtendedPictographic: %#v,\nrgi: %#v,\n}\n",
+			p.emoji, p.emojiPresentation, p.emojiModifier, p.emojiModifierBase, p.emojiComponent, p.extendedPictographic, readRGI(dir, version))
+		if err != nil {
+			log.Fatalf("Fprintf %v", err)
+		}
+	}
+
+	_, err = fmt.Fprintf(res, "\nfunc init() {\nregisterTables(%s)\n}\n", name)
+	if err != nil {
+		log.Fatalf("Fprintf %v", err)
+	}
+}
+
+// propertiesOf returns the properties of emoji-data.txt in dir
+// or the ones of ppucd.txt for the characters of the emoji version when dir has no emoji-data.txt
+func propertiesOf(dir, version string) properties {
+	file := filepath.Join(dir, "emoji-data.txt")
+	if _, err := os.Stat(file); err == nil {
+		return readProperties(file)
+	}
+	return ucdProperties(version)
+}
+
+// ucdProperties returns the emoji properties of the characters of the Unicode version X.Y or earlier listed in ppucd.txt,
+// whose newer characters are left out
+// Extended_Pictographic also changes for older characters, it lost code points such as U+2701 by Unicode 17.0,
+// so versions older than ppucd.txt keep the Extended_Pictographic of the package emoji-data.txt
+func ucdProperties(version string) properties {
+	major, minor := parseAge("E" + version)
+	ucdMajor, ucdMinor := parseAge("E" + filepath.Base(ucdDir))
+	if major > ucdMajor || major == ucdMajor && minor > ucdMinor {
+		log.Fatalf("emoji version %s is newer than the Unicode %s of ppucd.txt", version, filepath.Base(ucdDir))
+	}
+	p := readProperties("emoji-data.txt")
+	var emoji, emojiPresentation, emojiModifier, emojiModifierBase, emojiComponent, extendedPictographic []rune
+	for r, props := range readPPUCD() {
+		if props["ExtPict"] == "Y" {
+			extendedPictographic = append(extendedPictographic, rune(r))
+		}
+		if props["age"] == "NA" {
+			continue
+		}
+		ageMajor, ageMinor := parseAge("E" + props["age"])
+		if ageMajor > major || ageMajor == major && ageMinor > minor {
+			continue
+		}
+		for _, property := range []struct {
+			name  string
+			runes *[]rune
+		}{
+			{"Emoji", &emoji},
+			{"EPres", &emojiPresentation},
+			{"EMod", &emojiModifier},
+			{"EBase", &emojiModifierBase},
+			{"EComp", &emojiComponent},
+		} {
+			if props[property.name] == "Y" {
+				*property.runes = append(*property.runes, rune(r))
+			}
+		}
+	}
+	p.emoji = rangetable.New(emoji...)
+	p.emojiPresentation = rangetable.New(emojiPresentation...)
+	p.emojiModifier = rangetable.New(emojiModifier...)
+	p.emojiModifierBase = rangetable.New(emojiModifierBase...)
+	p.emojiComponent = rangetable.New(emojiComponent...)
+	if major == ucdMajor && minor == ucdMinor {
+		p.extendedPictographic = rangetable.New(extendedPictographic...)
+	}
+	return p
+}
+
+// generateAges builds ages.go, the emoji version of every emoji code point from the comments of emoji-data.txt
+// and of the emoji sequences of the latest emoji-test.txt that are not in the package metadata
+func generateAges(entries []metadata) {
+	ages := readProperties("emoji-data.txt").ages
+	known := make(map[string]bool)
+	for _, e := range entries {
+		known[e.sequence] = true
+	}
+	latest := versions[len(versions)-1].dir
+	_, _, newer := readMetadata(filepath.Join(latest, "emoji-test.txt"))
+	var sequences []metadata
+	for _, e := range newer {
+		if known[e.sequence] {
+			continue
+		}
+		sequences = append(sequences, e)
+		// newer emoji-data.txt may not carry versions
+		if r := []rune(e.sequence); len(r) == 1 || (len(r) == 2 && r[1] == 0xFE0F) {
+			if _, ok := ages[r[0]]; !ok {
+				ages[r[0]] = e.version
+			}
+		}
+	}
+
+	var runes []rune
+	for r := range ages {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	res, err := os.Create("ages.go")
+	if err != nil {
+		log.Fatalf("create ages.go %v", err)
+	}
+
+	_, err = res.Write([]byte(`// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+`))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+
+	_, err = res.Write([]byte("\nvar codePointAges = []codePointAge{\n"))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+	for i := 0; i < len(runes); {
+		// merge consecutive code points of the same version
+		j := i + 1
+		for j < len(runes) && runes[j] == runes[j-1]+1 && ages[runes[j]] == ages[runes[i]] {
+			j++
+		}
+		major, minor := parseAge(ages[runes[i]])
+		_, err = fmt.Fprintf(res, "{%#x, %#x, %d, %d},\n", runes[i], runes[j-1], major, minor)
+		if err != nil {
+			log.Fatalf("Fprintf %v", err)
+		}
+		i = j
+	}
+	_, err = res.Write([]byte("}\n"))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+
+	_, err = res.Write([]byte("\nvar sequenceAges = []sequenceAge{\n"))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+	for _, e := range sequences {
+		major, minor := parseAge(e.version)
+		_, err = fmt.Fprintf(res, "{%q, %d, %d},\n", e.sequence, major, minor)
+		if err != nil {
+			log.Fatalf("Fprintf %v", err)
+		}
+	}
+	_, err = res.Write([]byte("}\n"))
+	if err != nil {
+		log.Fatalf("Write %v", err)
+	}
+}
+
+// parseAge parses an emoji version such as E13.0
+func parseAge(age string) (int, int) {
+	var major, minor int
+	n, err := fmt.Sscanf(age, "E%d.%d", &major, &minor)
+	if err != nil || n != 2 {
+		log.Fatalf("invalid version %q %v", age, err)
+	}
+	return major, minor
 }
@@ -0,0 +1,139 @@
+package emoji
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Gender is the gender of a person emoji
+type Gender uint8
+
+const (
+	NeutralGender Gender = iota
+	FemaleGender
+	MaleGender
+)
+
+func (g Gender) String() string {
+	switch g {
+	case NeutralGender:
+		return "neutral"
+	case FemaleGender:
+		return "female"
+	case MaleGender:
+		return "male"
+	}
+	return "unknown gender"
+}
+
+const femaleSign = rune(0x2640)
+const maleSign = rune(0x2642)
+
+// genderedNames are the neutral, female and male words starting the CLDR names of person emoji
+// such as "person frowning", "woman frowning" and "man frowning"
+var genderedNames = [][3]string{
+	{"person", "woman", "man"},
+	{"people", "women", "men"},
+	{"child", "girl", "boy"},
+	{"older person", "old woman", "old man"},
+	{"merperson", "mermaid", "merman"},
+	{"mx claus", "Mrs. Claus", "Santa Claus"},
+	{"", "princess", "prince"},
+}
+
+// parseGenderedName splits the base of a name into its gender, the row of genderedNames used
+// and the rest of the name, "woman health worker" returns FemaleGender, 0 and "health worker"
+// a name without gendered word such as "health worker" returns NeutralGender, 0 and the full base
+func parseGenderedName(base string) (Gender, int, string) {
+	for i, words := range genderedNames {
+		for g, word := range words {
+			if word == "" {
+				continue
+			}
+			if base == word {
+				return Gender(g), i, ""
+			}
+			rest := strings.TrimPrefix(base, word+" ")
+			// "woman and man holding hands" is not a woman
+			if rest != base && !strings.HasPrefix(rest, "and ") {
+				return Gender(g), i, rest
+			}
+		}
+	}
+	return NeutralGender, 0, base
+}
+
+// GenderOf returns the gender of the person emoji s
+// using its CLDR name when s is listed in emoji-test.txt, so 👩‍⚕️ and 💃 are FemaleGender
+// otherwise using a \x{2640} or \x{2642} zwj element or a 👩 or 👨 base
+// emoji that are not a single person such as families are NeutralGender
+func GenderOf(s string) Gender {
+	if e, ok := lookup(s); ok {
+		base, _ := splitName(e.name)
+		g, _, _ := parseGenderedName(base)
+		return g
+	}
+	if !PossibleGlyphString(s) {
+		return NeutralGender
+	}
+	for _, element := range strings.Split(s, zeroWidthJoinerS) {
+		r, _ := utf8.DecodeRuneInString(element)
+		switch r {
+		case femaleSign:
+			return FemaleGender
+		case maleSign:
+			return MaleGender
+		}
+	}
+	switch r, _ := utf8.DecodeRuneInString(s); r {
+	case '👩':
+		return FemaleGender
+	case '👨':
+		return MaleGender
+	}
+	return NeutralGender
+}
+
+// WithGender changes the gender of every person emoji in s to g
+// keeping skin tones and hair styles, so 👩🏽‍⚕️ becomes 👨🏽‍⚕️ for MaleGender
+// emoji are left untouched when the RGI set has no such variant
+// s is returned unchanged for an unknown gender
+func WithGender(s string, g Gender) string {
+	if g > MaleGender {
+		return s
+	}
+	return ReplaceString(s, -1, func(glyph string) string {
+		e, ok := lookup(glyph)
+		if !ok {
+			return glyph
+		}
+		base, attributes := splitName(e.name)
+		current, i, rest := parseGenderedName(base)
+		if current == g {
+			return glyph
+		}
+		var candidates []string
+		word := genderedNames[i][g]
+		switch {
+		case rest == "":
+			candidates = []string{word}
+		case g == NeutralGender:
+			// the neutral of "man health worker" is "health worker"
+			candidates = []string{word + " " + rest, rest}
+		default:
+			candidates = []string{word + " " + rest}
+		}
+		for _, c := range candidates {
+			if r, ok := lookupName(joinName(c, attributes)); ok {
+				return r
+			}
+		}
+		return glyph
+	})
+}
+
+// Neutralize replaces every gendered person emoji in s by its gender neutral form
+// when the RGI set has one, so 👩‍⚕️ becomes 🧑‍⚕️ and 🙍‍♂️ becomes 🙍
+func Neutralize(s string) string {
+	return WithGender(s, NeutralGender)
+}
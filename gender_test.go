@@ -0,0 +1,90 @@
+package emoji
+
+import "testing"
+
+func Test_GenderOf(t *testing.T) {
+	tests := []struct {
+		s      string
+		gender Gender
+	}{
+		{"🧑", NeutralGender},
+		{"👩", FemaleGender},
+		{"👨🏿", MaleGender},
+		{"👩‍⚕️", FemaleGender},
+		{"🧑‍⚕️", NeutralGender},
+		{"🙍‍♂️", MaleGender},
+		{"🙍‍♂", MaleGender},
+		{"🙍", NeutralGender},
+		{"💃", FemaleGender},
+		{"👧🏻", FemaleGender},
+		{"👴", MaleGender},
+		{"🤶", FemaleGender},
+		{"👭", FemaleGender},
+		{"👫", NeutralGender},
+		{"👨‍👩‍👦", NeutralGender},
+		{"👩‍🦰", FemaleGender},
+		{"🐶", NeutralGender},
+		// not listed in emoji-test.txt
+		{"🦖‍♀️", FemaleGender},
+		{"👨‍🦖", MaleGender},
+		{"a", NeutralGender},
+	}
+	for _, test := range tests {
+		if g := GenderOf(test.s); g != test.gender {
+			t.Errorf("GenderOf(%q) returned %s not %s", test.s, g, test.gender)
+		}
+	}
+}
+
+func Test_WithGender(t *testing.T) {
+	tests := []struct {
+		s      string
+		gender Gender
+		with   string
+	}{
+		{"👩‍⚕️", NeutralGender, "🧑‍⚕️"},
+		{"👩‍⚕", NeutralGender, "🧑‍⚕️"},
+		{"🧑‍⚕️", MaleGender, "👨‍⚕️"},
+		{"👩🏽‍⚕️", MaleGender, "👨🏽‍⚕️"},
+		{"🙍‍♂️", NeutralGender, "🙍"},
+		{"🙍", FemaleGender, "🙍‍♀️"},
+		{"🙍🏻", FemaleGender, "🙍🏻‍♀️"},
+		{"👩", NeutralGender, "🧑"},
+		{"👨🏼‍🦰", FemaleGender, "👩🏼‍🦰"},
+		{"👨‍🦲", NeutralGender, "🧑‍🦲"},
+		{"👦", FemaleGender, "👧"},
+		{"👵", NeutralGender, "🧓"},
+		{"👬", NeutralGender, "🧑‍🤝‍🧑"},
+		{"👯‍♂️", NeutralGender, "👯"},
+		{"🧜", FemaleGender, "🧜‍♀️"},
+		{"💃", MaleGender, "🕺"},
+		{"🎅🏽", NeutralGender, "🧑🏽‍🎄"},
+		{"👸", MaleGender, "🤴"},
+		{"👸", NeutralGender, "👸"},
+		{"👩‍👩‍👦", MaleGender, "👩‍👩‍👦"},
+		{"👫", FemaleGender, "👫"},
+		{"🐶", FemaleGender, "🐶"},
+		{"I ❤ 👩‍⚕️ and 👨‍🍳!", NeutralGender, "I ❤ 🧑‍⚕️ and 🧑‍🍳!"},
+		{"👩‍⚕️", Gender(7), "👩‍⚕️"},
+	}
+	for _, test := range tests {
+		if s := WithGender(test.s, test.gender); s != test.with {
+			t.Errorf("WithGender(%q, %s) returned %q not %q", test.s, test.gender, s, test.with)
+		}
+	}
+	for _, s := range rgiSequences {
+		for _, g := range []Gender{NeutralGender, FemaleGender, MaleGender} {
+			if with := WithGender(s, g); !IsRGIString(with) {
+				t.Errorf("WithGender(%q, %s) returned %q which is not rgi", s, g, with)
+			}
+		}
+	}
+}
+
+func Test_Neutralize(t *testing.T) {
+	s := "👩‍⚕️👨🏿‍🌾🙆‍♀️💂🏻‍♂️👩‍🦳👨🕺"
+	expected := "🧑‍⚕️🧑🏿‍🌾🙆💂🏻🧑‍🦳🧑🕺"
+	if n := Neutralize(s); n != expected {
+		t.Errorf("Neutralize(%q) returned %q not %q", s, n, expected)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 const zeroWidthJoiner = rune(0x200D)
 const emojiVS = rune(0xFE0F)
+const textVS = rune(0xFE0E)
 const enclosingKeycap = rune(0x20E3)
 const termTag = rune(0xE007F)
 
@@ -26,17 +27,24 @@ var enclosingKeycapB = []byte(enclosingKeycapS)
 //   \p{RI}\p{RI}
 //
 // zwj_element :=
-//   \p{Emoji} emoji_modification?
+//   \p{Emoji} emoji_modification? tag_modifier?
 //
 // emoji_modification :=
 //   \p{EMod}
 // | \x{FE0F} \x{20E3}?
+// | \x{FE0E}
+// | \x{20E3}
+//
+// where \x{20E3} is only allowed after a keycap base [0-9#*]
+// \p{EMod} only after a modifier base, a lone modifier is not a zwj_element
+// and \x{FE0E} after a character with both a text and an emoji presentation sequence, as listed in variation-bases.txt
 //
 // tag_modifier :=
 //   [\x{E0020}-\x{E007E}]+ \x{E007F}
 //
 // There should not be false negative (glyph wrongly detected as an emoji)
 // There is false positive such inexistant flag indicator
+// use IsRGI to reject them
 func PossibleGlyph(b []byte) bool {
 	_, ok, n := Decode(b)
 	return ok && n == len(b)
@@ -55,33 +63,49 @@ func Decode(b []byte) ([]byte, bool, int) {
 		r2, n2 := utf8.DecodeRune(b[n1:])
 		u2 := uint32(r2)
 		if RegionalIndicator.R32[0].Lo > u2 || u2 > RegionalIndicator.R32[0].Hi {
-			return []byte(string(r1)), false, n1
+			return b[:n1], false, n1
 		}
 		return b[:n1+n2], true, n1 + n2
 	}
 	n := n1
-	for unicode.Is(Emoji, r1) {
+	// a skin tone modifier is only an emoji after a modifier base
+	for unicode.Is(Emoji, r1) && !isEmod(r1) {
 		r2, n2 := utf8.DecodeRune(b[n:])
 		if n2 == 0 {
-			return b[:n], unicode.Is(ExtendedPictographic, r1), n
+			return b[:n], n > n1 || unicode.Is(ExtendedPictographic, r1), n
 		}
 
 		if r2 == emojiVS {
 			n += n2
-			if bytes.HasPrefix(b[n:], enclosingKeycapB) {
+			if isKeycapBase(r1) && bytes.HasPrefix(b[n:], enclosingKeycapB) {
 				n += len(enclosingKeycapB)
 			}
 			r2, n2 = utf8.DecodeRune(b[n:])
 			if n2 == 0 {
 				return b[:n], true, n
 			}
+		} else if r2 == textVS && unicode.Is(variationBase, r1) {
+			// text presentation sequence
+			n += n2
+			r2, n2 = utf8.DecodeRune(b[n:])
+			if n2 == 0 {
+				return b[:n], true, n
+			}
+		} else if r2 == enclosingKeycap && isKeycapBase(r1) {
+			// unqualified keycap
+			n += n2
+			r2, n2 = utf8.DecodeRune(b[n:])
+			if n2 == 0 {
+				return b[:n], true, n
+			}
 		} else if isEmod(r2) && unicode.Is(EmojiModifierBase, r1) {
 			n += n2
 			r2, n2 = utf8.DecodeRune(b[n:])
 			if n2 == 0 {
 				return b[:n], true, n
 			}
-		} else if r1 == '🏴' && isTag(r2) {
+		}
+		if isTag(r2) {
 			for isTag(r2) {
 				r2, n2 = utf8.DecodeRune(b[n:])
 				n += n2
@@ -96,7 +120,8 @@ func Decode(b []byte) ([]byte, bool, int) {
 		}
 
 		if r2 != zeroWidthJoiner {
-			return b[:n], true, n
+			// a lone character such as a digit is only an emoji if it is pictographic
+			return b[:n], n > n1 || unicode.Is(ExtendedPictographic, r1), n
 		}
 		n += n2
 
@@ -135,28 +160,25 @@ func Replace(b []byte, max int, f func([]byte) []byte) []byte {
 	if max == 0 {
 		return b
 	}
-	if len(Find(b, max)) == 0 {
-		return b
-	}
 	var buf bytes.Buffer
-	var count int
-	for {
-		g, ok, n := Decode(b)
-		b = b[n:]
-		if n == 0 {
-			break
+	var count, last int
+	it := NewIterator(b)
+	for it.Next() {
+		if !it.IsEmoji() {
+			continue
 		}
-		if ok {
-			buf.Write(f(g))
-			count++
-			if count == max {
-				buf.Write(b)
-				return buf.Bytes()
-			}
-		} else {
-			buf.Write(g)
+		buf.Write(b[last:it.Offset()])
+		buf.Write(f(it.Segment()))
+		last = it.Offset() + len(it.Segment())
+		count++
+		if count == max {
+			break
 		}
 	}
+	if count == 0 {
+		return b
+	}
+	buf.Write(b[last:])
 	return buf.Bytes()
 }
 
@@ -18,17 +18,24 @@ import (
 //   \p{RI}\p{RI}
 //
 // zwj_element :=
-//   \p{Emoji} emoji_modification?
+//   \p{Emoji} emoji_modification? tag_modifier?
 //
 // emoji_modification :=
 //   \p{EMod}
 // | \x{FE0F} \x{20E3}?
+// | \x{FE0E}
+// | \x{20E3}
+//
+// where \x{20E3} is only allowed after a keycap base [0-9#*]
+// \p{EMod} only after a modifier base, a lone modifier is not a zwj_element
+// and \x{FE0E} after a character with both a text and an emoji presentation sequence, as listed in variation-bases.txt
 //
 // tag_modifier :=
 //   [\x{E0020}-\x{E007E}]+ \x{E007F}
 //
 // There should not be false negative (glyph wrongly detected as an emoji)
 // There is false positive such inexistant flag indicator
+// use IsRGIString to reject them
 func PossibleGlyphString(s string) bool {
 	g, ok, _ := DecodeString(s)
 	return ok && g == s
@@ -47,33 +54,49 @@ func DecodeString(s string) (string, bool, int) {
 		r2, n2 := utf8.DecodeRuneInString(s[n1:])
 		u2 := uint32(r2)
 		if RegionalIndicator.R32[0].Lo > u2 || u2 > RegionalIndicator.R32[0].Hi {
-			return string(r1), false, n1
+			return s[:n1], false, n1
 		}
 		return s[:n1+n2], true, n1 + n2
 	}
 	n := n1
-	for unicode.Is(Emoji, r1) {
+	// a skin tone modifier is only an emoji after a modifier base
+	for unicode.Is(Emoji, r1) && !isEmod(r1) {
 		r2, n2 := utf8.DecodeRuneInString(s[n:])
 		if n2 == 0 {
-			return s[:n], unicode.Is(ExtendedPictographic, r1), n
+			return s[:n], n > n1 || unicode.Is(ExtendedPictographic, r1), n
 		}
 
 		if r2 == emojiVS {
 			n += n2
-			if strings.HasPrefix(s[n:], enclosingKeycapS) {
+			if isKeycapBase(r1) && strings.HasPrefix(s[n:], enclosingKeycapS) {
 				n += len(enclosingKeycapS)
 			}
 			r2, n2 = utf8.DecodeRuneInString(s[n:])
 			if n2 == 0 {
 				return s[:n], true, n
 			}
+		} else if r2 == textVS && unicode.Is(variationBase, r1) {
+			// text presentation sequence
+			n += n2
+			r2, n2 = utf8.DecodeRuneInString(s[n:])
+			if n2 == 0 {
+				return s[:n], true, n
+			}
+		} else if r2 == enclosingKeycap && isKeycapBase(r1) {
+			// unqualified keycap
+			n += n2
+			r2, n2 = utf8.DecodeRuneInString(s[n:])
+			if n2 == 0 {
+				return s[:n], true, n
+			}
 		} else if isEmod(r2) && unicode.Is(EmojiModifierBase, r1) {
 			n += n2
 			r2, n2 = utf8.DecodeRuneInString(s[n:])
 			if n2 == 0 {
 				return s[:n], true, n
 			}
-		} else if r1 == '🏴' && isTag(r2) {
+		}
+		if isTag(r2) {
 			for isTag(r2) {
 				r2, n2 = utf8.DecodeRuneInString(s[n:])
 				n += n2
@@ -88,7 +111,8 @@ func DecodeString(s string) (string, bool, int) {
 		}
 
 		if r2 != zeroWidthJoiner {
-			return s[:n], true, n
+			// a lone character such as a digit is only an emoji if it is pictographic
+			return s[:n], n > n1 || unicode.Is(ExtendedPictographic, r1), n
 		}
 		n += n2
 
@@ -127,27 +151,24 @@ func ReplaceString(s string, max int, f func(string) string) string {
 	if max == 0 {
 		return s
 	}
-	if len(FindString(s, max)) == 0 {
-		return s
-	}
 	var b strings.Builder
-	var count int
-	for {
-		g, ok, n := DecodeString(s)
-		s = s[n:]
-		if n == 0 {
-			break
+	var count, last int
+	it := NewStringIterator(s)
+	for it.Next() {
+		if !it.IsEmoji() {
+			continue
 		}
-		if ok {
-			b.WriteString(f(g))
-			count++
-			if count == max {
-				b.WriteString(s)
-				return b.String()
-			}
-		} else {
-			b.WriteString(g)
+		b.WriteString(s[last:it.Offset()])
+		b.WriteString(f(it.Segment()))
+		last = it.Offset() + len(it.Segment())
+		count++
+		if count == max {
+			break
 		}
 	}
+	if count == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
 	return b.String()
 }
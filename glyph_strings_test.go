@@ -1,6 +1,7 @@
 package emoji
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 	"unicode"
@@ -25,6 +26,9 @@ var notEmojiTest = []string{
 	"⛰️🏼",
 	"🏥🏼",
 	"🏼",
+	"🏿\uFE0F",
+	"🏿\u200D😀",
+	"😀\u200D🏿",
 	"2",
 	"#",
 	string(rune(0x200D)),
@@ -249,6 +253,24 @@ func Test_FindString(t *testing.T) {
 	}
 }
 
+// Test_DecodeStringEndOfInput checks that a glyph is decoded the same at the end of the input
+func Test_DecodeStringEndOfInput(t *testing.T) {
+	for _, s := range []string{"😀\u200d1", "🏴\u200d#", "🏴\u200d🇯", "😀\u200d😀", "1", "👍🏽"} {
+		g, ok, n := DecodeString(s)
+		gx, okx, nx := DecodeString(s + "x")
+		if g != gx || ok != okx || n != nx {
+			t.Errorf("DecodeString(%q) returned %q %t %d but %q %t %d when followed by x", s, g, ok, n, gx, okx, nx)
+		}
+		b, okb, nb := Decode([]byte(s))
+		if string(b) != g || okb != ok || nb != n {
+			t.Errorf("Decode(%q) returned %q %t %d not %q %t %d", s, b, okb, nb, g, ok, n)
+		}
+		if found, foundx := FindString(s, -1), FindString(s+"x", -1); !reflect.DeepEqual(found, foundx) {
+			t.Errorf("FindString(%q) returned %q but %q when followed by x", s, found, foundx)
+		}
+	}
+}
+
 func Test_ReplaceString(t *testing.T) {
 	text := strings.Join(emojiTest, "test phrase")
 	for n := range emojiTest {
@@ -0,0 +1,196 @@
+package emoji
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// graphemeBreak is the Grapheme_Cluster_Break property of a character
+// as defined in https://www.unicode.org/reports/tr29/#Grapheme_Cluster_Break_Property_Values
+type graphemeBreak uint8
+
+const (
+	breakOther graphemeBreak = iota
+	breakCR
+	breakLF
+	breakControl
+	breakExtend
+	breakZWJ
+	breakRegionalIndicator
+	breakPrepend
+	breakSpacingMark
+	breakL
+	breakV
+	breakT
+	breakLV
+	breakLVT
+)
+
+var graphemeBreakTables = []struct {
+	table *unicode.RangeTable
+	value graphemeBreak
+}{
+	{graphemeExtend, breakExtend},
+	{graphemeControl, breakControl},
+	{graphemeSpacingMark, breakSpacingMark},
+	{graphemeRegionalIndicator, breakRegionalIndicator},
+	{graphemeZWJ, breakZWJ},
+	{graphemePrepend, breakPrepend},
+	{graphemeLV, breakLV},
+	{graphemeLVT, breakLVT},
+	{graphemeL, breakL},
+	{graphemeV, breakV},
+	{graphemeT, breakT},
+	{graphemeCR, breakCR},
+	{graphemeLF, breakLF},
+}
+
+func graphemeBreakOf(r rune) graphemeBreak {
+	if r < utf8.RuneSelf {
+		switch {
+		case r == '\r':
+			return breakCR
+		case r == '\n':
+			return breakLF
+		case r < 0x20 || r == 0x7F:
+			return breakControl
+		}
+		return breakOther
+	}
+	for _, t := range graphemeBreakTables {
+		if unicode.Is(t.table, r) {
+			return t.value
+		}
+	}
+	return breakOther
+}
+
+// graphemeState is what is needed of the previous characters of a cluster to find its end
+type graphemeState struct {
+	prev graphemeBreak
+	// regionalIndicators is the number of regional indicators just before, for GB12 and GB13
+	regionalIndicators int
+	// pictographic is 1 after ExtendedPictographic Extend* and 2 after ExtendedPictographic Extend* ZWJ, for GB11
+	pictographic uint8
+	// conjunct is 1 after an InCB consonant followed by InCB extend or linker
+	// and 2 once a linker was seen, for GB9c
+	conjunct uint8
+}
+
+// breaks reports whether there is a grapheme cluster boundary before r
+// and updates the state with r
+func (st *graphemeState) breaks(r rune) bool {
+	b := graphemeBreakOf(r)
+	br := st.rules(b, r)
+	st.update(b, r)
+	return br
+}
+
+// rules applies the rules of https://www.unicode.org/reports/tr29/#Grapheme_Cluster_Boundary_Rules
+func (st *graphemeState) rules(b graphemeBreak, r rune) bool {
+	prev := st.prev
+	switch {
+	case prev == breakCR && b == breakLF: // GB3
+		return false
+	case prev == breakControl || prev == breakCR || prev == breakLF: // GB4
+		return true
+	case b == breakControl || b == breakCR || b == breakLF: // GB5
+		return true
+	case prev == breakL && (b == breakL || b == breakV || b == breakLV || b == breakLVT): // GB6
+		return false
+	case (prev == breakLV || prev == breakV) && (b == breakV || b == breakT): // GB7
+		return false
+	case (prev == breakLVT || prev == breakT) && b == breakT: // GB8
+		return false
+	case b == breakExtend || b == breakZWJ: // GB9
+		return false
+	case b == breakSpacingMark: // GB9a
+		return false
+	case prev == breakPrepend: // GB9b
+		return false
+	case st.conjunct == 2 && unicode.Is(conjunctConsonant, r): // GB9c
+		return false
+	case st.pictographic == 2 && unicode.Is(graphemeExtendedPictographic, r): // GB11
+		return false
+	case b == breakRegionalIndicator && st.regionalIndicators%2 == 1: // GB12 and GB13
+		return false
+	}
+	return true // GB999
+}
+
+func (st *graphemeState) update(b graphemeBreak, r rune) {
+	st.prev = b
+	if b == breakRegionalIndicator {
+		st.regionalIndicators++
+	} else {
+		st.regionalIndicators = 0
+	}
+	switch {
+	case unicode.Is(graphemeExtendedPictographic, r):
+		st.pictographic = 1
+	case b == breakExtend && st.pictographic == 1:
+	case b == breakZWJ && st.pictographic == 1:
+		st.pictographic = 2
+	default:
+		st.pictographic = 0
+	}
+	switch {
+	case unicode.Is(conjunctConsonant, r):
+		st.conjunct = 1
+	case unicode.Is(conjunctLinker, r) && st.conjunct > 0:
+		st.conjunct = 2
+	case unicode.Is(conjunctExtend, r) && st.conjunct > 0:
+	default:
+		st.conjunct = 0
+	}
+}
+
+// DecodeGrapheme returns the first extended grapheme cluster of b and its length
+// as defined in https://www.unicode.org/reports/tr29/ using the Unicode 17.0 tables
+// a grapheme cluster is what a user sees as a single character, the unit for cursor movement and truncation
+// such as "é", "🇯🇵" or "👩‍👩‍👧"
+func DecodeGrapheme(b []byte) ([]byte, int) {
+	if len(b) == 0 {
+		return b, 0
+	}
+	var st graphemeState
+	r, n := utf8.DecodeRune(b)
+	st.update(graphemeBreakOf(r), r)
+	for n < len(b) {
+		r, size := utf8.DecodeRune(b[n:])
+		if st.breaks(r) {
+			break
+		}
+		n += size
+	}
+	return b[:n], n
+}
+
+// DecodeGraphemeString is the string equivalent of DecodeGrapheme
+func DecodeGraphemeString(s string) (string, int) {
+	if len(s) == 0 {
+		return s, 0
+	}
+	var st graphemeState
+	r, n := utf8.DecodeRuneInString(s)
+	st.update(graphemeBreakOf(r), r)
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if st.breaks(r) {
+			break
+		}
+		n += size
+	}
+	return s[:n], n
+}
+
+// Graphemes splits s in extended grapheme clusters
+func Graphemes(s string) []string {
+	graphemes := []string{}
+	for len(s) > 0 {
+		g, n := DecodeGraphemeString(s)
+		graphemes = append(graphemes, g)
+		s = s[n:]
+	}
+	return graphemes
+}
@@ -0,0 +1,40 @@
+// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+import "unicode"
+
+var graphemeExtendedPictographic = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0xa9, Hi: 0xae, Stride: 0x5}, {Lo: 0x203c, Hi: 0x2049, Stride: 0xd}, {Lo: 0x2122, Hi: 0x2139, Stride: 0x17}, {Lo: 0x2194, Hi: 0x2199, Stride: 0x1}, {Lo: 0x21a9, Hi: 0x21aa, Stride: 0x1}, {Lo: 0x231a, Hi: 0x231b, Stride: 0x1}, {Lo: 0x2328, Hi: 0x23cf, Stride: 0xa7}, {Lo: 0x23e9, Hi: 0x23f3, Stride: 0x1}, {Lo: 0x23f8, Hi: 0x23fa, Stride: 0x1}, {Lo: 0x24c2, Hi: 0x25aa, Stride: 0xe8}, {Lo: 0x25ab, Hi: 0x25b6, Stride: 0xb}, {Lo: 0x25c0, Hi: 0x25fb, Stride: 0x3b}, {Lo: 0x25fc, Hi: 0x25fe, Stride: 0x1}, {Lo: 0x2600, Hi: 0x2604, Stride: 0x1}, {Lo: 0x260e, Hi: 0x2614, Stride: 0x3}, {Lo: 0x2615, Hi: 0x2618, Stride: 0x3}, {Lo: 0x261d, Hi: 0x2620, Stride: 0x3}, {Lo: 0x2622, Hi: 0x2623, Stride: 0x1}, {Lo: 0x2626, Hi: 0x262e, Stride: 0x4}, {Lo: 0x262f, Hi: 0x2638, Stride: 0x9}, {Lo: 0x2639, Hi: 0x263a, Stride: 0x1}, {Lo: 0x2640, Hi: 0x2642, Stride: 0x2}, {Lo: 0x2648, Hi: 0x2653, Stride: 0x1}, {Lo: 0x265f, Hi: 0x2660, Stride: 0x1}, {Lo: 0x2663, Hi: 0x2665, Stride: 0x2}, {Lo: 0x2666, Hi: 0x2668, Stride: 0x2}, {Lo: 0x267b, Hi: 0x267e, Stride: 0x3}, {Lo: 0x267f, Hi: 0x2692, Stride: 0x13}, {Lo: 0x2693, Hi: 0x2697, Stride: 0x1}, {Lo: 0x2699, Hi: 0x269b, Stride: 0x2}, {Lo: 0x269c, Hi: 0x26a0, Stride: 0x4}, {Lo: 0x26a1, Hi: 0x26a7, Stride: 0x6}, {Lo: 0x26aa, Hi: 0x26ab, Stride: 0x1}, {Lo: 0x26b0, Hi: 0x26b1, Stride: 0x1}, {Lo: 0x26bd, Hi: 0x26be, Stride: 0x1}, {Lo: 0x26c4, Hi: 0x26c5, Stride: 0x1}, {Lo: 0x26c8, Hi: 0x26ce, Stride: 0x6}, {Lo: 0x26cf, Hi: 0x26d3, Stride: 0x2}, {Lo: 0x26d4, Hi: 0x26e9, Stride: 0x15}, {Lo: 0x26ea, Hi: 0x26f0, Stride: 0x6}, {Lo: 0x26f1, Hi: 0x26f5, Stride: 0x1}, {Lo: 0x26f7, Hi: 0x26fa, Stride: 0x1}, {Lo: 0x26fd, Hi: 0x2702, Stride: 0x5}, {Lo: 0x2705, Hi: 0x2708, Stride: 0x3}, {Lo: 0x2709, Hi: 0x270d, Stride: 0x1}, {Lo: 0x270f, Hi: 0x2712, Stride: 0x3}, {Lo: 0x2714, Hi: 0x2716, Stride: 0x2}, {Lo: 0x271d, Hi: 0x2721, Stride: 0x4}, {Lo: 0x2728, Hi: 0x2733, Stride: 0xb}, {Lo: 0x2734, Hi: 0x2744, Stride: 0x10}, {Lo: 0x2747, Hi: 0x274c, Stride: 0x5}, {Lo: 0x274e, Hi: 0x2753, Stride: 0x5}, {Lo: 0x2754, Hi: 0x2755, Stride: 0x1}, {Lo: 0x2757, Hi: 0x2763, Stride: 0xc}, {Lo: 0x2764, Hi: 0x2795, Stride: 0x31}, {Lo: 0x2796, Hi: 0x2797, Stride: 0x1}, {Lo: 0x27a1, Hi: 0x27bf, Stride: 0xf}, {Lo: 0x2934, Hi: 0x2935, Stride: 0x1}, {Lo: 0x2b05, Hi: 0x2b07, Stride: 0x1}, {Lo: 0x2b1b, Hi: 0x2b1c, Stride: 0x1}, {Lo: 0x2b50, Hi: 0x2b55, Stride: 0x5}, {Lo: 0x3030, Hi: 0x303d, Stride: 0xd}, {Lo: 0x3297, Hi: 0x3299, Stride: 0x2}}, R32: []unicode.Range32{{Lo: 0x1f004, Hi: 0x1f02c, Stride: 0x28}, {Lo: 0x1f02d, Hi: 0x1f02f, Stride: 0x1}, {Lo: 0x1f094, Hi: 0x1f09f, Stride: 0x1}, {Lo: 0x1f0af, Hi: 0x1f0b0, Stride: 0x1}, {Lo: 0x1f0c0, Hi: 0x1f0cf, Stride: 0xf}, {Lo: 0x1f0d0, Hi: 0x1f0f6, Stride: 0x26}, {Lo: 0x1f0f7, Hi: 0x1f0ff, Stride: 0x1}, {Lo: 0x1f170, Hi: 0x1f171, Stride: 0x1}, {Lo: 0x1f17e, Hi: 0x1f17f, Stride: 0x1}, {Lo: 0x1f18e, Hi: 0x1f191, Stride: 0x3}, {Lo: 0x1f192, Hi: 0x1f19a, Stride: 0x1}, {Lo: 0x1f1ae, Hi: 0x1f1e5, Stride: 0x1}, {Lo: 0x1f201, Hi: 0x1f20f, Stride: 0x1}, {Lo: 0x1f21a, Hi: 0x1f22f, Stride: 0x15}, {Lo: 0x1f232, Hi: 0x1f23a, Stride: 0x1}, {Lo: 0x1f23c, Hi: 0x1f23f, Stride: 0x1}, {Lo: 0x1f249, Hi: 0x1f25f, Stride: 0x1}, {Lo: 0x1f266, Hi: 0x1f321, Stride: 0x1}, {Lo: 0x1f324, Hi: 0x1f393, Stride: 0x1}, {Lo: 0x1f396, Hi: 0x1f397, Stride: 0x1}, {Lo: 0x1f399, Hi: 0x1f39b, Stride: 0x1}, {Lo: 0x1f39e, Hi: 0x1f3f0, Stride: 0x1}, {Lo: 0x1f3f3, Hi: 0x1f3f5, Stride: 0x1}, {Lo: 0x1f3f7, Hi: 0x1f3fa, Stride: 0x1}, {Lo: 0x1f400, Hi: 0x1f4fd, Stride: 0x1}, {Lo: 0x1f4ff, Hi: 0x1f53d, Stride: 0x1}, {Lo: 0x1f549, Hi: 0x1f54e, Stride: 0x1}, {Lo: 0x1f550, Hi: 0x1f567, Stride: 0x1}, {Lo: 0x1f56f, Hi: 0x1f570, Stride: 0x1}, {Lo: 0x1f573, Hi: 0x1f57a, Stride: 0x1}, {Lo: 0x1f587, Hi: 0x1f58a, Stride: 0x3}, {Lo: 0x1f58b, Hi: 0x1f58d, Stride: 0x1}, {Lo: 0x1f590, Hi: 0x1f595, Stride: 0x5}, {Lo: 0x1f596, Hi: 0x1f5a4, Stride: 0xe}, {Lo: 0x1f5a5, Hi: 0x1f5a8, Stride: 0x3}, {Lo: 0x1f5b1, Hi: 0x1f5b2, Stride: 0x1}, {Lo: 0x1f5bc, Hi: 0x1f5c2, Stride: 0x6}, {Lo: 0x1f5c3, Hi: 0x1f5c4, Stride: 0x1}, {Lo: 0x1f5d1, Hi: 0x1f5d3, Stride: 0x1}, {Lo: 0x1f5dc, Hi: 0x1f5de, Stride: 0x1}, {Lo: 0x1f5e1, Hi: 0x1f5e3, Stride: 0x2}, {Lo: 0x1f5e8, Hi: 0x1f5ef, Stride: 0x7}, {Lo: 0x1f5f3, Hi: 0x1f5fa, Stride: 0x7}, {Lo: 0x1f5fb, Hi: 0x1f64f, Stride: 0x1}, {Lo: 0x1f680, Hi: 0x1f6c5, Stride: 0x1}, {Lo: 0x1f6cb, Hi: 0x1f6d2, Stride: 0x1}, {Lo: 0x1f6d5, Hi: 0x1f6e5, Stride: 0x1}, {Lo: 0x1f6e9, Hi: 0x1f6eb, Stride: 0x2}, {Lo: 0x1f6ec, Hi: 0x1f6f0, Stride: 0x1}, {Lo: 0x1f6f3, Hi: 0x1f6ff, Stride: 0x1}, {Lo: 0x1f7da, Hi: 0x1f7ff, Stride: 0x1}, {Lo: 0x1f80c, Hi: 0x1f80f, Stride: 0x1}, {Lo: 0x1f848, Hi: 0x1f84f, Stride: 0x1}, {Lo: 0x1f85a, Hi: 0x1f85f, Stride: 0x1}, {Lo: 0x1f888, Hi: 0x1f88f, Stride: 0x1}, {Lo: 0x1f8ae, Hi: 0x1f8af, Stride: 0x1}, {Lo: 0x1f8bc, Hi: 0x1f8bf, Stride: 0x1}, {Lo: 0x1f8c2, Hi: 0x1f8cf, Stride: 0x1}, {Lo: 0x1f8d9, Hi: 0x1f8ff, Stride: 0x1}, {Lo: 0x1f90c, Hi: 0x1f93a, Stride: 0x1}, {Lo: 0x1f93c, Hi: 0x1f945, Stride: 0x1}, {Lo: 0x1f947, Hi: 0x1f9ff, Stride: 0x1}, {Lo: 0x1fa58, Hi: 0x1fa5f, Stride: 0x1}, {Lo: 0x1fa6e, Hi: 0x1faff, Stride: 0x1}, {Lo: 0x1fc00, Hi: 0x1fffd, Stride: 0x1}}, LatinOffset: 1}
+
+var graphemeControl = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x0, Hi: 0x9, Stride: 0x1}, {Lo: 0xb, Hi: 0xc, Stride: 0x1}, {Lo: 0xe, Hi: 0x1f, Stride: 0x1}, {Lo: 0x7f, Hi: 0x9f, Stride: 0x1}, {Lo: 0xad, Hi: 0x61c, Stride: 0x56f}, {Lo: 0x180e, Hi: 0x200b, Stride: 0x7fd}, {Lo: 0x200e, Hi: 0x200f, Stride: 0x1}, {Lo: 0x2028, Hi: 0x202e, Stride: 0x1}, {Lo: 0x2060, Hi: 0x206f, Stride: 0x1}, {Lo: 0xfeff, Hi: 0xfff0, Stride: 0xf1}, {Lo: 0xfff1, Hi: 0xfffb, Stride: 0x1}}, R32: []unicode.Range32{{Lo: 0x13430, Hi: 0x1343f, Stride: 0x1}, {Lo: 0x1bca0, Hi: 0x1bca3, Stride: 0x1}, {Lo: 0x1d173, Hi: 0x1d17a, Stride: 0x1}, {Lo: 0xe0000, Hi: 0xe001f, Stride: 0x1}, {Lo: 0xe0080, Hi: 0xe00ff, Stride: 0x1}, {Lo: 0xe01f0, Hi: 0xe0fff, Stride: 0x1}}, LatinOffset: 4}
+
+var graphemeCR = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0xd, Hi: 0xd, Stride: 0x1}}, R32: []unicode.Range32(nil), LatinOffset: 1}
+
+var graphemeExtend = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x300, Hi: 0x36f, Stride: 0x1}, {Lo: 0x483, Hi: 0x489, Stride: 0x1}, {Lo: 0x591, Hi: 0x5bd, Stride: 0x1}, {Lo: 0x5bf, Hi: 0x5c1, Stride: 0x2}, {Lo: 0x5c2, Hi: 0x5c4, Stride: 0x2}, {Lo: 0x5c5, Hi: 0x5c7, Stride: 0x2}, {Lo: 0x610, Hi: 0x61a, Stride: 0x1}, {Lo: 0x64b, Hi: 0x65f, Stride: 0x1}, {Lo: 0x670, Hi: 0x6d6, Stride: 0x66}, {Lo: 0x6d7, Hi: 0x6dc, Stride: 0x1}, {Lo: 0x6df, Hi: 0x6e4, Stride: 0x1}, {Lo: 0x6e7, Hi: 0x6e8, Stride: 0x1}, {Lo: 0x6ea, Hi: 0x6ed, Stride: 0x1}, {Lo: 0x711, Hi: 0x730, Stride: 0x1f}, {Lo: 0x731, Hi: 0x74a, Stride: 0x1}, {Lo: 0x7a6, Hi: 0x7b0, Stride: 0x1}, {Lo: 0x7eb, Hi: 0x7f3, Stride: 0x1}, {Lo: 0x7fd, Hi: 0x816, Stride: 0x19}, {Lo: 0x817, Hi: 0x819, Stride: 0x1}, {Lo: 0x81b, Hi: 0x823, Stride: 0x1}, {Lo: 0x825, Hi: 0x827, Stride: 0x1}, {Lo: 0x829, Hi: 0x82d, Stride: 0x1}, {Lo: 0x859, Hi: 0x85b, Stride: 0x1}, {Lo: 0x897, Hi: 0x89f, Stride: 0x1}, {Lo: 0x8ca, Hi: 0x8e1, Stride: 0x1}, {Lo: 0x8e3, Hi: 0x902, Stride: 0x1}, {Lo: 0x93a, Hi: 0x93c, Stride: 0x2}, {Lo: 0x941, Hi: 0x948, Stride: 0x1}, {Lo: 0x94d, Hi: 0x951, Stride: 0x4}, {Lo: 0x952, Hi: 0x957, Stride: 0x1}, {Lo: 0x962, Hi: 0x963, Stride: 0x1}, {Lo: 0x981, Hi: 0x9bc, Stride: 0x3b}, {Lo: 0x9be, Hi: 0x9c1, Stride: 0x3}, {Lo: 0x9c2, Hi: 0x9c4, Stride: 0x1}, {Lo: 0x9cd, Hi: 0x9d7, Stride: 0xa}, {Lo: 0x9e2, Hi: 0x9e3, Stride: 0x1}, {Lo: 0x9fe, Hi: 0xa01, Stride: 0x3}, {Lo: 0xa02, Hi: 0xa3c, Stride: 0x3a}, {Lo: 0xa41, Hi: 0xa42, Stride: 0x1}, {Lo: 0xa47, Hi: 0xa48, Stride: 0x1}, {Lo: 0xa4b, Hi: 0xa4d, Stride: 0x1}, {Lo: 0xa51, Hi: 0xa70, Stride: 0x1f}, {Lo: 0xa71, Hi: 0xa75, Stride: 0x4}, {Lo: 0xa81, Hi: 0xa82, Stride: 0x1}, {Lo: 0xabc, Hi: 0xac1, Stride: 0x5}, {Lo: 0xac2, Hi: 0xac5, Stride: 0x1}, {Lo: 0xac7, Hi: 0xac8, Stride: 0x1}, {Lo: 0xacd, Hi: 0xae2, Stride: 0x15}, {Lo: 0xae3, Hi: 0xafa, Stride: 0x17}, {Lo: 0xafb, Hi: 0xaff, Stride: 0x1}, {Lo: 0xb01, Hi: 0xb3c, Stride: 0x3b}, {Lo: 0xb3e, Hi: 0xb3f, Stride: 0x1}, {Lo: 0xb41, Hi: 0xb44, Stride: 0x1}, {Lo: 0xb4d, Hi: 0xb55, Stride: 0x8}, {Lo: 0xb56, Hi: 0xb57, Stride: 0x1}, {Lo: 0xb62, Hi: 0xb63, Stride: 0x1}, {Lo: 0xb82, Hi: 0xbbe, Stride: 0x3c}, {Lo: 0xbc0, Hi: 0xbcd, Stride: 0xd}, {Lo: 0xbd7, Hi: 0xc00, Stride: 0x29}, {Lo: 0xc04, Hi: 0xc3c, Stride: 0x38}, {Lo: 0xc3e, Hi: 0xc40, Stride: 0x1}, {Lo: 0xc46, Hi: 0xc48, Stride: 0x1}, {Lo: 0xc4a, Hi: 0xc4d, Stride: 0x1}, {Lo: 0xc55, Hi: 0xc56, Stride: 0x1}, {Lo: 0xc62, Hi: 0xc63, Stride: 0x1}, {Lo: 0xc81, Hi: 0xcbc, Stride: 0x3b}, {Lo: 0xcbf, Hi: 0xcc0, Stride: 0x1}, {Lo: 0xcc2, Hi: 0xcc6, Stride: 0x4}, {Lo: 0xcc7, Hi: 0xcc8, Stride: 0x1}, {Lo: 0xcca, Hi: 0xccd, Stride: 0x1}, {Lo: 0xcd5, Hi: 0xcd6, Stride: 0x1}, {Lo: 0xce2, Hi: 0xce3, Stride: 0x1}, {Lo: 0xd00, Hi: 0xd01, Stride: 0x1}, {Lo: 0xd3b, Hi: 0xd3c, Stride: 0x1}, {Lo: 0xd3e, Hi: 0xd41, Stride: 0x3}, {Lo: 0xd42, Hi: 0xd44, Stride: 0x1}, {Lo: 0xd4d, Hi: 0xd57, Stride: 0xa}, {Lo: 0xd62, Hi: 0xd63, Stride: 0x1}, {Lo: 0xd81, Hi: 0xdca, Stride: 0x49}, {Lo: 0xdcf, Hi: 0xdd2, Stride: 0x3}, {Lo: 0xdd3, Hi: 0xdd4, Stride: 0x1}, {Lo: 0xdd6, Hi: 0xddf, Stride: 0x9}, {Lo: 0xe31, Hi: 0xe34, Stride: 0x3}, {Lo: 0xe35, Hi: 0xe3a, Stride: 0x1}, {Lo: 0xe47, Hi: 0xe4e, Stride: 0x1}, {Lo: 0xeb1, Hi: 0xeb4, Stride: 0x3}, {Lo: 0xeb5, Hi: 0xebc, Stride: 0x1}, {Lo: 0xec8, Hi: 0xece, Stride: 0x1}, {Lo: 0xf18, Hi: 0xf19, Stride: 0x1}, {Lo: 0xf35, Hi: 0xf39, Stride: 0x2}, {Lo: 0xf71, Hi: 0xf7e, Stride: 0x1}, {Lo: 0xf80, Hi: 0xf84, Stride: 0x1}, {Lo: 0xf86, Hi: 0xf87, Stride: 0x1}, {Lo: 0xf8d, Hi: 0xf97, Stride: 0x1}, {Lo: 0xf99, Hi: 0xfbc, Stride: 0x1}, {Lo: 0xfc6, Hi: 0x102d, Stride: 0x67}, {Lo: 0x102e, Hi: 0x1030, Stride: 0x1}, {Lo: 0x1032, Hi: 0x1037, Stride: 0x1}, {Lo: 0x1039, Hi: 0x103a, Stride: 0x1}, {Lo: 0x103d, Hi: 0x103e, Stride: 0x1}, {Lo: 0x1058, Hi: 0x1059, Stride: 0x1}, {Lo: 0x105e, Hi: 0x1060, Stride: 0x1}, {Lo: 0x1071, Hi: 0x1074, Stride: 0x1}, {Lo: 0x1082, Hi: 0x1085, Stride: 0x3}, {Lo: 0x1086, Hi: 0x108d, Stride: 0x7}, {Lo: 0x109d, Hi: 0x135d, Stride: 0x2c0}, {Lo: 0x135e, Hi: 0x135f, Stride: 0x1}, {Lo: 0x1712, Hi: 0x1715, Stride: 0x1}, {Lo: 0x1732, Hi: 0x1734, Stride: 0x1}, {Lo: 0x1752, Hi: 0x1753, Stride: 0x1}, {Lo: 0x1772, Hi: 0x1773, Stride: 0x1}, {Lo: 0x17b4, Hi: 0x17b5, Stride: 0x1}, {Lo: 0x17b7, Hi: 0x17bd, Stride: 0x1}, {Lo: 0x17c6, Hi: 0x17c9, Stride: 0x3}, {Lo: 0x17ca, Hi: 0x17d3, Stride: 0x1}, {Lo: 0x17dd, Hi: 0x180b, Stride: 0x2e}, {Lo: 0x180c, Hi: 0x180d, Stride: 0x1}, {Lo: 0x180f, Hi: 0x1885, Stride: 0x76}, {Lo: 0x1886, Hi: 0x18a9, Stride: 0x23}, {Lo: 0x1920, Hi: 0x1922, Stride: 0x1}, {Lo: 0x1927, Hi: 0x1928, Stride: 0x1}, {Lo: 0x1932, Hi: 0x1939, Stride: 0x7}, {Lo: 0x193a, Hi: 0x193b, Stride: 0x1}, {Lo: 0x1a17, Hi: 0x1a18, Stride: 0x1}, {Lo: 0x1a1b, Hi: 0x1a56, Stride: 0x3b}, {Lo: 0x1a58, Hi: 0x1a5e, Stride: 0x1}, {Lo: 0x1a60, Hi: 0x1a62, Stride: 0x2}, {Lo: 0x1a65, Hi: 0x1a6c, Stride: 0x1}, {Lo: 0x1a73, Hi: 0x1a7c, Stride: 0x1}, {Lo: 0x1a7f, Hi: 0x1ab0, Stride: 0x31}, {Lo: 0x1ab1, Hi: 0x1add, Stride: 0x1}, {Lo: 0x1ae0, Hi: 0x1aeb, Stride: 0x1}, {Lo: 0x1b00, Hi: 0x1b03, Stride: 0x1}, {Lo: 0x1b34, Hi: 0x1b3d, Stride: 0x1}, {Lo: 0x1b42, Hi: 0x1b44, Stride: 0x1}, {Lo: 0x1b6b, Hi: 0x1b73, Stride: 0x1}, {Lo: 0x1b80, Hi: 0x1b81, Stride: 0x1}, {Lo: 0x1ba2, Hi: 0x1ba5, Stride: 0x1}, {Lo: 0x1ba8, Hi: 0x1bad, Stride: 0x1}, {Lo: 0x1be6, Hi: 0x1be8, Stride: 0x2}, {Lo: 0x1be9, Hi: 0x1bed, Stride: 0x4}, {Lo: 0x1bef, Hi: 0x1bf3, Stride: 0x1}, {Lo: 0x1c2c, Hi: 0x1c33, Stride: 0x1}, {Lo: 0x1c36, Hi: 0x1c37, Stride: 0x1}, {Lo: 0x1cd0, Hi: 0x1cd2, Stride: 0x1}, {Lo: 0x1cd4, Hi: 0x1ce0, Stride: 0x1}, {Lo: 0x1ce2, Hi: 0x1ce8, Stride: 0x1}, {Lo: 0x1ced, Hi: 0x1cf4, Stride: 0x7}, {Lo: 0x1cf8, Hi: 0x1cf9, Stride: 0x1}, {Lo: 0x1dc0, Hi: 0x1dff, Stride: 0x1}, {Lo: 0x200c, Hi: 0x20d0, Stride: 0xc4}, {Lo: 0x20d1, Hi: 0x20f0, Stride: 0x1}, {Lo: 0x2cef, Hi: 0x2cf1, Stride: 0x1}, {Lo: 0x2d7f, Hi: 0x2de0, Stride: 0x61}, {Lo: 0x2de1, Hi: 0x2dff, Stride: 0x1}, {Lo: 0x302a, Hi: 0x302f, Stride: 0x1}, {Lo: 0x3099, Hi: 0x309a, Stride: 0x1}, {Lo: 0xa66f, Hi: 0xa672, Stride: 0x1}, {Lo: 0xa674, Hi: 0xa67d, Stride: 0x1}, {Lo: 0xa69e, Hi: 0xa69f, Stride: 0x1}, {Lo: 0xa6f0, Hi: 0xa6f1, Stride: 0x1}, {Lo: 0xa802, Hi: 0xa806, Stride: 0x4}, {Lo: 0xa80b, Hi: 0xa825, Stride: 0x1a}, {Lo: 0xa826, Hi: 0xa82c, Stride: 0x6}, {Lo: 0xa8c4, Hi: 0xa8c5, Stride: 0x1}, {Lo: 0xa8e0, Hi: 0xa8f1, Stride: 0x1}, {Lo: 0xa8ff, Hi: 0xa926, Stride: 0x27}, {Lo: 0xa927, Hi: 0xa92d, Stride: 0x1}, {Lo: 0xa947, Hi: 0xa951, Stride: 0x1}, {Lo: 0xa953, Hi: 0xa980, Stride: 0x2d}, {Lo: 0xa981, Hi: 0xa982, Stride: 0x1}, {Lo: 0xa9b3, Hi: 0xa9b6, Stride: 0x3}, {Lo: 0xa9b7, Hi: 0xa9b9, Stride: 0x1}, {Lo: 0xa9bc, Hi: 0xa9bd, Stride: 0x1}, {Lo: 0xa9c0, Hi: 0xa9e5, Stride: 0x25}, {Lo: 0xaa29, Hi: 0xaa2e, Stride: 0x1}, {Lo: 0xaa31, Hi: 0xaa32, Stride: 0x1}, {Lo: 0xaa35, Hi: 0xaa36, Stride: 0x1}, {Lo: 0xaa43, Hi: 0xaa4c, Stride: 0x9}, {Lo: 0xaa7c, Hi: 0xaab0, Stride: 0x34}, {Lo: 0xaab2, Hi: 0xaab4, Stride: 0x1}, {Lo: 0xaab7, Hi: 0xaab8, Stride: 0x1}, {Lo: 0xaabe, Hi: 0xaabf, Stride: 0x1}, {Lo: 0xaac1, Hi: 0xaaec, Stride: 0x2b}, {Lo: 0xaaed, Hi: 0xaaf6, Stride: 0x9}, {Lo: 0xabe5, Hi: 0xabe8, Stride: 0x3}, {Lo: 0xabed, Hi: 0xfb1e, Stride: 0x4f31}, {Lo: 0xfe00, Hi: 0xfe0f, Stride: 0x1}, {Lo: 0xfe20, Hi: 0xfe2f, Stride: 0x1}, {Lo: 0xff9e, Hi: 0xff9f, Stride: 0x1}}, R32: []unicode.Range32{{Lo: 0x101fd, Hi: 0x102e0, Stride: 0xe3}, {Lo: 0x10376, Hi: 0x1037a, Stride: 0x1}, {Lo: 0x10a01, Hi: 0x10a03, Stride: 0x1}, {Lo: 0x10a05, Hi: 0x10a06, Stride: 0x1}, {Lo: 0x10a0c, Hi: 0x10a0f, Stride: 0x1}, {Lo: 0x10a38, Hi: 0x10a3a, Stride: 0x1}, {Lo: 0x10a3f, Hi: 0x10ae5, Stride: 0xa6}, {Lo: 0x10ae6, Hi: 0x10d24, Stride: 0x23e}, {Lo: 0x10d25, Hi: 0x10d27, Stride: 0x1}, {Lo: 0x10d69, Hi: 0x10d6d, Stride: 0x1}, {Lo: 0x10eab, Hi: 0x10eac, Stride: 0x1}, {Lo: 0x10efa, Hi: 0x10eff, Stride: 0x1}, {Lo: 0x10f46, Hi: 0x10f50, Stride: 0x1}, {Lo: 0x10f82, Hi: 0x10f85, Stride: 0x1}, {Lo: 0x11001, Hi: 0x11038, Stride: 0x37}, {Lo: 0x11039, Hi: 0x11046, Stride: 0x1}, {Lo: 0x11070, Hi: 0x11073, Stride: 0x3}, {Lo: 0x11074, Hi: 0x1107f, Stride: 0xb}, {Lo: 0x11080, Hi: 0x11081, Stride: 0x1}, {Lo: 0x110b3, Hi: 0x110b6, Stride: 0x1}, {Lo: 0x110b9, Hi: 0x110ba, Stride: 0x1}, {Lo: 0x110c2, Hi: 0x11100, Stride: 0x3e}, {Lo: 0x11101, Hi: 0x11102, Stride: 0x1}, {Lo: 0x11127, Hi: 0x1112b, Stride: 0x1}, {Lo: 0x1112d, Hi: 0x11134, Stride: 0x1}, {Lo: 0x11173, Hi: 0x11180, Stride: 0xd}, {Lo: 0x11181, Hi: 0x111b6, Stride: 0x35}, {Lo: 0x111b7, Hi: 0x111be, Stride: 0x1}, {Lo: 0x111c0, Hi: 0x111c9, Stride: 0x9}, {Lo: 0x111ca, Hi: 0x111cc, Stride: 0x1}, {Lo: 0x111cf, Hi: 0x1122f, Stride: 0x60}, {Lo: 0x11230, Hi: 0x11231, Stride: 0x1}, {Lo: 0x11234, Hi: 0x11237, Stride: 0x1}, {Lo: 0x1123e, Hi: 0x11241, Stride: 0x3}, {Lo: 0x112df, Hi: 0x112e3, Stride: 0x4}, {Lo: 0x112e4, Hi: 0x112ea, Stride: 0x1}, {Lo: 0x11300, Hi: 0x11301, Stride: 0x1}, {Lo: 0x1133b, Hi: 0x1133c, Stride: 0x1}, {Lo: 0x1133e, Hi: 0x11340, Stride: 0x2}, {Lo: 0x1134d, Hi: 0x11357, Stride: 0xa}, {Lo: 0x11366, Hi: 0x1136c, Stride: 0x1}, {Lo: 0x11370, Hi: 0x11374, Stride: 0x1}, {Lo: 0x113b8, Hi: 0x113bb, Stride: 0x3}, {Lo: 0x113bc, Hi: 0x113c0, Stride: 0x1}, {Lo: 0x113c2, Hi: 0x113c5, Stride: 0x3}, {Lo: 0x113c7, Hi: 0x113c9, Stride: 0x1}, {Lo: 0x113ce, Hi: 0x113d0, Stride: 0x1}, {Lo: 0x113d2, Hi: 0x113e1, Stride: 0xf}, {Lo: 0x113e2, Hi: 0x11438, Stride: 0x56}, {Lo: 0x11439, Hi: 0x1143f, Stride: 0x1}, {Lo: 0x11442, Hi: 0x11444, Stride: 0x1}, {Lo: 0x11446, Hi: 0x1145e, Stride: 0x18}, {Lo: 0x114b0, Hi: 0x114b3, Stride: 0x3}, {Lo: 0x114b4, Hi: 0x114b8, Stride: 0x1}, {Lo: 0x114ba, Hi: 0x114bd, Stride: 0x3}, {Lo: 0x114bf, Hi: 0x114c0, Stride: 0x1}, {Lo: 0x114c2, Hi: 0x114c3, Stride: 0x1}, {Lo: 0x115af, Hi: 0x115b2, Stride: 0x3}, {Lo: 0x115b3, Hi: 0x115b5, Stride: 0x1}, {Lo: 0x115bc, Hi: 0x115bd, Stride: 0x1}, {Lo: 0x115bf, Hi: 0x115c0, Stride: 0x1}, {Lo: 0x115dc, Hi: 0x115dd, Stride: 0x1}, {Lo: 0x11633, Hi: 0x1163a, Stride: 0x1}, {Lo: 0x1163d, Hi: 0x1163f, Stride: 0x2}, {Lo: 0x11640, Hi: 0x116ab, Stride: 0x6b}, {Lo: 0x116ad, Hi: 0x116b0, Stride: 0x3}, {Lo: 0x116b1, Hi: 0x116b7, Stride: 0x1}, {Lo: 0x1171d, Hi: 0x1171f, Stride: 0x2}, {Lo: 0x11722, Hi: 0x11725, Stride: 0x1}, {Lo: 0x11727, Hi: 0x1172b, Stride: 0x1}, {Lo: 0x1182f, Hi: 0x11837, Stride: 0x1}, {Lo: 0x11839, Hi: 0x1183a, Stride: 0x1}, {Lo: 0x11930, Hi: 0x1193b, Stride: 0xb}, {Lo: 0x1193c, Hi: 0x1193e, Stride: 0x1}, {Lo: 0x11943, Hi: 0x119d4, Stride: 0x91}, {Lo: 0x119d5, Hi: 0x119d7, Stride: 0x1}, {Lo: 0x119da, Hi: 0x119db, Stride: 0x1}, {Lo: 0x119e0, Hi: 0x11a01, Stride: 0x21}, {Lo: 0x11a02, Hi: 0x11a0a, Stride: 0x1}, {Lo: 0x11a33, Hi: 0x11a38, Stride: 0x1}, {Lo: 0x11a3b, Hi: 0x11a3e, Stride: 0x1}, {Lo: 0x11a47, Hi: 0x11a51, Stride: 0xa}, {Lo: 0x11a52, Hi: 0x11a56, Stride: 0x1}, {Lo: 0x11a59, Hi: 0x11a5b, Stride: 0x1}, {Lo: 0x11a8a, Hi: 0x11a96, Stride: 0x1}, {Lo: 0x11a98, Hi: 0x11a99, Stride: 0x1}, {Lo: 0x11b60, Hi: 0x11b62, Stride: 0x2}, {Lo: 0x11b63, Hi: 0x11b64, Stride: 0x1}, {Lo: 0x11b66, Hi: 0x11c30, Stride: 0xca}, {Lo: 0x11c31, Hi: 0x11c36, Stride: 0x1}, {Lo: 0x11c38, Hi: 0x11c3d, Stride: 0x1}, {Lo: 0x11c3f, Hi: 0x11c92, Stride: 0x53}, {Lo: 0x11c93, Hi: 0x11ca7, Stride: 0x1}, {Lo: 0x11caa, Hi: 0x11cb0, Stride: 0x1}, {Lo: 0x11cb2, Hi: 0x11cb3, Stride: 0x1}, {Lo: 0x11cb5, Hi: 0x11cb6, Stride: 0x1}, {Lo: 0x11d31, Hi: 0x11d36, Stride: 0x1}, {Lo: 0x11d3a, Hi: 0x11d3c, Stride: 0x2}, {Lo: 0x11d3d, Hi: 0x11d3f, Stride: 0x2}, {Lo: 0x11d40, Hi: 0x11d45, Stride: 0x1}, {Lo: 0x11d47, Hi: 0x11d90, Stride: 0x49}, {Lo: 0x11d91, Hi: 0x11d95, Stride: 0x4}, {Lo: 0x11d97, Hi: 0x11ef3, Stride: 0x15c}, {Lo: 0x11ef4, Hi: 0x11f00, Stride: 0xc}, {Lo: 0x11f01, Hi: 0x11f36, Stride: 0x35}, {Lo: 0x11f37, Hi: 0x11f3a, Stride: 0x1}, {Lo: 0x11f40, Hi: 0x11f42, Stride: 0x1}, {Lo: 0x11f5a, Hi: 0x13440, Stride: 0x14e6}, {Lo: 0x13447, Hi: 0x13455, Stride: 0x1}, {Lo: 0x1611e, Hi: 0x16129, Stride: 0x1}, {Lo: 0x1612d, Hi: 0x1612f, Stride: 0x1}, {Lo: 0x16af0, Hi: 0x16af4, Stride: 0x1}, {Lo: 0x16b30, Hi: 0x16b36, Stride: 0x1}, {Lo: 0x16f4f, Hi: 0x16f8f, Stride: 0x40}, {Lo: 0x16f90, Hi: 0x16f92, Stride: 0x1}, {Lo: 0x16fe4, Hi: 0x16ff0, Stride: 0xc}, {Lo: 0x16ff1, Hi: 0x1bc9d, Stride: 0x4cac}, {Lo: 0x1bc9e, Hi: 0x1cf00, Stride: 0x1262}, {Lo: 0x1cf01, Hi: 0x1cf2d, Stride: 0x1}, {Lo: 0x1cf30, Hi: 0x1cf46, Stride: 0x1}, {Lo: 0x1d165, Hi: 0x1d169, Stride: 0x1}, {Lo: 0x1d16d, Hi: 0x1d172, Stride: 0x1}, {Lo: 0x1d17b, Hi: 0x1d182, Stride: 0x1}, {Lo: 0x1d185, Hi: 0x1d18b, Stride: 0x1}, {Lo: 0x1d1aa, Hi: 0x1d1ad, Stride: 0x1}, {Lo: 0x1d242, Hi: 0x1d244, Stride: 0x1}, {Lo: 0x1da00, Hi: 0x1da36, Stride: 0x1}, {Lo: 0x1da3b, Hi: 0x1da6c, Stride: 0x1}, {Lo: 0x1da75, Hi: 0x1da84, Stride: 0xf}, {Lo: 0x1da9b, Hi: 0x1da9f, Stride: 0x1}, {Lo: 0x1daa1, Hi: 0x1daaf, Stride: 0x1}, {Lo: 0x1e000, Hi: 0x1e006, Stride: 0x1}, {Lo: 0x1e008, Hi: 0x1e018, Stride: 0x1}, {Lo: 0x1e01b, Hi: 0x1e021, Stride: 0x1}, {Lo: 0x1e023, Hi: 0x1e024, Stride: 0x1}, {Lo: 0x1e026, Hi: 0x1e02a, Stride: 0x1}, {Lo: 0x1e08f, Hi: 0x1e130, Stride: 0xa1}, {Lo: 0x1e131, Hi: 0x1e136, Stride: 0x1}, {Lo: 0x1e2ae, Hi: 0x1e2ec, Stride: 0x3e}, {Lo: 0x1e2ed, Hi: 0x1e2ef, Stride: 0x1}, {Lo: 0x1e4ec, Hi: 0x1e4ef, Stride: 0x1}, {Lo: 0x1e5ee, Hi: 0x1e5ef, Stride: 0x1}, {Lo: 0x1e6e3, Hi: 0x1e6e6, Stride: 0x3}, {Lo: 0x1e6ee, Hi: 0x1e6ef, Stride: 0x1}, {Lo: 0x1e6f5, Hi: 0x1e8d0, Stride: 0x1db}, {Lo: 0x1e8d1, Hi: 0x1e8d6, Stride: 0x1}, {Lo: 0x1e944, Hi: 0x1e94a, Stride: 0x1}, {Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 0x1}, {Lo: 0xe0020, Hi: 0xe007f, Stride: 0x1}, {Lo: 0xe0100, Hi: 0xe01ef, Stride: 0x1}}, LatinOffset: 0}
+
+var graphemeL = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x1100, Hi: 0x115f, Stride: 0x1}, {Lo: 0xa960, Hi: 0xa97c, Stride: 0x1}}, R32: []unicode.Range32(nil), LatinOffset: 0}
+
+var graphemeLF = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0xa, Hi: 0xa, Stride: 0x1}}, R32: []unicode.Range32(nil), LatinOffset: 1}
+
+var graphemeLV = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0xac00, Hi: 0xd788, Stride: 0x1c}}, R32: []unicode.Range32(nil), LatinOffset: 0}
+
+var graphemeLVT = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0xac01, Hi: 0xac1b, Stride: 0x1}, {Lo: 0xac1d, Hi: 0xac37, Stride: 0x1}, {Lo: 0xac39, Hi: 0xac53, Stride: 0x1}, {Lo: 0xac55, Hi: 0xac6f, Stride: 0x1}, {Lo: 0xac71, Hi: 0xac8b, Stride: 0x1}, {Lo: 0xac8d, Hi: 0xaca7, Stride: 0x1}, {Lo: 0xaca9, Hi: 0xacc3, Stride: 0x1}, {Lo: 0xacc5, Hi: 0xacdf, Stride: 0x1}, {Lo: 0xace1, Hi: 0xacfb, Stride: 0x1}, {Lo: 0xacfd, Hi: 0xad17, Stride: 0x1}, {Lo: 0xad19, Hi: 0xad33, Stride: 0x1}, {Lo: 0xad35, Hi: 0xad4f, Stride: 0x1}, {Lo: 0xad51, Hi: 0xad6b, Stride: 0x1}, {Lo: 0xad6d, Hi: 0xad87, Stride: 0x1}, {Lo: 0xad89, Hi: 0xada3, Stride: 0x1}, {Lo: 0xada5, Hi: 0xadbf, Stride: 0x1}, {Lo: 0xadc1, Hi: 0xaddb, Stride: 0x1}, {Lo: 0xaddd, Hi: 0xadf7, Stride: 0x1}, {Lo: 0xadf9, Hi: 0xae13, Stride: 0x1}, {Lo: 0xae15, Hi: 0xae2f, Stride: 0x1}, {Lo: 0xae31, Hi: 0xae4b, Stride: 0x1}, {Lo: 0xae4d, Hi: 0xae67, Stride: 0x1}, {Lo: 0xae69, Hi: 0xae83, Stride: 0x1}, {Lo: 0xae85, Hi: 0xae9f, Stride: 0x1}, {Lo: 0xaea1, Hi: 0xaebb, Stride: 0x1}, {Lo: 0xaebd, Hi: 0xaed7, Stride: 0x1}, {Lo: 0xaed9, Hi: 0xaef3, Stride: 0x1}, {Lo: 0xaef5, Hi: 0xaf0f, Stride: 0x1}, {Lo: 0xaf11, Hi: 0xaf2b, Stride: 0x1}, {Lo: 0xaf2d, Hi: 0xaf47, Stride: 0x1}, {Lo: 0xaf49, Hi: 0xaf63, Stride: 0x1}, {Lo: 0xaf65, Hi: 0xaf7f, Stride: 0x1}, {Lo: 0xaf81, Hi: 0xaf9b, Stride: 0x1}, {Lo: 0xaf9d, Hi: 0xafb7, Stride: 0x1}, {Lo: 0xafb9, Hi: 0xafd3, Stride: 0x1}, {Lo: 0xafd5, Hi: 0xafef, Stride: 0x1}, {Lo: 0xaff1, Hi: 0xb00b, Stride: 0x1}, {Lo: 0xb00d, Hi: 0xb027, Stride: 0x1}, {Lo: 0xb029, Hi: 0xb043, Stride: 0x1}, {Lo: 0xb045, Hi: 0xb05f, Stride: 0x1}, {Lo: 0xb061, Hi: 0xb07b, Stride: 0x1}, {Lo: 0xb07d, Hi: 0xb097, Stride: 0x1}, {Lo: 0xb099, Hi: 0xb0b3, Stride: 0x1}, {Lo: 0xb0b5, Hi: 0xb0cf, Stride: 0x1}, {Lo: 0xb0d1, Hi: 0xb0eb, Stride: 0x1}, {Lo: 0xb0ed, Hi: 0xb107, Stride: 0x1}, {Lo: 0xb109, Hi: 0xb123, Stride: 0x1}, {Lo: 0xb125, Hi: 0xb13f, Stride: 0x1}, {Lo: 0xb141, Hi: 0xb15b, Stride: 0x1}, {Lo: 0xb15d, Hi: 0xb177, Stride: 0x1}, {Lo: 0xb179, Hi: 0xb193, Stride: 0x1}, {Lo: 0xb195, Hi: 0xb1af, Stride: 0x1}, {Lo: 0xb1b1, Hi: 0xb1cb, Stride: 0x1}, {Lo: 0xb1cd, Hi: 0xb1e7, Stride: 0x1}, {Lo: 0xb1e9, Hi: 0xb203, Stride: 0x1}, {Lo: 0xb205, Hi: 0xb21f, Stride: 0x1}, {Lo: 0xb221, Hi: 0xb23b, Stride: 0x1}, {Lo: 0xb23d, Hi: 0xb257, Stride: 0x1}, {Lo: 0xb259, Hi: 0xb273, Stride: 0x1}, {Lo: 0xb275, Hi: 0xb28f, Stride: 0x1}, {Lo: 0xb291, Hi: 0xb2ab, Stride: 0x1}, {Lo: 0xb2ad, Hi: 0xb2c7, Stride: 0x1}, {Lo: 0xb2c9, Hi: 0xb2e3, Stride: 0x1}, {Lo: 0xb2e5, Hi: 0xb2ff, Stride: 0x1}, {Lo: 0xb301, Hi: 0xb31b, Stride: 0x1}, {Lo: 0xb31d, Hi: 0xb337, Stride: 0x1}, {Lo: 0xb339, Hi: 0xb353, Stride: 0x1}, {Lo: 0xb355, Hi: 0xb36f, Stride: 0x1}, {Lo: 0xb371, Hi: 0xb38b, Stride: 0x1}, {Lo: 0xb38d, Hi: 0xb3a7, Stride: 0x1}, {Lo: 0xb3a9, Hi: 0xb3c3, Stride: 0x1}, {Lo: 0xb3c5, Hi: 0xb3df, Stride: 0x1}, {Lo: 0xb3e1, Hi: 0xb3fb, Stride: 0x1}, {Lo: 0xb3fd, Hi: 0xb417, Stride: 0x1}, {Lo: 0xb419, Hi: 0xb433, Stride: 0x1}, {Lo: 0xb435, Hi: 0xb44f, Stride: 0x1}, {Lo: 0xb451, Hi: 0xb46b, Stride: 0x1}, {Lo: 0xb46d, Hi: 0xb487, Stride: 0x1}, {Lo: 0xb489, Hi: 0xb4a3, Stride: 0x1}, {Lo: 0xb4a5, Hi: 0xb4bf, Stride: 0x1}, {Lo: 0xb4c1, Hi: 0xb4db, Stride: 0x1}, {Lo: 0xb4dd, Hi: 0xb4f7, Stride: 0x1}, {Lo: 0xb4f9, Hi: 0xb513, Stride: 0x1}, {Lo: 0xb515, Hi: 0xb52f, Stride: 0x1}, {Lo: 0xb531, Hi: 0xb54b, Stride: 0x1}, {Lo: 0xb54d, Hi: 0xb567, Stride: 0x1}, {Lo: 0xb569, Hi: 0xb583, Stride: 0x1}, {Lo: 0xb585, Hi: 0xb59f, Stride: 0x1}, {Lo: 0xb5a1, Hi: 0xb5bb, Stride: 0x1}, {Lo: 0xb5bd, Hi: 0xb5d7, Stride: 0x1}, {Lo: 0xb5d9, Hi: 0xb5f3, Stride: 0x1}, {Lo: 0xb5f5, Hi: 0xb60f, Stride: 0x1}, {Lo: 0xb611, Hi: 0xb62b, Stride: 0x1}, {Lo: 0xb62d, Hi: 0xb647, Stride: 0x1}, {Lo: 0xb649, Hi: 0xb663, Stride: 0x1}, {Lo: 0xb665, Hi: 0xb67f, Stride: 0x1}, {Lo: 0xb681, Hi: 0xb69b, Stride: 0x1}, {Lo: 0xb69d, Hi: 0xb6b7, Stride: 0x1}, {Lo: 0xb6b9, Hi: 0xb6d3, Stride: 0x1}, {Lo: 0xb6d5, Hi: 0xb6ef, Stride: 0x1}, {Lo: 0xb6f1, Hi: 0xb70b, Stride: 0x1}, {Lo: 0xb70d, Hi: 0xb727, Stride: 0x1}, {Lo: 0xb729, Hi: 0xb743, Stride: 0x1}, {Lo: 0xb745, Hi: 0xb75f, Stride: 0x1}, {Lo: 0xb761, Hi: 0xb77b, Stride: 0x1}, {Lo: 0xb77d, Hi: 0xb797, Stride: 0x1}, {Lo: 0xb799, Hi: 0xb7b3, Stride: 0x1}, {Lo: 0xb7b5, Hi: 0xb7cf, Stride: 0x1}, {Lo: 0xb7d1, Hi: 0xb7eb, Stride: 0x1}, {Lo: 0xb7ed, Hi: 0xb807, Stride: 0x1}, {Lo: 0xb809, Hi: 0xb823, Stride: 0x1}, {Lo: 0xb825, Hi: 0xb83f, Stride: 0x1}, {Lo: 0xb841, Hi: 0xb85b, Stride: 0x1}, {Lo: 0xb85d, Hi: 0xb877, Stride: 0x1}, {Lo: 0xb879, Hi: 0xb893, Stride: 0x1}, {Lo: 0xb895, Hi: 0xb8af, Stride: 0x1}, {Lo: 0xb8b1, Hi: 0xb8cb, Stride: 0x1}, {Lo: 0xb8cd, Hi: 0xb8e7, Stride: 0x1}, {Lo: 0xb8e9, Hi: 0xb903, Stride: 0x1}, {Lo: 0xb905, Hi: 0xb91f, Stride: 0x1}, {Lo: 0xb921, Hi: 0xb93b, Stride: 0x1}, {Lo: 0xb93d, Hi: 0xb957, Stride: 0x1}, {Lo: 0xb959, Hi: 0xb973, Stride: 0x1}, {Lo: 0xb975, Hi: 0xb98f, Stride: 0x1}, {Lo: 0xb991, Hi: 0xb9ab, Stride: 0x1}, {Lo: 0xb9ad, Hi: 0xb9c7, Stride: 0x1}, {Lo: 0xb9c9, Hi: 0xb9e3, Stride: 0x1}, {Lo: 0xb9e5, Hi: 0xb9ff, Stride: 0x1}, {Lo: 0xba01, Hi: 0xba1b, Stride: 0x1}, {Lo: 0xba1d, Hi: 0xba37, Stride: 0x1}, {Lo: 0xba39, Hi: 0xba53, Stride: 0x1}, {Lo: 0xba55, Hi: 0xba6f, Stride: 0x1}, {Lo: 0xba71, Hi: 0xba8b, Stride: 0x1}, {Lo: 0xba8d, Hi: 0xbaa7, Stride: 0x1}, {Lo: 0xbaa9, Hi: 0xbac3, Stride: 0x1}, {Lo: 0xbac5, Hi: 0xbadf, Stride: 0x1}, {Lo: 0xbae1, Hi: 0xbafb, Stride: 0x1}, {Lo: 0xbafd, Hi: 0xbb17, Stride: 0x1}, {Lo: 0xbb19, Hi: 0xbb33, Stride: 0x1}, {Lo: 0xbb35, Hi: 0xbb4f, Stride: 0x1}, {Lo: 0xbb51, Hi: 0xbb6b, Stride: 0x1}, {Lo: 0xbb6d, Hi: 0xbb87, Stride: 0x1}, {Lo: 0xbb89, Hi: 0xbba3, Stride: 0x1}, {Lo: 0xbba5, Hi: 0xbbbf, Stride: 0x1}, {Lo: 0xbbc1, Hi: 0xbbdb, Stride: 0x1}, {Lo: 0xbbdd, Hi: 0xbbf7, Stride: 0x1}, {Lo: 0xbbf9, Hi: 0xbc13, Stride: 0x1}, {Lo: 0xbc15, Hi: 0xbc2f, Stride: 0x1}, {Lo: 0xbc31, Hi: 0xbc4b, Stride: 0x1}, {Lo: 0xbc4d, Hi: 0xbc67, Stride: 0x1}, {Lo: 0xbc69, Hi: 0xbc83, Stride: 0x1}, {Lo: 0xbc85, Hi: 0xbc9f, Stride: 0x1}, {Lo: 0xbca1, Hi: 0xbcbb, Stride: 0x1}, {Lo: 0xbcbd, Hi: 0xbcd7, Stride: 0x1}, {Lo: 0xbcd9, Hi: 0xbcf3, Stride: 0x1}, {Lo: 0xbcf5, Hi: 0xbd0f, Stride: 0x1}, {Lo: 0xbd11, Hi: 0xbd2b, Stride: 0x1}, {Lo: 0xbd2d, Hi: 0xbd47, Stride: 0x1}, {Lo: 0xbd49, Hi: 0xbd63, Stride: 0x1}, {Lo: 0xbd65, Hi: 0xbd7f, Stride: 0x1}, {Lo: 0xbd81, Hi: 0xbd9b, Stride: 0x1}, {Lo: 0xbd9d, Hi: 0xbdb7, Stride: 0x1}, {Lo: 0xbdb9, Hi: 0xbdd3, Stride: 0x1}, {Lo: 0xbdd5, Hi: 0xbdef, Stride: 0x1}, {Lo: 0xbdf1, Hi: 0xbe0b, Stride: 0x1}, {Lo: 0xbe0d, Hi: 0xbe27, Stride: 0x1}, {Lo: 0xbe29, Hi: 0xbe43, Stride: 0x1}, {Lo: 0xbe45, Hi: 0xbe5f, Stride: 0x1}, {Lo: 0xbe61, Hi: 0xbe7b, Stride: 0x1}, {Lo: 0xbe7d, Hi: 0xbe97, Stride: 0x1}, {Lo: 0xbe99, Hi: 0xbeb3, Stride: 0x1}, {Lo: 0xbeb5, Hi: 0xbecf, Stride: 0x1}, {Lo: 0xbed1, Hi: 0xbeeb, Stride: 0x1}, {Lo: 0xbeed, Hi: 0xbf07, Stride: 0x1}, {Lo: 0xbf09, Hi: 0xbf23, Stride: 0x1}, {Lo: 0xbf25, Hi: 0xbf3f, Stride: 0x1}, {Lo: 0xbf41, Hi: 0xbf5b, Stride: 0x1}, {Lo: 0xbf5d, Hi: 0xbf77, Stride: 0x1}, {Lo: 0xbf79, Hi: 0xbf93, Stride: 0x1}, {Lo: 0xbf95, Hi: 0xbfaf, Stride: 0x1}, {Lo: 0xbfb1, Hi: 0xbfcb, Stride: 0x1}, {Lo: 0xbfcd, Hi: 0xbfe7, Stride: 0x1}, {Lo: 0xbfe9, Hi: 0xc003, Stride: 0x1}, {Lo: 0xc005, Hi: 0xc01f, Stride: 0x1}, {Lo: 0xc021, Hi: 0xc03b, Stride: 0x1}, {Lo: 0xc03d, Hi: 0xc057, Stride: 0x1}, {Lo: 0xc059, Hi: 0xc073, Stride: 0x1}, {Lo: 0xc075, Hi: 0xc08f, Stride: 0x1}, {Lo: 0xc091, Hi: 0xc0ab, Stride: 0x1}, {Lo: 0xc0ad, Hi: 0xc0c7, Stride: 0x1}, {Lo: 0xc0c9, Hi: 0xc0e3, Stride: 0x1}, {Lo: 0xc0e5, Hi: 0xc0ff, Stride: 0x1}, {Lo: 0xc101, Hi: 0xc11b, Stride: 0x1}, {Lo: 0xc11d, Hi: 0xc137, Stride: 0x1}, {Lo: 0xc139, Hi: 0xc153, Stride: 0x1}, {Lo: 0xc155, Hi: 0xc16f, Stride: 0x1}, {Lo: 0xc171, Hi: 0xc18b, Stride: 0x1}, {Lo: 0xc18d, Hi: 0xc1a7, Stride: 0x1}, {Lo: 0xc1a9, Hi: 0xc1c3, Stride: 0x1}, {Lo: 0xc1c5, Hi: 0xc1df, Stride: 0x1}, {Lo: 0xc1e1, Hi: 0xc1fb, Stride: 0x1}, {Lo: 0xc1fd, Hi: 0xc217, Stride: 0x1}, {Lo: 0xc219, Hi: 0xc233, Stride: 0x1}, {Lo: 0xc235, Hi: 0xc24f, Stride: 0x1}, {Lo: 0xc251, Hi: 0xc26b, Stride: 0x1}, {Lo: 0xc26d, Hi: 0xc287, Stride: 0x1}, {Lo: 0xc289, Hi: 0xc2a3, Stride: 0x1}, {Lo: 0xc2a5, Hi: 0xc2bf, Stride: 0x1}, {Lo: 0xc2c1, Hi: 0xc2db, Stride: 0x1}, {Lo: 0xc2dd, Hi: 0xc2f7, Stride: 0x1}, {Lo: 0xc2f9, Hi: 0xc313, Stride: 0x1}, {Lo: 0xc315, Hi: 0xc32f, Stride: 0x1}, {Lo: 0xc331, Hi: 0xc34b, Stride: 0x1}, {Lo: 0xc34d, Hi: 0xc367, Stride: 0x1}, {Lo: 0xc369, Hi: 0xc383, Stride: 0x1}, {Lo: 0xc385, Hi: 0xc39f, Stride: 0x1}, {Lo: 0xc3a1, Hi: 0xc3bb, Stride: 0x1}, {Lo: 0xc3bd, Hi: 0xc3d7, Stride: 0x1}, {Lo: 0xc3d9, Hi: 0xc3f3, Stride: 0x1}, {Lo: 0xc3f5, Hi: 0xc40f, Stride: 0x1}, {Lo: 0xc411, Hi: 0xc42b, Stride: 0x1}, {Lo: 0xc42d, Hi: 0xc447, Stride: 0x1}, {Lo: 0xc449, Hi: 0xc463, Stride: 0x1}, {Lo: 0xc465, Hi: 0xc47f, Stride: 0x1}, {Lo: 0xc481, Hi: 0xc49b, Stride: 0x1}, {Lo: 0xc49d, Hi: 0xc4b7, Stride: 0x1}, {Lo: 0xc4b9, Hi: 0xc4d3, Stride: 0x1}, {Lo: 0xc4d5, Hi: 0xc4ef, Stride: 0x1}, {Lo: 0xc4f1, Hi: 0xc50b, Stride: 0x1}, {Lo: 0xc50d, Hi: 0xc527, Stride: 0x1}, {Lo: 0xc529, Hi: 0xc543, Stride: 0x1}, {Lo: 0xc545, Hi: 0xc55f, Stride: 0x1}, {Lo: 0xc561, Hi: 0xc57b, Stride: 0x1}, {Lo: 0xc57d, Hi: 0xc597, Stride: 0x1}, {Lo: 0xc599, Hi: 0xc5b3, Stride: 0x1}, {Lo: 0xc5b5, Hi: 0xc5cf, Stride: 0x1}, {Lo: 0xc5d1, Hi: 0xc5eb, Stride: 0x1}, {Lo: 0xc5ed, Hi: 0xc607, Stride: 0x1}, {Lo: 0xc609, Hi: 0xc623, Stride: 0x1}, {Lo: 0xc625, Hi: 0xc63f, Stride: 0x1}, {Lo: 0xc641, Hi: 0xc65b, Stride: 0x1}, {Lo: 0xc65d, Hi: 0xc677, Stride: 0x1}, {Lo: 0xc679, Hi: 0xc693, Stride: 0x1}, {Lo: 0xc695, Hi: 0xc6af, Stride: 0x1}, {Lo: 0xc6b1, Hi: 0xc6cb, Stride: 0x1}, {Lo: 0xc6cd, Hi: 0xc6e7, Stride: 0x1}, {Lo: 0xc6e9, Hi: 0xc703, Stride: 0x1}, {Lo: 0xc705, Hi: 0xc71f, Stride: 0x1}, {Lo: 0xc721, Hi: 0xc73b, Stride: 0x1}, {Lo: 0xc73d, Hi: 0xc757, Stride: 0x1}, {Lo: 0xc759, Hi: 0xc773, Stride: 0x1}, {Lo: 0xc775, Hi: 0xc78f, Stride: 0x1}, {Lo: 0xc791, Hi: 0xc7ab, Stride: 0x1}, {Lo: 0xc7ad, Hi: 0xc7c7, Stride: 0x1}, {Lo: 0xc7c9, Hi: 0xc7e3, Stride: 0x1}, {Lo: 0xc7e5, Hi: 0xc7ff, Stride: 0x1}, {Lo: 0xc801, Hi: 0xc81b, Stride: 0x1}, {Lo: 0xc81d, Hi: 0xc837, Stride: 0x1}, {Lo: 0xc839, Hi: 0xc853, Stride: 0x1}, {Lo: 0xc855, Hi: 0xc86f, Stride: 0x1}, {Lo: 0xc871, Hi: 0xc88b, Stride: 0x1}, {Lo: 0xc88d, Hi: 0xc8a7, Stride: 0x1}, {Lo: 0xc8a9, Hi: 0xc8c3, Stride: 0x1}, {Lo: 0xc8c5, Hi: 0xc8df, Stride: 0x1}, {Lo: 0xc8e1, Hi: 0xc8fb, Stride: 0x1}, {Lo: 0xc8fd, Hi: 0xc917, Stride: 0x1}, {Lo: 0xc919, Hi: 0xc933, Stride: 0x1}, {Lo: 0xc935, Hi: 0xc94f, Stride: 0x1}, {Lo: 0xc951, Hi: 0xc96b, Stride: 0x1}, {Lo: 0xc96d, Hi: 0xc987, Stride: 0x1}, {Lo: 0xc989, Hi: 0xc9a3, Stride: 0x1}, {Lo: 0xc9a5, Hi: 0xc9bf, Stride: 0x1}, {Lo: 0xc9c1, Hi: 0xc9db, Stride: 0x1}, {Lo: 0xc9dd, Hi: 0xc9f7, Stride: 0x1}, {Lo: 0xc9f9, Hi: 0xca13, Stride: 0x1}, {Lo: 0xca15, Hi: 0xca2f, Stride: 0x1}, {Lo: 0xca31, Hi: 0xca4b, Stride: 0x1}, {Lo: 0xca4d, Hi: 0xca67, Stride: 0x1}, {Lo: 0xca69, Hi: 0xca83, Stride: 0x1}, {Lo: 0xca85, Hi: 0xca9f, Stride: 0x1}, {Lo: 0xcaa1, Hi: 0xcabb, Stride: 0x1}, {Lo: 0xcabd, Hi: 0xcad7, Stride: 0x1}, {Lo: 0xcad9, Hi: 0xcaf3, Stride: 0x1}, {Lo: 0xcaf5, Hi: 0xcb0f, Stride: 0x1}, {Lo: 0xcb11, Hi: 0xcb2b, Stride: 0x1}, {Lo: 0xcb2d, Hi: 0xcb47, Stride: 0x1}, {Lo: 0xcb49, Hi: 0xcb63, Stride: 0x1}, {Lo: 0xcb65, Hi: 0xcb7f, Stride: 0x1}, {Lo: 0xcb81, Hi: 0xcb9b, Stride: 0x1}, {Lo: 0xcb9d, Hi: 0xcbb7, Stride: 0x1}, {Lo: 0xcbb9, Hi: 0xcbd3, Stride: 0x1}, {Lo: 0xcbd5, Hi: 0xcbef, Stride: 0x1}, {Lo: 0xcbf1, Hi: 0xcc0b, Stride: 0x1}, {Lo: 0xcc0d, Hi: 0xcc27, Stride: 0x1}, {Lo: 0xcc29, Hi: 0xcc43, Stride: 0x1}, {Lo: 0xcc45, Hi: 0xcc5f, Stride: 0x1}, {Lo: 0xcc61, Hi: 0xcc7b, Stride: 0x1}, {Lo: 0xcc7d, Hi: 0xcc97, Stride: 0x1}, {Lo: 0xcc99, Hi: 0xccb3, Stride: 0x1}, {Lo: 0xccb5, Hi: 0xcccf, Stride: 0x1}, {Lo: 0xccd1, Hi: 0xcceb, Stride: 0x1}, {Lo: 0xcced, Hi: 0xcd07, Stride: 0x1}, {Lo: 0xcd09, Hi: 0xcd23, Stride: 0x1}, {Lo: 0xcd25, Hi: 0xcd3f, Stride: 0x1}, {Lo: 0xcd41, Hi: 0xcd5b, Stride: 0x1}, {Lo: 0xcd5d, Hi: 0xcd77, Stride: 0x1}, {Lo: 0xcd79, Hi: 0xcd93, Stride: 0x1}, {Lo: 0xcd95, Hi: 0xcdaf, Stride: 0x1}, {Lo: 0xcdb1, Hi: 0xcdcb, Stride: 0x1}, {Lo: 0xcdcd, Hi: 0xcde7, Stride: 0x1}, {Lo: 0xcde9, Hi: 0xce03, Stride: 0x1}, {Lo: 0xce05, Hi: 0xce1f, Stride: 0x1}, {Lo: 0xce21, Hi: 0xce3b, Stride: 0x1}, {Lo: 0xce3d, Hi: 0xce57, Stride: 0x1}, {Lo: 0xce59, Hi: 0xce73, Stride: 0x1}, {Lo: 0xce75, Hi: 0xce8f, Stride: 0x1}, {Lo: 0xce91, Hi: 0xceab, Stride: 0x1}, {Lo: 0xcead, Hi: 0xcec7, Stride: 0x1}, {Lo: 0xcec9, Hi: 0xcee3, Stride: 0x1}, {Lo: 0xcee5, Hi: 0xceff, Stride: 0x1}, {Lo: 0xcf01, Hi: 0xcf1b, Stride: 0x1}, {Lo: 0xcf1d, Hi: 0xcf37, Stride: 0x1}, {Lo: 0xcf39, Hi: 0xcf53, Stride: 0x1}, {Lo: 0xcf55, Hi: 0xcf6f, Stride: 0x1}, {Lo: 0xcf71, Hi: 0xcf8b, Stride: 0x1}, {Lo: 0xcf8d, Hi: 0xcfa7, Stride: 0x1}, {Lo: 0xcfa9, Hi: 0xcfc3, Stride: 0x1}, {Lo: 0xcfc5, Hi: 0xcfdf, Stride: 0x1}, {Lo: 0xcfe1, Hi: 0xcffb, Stride: 0x1}, {Lo: 0xcffd, Hi: 0xd017, Stride: 0x1}, {Lo: 0xd019, Hi: 0xd033, Stride: 0x1}, {Lo: 0xd035, Hi: 0xd04f, Stride: 0x1}, {Lo: 0xd051, Hi: 0xd06b, Stride: 0x1}, {Lo: 0xd06d, Hi: 0xd087, Stride: 0x1}, {Lo: 0xd089, Hi: 0xd0a3, Stride: 0x1}, {Lo: 0xd0a5, Hi: 0xd0bf, Stride: 0x1}, {Lo: 0xd0c1, Hi: 0xd0db, Stride: 0x1}, {Lo: 0xd0dd, Hi: 0xd0f7, Stride: 0x1}, {Lo: 0xd0f9, Hi: 0xd113, Stride: 0x1}, {Lo: 0xd115, Hi: 0xd12f, Stride: 0x1}, {Lo: 0xd131, Hi: 0xd14b, Stride: 0x1}, {Lo: 0xd14d, Hi: 0xd167, Stride: 0x1}, {Lo: 0xd169, Hi: 0xd183, Stride: 0x1}, {Lo: 0xd185, Hi: 0xd19f, Stride: 0x1}, {Lo: 0xd1a1, Hi: 0xd1bb, Stride: 0x1}, {Lo: 0xd1bd, Hi: 0xd1d7, Stride: 0x1}, {Lo: 0xd1d9, Hi: 0xd1f3, Stride: 0x1}, {Lo: 0xd1f5, Hi: 0xd20f, Stride: 0x1}, {Lo: 0xd211, Hi: 0xd22b, Stride: 0x1}, {Lo: 0xd22d, Hi: 0xd247, Stride: 0x1}, {Lo: 0xd249, Hi: 0xd263, Stride: 0x1}, {Lo: 0xd265, Hi: 0xd27f, Stride: 0x1}, {Lo: 0xd281, Hi: 0xd29b, Stride: 0x1}, {Lo: 0xd29d, Hi: 0xd2b7, Stride: 0x1}, {Lo: 0xd2b9, Hi: 0xd2d3, Stride: 0x1}, {Lo: 0xd2d5, Hi: 0xd2ef, Stride: 0x1}, {Lo: 0xd2f1, Hi: 0xd30b, Stride: 0x1}, {Lo: 0xd30d, Hi: 0xd327, Stride: 0x1}, {Lo: 0xd329, Hi: 0xd343, Stride: 0x1}, {Lo: 0xd345, Hi: 0xd35f, Stride: 0x1}, {Lo: 0xd361, Hi: 0xd37b, Stride: 0x1}, {Lo: 0xd37d, Hi: 0xd397, Stride: 0x1}, {Lo: 0xd399, Hi: 0xd3b3, Stride: 0x1}, {Lo: 0xd3b5, Hi: 0xd3cf, Stride: 0x1}, {Lo: 0xd3d1, Hi: 0xd3eb, Stride: 0x1}, {Lo: 0xd3ed, Hi: 0xd407, Stride: 0x1}, {Lo: 0xd409, Hi: 0xd423, Stride: 0x1}, {Lo: 0xd425, Hi: 0xd43f, Stride: 0x1}, {Lo: 0xd441, Hi: 0xd45b, Stride: 0x1}, {Lo: 0xd45d, Hi: 0xd477, Stride: 0x1}, {Lo: 0xd479, Hi: 0xd493, Stride: 0x1}, {Lo: 0xd495, Hi: 0xd4af, Stride: 0x1}, {Lo: 0xd4b1, Hi: 0xd4cb, Stride: 0x1}, {Lo: 0xd4cd, Hi: 0xd4e7, Stride: 0x1}, {Lo: 0xd4e9, Hi: 0xd503, Stride: 0x1}, {Lo: 0xd505, Hi: 0xd51f, Stride: 0x1}, {Lo: 0xd521, Hi: 0xd53b, Stride: 0x1}, {Lo: 0xd53d, Hi: 0xd557, Stride: 0x1}, {Lo: 0xd559, Hi: 0xd573, Stride: 0x1}, {Lo: 0xd575, Hi: 0xd58f, Stride: 0x1}, {Lo: 0xd591, Hi: 0xd5ab, Stride: 0x1}, {Lo: 0xd5ad, Hi: 0xd5c7, Stride: 0x1}, {Lo: 0xd5c9, Hi: 0xd5e3, Stride: 0x1}, {Lo: 0xd5e5, Hi: 0xd5ff, Stride: 0x1}, {Lo: 0xd601, Hi: 0xd61b, Stride: 0x1}, {Lo: 0xd61d, Hi: 0xd637, Stride: 0x1}, {Lo: 0xd639, Hi: 0xd653, Stride: 0x1}, {Lo: 0xd655, Hi: 0xd66f, Stride: 0x1}, {Lo: 0xd671, Hi: 0xd68b, Stride: 0x1}, {Lo: 0xd68d, Hi: 0xd6a7, Stride: 0x1}, {Lo: 0xd6a9, Hi: 0xd6c3, Stride: 0x1}, {Lo: 0xd6c5, Hi: 0xd6df, Stride: 0x1}, {Lo: 0xd6e1, Hi: 0xd6fb, Stride: 0x1}, {Lo: 0xd6fd, Hi: 0xd717, Stride: 0x1}, {Lo: 0xd719, Hi: 0xd733, Stride: 0x1}, {Lo: 0xd735, Hi: 0xd74f, Stride: 0x1}, {Lo: 0xd751, Hi: 0xd76b, Stride: 0x1}, {Lo: 0xd76d, Hi: 0xd787, Stride: 0x1}, {Lo: 0xd789, Hi: 0xd7a3, Stride: 0x1}}, R32: []unicode.Range32(nil), LatinOffset: 0}
+
+var graphemePrepend = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x600, Hi: 0x605, Stride: 0x1}, {Lo: 0x6dd, Hi: 0x70f, Stride: 0x32}, {Lo: 0x890, Hi: 0x891, Stride: 0x1}, {Lo: 0x8e2, Hi: 0xd4e, Stride: 0x46c}}, R32: []unicode.Range32{{Lo: 0x110bd, Hi: 0x110cd, Stride: 0x10}, {Lo: 0x111c2, Hi: 0x111c3, Stride: 0x1}, {Lo: 0x113d1, Hi: 0x1193f, Stride: 0x56e}, {Lo: 0x11941, Hi: 0x11a84, Stride: 0x143}, {Lo: 0x11a85, Hi: 0x11a89, Stride: 0x1}, {Lo: 0x11d46, Hi: 0x11f02, Stride: 0x1bc}}, LatinOffset: 0}
+
+var graphemeRegionalIndicator = &unicode.RangeTable{R16: []unicode.Range16(nil), R32: []unicode.Range32{{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 0x1}}, LatinOffset: 0}
+
+var graphemeSpacingMark = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x903, Hi: 0x93b, Stride: 0x38}, {Lo: 0x93e, Hi: 0x940, Stride: 0x1}, {Lo: 0x949, Hi: 0x94c, Stride: 0x1}, {Lo: 0x94e, Hi: 0x94f, Stride: 0x1}, {Lo: 0x982, Hi: 0x983, Stride: 0x1}, {Lo: 0x9bf, Hi: 0x9c0, Stride: 0x1}, {Lo: 0x9c7, Hi: 0x9c8, Stride: 0x1}, {Lo: 0x9cb, Hi: 0x9cc, Stride: 0x1}, {Lo: 0xa03, Hi: 0xa3e, Stride: 0x3b}, {Lo: 0xa3f, Hi: 0xa40, Stride: 0x1}, {Lo: 0xa83, Hi: 0xabe, Stride: 0x3b}, {Lo: 0xabf, Hi: 0xac0, Stride: 0x1}, {Lo: 0xac9, Hi: 0xacb, Stride: 0x2}, {Lo: 0xacc, Hi: 0xb02, Stride: 0x36}, {Lo: 0xb03, Hi: 0xb40, Stride: 0x3d}, {Lo: 0xb47, Hi: 0xb48, Stride: 0x1}, {Lo: 0xb4b, Hi: 0xb4c, Stride: 0x1}, {Lo: 0xbbf, Hi: 0xbc1, Stride: 0x2}, {Lo: 0xbc2, Hi: 0xbc6, Stride: 0x4}, {Lo: 0xbc7, Hi: 0xbc8, Stride: 0x1}, {Lo: 0xbca, Hi: 0xbcc, Stride: 0x1}, {Lo: 0xc01, Hi: 0xc03, Stride: 0x1}, {Lo: 0xc41, Hi: 0xc44, Stride: 0x1}, {Lo: 0xc82, Hi: 0xc83, Stride: 0x1}, {Lo: 0xcbe, Hi: 0xcc1, Stride: 0x3}, {Lo: 0xcc3, Hi: 0xcc4, Stride: 0x1}, {Lo: 0xcf3, Hi: 0xd02, Stride: 0xf}, {Lo: 0xd03, Hi: 0xd3f, Stride: 0x3c}, {Lo: 0xd40, Hi: 0xd46, Stride: 0x6}, {Lo: 0xd47, Hi: 0xd48, Stride: 0x1}, {Lo: 0xd4a, Hi: 0xd4c, Stride: 0x1}, {Lo: 0xd82, Hi: 0xd83, Stride: 0x1}, {Lo: 0xdd0, Hi: 0xdd1, Stride: 0x1}, {Lo: 0xdd8, Hi: 0xdde, Stride: 0x1}, {Lo: 0xdf2, Hi: 0xdf3, Stride: 0x1}, {Lo: 0xe33, Hi: 0xeb3, Stride: 0x80}, {Lo: 0xf3e, Hi: 0xf3f, Stride: 0x1}, {Lo: 0xf7f, Hi: 0x1031, Stride: 0xb2}, {Lo: 0x103b, Hi: 0x103c, Stride: 0x1}, {Lo: 0x1056, Hi: 0x1057, Stride: 0x1}, {Lo: 0x1084, Hi: 0x17b6, Stride: 0x732}, {Lo: 0x17be, Hi: 0x17c5, Stride: 0x1}, {Lo: 0x17c7, Hi: 0x17c8, Stride: 0x1}, {Lo: 0x1923, Hi: 0x1926, Stride: 0x1}, {Lo: 0x1929, Hi: 0x192b, Stride: 0x1}, {Lo: 0x1930, Hi: 0x1931, Stride: 0x1}, {Lo: 0x1933, Hi: 0x1938, Stride: 0x1}, {Lo: 0x1a19, Hi: 0x1a1a, Stride: 0x1}, {Lo: 0x1a55, Hi: 0x1a57, Stride: 0x2}, {Lo: 0x1a6d, Hi: 0x1a72, Stride: 0x1}, {Lo: 0x1b04, Hi: 0x1b3e, Stride: 0x3a}, {Lo: 0x1b3f, Hi: 0x1b41, Stride: 0x1}, {Lo: 0x1b82, Hi: 0x1ba1, Stride: 0x1f}, {Lo: 0x1ba6, Hi: 0x1ba7, Stride: 0x1}, {Lo: 0x1be7, Hi: 0x1bea, Stride: 0x3}, {Lo: 0x1beb, Hi: 0x1bec, Stride: 0x1}, {Lo: 0x1bee, Hi: 0x1c24, Stride: 0x36}, {Lo: 0x1c25, Hi: 0x1c2b, Stride: 0x1}, {Lo: 0x1c34, Hi: 0x1c35, Stride: 0x1}, {Lo: 0x1ce1, Hi: 0x1cf7, Stride: 0x16}, {Lo: 0xa823, Hi: 0xa824, Stride: 0x1}, {Lo: 0xa827, Hi: 0xa880, Stride: 0x59}, {Lo: 0xa881, Hi: 0xa8b4, Stride: 0x33}, {Lo: 0xa8b5, Hi: 0xa8c3, Stride: 0x1}, {Lo: 0xa952, Hi: 0xa9b4, Stride: 0x31}, {Lo: 0xa9b5, Hi: 0xa9ba, Stride: 0x5}, {Lo: 0xa9bb, Hi: 0xa9be, Stride: 0x3}, {Lo: 0xa9bf, Hi: 0xaa2f, Stride: 0x70}, {Lo: 0xaa30, Hi: 0xaa33, Stride: 0x3}, {Lo: 0xaa34, Hi: 0xaa4d, Stride: 0x19}, {Lo: 0xaaeb, Hi: 0xaaee, Stride: 0x3}, {Lo: 0xaaef, Hi: 0xaaf5, Stride: 0x6}, {Lo: 0xabe3, Hi: 0xabe4, Stride: 0x1}, {Lo: 0xabe6, Hi: 0xabe7, Stride: 0x1}, {Lo: 0xabe9, Hi: 0xabea, Stride: 0x1}, {Lo: 0xabec, Hi: 0xabec, Stride: 0x1}}, R32: []unicode.Range32{{Lo: 0x11000, Hi: 0x11002, Stride: 0x2}, {Lo: 0x11082, Hi: 0x110b0, Stride: 0x2e}, {Lo: 0x110b1, Hi: 0x110b2, Stride: 0x1}, {Lo: 0x110b7, Hi: 0x110b8, Stride: 0x1}, {Lo: 0x1112c, Hi: 0x11145, Stride: 0x19}, {Lo: 0x11146, Hi: 0x11182, Stride: 0x3c}, {Lo: 0x111b3, Hi: 0x111b5, Stride: 0x1}, {Lo: 0x111bf, Hi: 0x111ce, Stride: 0xf}, {Lo: 0x1122c, Hi: 0x1122e, Stride: 0x1}, {Lo: 0x11232, Hi: 0x11233, Stride: 0x1}, {Lo: 0x112e0, Hi: 0x112e2, Stride: 0x1}, {Lo: 0x11302, Hi: 0x11303, Stride: 0x1}, {Lo: 0x1133f, Hi: 0x11341, Stride: 0x2}, {Lo: 0x11342, Hi: 0x11344, Stride: 0x1}, {Lo: 0x11347, Hi: 0x11348, Stride: 0x1}, {Lo: 0x1134b, Hi: 0x1134c, Stride: 0x1}, {Lo: 0x11362, Hi: 0x11363, Stride: 0x1}, {Lo: 0x113b9, Hi: 0x113ba, Stride: 0x1}, {Lo: 0x113ca, Hi: 0x113cc, Stride: 0x2}, {Lo: 0x113cd, Hi: 0x11435, Stride: 0x68}, {Lo: 0x11436, Hi: 0x11437, Stride: 0x1}, {Lo: 0x11440, Hi: 0x11441, Stride: 0x1}, {Lo: 0x11445, Hi: 0x114b1, Stride: 0x6c}, {Lo: 0x114b2, Hi: 0x114b9, Stride: 0x7}, {Lo: 0x114bb, Hi: 0x114bc, Stride: 0x1}, {Lo: 0x114be, Hi: 0x114c1, Stride: 0x3}, {Lo: 0x115b0, Hi: 0x115b1, Stride: 0x1}, {Lo: 0x115b8, Hi: 0x115bb, Stride: 0x1}, {Lo: 0x115be, Hi: 0x11630, Stride: 0x72}, {Lo: 0x11631, Hi: 0x11632, Stride: 0x1}, {Lo: 0x1163b, Hi: 0x1163c, Stride: 0x1}, {Lo: 0x1163e, Hi: 0x116ac, Stride: 0x6e}, {Lo: 0x116ae, Hi: 0x116af, Stride: 0x1}, {Lo: 0x1171e, Hi: 0x11726, Stride: 0x8}, {Lo: 0x1182c, Hi: 0x1182e, Stride: 0x1}, {Lo: 0x11838, Hi: 0x11931, Stride: 0xf9}, {Lo: 0x11932, Hi: 0x11935, Stride: 0x1}, {Lo: 0x11937, Hi: 0x11938, Stride: 0x1}, {Lo: 0x11940, Hi: 0x11942, Stride: 0x2}, {Lo: 0x119d1, Hi: 0x119d3, Stride: 0x1}, {Lo: 0x119dc, Hi: 0x119df, Stride: 0x1}, {Lo: 0x119e4, Hi: 0x11a39, Stride: 0x55}, {Lo: 0x11a57, Hi: 0x11a58, Stride: 0x1}, {Lo: 0x11a97, Hi: 0x11b61, Stride: 0xca}, {Lo: 0x11b65, Hi: 0x11b67, Stride: 0x2}, {Lo: 0x11c2f, Hi: 0x11c3e, Stride: 0xf}, {Lo: 0x11ca9, Hi: 0x11cb1, Stride: 0x8}, {Lo: 0x11cb4, Hi: 0x11d8a, Stride: 0xd6}, {Lo: 0x11d8b, Hi: 0x11d8e, Stride: 0x1}, {Lo: 0x11d93, Hi: 0x11d94, Stride: 0x1}, {Lo: 0x11d96, Hi: 0x11ef5, Stride: 0x15f}, {Lo: 0x11ef6, Hi: 0x11f03, Stride: 0xd}, {Lo: 0x11f34, Hi: 0x11f35, Stride: 0x1}, {Lo: 0x11f3e, Hi: 0x11f3f, Stride: 0x1}, {Lo: 0x1612a, Hi: 0x1612c, Stride: 0x1}, {Lo: 0x16f51, Hi: 0x16f87, Stride: 0x1}}, LatinOffset: 0}
+
+var graphemeT = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x11a8, Hi: 0x11ff, Stride: 0x1}, {Lo: 0xd7cb, Hi: 0xd7fb, Stride: 0x1}}, R32: []unicode.Range32(nil), LatinOffset: 0}
+
+var graphemeV = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x1160, Hi: 0x11a7, Stride: 0x1}, {Lo: 0xd7b0, Hi: 0xd7c6, Stride: 0x1}}, R32: []unicode.Range32{{Lo: 0x16d63, Hi: 0x16d67, Stride: 0x4}, {Lo: 0x16d68, Hi: 0x16d6a, Stride: 0x1}}, LatinOffset: 0}
+
+var graphemeZWJ = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x200d, Hi: 0x200d, Stride: 0x1}}, R32: []unicode.Range32(nil), LatinOffset: 0}
+
+var conjunctConsonant = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x915, Hi: 0x939, Stride: 0x1}, {Lo: 0x958, Hi: 0x95f, Stride: 0x1}, {Lo: 0x978, Hi: 0x97f, Stride: 0x1}, {Lo: 0x995, Hi: 0x9a8, Stride: 0x1}, {Lo: 0x9aa, Hi: 0x9b0, Stride: 0x1}, {Lo: 0x9b2, Hi: 0x9b6, Stride: 0x4}, {Lo: 0x9b7, Hi: 0x9b9, Stride: 0x1}, {Lo: 0x9dc, Hi: 0x9dd, Stride: 0x1}, {Lo: 0x9df, Hi: 0x9f0, Stride: 0x11}, {Lo: 0x9f1, Hi: 0xa95, Stride: 0xa4}, {Lo: 0xa96, Hi: 0xaa8, Stride: 0x1}, {Lo: 0xaaa, Hi: 0xab0, Stride: 0x1}, {Lo: 0xab2, Hi: 0xab3, Stride: 0x1}, {Lo: 0xab5, Hi: 0xab9, Stride: 0x1}, {Lo: 0xaf9, Hi: 0xb15, Stride: 0x1c}, {Lo: 0xb16, Hi: 0xb28, Stride: 0x1}, {Lo: 0xb2a, Hi: 0xb30, Stride: 0x1}, {Lo: 0xb32, Hi: 0xb33, Stride: 0x1}, {Lo: 0xb35, Hi: 0xb39, Stride: 0x1}, {Lo: 0xb5c, Hi: 0xb5d, Stride: 0x1}, {Lo: 0xb5f, Hi: 0xb71, Stride: 0x12}, {Lo: 0xc15, Hi: 0xc28, Stride: 0x1}, {Lo: 0xc2a, Hi: 0xc39, Stride: 0x1}, {Lo: 0xc58, Hi: 0xc5a, Stride: 0x1}, {Lo: 0xd15, Hi: 0xd3a, Stride: 0x1}, {Lo: 0x1000, Hi: 0x102a, Stride: 0x1}, {Lo: 0x103f, Hi: 0x1050, Stride: 0x11}, {Lo: 0x1051, Hi: 0x1055, Stride: 0x1}, {Lo: 0x105a, Hi: 0x105d, Stride: 0x1}, {Lo: 0x1061, Hi: 0x1065, Stride: 0x4}, {Lo: 0x1066, Hi: 0x106e, Stride: 0x8}, {Lo: 0x106f, Hi: 0x1070, Stride: 0x1}, {Lo: 0x1075, Hi: 0x1081, Stride: 0x1}, {Lo: 0x108e, Hi: 0x1780, Stride: 0x6f2}, {Lo: 0x1781, Hi: 0x17b3, Stride: 0x1}, {Lo: 0x1a20, Hi: 0x1a54, Stride: 0x1}, {Lo: 0x1b0b, Hi: 0x1b0c, Stride: 0x1}, {Lo: 0x1b13, Hi: 0x1b33, Stride: 0x1}, {Lo: 0x1b45, Hi: 0x1b4c, Stride: 0x1}, {Lo: 0x1b83, Hi: 0x1ba0, Stride: 0x1}, {Lo: 0x1bae, Hi: 0x1baf, Stride: 0x1}, {Lo: 0x1bbb, Hi: 0x1bbd, Stride: 0x1}, {Lo: 0xa989, Hi: 0xa98b, Stride: 0x1}, {Lo: 0xa98f, Hi: 0xa9b2, Stride: 0x1}, {Lo: 0xa9e0, Hi: 0xa9e4, Stride: 0x1}, {Lo: 0xa9e7, Hi: 0xa9ef, Stride: 0x1}, {Lo: 0xa9fa, Hi: 0xa9fe, Stride: 0x1}, {Lo: 0xaa60, Hi: 0xaa6f, Stride: 0x1}, {Lo: 0xaa71, Hi: 0xaa73, Stride: 0x1}, {Lo: 0xaa7a, Hi: 0xaa7e, Stride: 0x4}, {Lo: 0xaa7f, Hi: 0xaae0, Stride: 0x61}, {Lo: 0xaae1, Hi: 0xaaea, Stride: 0x1}, {Lo: 0xabc0, Hi: 0xabda, Stride: 0x1}}, R32: []unicode.Range32{{Lo: 0x10a00, Hi: 0x10a10, Stride: 0x10}, {Lo: 0x10a11, Hi: 0x10a13, Stride: 0x1}, {Lo: 0x10a15, Hi: 0x10a17, Stride: 0x1}, {Lo: 0x10a19, Hi: 0x10a35, Stride: 0x1}, {Lo: 0x11103, Hi: 0x11126, Stride: 0x1}, {Lo: 0x11144, Hi: 0x11147, Stride: 0x3}, {Lo: 0x11380, Hi: 0x11389, Stride: 0x1}, {Lo: 0x1138b, Hi: 0x1138e, Stride: 0x3}, {Lo: 0x11390, Hi: 0x113b5, Stride: 0x1}, {Lo: 0x11900, Hi: 0x11906, Stride: 0x1}, {Lo: 0x11909, Hi: 0x1190c, Stride: 0x3}, {Lo: 0x1190d, Hi: 0x11913, Stride: 0x1}, {Lo: 0x11915, Hi: 0x11916, Stride: 0x1}, {Lo: 0x11918, Hi: 0x1192f, Stride: 0x1}, {Lo: 0x11a00, Hi: 0x11a0b, Stride: 0xb}, {Lo: 0x11a0c, Hi: 0x11a32, Stride: 0x1}, {Lo: 0x11a50, Hi: 0x11a5c, Stride: 0xc}, {Lo: 0x11a5d, Hi: 0x11a83, Stride: 0x1}, {Lo: 0x11f04, Hi: 0x11f10, Stride: 0x1}, {Lo: 0x11f12, Hi: 0x11f33, Stride: 0x1}}, LatinOffset: 0}
+
+var conjunctExtend = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x300, Hi: 0x36f, Stride: 0x1}, {Lo: 0x483, Hi: 0x489, Stride: 0x1}, {Lo: 0x591, Hi: 0x5bd, Stride: 0x1}, {Lo: 0x5bf, Hi: 0x5c1, Stride: 0x2}, {Lo: 0x5c2, Hi: 0x5c4, Stride: 0x2}, {Lo: 0x5c5, Hi: 0x5c7, Stride: 0x2}, {Lo: 0x610, Hi: 0x61a, Stride: 0x1}, {Lo: 0x64b, Hi: 0x65f, Stride: 0x1}, {Lo: 0x670, Hi: 0x6d6, Stride: 0x66}, {Lo: 0x6d7, Hi: 0x6dc, Stride: 0x1}, {Lo: 0x6df, Hi: 0x6e4, Stride: 0x1}, {Lo: 0x6e7, Hi: 0x6e8, Stride: 0x1}, {Lo: 0x6ea, Hi: 0x6ed, Stride: 0x1}, {Lo: 0x711, Hi: 0x730, Stride: 0x1f}, {Lo: 0x731, Hi: 0x74a, Stride: 0x1}, {Lo: 0x7a6, Hi: 0x7b0, Stride: 0x1}, {Lo: 0x7eb, Hi: 0x7f3, Stride: 0x1}, {Lo: 0x7fd, Hi: 0x816, Stride: 0x19}, {Lo: 0x817, Hi: 0x819, Stride: 0x1}, {Lo: 0x81b, Hi: 0x823, Stride: 0x1}, {Lo: 0x825, Hi: 0x827, Stride: 0x1}, {Lo: 0x829, Hi: 0x82d, Stride: 0x1}, {Lo: 0x859, Hi: 0x85b, Stride: 0x1}, {Lo: 0x897, Hi: 0x89f, Stride: 0x1}, {Lo: 0x8ca, Hi: 0x8e1, Stride: 0x1}, {Lo: 0x8e3, Hi: 0x902, Stride: 0x1}, {Lo: 0x93a, Hi: 0x93c, Stride: 0x2}, {Lo: 0x941, Hi: 0x948, Stride: 0x1}, {Lo: 0x951, Hi: 0x957, Stride: 0x1}, {Lo: 0x962, Hi: 0x963, Stride: 0x1}, {Lo: 0x981, Hi: 0x9bc, Stride: 0x3b}, {Lo: 0x9be, Hi: 0x9c1, Stride: 0x3}, {Lo: 0x9c2, Hi: 0x9c4, Stride: 0x1}, {Lo: 0x9d7, Hi: 0x9e2, Stride: 0xb}, {Lo: 0x9e3, Hi: 0x9fe, Stride: 0x1b}, {Lo: 0xa01, Hi: 0xa02, Stride: 0x1}, {Lo: 0xa3c, Hi: 0xa41, Stride: 0x5}, {Lo: 0xa42, Hi: 0xa47, Stride: 0x5}, {Lo: 0xa48, Hi: 0xa4b, Stride: 0x3}, {Lo: 0xa4c, Hi: 0xa4d, Stride: 0x1}, {Lo: 0xa51, Hi: 0xa70, Stride: 0x1f}, {Lo: 0xa71, Hi: 0xa75, Stride: 0x4}, {Lo: 0xa81, Hi: 0xa82, Stride: 0x1}, {Lo: 0xabc, Hi: 0xac1, Stride: 0x5}, {Lo: 0xac2, Hi: 0xac5, Stride: 0x1}, {Lo: 0xac7, Hi: 0xac8, Stride: 0x1}, {Lo: 0xae2, Hi: 0xae3, Stride: 0x1}, {Lo: 0xafa, Hi: 0xaff, Stride: 0x1}, {Lo: 0xb01, Hi: 0xb3c, Stride: 0x3b}, {Lo: 0xb3e, Hi: 0xb3f, Stride: 0x1}, {Lo: 0xb41, Hi: 0xb44, Stride: 0x1}, {Lo: 0xb55, Hi: 0xb57, Stride: 0x1}, {Lo: 0xb62, Hi: 0xb63, Stride: 0x1}, {Lo: 0xb82, Hi: 0xbbe, Stride: 0x3c}, {Lo: 0xbc0, Hi: 0xbcd, Stride: 0xd}, {Lo: 0xbd7, Hi: 0xc00, Stride: 0x29}, {Lo: 0xc04, Hi: 0xc3c, Stride: 0x38}, {Lo: 0xc3e, Hi: 0xc40, Stride: 0x1}, {Lo: 0xc46, Hi: 0xc48, Stride: 0x1}, {Lo: 0xc4a, Hi: 0xc4c, Stride: 0x1}, {Lo: 0xc55, Hi: 0xc56, Stride: 0x1}, {Lo: 0xc62, Hi: 0xc63, Stride: 0x1}, {Lo: 0xc81, Hi: 0xcbc, Stride: 0x3b}, {Lo: 0xcbf, Hi: 0xcc0, Stride: 0x1}, {Lo: 0xcc2, Hi: 0xcc6, Stride: 0x4}, {Lo: 0xcc7, Hi: 0xcc8, Stride: 0x1}, {Lo: 0xcca, Hi: 0xccd, Stride: 0x1}, {Lo: 0xcd5, Hi: 0xcd6, Stride: 0x1}, {Lo: 0xce2, Hi: 0xce3, Stride: 0x1}, {Lo: 0xd00, Hi: 0xd01, Stride: 0x1}, {Lo: 0xd3b, Hi: 0xd3c, Stride: 0x1}, {Lo: 0xd3e, Hi: 0xd41, Stride: 0x3}, {Lo: 0xd42, Hi: 0xd44, Stride: 0x1}, {Lo: 0xd57, Hi: 0xd62, Stride: 0xb}, {Lo: 0xd63, Hi: 0xd81, Stride: 0x1e}, {Lo: 0xdca, Hi: 0xdcf, Stride: 0x5}, {Lo: 0xdd2, Hi: 0xdd4, Stride: 0x1}, {Lo: 0xdd6, Hi: 0xddf, Stride: 0x9}, {Lo: 0xe31, Hi: 0xe34, Stride: 0x3}, {Lo: 0xe35, Hi: 0xe3a, Stride: 0x1}, {Lo: 0xe47, Hi: 0xe4e, Stride: 0x1}, {Lo: 0xeb1, Hi: 0xeb4, Stride: 0x3}, {Lo: 0xeb5, Hi: 0xebc, Stride: 0x1}, {Lo: 0xec8, Hi: 0xece, Stride: 0x1}, {Lo: 0xf18, Hi: 0xf19, Stride: 0x1}, {Lo: 0xf35, Hi: 0xf39, Stride: 0x2}, {Lo: 0xf71, Hi: 0xf7e, Stride: 0x1}, {Lo: 0xf80, Hi: 0xf84, Stride: 0x1}, {Lo: 0xf86, Hi: 0xf87, Stride: 0x1}, {Lo: 0xf8d, Hi: 0xf97, Stride: 0x1}, {Lo: 0xf99, Hi: 0xfbc, Stride: 0x1}, {Lo: 0xfc6, Hi: 0x102d, Stride: 0x67}, {Lo: 0x102e, Hi: 0x1030, Stride: 0x1}, {Lo: 0x1032, Hi: 0x1037, Stride: 0x1}, {Lo: 0x103a, Hi: 0x103d, Stride: 0x3}, {Lo: 0x103e, Hi: 0x1058, Stride: 0x1a}, {Lo: 0x1059, Hi: 0x105e, Stride: 0x5}, {Lo: 0x105f, Hi: 0x1060, Stride: 0x1}, {Lo: 0x1071, Hi: 0x1074, Stride: 0x1}, {Lo: 0x1082, Hi: 0x1085, Stride: 0x3}, {Lo: 0x1086, Hi: 0x108d, Stride: 0x7}, {Lo: 0x109d, Hi: 0x135d, Stride: 0x2c0}, {Lo: 0x135e, Hi: 0x135f, Stride: 0x1}, {Lo: 0x1712, Hi: 0x1715, Stride: 0x1}, {Lo: 0x1732, Hi: 0x1734, Stride: 0x1}, {Lo: 0x1752, Hi: 0x1753, Stride: 0x1}, {Lo: 0x1772, Hi: 0x1773, Stride: 0x1}, {Lo: 0x17b4, Hi: 0x17b5, Stride: 0x1}, {Lo: 0x17b7, Hi: 0x17bd, Stride: 0x1}, {Lo: 0x17c6, Hi: 0x17c9, Stride: 0x3}, {Lo: 0x17ca, Hi: 0x17d1, Stride: 0x1}, {Lo: 0x17d3, Hi: 0x17dd, Stride: 0xa}, {Lo: 0x180b, Hi: 0x180d, Stride: 0x1}, {Lo: 0x180f, Hi: 0x1885, Stride: 0x76}, {Lo: 0x1886, Hi: 0x18a9, Stride: 0x23}, {Lo: 0x1920, Hi: 0x1922, Stride: 0x1}, {Lo: 0x1927, Hi: 0x1928, Stride: 0x1}, {Lo: 0x1932, Hi: 0x1939, Stride: 0x7}, {Lo: 0x193a, Hi: 0x193b, Stride: 0x1}, {Lo: 0x1a17, Hi: 0x1a18, Stride: 0x1}, {Lo: 0x1a1b, Hi: 0x1a56, Stride: 0x3b}, {Lo: 0x1a58, Hi: 0x1a5e, Stride: 0x1}, {Lo: 0x1a62, Hi: 0x1a65, Stride: 0x3}, {Lo: 0x1a66, Hi: 0x1a6c, Stride: 0x1}, {Lo: 0x1a73, Hi: 0x1a7c, Stride: 0x1}, {Lo: 0x1a7f, Hi: 0x1ab0, Stride: 0x31}, {Lo: 0x1ab1, Hi: 0x1add, Stride: 0x1}, {Lo: 0x1ae0, Hi: 0x1aeb, Stride: 0x1}, {Lo: 0x1b00, Hi: 0x1b03, Stride: 0x1}, {Lo: 0x1b34, Hi: 0x1b3d, Stride: 0x1}, {Lo: 0x1b42, Hi: 0x1b43, Stride: 0x1}, {Lo: 0x1b6b, Hi: 0x1b73, Stride: 0x1}, {Lo: 0x1b80, Hi: 0x1b81, Stride: 0x1}, {Lo: 0x1ba2, Hi: 0x1ba5, Stride: 0x1}, {Lo: 0x1ba8, Hi: 0x1baa, Stride: 0x1}, {Lo: 0x1bac, Hi: 0x1bad, Stride: 0x1}, {Lo: 0x1be6, Hi: 0x1be8, Stride: 0x2}, {Lo: 0x1be9, Hi: 0x1bed, Stride: 0x4}, {Lo: 0x1bef, Hi: 0x1bf3, Stride: 0x1}, {Lo: 0x1c2c, Hi: 0x1c33, Stride: 0x1}, {Lo: 0x1c36, Hi: 0x1c37, Stride: 0x1}, {Lo: 0x1cd0, Hi: 0x1cd2, Stride: 0x1}, {Lo: 0x1cd4, Hi: 0x1ce0, Stride: 0x1}, {Lo: 0x1ce2, Hi: 0x1ce8, Stride: 0x1}, {Lo: 0x1ced, Hi: 0x1cf4, Stride: 0x7}, {Lo: 0x1cf8, Hi: 0x1cf9, Stride: 0x1}, {Lo: 0x1dc0, Hi: 0x1dff, Stride: 0x1}, {Lo: 0x200d, Hi: 0x20d0, Stride: 0xc3}, {Lo: 0x20d1, Hi: 0x20f0, Stride: 0x1}, {Lo: 0x2cef, Hi: 0x2cf1, Stride: 0x1}, {Lo: 0x2d7f, Hi: 0x2de0, Stride: 0x61}, {Lo: 0x2de1, Hi: 0x2dff, Stride: 0x1}, {Lo: 0x302a, Hi: 0x302f, Stride: 0x1}, {Lo: 0x3099, Hi: 0x309a, Stride: 0x1}, {Lo: 0xa66f, Hi: 0xa672, Stride: 0x1}, {Lo: 0xa674, Hi: 0xa67d, Stride: 0x1}, {Lo: 0xa69e, Hi: 0xa69f, Stride: 0x1}, {Lo: 0xa6f0, Hi: 0xa6f1, Stride: 0x1}, {Lo: 0xa802, Hi: 0xa806, Stride: 0x4}, {Lo: 0xa80b, Hi: 0xa825, Stride: 0x1a}, {Lo: 0xa826, Hi: 0xa82c, Stride: 0x6}, {Lo: 0xa8c4, Hi: 0xa8c5, Stride: 0x1}, {Lo: 0xa8e0, Hi: 0xa8f1, Stride: 0x1}, {Lo: 0xa8ff, Hi: 0xa926, Stride: 0x27}, {Lo: 0xa927, Hi: 0xa92d, Stride: 0x1}, {Lo: 0xa947, Hi: 0xa951, Stride: 0x1}, {Lo: 0xa953, Hi: 0xa980, Stride: 0x2d}, {Lo: 0xa981, Hi: 0xa982, Stride: 0x1}, {Lo: 0xa9b3, Hi: 0xa9b6, Stride: 0x3}, {Lo: 0xa9b7, Hi: 0xa9b9, Stride: 0x1}, {Lo: 0xa9bc, Hi: 0xa9bd, Stride: 0x1}, {Lo: 0xa9e5, Hi: 0xaa29, Stride: 0x44}, {Lo: 0xaa2a, Hi: 0xaa2e, Stride: 0x1}, {Lo: 0xaa31, Hi: 0xaa32, Stride: 0x1}, {Lo: 0xaa35, Hi: 0xaa36, Stride: 0x1}, {Lo: 0xaa43, Hi: 0xaa4c, Stride: 0x9}, {Lo: 0xaa7c, Hi: 0xaab0, Stride: 0x34}, {Lo: 0xaab2, Hi: 0xaab4, Stride: 0x1}, {Lo: 0xaab7, Hi: 0xaab8, Stride: 0x1}, {Lo: 0xaabe, Hi: 0xaabf, Stride: 0x1}, {Lo: 0xaac1, Hi: 0xaaec, Stride: 0x2b}, {Lo: 0xaaed, Hi: 0xabe5, Stride: 0xf8}, {Lo: 0xabe8, Hi: 0xabed, Stride: 0x5}, {Lo: 0xfb1e, Hi: 0xfe00, Stride: 0x2e2}, {Lo: 0xfe01, Hi: 0xfe0f, Stride: 0x1}, {Lo: 0xfe20, Hi: 0xfe2f, Stride: 0x1}, {Lo: 0xff9e, Hi: 0xff9f, Stride: 0x1}}, R32: []unicode.Range32{{Lo: 0x101fd, Hi: 0x102e0, Stride: 0xe3}, {Lo: 0x10376, Hi: 0x1037a, Stride: 0x1}, {Lo: 0x10a01, Hi: 0x10a03, Stride: 0x1}, {Lo: 0x10a05, Hi: 0x10a06, Stride: 0x1}, {Lo: 0x10a0c, Hi: 0x10a0f, Stride: 0x1}, {Lo: 0x10a38, Hi: 0x10a3a, Stride: 0x1}, {Lo: 0x10ae5, Hi: 0x10ae6, Stride: 0x1}, {Lo: 0x10d24, Hi: 0x10d27, Stride: 0x1}, {Lo: 0x10d69, Hi: 0x10d6d, Stride: 0x1}, {Lo: 0x10eab, Hi: 0x10eac, Stride: 0x1}, {Lo: 0x10efa, Hi: 0x10eff, Stride: 0x1}, {Lo: 0x10f46, Hi: 0x10f50, Stride: 0x1}, {Lo: 0x10f82, Hi: 0x10f85, Stride: 0x1}, {Lo: 0x11001, Hi: 0x11038, Stride: 0x37}, {Lo: 0x11039, Hi: 0x11046, Stride: 0x1}, {Lo: 0x11070, Hi: 0x11073, Stride: 0x3}, {Lo: 0x11074, Hi: 0x1107f, Stride: 0xb}, {Lo: 0x11080, Hi: 0x11081, Stride: 0x1}, {Lo: 0x110b3, Hi: 0x110b6, Stride: 0x1}, {Lo: 0x110b9, Hi: 0x110ba, Stride: 0x1}, {Lo: 0x110c2, Hi: 0x11100, Stride: 0x3e}, {Lo: 0x11101, Hi: 0x11102, Stride: 0x1}, {Lo: 0x11127, Hi: 0x1112b, Stride: 0x1}, {Lo: 0x1112d, Hi: 0x11132, Stride: 0x1}, {Lo: 0x11134, Hi: 0x11173, Stride: 0x3f}, {Lo: 0x11180, Hi: 0x11181, Stride: 0x1}, {Lo: 0x111b6, Hi: 0x111be, Stride: 0x1}, {Lo: 0x111c0, Hi: 0x111c9, Stride: 0x9}, {Lo: 0x111ca, Hi: 0x111cc, Stride: 0x1}, {Lo: 0x111cf, Hi: 0x1122f, Stride: 0x60}, {Lo: 0x11230, Hi: 0x11231, Stride: 0x1}, {Lo: 0x11234, Hi: 0x11237, Stride: 0x1}, {Lo: 0x1123e, Hi: 0x11241, Stride: 0x3}, {Lo: 0x112df, Hi: 0x112e3, Stride: 0x4}, {Lo: 0x112e4, Hi: 0x112ea, Stride: 0x1}, {Lo: 0x11300, Hi: 0x11301, Stride: 0x1}, {Lo: 0x1133b, Hi: 0x1133c, Stride: 0x1}, {Lo: 0x1133e, Hi: 0x11340, Stride: 0x2}, {Lo: 0x1134d, Hi: 0x11357, Stride: 0xa}, {Lo: 0x11366, Hi: 0x1136c, Stride: 0x1}, {Lo: 0x11370, Hi: 0x11374, Stride: 0x1}, {Lo: 0x113b8, Hi: 0x113bb, Stride: 0x3}, {Lo: 0x113bc, Hi: 0x113c0, Stride: 0x1}, {Lo: 0x113c2, Hi: 0x113c5, Stride: 0x3}, {Lo: 0x113c7, Hi: 0x113c9, Stride: 0x1}, {Lo: 0x113ce, Hi: 0x113cf, Stride: 0x1}, {Lo: 0x113d2, Hi: 0x113e1, Stride: 0xf}, {Lo: 0x113e2, Hi: 0x11438, Stride: 0x56}, {Lo: 0x11439, Hi: 0x1143f, Stride: 0x1}, {Lo: 0x11442, Hi: 0x11444, Stride: 0x1}, {Lo: 0x11446, Hi: 0x1145e, Stride: 0x18}, {Lo: 0x114b0, Hi: 0x114b3, Stride: 0x3}, {Lo: 0x114b4, Hi: 0x114b8, Stride: 0x1}, {Lo: 0x114ba, Hi: 0x114bd, Stride: 0x3}, {Lo: 0x114bf, Hi: 0x114c0, Stride: 0x1}, {Lo: 0x114c2, Hi: 0x114c3, Stride: 0x1}, {Lo: 0x115af, Hi: 0x115b2, Stride: 0x3}, {Lo: 0x115b3, Hi: 0x115b5, Stride: 0x1}, {Lo: 0x115bc, Hi: 0x115bd, Stride: 0x1}, {Lo: 0x115bf, Hi: 0x115c0, Stride: 0x1}, {Lo: 0x115dc, Hi: 0x115dd, Stride: 0x1}, {Lo: 0x11633, Hi: 0x1163a, Stride: 0x1}, {Lo: 0x1163d, Hi: 0x1163f, Stride: 0x2}, {Lo: 0x11640, Hi: 0x116ab, Stride: 0x6b}, {Lo: 0x116ad, Hi: 0x116b0, Stride: 0x3}, {Lo: 0x116b1, Hi: 0x116b7, Stride: 0x1}, {Lo: 0x1171d, Hi: 0x1171f, Stride: 0x2}, {Lo: 0x11722, Hi: 0x11725, Stride: 0x1}, {Lo: 0x11727, Hi: 0x1172b, Stride: 0x1}, {Lo: 0x1182f, Hi: 0x11837, Stride: 0x1}, {Lo: 0x11839, Hi: 0x1183a, Stride: 0x1}, {Lo: 0x11930, Hi: 0x1193b, Stride: 0xb}, {Lo: 0x1193c, Hi: 0x1193d, Stride: 0x1}, {Lo: 0x11943, Hi: 0x119d4, Stride: 0x91}, {Lo: 0x119d5, Hi: 0x119d7, Stride: 0x1}, {Lo: 0x119da, Hi: 0x119db, Stride: 0x1}, {Lo: 0x119e0, Hi: 0x11a01, Stride: 0x21}, {Lo: 0x11a02, Hi: 0x11a0a, Stride: 0x1}, {Lo: 0x11a33, Hi: 0x11a38, Stride: 0x1}, {Lo: 0x11a3b, Hi: 0x11a3e, Stride: 0x1}, {Lo: 0x11a51, Hi: 0x11a56, Stride: 0x1}, {Lo: 0x11a59, Hi: 0x11a5b, Stride: 0x1}, {Lo: 0x11a8a, Hi: 0x11a96, Stride: 0x1}, {Lo: 0x11a98, Hi: 0x11b60, Stride: 0xc8}, {Lo: 0x11b62, Hi: 0x11b64, Stride: 0x1}, {Lo: 0x11b66, Hi: 0x11c30, Stride: 0xca}, {Lo: 0x11c31, Hi: 0x11c36, Stride: 0x1}, {Lo: 0x11c38, Hi: 0x11c3d, Stride: 0x1}, {Lo: 0x11c3f, Hi: 0x11c92, Stride: 0x53}, {Lo: 0x11c93, Hi: 0x11ca7, Stride: 0x1}, {Lo: 0x11caa, Hi: 0x11cb0, Stride: 0x1}, {Lo: 0x11cb2, Hi: 0x11cb3, Stride: 0x1}, {Lo: 0x11cb5, Hi: 0x11cb6, Stride: 0x1}, {Lo: 0x11d31, Hi: 0x11d36, Stride: 0x1}, {Lo: 0x11d3a, Hi: 0x11d3c, Stride: 0x2}, {Lo: 0x11d3d, Hi: 0x11d3f, Stride: 0x2}, {Lo: 0x11d40, Hi: 0x11d45, Stride: 0x1}, {Lo: 0x11d47, Hi: 0x11d90, Stride: 0x49}, {Lo: 0x11d91, Hi: 0x11d95, Stride: 0x4}, {Lo: 0x11d97, Hi: 0x11ef3, Stride: 0x15c}, {Lo: 0x11ef4, Hi: 0x11f00, Stride: 0xc}, {Lo: 0x11f01, Hi: 0x11f36, Stride: 0x35}, {Lo: 0x11f37, Hi: 0x11f3a, Stride: 0x1}, {Lo: 0x11f40, Hi: 0x11f41, Stride: 0x1}, {Lo: 0x11f5a, Hi: 0x13440, Stride: 0x14e6}, {Lo: 0x13447, Hi: 0x13455, Stride: 0x1}, {Lo: 0x1611e, Hi: 0x16129, Stride: 0x1}, {Lo: 0x1612d, Hi: 0x1612f, Stride: 0x1}, {Lo: 0x16af0, Hi: 0x16af4, Stride: 0x1}, {Lo: 0x16b30, Hi: 0x16b36, Stride: 0x1}, {Lo: 0x16f4f, Hi: 0x16f8f, Stride: 0x40}, {Lo: 0x16f90, Hi: 0x16f92, Stride: 0x1}, {Lo: 0x16fe4, Hi: 0x16ff0, Stride: 0xc}, {Lo: 0x16ff1, Hi: 0x1bc9d, Stride: 0x4cac}, {Lo: 0x1bc9e, Hi: 0x1cf00, Stride: 0x1262}, {Lo: 0x1cf01, Hi: 0x1cf2d, Stride: 0x1}, {Lo: 0x1cf30, Hi: 0x1cf46, Stride: 0x1}, {Lo: 0x1d165, Hi: 0x1d169, Stride: 0x1}, {Lo: 0x1d16d, Hi: 0x1d172, Stride: 0x1}, {Lo: 0x1d17b, Hi: 0x1d182, Stride: 0x1}, {Lo: 0x1d185, Hi: 0x1d18b, Stride: 0x1}, {Lo: 0x1d1aa, Hi: 0x1d1ad, Stride: 0x1}, {Lo: 0x1d242, Hi: 0x1d244, Stride: 0x1}, {Lo: 0x1da00, Hi: 0x1da36, Stride: 0x1}, {Lo: 0x1da3b, Hi: 0x1da6c, Stride: 0x1}, {Lo: 0x1da75, Hi: 0x1da84, Stride: 0xf}, {Lo: 0x1da9b, Hi: 0x1da9f, Stride: 0x1}, {Lo: 0x1daa1, Hi: 0x1daaf, Stride: 0x1}, {Lo: 0x1e000, Hi: 0x1e006, Stride: 0x1}, {Lo: 0x1e008, Hi: 0x1e018, Stride: 0x1}, {Lo: 0x1e01b, Hi: 0x1e021, Stride: 0x1}, {Lo: 0x1e023, Hi: 0x1e024, Stride: 0x1}, {Lo: 0x1e026, Hi: 0x1e02a, Stride: 0x1}, {Lo: 0x1e08f, Hi: 0x1e130, Stride: 0xa1}, {Lo: 0x1e131, Hi: 0x1e136, Stride: 0x1}, {Lo: 0x1e2ae, Hi: 0x1e2ec, Stride: 0x3e}, {Lo: 0x1e2ed, Hi: 0x1e2ef, Stride: 0x1}, {Lo: 0x1e4ec, Hi: 0x1e4ef, Stride: 0x1}, {Lo: 0x1e5ee, Hi: 0x1e5ef, Stride: 0x1}, {Lo: 0x1e6e3, Hi: 0x1e6e6, Stride: 0x3}, {Lo: 0x1e6ee, Hi: 0x1e6ef, Stride: 0x1}, {Lo: 0x1e6f5, Hi: 0x1e8d0, Stride: 0x1db}, {Lo: 0x1e8d1, Hi: 0x1e8d6, Stride: 0x1}, {Lo: 0x1e944, Hi: 0x1e94a, Stride: 0x1}, {Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 0x1}, {Lo: 0xe0020, Hi: 0xe007f, Stride: 0x1}, {Lo: 0xe0100, Hi: 0xe01ef, Stride: 0x1}}, LatinOffset: 0}
+
+var conjunctLinker = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x94d, Hi: 0x9cd, Stride: 0x80}, {Lo: 0xacd, Hi: 0xb4d, Stride: 0x80}, {Lo: 0xc4d, Hi: 0xd4d, Stride: 0x100}, {Lo: 0x1039, Hi: 0x17d2, Stride: 0x799}, {Lo: 0x1a60, Hi: 0x1b44, Stride: 0xe4}, {Lo: 0x1bab, Hi: 0xa9c0, Stride: 0x8e15}, {Lo: 0xaaf6, Hi: 0xaaf6, Stride: 0x1}}, R32: []unicode.Range32{{Lo: 0x10a3f, Hi: 0x11133, Stride: 0x6f4}, {Lo: 0x113d0, Hi: 0x1193e, Stride: 0x56e}, {Lo: 0x11a47, Hi: 0x11a99, Stride: 0x52}, {Lo: 0x11f42, Hi: 0x11f42, Stride: 0x1}}, LatinOffset: 0}
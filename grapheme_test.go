@@ -0,0 +1,91 @@
+package emoji
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Test_GraphemeBreakTest checks every case of GraphemeBreakTest.txt
+func Test_GraphemeBreakTest(t *testing.T) {
+	f, err := os.Open("data/17.0/GraphemeBreakTest.txt")
+	if err != nil {
+		t.Fatalf("open GraphemeBreakTest.txt %v", err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	var count int
+	for scanner.Scan() {
+		l := scanner.Text()
+		if i := strings.IndexRune(l, '#'); i != -1 {
+			l = l[:i]
+		}
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		// format: ÷ 0020 × 0308 ÷ 0020 ÷
+		var expected []string
+		var cluster strings.Builder
+		for _, field := range strings.Fields(l) {
+			switch field {
+			case "÷":
+				if cluster.Len() > 0 {
+					expected = append(expected, cluster.String())
+					cluster.Reset()
+				}
+			case "×":
+			default:
+				var r rune
+				if _, err := fmt.Sscanf(field, "%X", &r); err != nil {
+					t.Fatalf("Sscanf %q %v", field, err)
+				}
+				cluster.WriteRune(r)
+			}
+		}
+		s := strings.Join(expected, "")
+		if graphemes := Graphemes(s); !reflect.DeepEqual(graphemes, expected) {
+			t.Errorf("Graphemes(%q) returned %q not %q (%s)", s, graphemes, expected, l)
+		}
+		if b, n := DecodeGrapheme([]byte(s)); string(b) != expected[0] || n != len(expected[0]) {
+			t.Errorf("DecodeGrapheme(%q) returned %q %d not %q", s, b, n, expected[0])
+		}
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read GraphemeBreakTest.txt %v", err)
+	}
+	if count == 0 {
+		t.Errorf("no test in GraphemeBreakTest.txt")
+	}
+}
+
+func Test_Graphemes(t *testing.T) {
+	tests := []struct {
+		s         string
+		graphemes []string
+	}{
+		{"", []string{}},
+		{"abc", []string{"a", "b", "c"}},
+		{"é\r\n", []string{"é", "\r\n"}},
+		{"👩‍👩‍👧!", []string{"👩‍👩‍👧", "!"}},
+		{"🇯🇵🇫🇷🇦", []string{"🇯🇵", "🇫🇷", "🇦"}},
+		{"1️⃣👍🏽", []string{"1️⃣", "👍🏽"}},
+		{"🏴󠁧󠁢󠁳󠁣󠁴󠁿", []string{"🏴󠁧󠁢󠁳󠁣󠁴󠁿"}},
+		{"한국어", []string{"한", "국", "어"}},
+		{"क्षि", []string{"क्षि"}},
+	}
+	for _, test := range tests {
+		if g := Graphemes(test.s); !reflect.DeepEqual(g, test.graphemes) {
+			t.Errorf("Graphemes(%q) returned %q not %q", test.s, g, test.graphemes)
+		}
+	}
+	// every RGI emoji is a single grapheme cluster
+	for _, s := range rgiSequences {
+		if g, _ := DecodeGraphemeString(s + "a"); g != s {
+			t.Errorf("DecodeGraphemeString(%q) returned %q", s, g)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package emoji
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// HairStyle is an emoji component for the hair of a person
+// used as the last element of a zwj sequence such as 👩‍🦰
+type HairStyle rune
+
+const (
+	NoHairStyle HairStyle = 0
+	RedHair     HairStyle = 0x1F9B0
+	CurlyHair   HairStyle = 0x1F9B1
+	Bald        HairStyle = 0x1F9B2
+	WhiteHair   HairStyle = 0x1F9B3
+)
+
+func (h HairStyle) String() string {
+	switch h {
+	case NoHairStyle:
+		return "no hair style"
+	case RedHair:
+		return "red hair"
+	case CurlyHair:
+		return "curly hair"
+	case Bald:
+		return "bald"
+	case WhiteHair:
+		return "white hair"
+	}
+	return "unknown hair style"
+}
+
+func isHairStyle(r rune) bool {
+	return RedHair <= HairStyle(r) && HairStyle(r) <= WhiteHair
+}
+
+// HairStyleOf returns the hair style component of the emoji s
+func HairStyleOf(s string) HairStyle {
+	if !PossibleGlyphString(s) {
+		return NoHairStyle
+	}
+	// the first element is the person
+	for _, element := range strings.Split(s, zeroWidthJoinerS)[1:] {
+		if r, _ := utf8.DecodeRuneInString(element); isHairStyle(r) {
+			return HairStyle(r)
+		}
+	}
+	return NoHairStyle
+}
+
+// WithHairStyle sets the hair style of every person emoji in s to h
+// keeping gender and skin tone, so 👩🏽 becomes 👩🏽‍🦱 for CurlyHair
+// NoHairStyle removes the hair style, so 🧑‍🦰 becomes 🧑
+// emoji are left untouched when the result would not be RGI
+func WithHairStyle(s string, h HairStyle) string {
+	return ReplaceString(s, -1, func(g string) string {
+		person := g
+		if i := strings.LastIndex(g, zeroWidthJoinerS); i != -1 {
+			if r, _ := utf8.DecodeRuneInString(g[i+len(zeroWidthJoinerS):]); !isHairStyle(r) {
+				return g
+			}
+			person = g[:i]
+		}
+		r := person
+		if h != NoHairStyle {
+			r = person + zeroWidthJoinerS + string(h)
+		}
+		if IsRGIString(r) {
+			return r
+		}
+		return g
+	})
+}
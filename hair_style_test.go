@@ -0,0 +1,48 @@
+package emoji
+
+import "testing"
+
+func Test_HairStyleOf(t *testing.T) {
+	tests := []struct {
+		s    string
+		hair HairStyle
+	}{
+		{"👩‍🦰", RedHair},
+		{"👨🏼‍🦱", CurlyHair},
+		{"🧑‍🦲", Bald},
+		{"👩🏿‍🦳", WhiteHair},
+		{"👩", NoHairStyle},
+		{"👩‍⚕️", NoHairStyle},
+		{"🦰", NoHairStyle},
+		{"a", NoHairStyle},
+	}
+	for _, test := range tests {
+		if h := HairStyleOf(test.s); h != test.hair {
+			t.Errorf("HairStyleOf(%q) returned %s not %s", test.s, h, test.hair)
+		}
+	}
+}
+
+func Test_WithHairStyle(t *testing.T) {
+	tests := []struct {
+		s    string
+		hair HairStyle
+		with string
+	}{
+		{"👩🏽", CurlyHair, "👩🏽‍🦱"},
+		{"👩", RedHair, "👩‍🦰"},
+		{"👨‍🦰", WhiteHair, "👨‍🦳"},
+		{"🧑🏻‍🦲", RedHair, "🧑🏻‍🦰"},
+		{"🧑‍🦰", NoHairStyle, "🧑"},
+		{"🧑", NoHairStyle, "🧑"},
+		{"👩‍⚕️", RedHair, "👩‍⚕️"},
+		{"👨‍👩‍👦", RedHair, "👨‍👩‍👦"},
+		{"🐶", RedHair, "🐶"},
+		{"a👩b", Bald, "a👩‍🦲b"},
+	}
+	for _, test := range tests {
+		if s := WithHairStyle(test.s, test.hair); s != test.with {
+			t.Errorf("WithHairStyle(%q, %s) returned %q not %q", test.s, test.hair, s, test.with)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Status is the qualification of an emoji sequence
+// as defined in https://www.unicode.org/reports/tr51/#def_qualified_emoji_character
+type Status uint8
+
+const (
+	// Component is an EmojiComponent listed on its own such as skin tones and hair styles
+	Component Status = iota + 1
+	// FullyQualified is an emoji with every presentation selector it needs, part of the RGI set
+	FullyQualified
+	// MinimallyQualified is an emoji only missing non initial presentation selectors
+	MinimallyQualified
+	// Unqualified is an emoji missing its initial presentation selector
+	Unqualified
+)
+
+func (s Status) String() string {
+	switch s {
+	case Component:
+		return "component"
+	case FullyQualified:
+		return "fully-qualified"
+	case MinimallyQualified:
+		return "minimally-qualified"
+	case Unqualified:
+		return "unqualified"
+	}
+	return "unknown"
+}
+
+// Info describes an emoji of version 13.0 or earlier as listed in https://www.unicode.org/Public/emoji/15.1/emoji-test.txt
+type Info struct {
+	// Name is the CLDR short name such as "grinning face"
+	Name string
+	// Group and Subgroup are the emoji picker categories such as "Smileys & Emotion" and "face-smiling"
+	Group    string
+	Subgroup string
+	// Version is the emoji version that introduced it such as "E13.0"
+	Version string
+	Status  Status
+}
+
+type entry struct {
+	sequence string
+	name     string
+	group    uint8
+	subgroup uint8
+	version  string
+	status   Status
+	// qualified is the fully-qualified form of minimally-qualified and unqualified emoji
+	qualified string
+}
+
+// Lookup returns the Info of the emoji s
+// s must be exactly one sequence listed in emoji-test.txt, fully-qualified or not
+func Lookup(s string) (Info, bool) {
+	e, ok := lookup(s)
+	if !ok {
+		return Info{}, false
+	}
+	return Info{
+		Name:     e.name,
+		Group:    groups[e.group],
+		Subgroup: subgroups[e.subgroup],
+		Version:  e.version,
+		Status:   e.status,
+	}, true
+}
+
+func lookup(s string) (entry, bool) {
+	i := sort.Search(len(metadata), func(i int) bool { return metadata[i].sequence >= s })
+	if i == len(metadata) || metadata[i].sequence != s {
+		return entry{}, false
+	}
+	return metadata[i], true
+}
+
+// byName maps the name of every fully-qualified emoji to its sequence
+var byName map[string]string
+var byNameOnce sync.Once
+
+func lookupName(name string) (string, bool) {
+	byNameOnce.Do(func() {
+		byName = make(map[string]string)
+		for _, e := range metadata {
+			if e.status == FullyQualified {
+				byName[e.name] = e.sequence
+			}
+		}
+	})
+	s, ok := byName[name]
+	return s, ok
+}
+
+// splitName splits a name such as "man: light skin tone, red hair"
+// into its base "man" and its attributes ["light skin tone", "red hair"]
+func splitName(name string) (string, []string) {
+	i := strings.Index(name, ": ")
+	if i == -1 {
+		return name, nil
+	}
+	return name[:i], strings.Split(name[i+2:], ", ")
+}
+
+func joinName(base string, attributes []string) string {
+	if len(attributes) == 0 {
+		return base
+	}
+	return base + ": " + strings.Join(attributes, ", ")
+}
@@ -0,0 +1,61 @@
+package emoji
+
+import (
+	"sort"
+	"testing"
+)
+
+func Test_metadata_is_sorted(t *testing.T) {
+	if !sort.SliceIsSorted(metadata, func(i, j int) bool { return metadata[i].sequence < metadata[j].sequence }) {
+		t.Errorf("metadata is not sorted")
+	}
+}
+
+func Test_Lookup(t *testing.T) {
+	tests := []struct {
+		s    string
+		info Info
+	}{
+		{"😀", Info{"grinning face", "Smileys & Emotion", "face-smiling", "E1.0", FullyQualified}},
+		{"☺️", Info{"smiling face", "Smileys & Emotion", "face-affection", "E0.6", FullyQualified}},
+		{"☺", Info{"smiling face", "Smileys & Emotion", "face-affection", "E0.6", Unqualified}},
+		{"👁️‍🗨", Info{"eye in speech bubble", "Smileys & Emotion", "emotion", "E2.0", MinimallyQualified}},
+		{"🏼", Info{"medium-light skin tone", "Component", "skin-tone", "E1.0", Component}},
+		{"🧑‍🦯", Info{"person with white cane", "People & Body", "person-activity", "E12.1", FullyQualified}},
+		{"🇧🇳", Info{"flag: Brunei", "Flags", "country-flag", "E2.0", FullyQualified}},
+		{"#️⃣", Info{"keycap: #", "Symbols", "keycap", "E0.6", FullyQualified}},
+	}
+	for _, test := range tests {
+		info, ok := Lookup(test.s)
+		if !ok {
+			t.Errorf("Lookup(%q) returned negative", test.s)
+		}
+		if info != test.info {
+			t.Errorf("Lookup(%q) returned %+v not %+v", test.s, info, test.info)
+		}
+	}
+
+	for _, s := range []string{"", "a", "😀😀", "👨‍🦖", "🇦🇦"} {
+		if _, ok := Lookup(s); ok {
+			t.Errorf("Lookup(%q) returned positive", s)
+		}
+	}
+}
+
+func Test_Lookup_rgi(t *testing.T) {
+	for _, s := range rgiSequences {
+		info, ok := Lookup(s)
+		if !ok {
+			t.Errorf("rgi %q is missing from metadata", s)
+			continue
+		}
+		if info.Status != FullyQualified && info.Status != Component {
+			t.Errorf("rgi %q is %s", s, info.Status)
+		}
+	}
+	for _, e := range metadata {
+		if e.status == FullyQualified && !IsRGIString(e.sequence) {
+			t.Errorf("fully-qualified %q is not rgi", e.sequence)
+		}
+	}
+}
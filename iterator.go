@@ -0,0 +1,68 @@
+package emoji
+
+// Iterator walks a byte slice segment by segment, a segment being either one emoji
+// or a run of text between two emoji, without allocating
+//
+//	it := NewIterator(b)
+//	for it.Next() {
+//		if it.IsEmoji() {
+//			fmt.Println(it.Offset(), string(it.Segment()))
+//		}
+//	}
+type Iterator struct {
+	b     []byte
+	start int
+	end   int
+	emoji bool
+	// pending is the length of the emoji found at end while looking for the end of a text segment
+	pending int
+}
+
+// NewIterator returns an Iterator over b
+func NewIterator(b []byte) *Iterator {
+	return &Iterator{b: b}
+}
+
+// Next advances to the next segment, it returns false at the end of b
+func (it *Iterator) Next() bool {
+	it.start = it.end
+	if it.pending > 0 {
+		it.end += it.pending
+		it.pending = 0
+		it.emoji = true
+		return true
+	}
+	if it.start == len(it.b) {
+		return false
+	}
+	_, ok, n := Decode(it.b[it.start:])
+	it.end = it.start + n
+	it.emoji = ok
+	if ok {
+		return true
+	}
+	for it.end < len(it.b) {
+		_, ok, n := Decode(it.b[it.end:])
+		if ok {
+			it.pending = n
+			break
+		}
+		it.end += n
+	}
+	return true
+}
+
+// Segment returns the current segment, it is a subslice of b
+func (it *Iterator) Segment() []byte {
+	return it.b[it.start:it.end]
+}
+
+// IsEmoji reports whether the current segment is an emoji
+func (it *Iterator) IsEmoji() bool {
+	return it.emoji
+}
+
+// Offset returns the byte offset of the current segment in b
+func (it *Iterator) Offset() int {
+	return it.start
+}
@@ -0,0 +1,60 @@
+package emoji
+
+// StringIterator is the string equivalent of Iterator
+type StringIterator struct {
+	s     string
+	start int
+	end   int
+	emoji bool
+	// pending is the length of the emoji found at end while looking for the end of a text segment
+	pending int
+}
+
+// NewStringIterator returns a StringIterator over s
+func NewStringIterator(s string) *StringIterator {
+	return &StringIterator{s: s}
+}
+
+// Next advances to the next segment, it returns false at the end of s
+func (it *StringIterator) Next() bool {
+	it.start = it.end
+	if it.pending > 0 {
+		it.end += it.pending
+		it.pending = 0
+		it.emoji = true
+		return true
+	}
+	if it.start == len(it.s) {
+		return false
+	}
+	_, ok, n := DecodeString(it.s[it.start:])
+	it.end = it.start + n
+	it.emoji = ok
+	if ok {
+		return true
+	}
+	for it.end < len(it.s) {
+		_, ok, n := DecodeString(it.s[it.end:])
+		if ok {
+			it.pending = n
+			break
+		}
+		it.end += n
+	}
+	return true
+}
+
+// Segment returns the current segment, it is a substring of s
+func (it *StringIterator) Segment() string {
+	return it.s[it.start:it.end]
+}
+
+// IsEmoji reports whether the current segment is an emoji
+func (it *StringIterator) IsEmoji() bool {
+	return it.emoji
+}
+
+// Offset returns the byte offset of the current segment in s
+func (it *StringIterator) Offset() int {
+	return it.start
+}
@@ -0,0 +1,69 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+type segment struct {
+	offset  int
+	segment string
+	emoji   bool
+}
+
+var iteratorTests = []struct {
+	s        string
+	segments []segment
+}{
+	{"", nil},
+	{"abc", []segment{{0, "abc", false}}},
+	{"😀", []segment{{0, "😀", true}}},
+	{"a😀b", []segment{{0, "a", false}, {1, "😀", true}, {5, "b", false}}},
+	{"😀😀", []segment{{0, "😀", true}, {4, "😀", true}}},
+	{"I ❤️ 🇯🇵!", []segment{{0, "I ", false}, {2, "❤️", true}, {8, " ", false}, {9, "🇯🇵", true}, {17, "!", false}}},
+	{"123 #️⃣", []segment{{0, "123 ", false}, {4, "#️⃣", true}}},
+	{"👩‍👩‍👧 family", []segment{{0, "👩‍👩‍👧", true}, {18, " family", false}}},
+	{"\xff😀", []segment{{0, "\xff", false}, {1, "😀", true}}},
+}
+
+func Test_Iterator(t *testing.T) {
+	for _, test := range iteratorTests {
+		var segments []segment
+		it := NewIterator([]byte(test.s))
+		for it.Next() {
+			segments = append(segments, segment{it.Offset(), string(it.Segment()), it.IsEmoji()})
+		}
+		if !reflect.DeepEqual(segments, test.segments) {
+			t.Errorf("Iterator(%q) returned %v not %v", test.s, segments, test.segments)
+		}
+		if it.Next() {
+			t.Errorf("Iterator(%q) Next returned true after the end", test.s)
+		}
+	}
+}
+
+func Test_StringIterator(t *testing.T) {
+	for _, test := range iteratorTests {
+		var segments []segment
+		it := NewStringIterator(test.s)
+		for it.Next() {
+			segments = append(segments, segment{it.Offset(), it.Segment(), it.IsEmoji()})
+		}
+		if !reflect.DeepEqual(segments, test.segments) {
+			t.Errorf("StringIterator(%q) returned %v not %v", test.s, segments, test.segments)
+		}
+	}
+}
+
+func Test_IteratorAllocs(t *testing.T) {
+	b := []byte("I ❤️ 🇯🇵, 👩‍👩‍👧 and 1️⃣ but not 1 nor a lone 🇯 indicator")
+	allocs := testing.AllocsPerRun(100, func() {
+		it := NewIterator(b)
+		for it.Next() {
+			_ = it.Segment()
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Iterator allocates %v times", allocs)
+	}
+}
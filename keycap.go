@@ -0,0 +1,33 @@
+package emoji
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+func isKeycapBase(r rune) bool {
+	return ('0' <= r && r <= '9') || r == '#' || r == '*'
+}
+
+// Keycap returns the fully-qualified keycap emoji of r such as 1️⃣ for '1'
+// r must be a keycap base [0-9#*], otherwise an empty string is returned
+func Keycap(r rune) string {
+	if !isKeycapBase(r) {
+		return ""
+	}
+	return string([]rune{r, emojiVS, enclosingKeycap})
+}
+
+// KeycapBase returns the base of the keycap emoji s such as '1' for 1️⃣
+// both the fully-qualified form and the unqualified form without \x{FE0F} are accepted
+func KeycapBase(s string) (rune, bool) {
+	r, n := utf8.DecodeRuneInString(s)
+	if !isKeycapBase(r) {
+		return 0, false
+	}
+	s = strings.TrimPrefix(s[n:], string(emojiVS))
+	if s != enclosingKeycapS {
+		return 0, false
+	}
+	return r, true
+}
@@ -0,0 +1,68 @@
+package emoji
+
+import "testing"
+
+func Test_Keycap(t *testing.T) {
+	for _, r := range "0123456789#*" {
+		k := Keycap(r)
+		if !IsRGIString(k) {
+			t.Errorf("Keycap(%q) returned %q which is not rgi", r, k)
+		}
+		if base, ok := KeycapBase(k); !ok || base != r {
+			t.Errorf("KeycapBase(%q) returned %q %v not %q", k, base, ok, r)
+		}
+		unqualified := string(r) + enclosingKeycapS
+		if base, ok := KeycapBase(unqualified); !ok || base != r {
+			t.Errorf("KeycapBase(%q) returned %q %v not %q", unqualified, base, ok, r)
+		}
+		if !PossibleGlyphString(unqualified) {
+			t.Errorf("%q returned negative", unqualified)
+		}
+		if Qualify(unqualified) != k {
+			t.Errorf("Qualify(%q) returned %q not %q", unqualified, Qualify(unqualified), k)
+		}
+	}
+
+	for _, r := range "a❤😀 " {
+		if k := Keycap(r); k != "" {
+			t.Errorf("Keycap(%q) returned %q", r, k)
+		}
+	}
+	for _, s := range []string{"", "1", "1️", "❤️⃣", "❤⃣", "1️⃣a", "11️⃣", "⃣"} {
+		if base, ok := KeycapBase(s); ok {
+			t.Errorf("KeycapBase(%q) returned positive %q", s, base)
+		}
+	}
+}
+
+func Test_DecodeKeycap(t *testing.T) {
+	tests := []struct {
+		s  string
+		g  string
+		ok bool
+	}{
+		{"1️⃣", "1️⃣", true},
+		{"1⃣", "1⃣", true},
+		{"1⃣a", "1⃣", true},
+		{"❤️⃣", "❤️", true},
+		{"❤⃣", "❤", true},
+		{"1a", "1", false},
+		{"123", "1", false},
+		{"1️a", "1️", true},
+	}
+	for _, test := range tests {
+		g, ok, n := DecodeString(test.s)
+		if g != test.g || ok != test.ok || n != len(g) {
+			t.Errorf("DecodeString(%q) returned %q %v %d not %q %v", test.s, g, ok, n, test.g, test.ok)
+		}
+		b, ok, n := Decode([]byte(test.s))
+		if string(b) != test.g || ok != test.ok || n != len(b) {
+			t.Errorf("Decode(%q) returned %q %v %d not %q %v", test.s, b, ok, n, test.g, test.ok)
+		}
+	}
+	for _, s := range []string{"❤️⃣", "😀️⃣"} {
+		if PossibleGlyphString(s) {
+			t.Errorf("%q returned positive", s)
+		}
+	}
+}
@@ -0,0 +1,4179 @@
+// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+var groups = []string{"Smileys & Emotion", "People & Body", "Component", "Animals & Nature", "Food & Drink", "Travel & Places", "Activities", "Objects", "Symbols", "Flags"}
+
+var subgroups = []string{"face-smiling", "face-affection", "face-tongue", "face-hand", "face-neutral-skeptical", "face-sleepy", "face-unwell", "face-hat", "face-glasses", "face-concerned", "face-negative", "face-costume", "cat-face", "monkey-face", "heart", "emotion", "hand-fingers-open", "hand-fingers-partial", "hand-single-finger", "hand-fingers-closed", "hands", "hand-prop", "body-parts", "person", "person-gesture", "person-role", "person-fantasy", "person-activity", "person-sport", "person-resting", "family", "person-symbol", "skin-tone", "hair-style", "animal-mammal", "animal-bird", "animal-amphibian", "animal-reptile", "animal-marine", "animal-bug", "plant-flower", "plant-other", "food-fruit", "food-vegetable", "food-prepared", "food-asian", "food-marine", "food-sweet", "drink", "dishware", "place-map", "place-geographic", "place-building", "place-religious", "place-other", "transport-ground", "transport-water", "transport-air", "hotel", "time", "sky & weather", "event", "award-medal", "sport", "game", "arts & crafts", "clothing", "sound", "music", "musical-instrument", "phone", "computer", "light & video", "book-paper", "money", "mail", "writing", "office", "lock", "tool", "science", "medical", "household", "other-object", "transport-sign", "warning", "arrow", "religion", "zodiac", "av-symbol", "gender", "math", "punctuation", "currency", "other-symbol", "keycap", "alphanum", "geometric", "flag", "country-flag", "subdivision-flag"}
+
+var metadata = []entry{
+	{"#⃣", "keycap: #", 8, 95, "E0.6", Unqualified, "#️⃣"},
+	{"#️⃣", "keycap: #", 8, 95, "E0.6", FullyQualified, ""},
+	{"*⃣", "keycap: *", 8, 95, "E2.0", Unqualified, "*️⃣"},
+	{"*️⃣", "keycap: *", 8, 95, "E2.0", FullyQualified, ""},
+	{"0⃣", "keycap: 0", 8, 95, "E0.6", Unqualified, "0️⃣"},
+	{"0️⃣", "keycap: 0", 8, 95, "E0.6", FullyQualified, ""},
+	{"1⃣", "keycap: 1", 8, 95, "E0.6", Unqualified, "1️⃣"},
+	{"1️⃣", "keycap: 1", 8, 95, "E0.6", FullyQualified, ""},
+	{"2⃣", "keycap: 2", 8, 95, "E0.6", Unqualified, "2️⃣"},
+	{"2️⃣", "keycap: 2", 8, 95, "E0.6", FullyQualified, ""},
+	{"3⃣", "keycap: 3", 8, 95, "E0.6", Unqualified, "3️⃣"},
+	{"3️⃣", "keycap: 3", 8, 95, "E0.6", FullyQualified, ""},
+	{"4⃣", "keycap: 4", 8, 95, "E0.6", Unqualified, "4️⃣"},
+	{"4️⃣", "keycap: 4", 8, 95, "E0.6", FullyQualified, ""},
+	{"5⃣", "keycap: 5", 8, 95, "E0.6", Unqualified, "5️⃣"},
+	{"5️⃣", "keycap: 5", 8, 95, "E0.6", FullyQualified, ""},
+	{"6⃣", "keycap: 6", 8, 95, "E0.6", Unqualified, "6️⃣"},
+	{"6️⃣", "keycap: 6", 8, 95, "E0.6", FullyQualified, ""},
+	{"7⃣", "keycap: 7", 8, 95, "E0.6", Unqualified, "7️⃣"},
+	{"7️⃣", "keycap: 7", 8, 95, "E0.6", FullyQualified, ""},
+	{"8⃣", "keycap: 8", 8, 95, "E0.6", Unqualified, "8️⃣"},
+	{"8️⃣", "keycap: 8", 8, 95, "E0.6", FullyQualified, ""},
+	{"9⃣", "keycap: 9", 8, 95, "E0.6", Unqualified, "9️⃣"},
+	{"9️⃣", "keycap: 9", 8, 95, "E0.6", FullyQualified, ""},
+	{"©", "copyright", 8, 94, "E0.6", Unqualified, "©️"},
+	{"©️", "copyright", 8, 94, "E0.6", FullyQualified, ""},
+	{"®", "registered", 8, 94, "E0.6", Unqualified, "®️"},
+	{"®️", "registered", 8, 94, "E0.6", FullyQualified, ""},
+	{"‼", "double exclamation mark", 8, 92, "E0.6", Unqualified, "‼️"},
+	{"‼️", "double exclamation mark", 8, 92, "E0.6", FullyQualified, ""},
+	{"⁉", "exclamation question mark", 8, 92, "E0.6", Unqualified, "⁉️"},
+	{"⁉️", "exclamation question mark", 8, 92, "E0.6", FullyQualified, ""},
+	{"™", "trade mark", 8, 94, "E0.6", Unqualified, "™️"},
+	{"™️", "trade mark", 8, 94, "E0.6", FullyQualified, ""},
+	{"ℹ", "information", 8, 96, "E0.6", Unqualified, "ℹ️"},
+	{"ℹ️", "information", 8, 96, "E0.6", FullyQualified, ""},
+	{"↔", "left-right arrow", 8, 86, "E0.6", Unqualified, "↔️"},
+	{"↔️", "left-right arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"↕", "up-down arrow", 8, 86, "E0.6", Unqualified, "↕️"},
+	{"↕️", "up-down arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"↖", "up-left arrow", 8, 86, "E0.6", Unqualified, "↖️"},
+	{"↖️", "up-left arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"↗", "up-right arrow", 8, 86, "E0.6", Unqualified, "↗️"},
+	{"↗️", "up-right arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"↘", "down-right arrow", 8, 86, "E0.6", Unqualified, "↘️"},
+	{"↘️", "down-right arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"↙", "down-left arrow", 8, 86, "E0.6", Unqualified, "↙️"},
+	{"↙️", "down-left arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"↩", "right arrow curving left", 8, 86, "E0.6", Unqualified, "↩️"},
+	{"↩️", "right arrow curving left", 8, 86, "E0.6", FullyQualified, ""},
+	{"↪", "left arrow curving right", 8, 86, "E0.6", Unqualified, "↪️"},
+	{"↪️", "left arrow curving right", 8, 86, "E0.6", FullyQualified, ""},
+	{"⌚", "watch", 5, 59, "E0.6", FullyQualified, ""},
+	{"⌛", "hourglass done", 5, 59, "E0.6", FullyQualified, ""},
+	{"⌨", "keyboard", 7, 71, "E1.0", Unqualified, "⌨️"},
+	{"⌨️", "keyboard", 7, 71, "E1.0", FullyQualified, ""},
+	{"⏏", "eject button", 8, 89, "E1.0", Unqualified, "⏏️"},
+	{"⏏️", "eject button", 8, 89, "E1.0", FullyQualified, ""},
+	{"⏩", "fast-forward button", 8, 89, "E0.6", FullyQualified, ""},
+	{"⏪", "fast reverse button", 8, 89, "E0.6", FullyQualified, ""},
+	{"⏫", "fast up button", 8, 89, "E0.6", FullyQualified, ""},
+	{"⏬", "fast down button", 8, 89, "E0.6", FullyQualified, ""},
+	{"⏭", "next track button", 8, 89, "E0.7", Unqualified, "⏭️"},
+	{"⏭️", "next track button", 8, 89, "E0.7", FullyQualified, ""},
+	{"⏮", "last track button", 8, 89, "E0.7", Unqualified, "⏮️"},
+	{"⏮️", "last track button", 8, 89, "E0.7", FullyQualified, ""},
+	{"⏯", "play or pause button", 8, 89, "E1.0", Unqualified, "⏯️"},
+	{"⏯️", "play or pause button", 8, 89, "E1.0", FullyQualified, ""},
+	{"⏰", "alarm clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"⏱", "stopwatch", 5, 59, "E1.0", Unqualified, "⏱️"},
+	{"⏱️", "stopwatch", 5, 59, "E1.0", FullyQualified, ""},
+	{"⏲", "timer clock", 5, 59, "E1.0", Unqualified, "⏲️"},
+	{"⏲️", "timer clock", 5, 59, "E1.0", FullyQualified, ""},
+	{"⏳", "hourglass not done", 5, 59, "E0.6", FullyQualified, ""},
+	{"⏸", "pause button", 8, 89, "E0.7", Unqualified, "⏸️"},
+	{"⏸️", "pause button", 8, 89, "E0.7", FullyQualified, ""},
+	{"⏹", "stop button", 8, 89, "E0.7", Unqualified, "⏹️"},
+	{"⏹️", "stop button", 8, 89, "E0.7", FullyQualified, ""},
+	{"⏺", "record button", 8, 89, "E0.7", Unqualified, "⏺️"},
+	{"⏺️", "record button", 8, 89, "E0.7", FullyQualified, ""},
+	{"Ⓜ", "circled M", 8, 96, "E0.6", Unqualified, "Ⓜ️"},
+	{"Ⓜ️", "circled M", 8, 96, "E0.6", FullyQualified, ""},
+	{"▪", "black small square", 8, 97, "E0.6", Unqualified, "▪️"},
+	{"▪️", "black small square", 8, 97, "E0.6", FullyQualified, ""},
+	{"▫", "white small square", 8, 97, "E0.6", Unqualified, "▫️"},
+	{"▫️", "white small square", 8, 97, "E0.6", FullyQualified, ""},
+	{"▶", "play button", 8, 89, "E0.6", Unqualified, "▶️"},
+	{"▶️", "play button", 8, 89, "E0.6", FullyQualified, ""},
+	{"◀", "reverse button", 8, 89, "E0.6", Unqualified, "◀️"},
+	{"◀️", "reverse button", 8, 89, "E0.6", FullyQualified, ""},
+	{"◻", "white medium square", 8, 97, "E0.6", Unqualified, "◻️"},
+	{"◻️", "white medium square", 8, 97, "E0.6", FullyQualified, ""},
+	{"◼", "black medium square", 8, 97, "E0.6", Unqualified, "◼️"},
+	{"◼️", "black medium square", 8, 97, "E0.6", FullyQualified, ""},
+	{"◽", "white medium-small square", 8, 97, "E0.6", FullyQualified, ""},
+	{"◾", "black medium-small square", 8, 97, "E0.6", FullyQualified, ""},
+	{"☀", "sun", 5, 60, "E0.6", Unqualified, "☀️"},
+	{"☀️", "sun", 5, 60, "E0.6", FullyQualified, ""},
+	{"☁", "cloud", 5, 60, "E0.6", Unqualified, "☁️"},
+	{"☁️", "cloud", 5, 60, "E0.6", FullyQualified, ""},
+	{"☂", "umbrella", 5, 60, "E0.7", Unqualified, "☂️"},
+	{"☂️", "umbrella", 5, 60, "E0.7", FullyQualified, ""},
+	{"☃", "snowman", 5, 60, "E0.7", Unqualified, "☃️"},
+	{"☃️", "snowman", 5, 60, "E0.7", FullyQualified, ""},
+	{"☄", "comet", 5, 60, "E1.0", Unqualified, "☄️"},
+	{"☄️", "comet", 5, 60, "E1.0", FullyQualified, ""},
+	{"☎", "telephone", 7, 70, "E0.6", Unqualified, "☎️"},
+	{"☎️", "telephone", 7, 70, "E0.6", FullyQualified, ""},
+	{"☑", "check box with check", 8, 94, "E0.6", Unqualified, "☑️"},
+	{"☑️", "check box with check", 8, 94, "E0.6", FullyQualified, ""},
+	{"☔", "umbrella with rain drops", 5, 60, "E0.6", FullyQualified, ""},
+	{"☕", "hot beverage", 4, 48, "E0.6", FullyQualified, ""},
+	{"☘", "shamrock", 3, 41, "E1.0", Unqualified, "☘️"},
+	{"☘️", "shamrock", 3, 41, "E1.0", FullyQualified, ""},
+	{"☝", "index pointing up", 1, 18, "E0.6", Unqualified, "☝️"},
+	{"☝️", "index pointing up", 1, 18, "E0.6", FullyQualified, ""},
+	{"☝🏻", "index pointing up: light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"☝🏼", "index pointing up: medium-light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"☝🏽", "index pointing up: medium skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"☝🏾", "index pointing up: medium-dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"☝🏿", "index pointing up: dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"☠", "skull and crossbones", 0, 10, "E1.0", Unqualified, "☠️"},
+	{"☠️", "skull and crossbones", 0, 10, "E1.0", FullyQualified, ""},
+	{"☢", "radioactive", 8, 85, "E1.0", Unqualified, "☢️"},
+	{"☢️", "radioactive", 8, 85, "E1.0", FullyQualified, ""},
+	{"☣", "biohazard", 8, 85, "E1.0", Unqualified, "☣️"},
+	{"☣️", "biohazard", 8, 85, "E1.0", FullyQualified, ""},
+	{"☦", "orthodox cross", 8, 87, "E1.0", Unqualified, "☦️"},
+	{"☦️", "orthodox cross", 8, 87, "E1.0", FullyQualified, ""},
+	{"☪", "star and crescent", 8, 87, "E0.7", Unqualified, "☪️"},
+	{"☪️", "star and crescent", 8, 87, "E0.7", FullyQualified, ""},
+	{"☮", "peace symbol", 8, 87, "E1.0", Unqualified, "☮️"},
+	{"☮️", "peace symbol", 8, 87, "E1.0", FullyQualified, ""},
+	{"☯", "yin yang", 8, 87, "E0.7", Unqualified, "☯️"},
+	{"☯️", "yin yang", 8, 87, "E0.7", FullyQualified, ""},
+	{"☸", "wheel of dharma", 8, 87, "E0.7", Unqualified, "☸️"},
+	{"☸️", "wheel of dharma", 8, 87, "E0.7", FullyQualified, ""},
+	{"☹", "frowning face", 0, 9, "E0.7", Unqualified, "☹️"},
+	{"☹️", "frowning face", 0, 9, "E0.7", FullyQualified, ""},
+	{"☺", "smiling face", 0, 1, "E0.6", Unqualified, "☺️"},
+	{"☺️", "smiling face", 0, 1, "E0.6", FullyQualified, ""},
+	{"♀", "female sign", 8, 90, "E4.0", Unqualified, "♀️"},
+	{"♀️", "female sign", 8, 90, "E4.0", FullyQualified, ""},
+	{"♂", "male sign", 8, 90, "E4.0", Unqualified, "♂️"},
+	{"♂️", "male sign", 8, 90, "E4.0", FullyQualified, ""},
+	{"♈", "Aries", 8, 88, "E0.6", FullyQualified, ""},
+	{"♉", "Taurus", 8, 88, "E0.6", FullyQualified, ""},
+	{"♊", "Gemini", 8, 88, "E0.6", FullyQualified, ""},
+	{"♋", "Cancer", 8, 88, "E0.6", FullyQualified, ""},
+	{"♌", "Leo", 8, 88, "E0.6", FullyQualified, ""},
+	{"♍", "Virgo", 8, 88, "E0.6", FullyQualified, ""},
+	{"♎", "Libra", 8, 88, "E0.6", FullyQualified, ""},
+	{"♏", "Scorpio", 8, 88, "E0.6", FullyQualified, ""},
+	{"♐", "Sagittarius", 8, 88, "E0.6", FullyQualified, ""},
+	{"♑", "Capricorn", 8, 88, "E0.6", FullyQualified, ""},
+	{"♒", "Aquarius", 8, 88, "E0.6", FullyQualified, ""},
+	{"♓", "Pisces", 8, 88, "E0.6", FullyQualified, ""},
+	{"♟", "chess pawn", 6, 64, "E11.0", Unqualified, "♟️"},
+	{"♟️", "chess pawn", 6, 64, "E11.0", FullyQualified, ""},
+	{"♠", "spade suit", 6, 64, "E0.6", Unqualified, "♠️"},
+	{"♠️", "spade suit", 6, 64, "E0.6", FullyQualified, ""},
+	{"♣", "club suit", 6, 64, "E0.6", Unqualified, "♣️"},
+	{"♣️", "club suit", 6, 64, "E0.6", FullyQualified, ""},
+	{"♥", "heart suit", 6, 64, "E0.6", Unqualified, "♥️"},
+	{"♥️", "heart suit", 6, 64, "E0.6", FullyQualified, ""},
+	{"♦", "diamond suit", 6, 64, "E0.6", Unqualified, "♦️"},
+	{"♦️", "diamond suit", 6, 64, "E0.6", FullyQualified, ""},
+	{"♨", "hot springs", 5, 54, "E0.6", Unqualified, "♨️"},
+	{"♨️", "hot springs", 5, 54, "E0.6", FullyQualified, ""},
+	{"♻", "recycling symbol", 8, 94, "E0.6", Unqualified, "♻️"},
+	{"♻️", "recycling symbol", 8, 94, "E0.6", FullyQualified, ""},
+	{"♾", "infinity", 8, 91, "E11.0", Unqualified, "♾️"},
+	{"♾️", "infinity", 8, 91, "E11.0", FullyQualified, ""},
+	{"♿", "wheelchair symbol", 8, 84, "E0.6", FullyQualified, ""},
+	{"⚒", "hammer and pick", 7, 79, "E1.0", Unqualified, "⚒️"},
+	{"⚒️", "hammer and pick", 7, 79, "E1.0", FullyQualified, ""},
+	{"⚓", "anchor", 5, 56, "E0.6", FullyQualified, ""},
+	{"⚔", "crossed swords", 7, 79, "E1.0", Unqualified, "⚔️"},
+	{"⚔️", "crossed swords", 7, 79, "E1.0", FullyQualified, ""},
+	{"⚕", "medical symbol", 8, 94, "E4.0", Unqualified, "⚕️"},
+	{"⚕️", "medical symbol", 8, 94, "E4.0", FullyQualified, ""},
+	{"⚖", "balance scale", 7, 79, "E1.0", Unqualified, "⚖️"},
+	{"⚖️", "balance scale", 7, 79, "E1.0", FullyQualified, ""},
+	{"⚗", "alembic", 7, 80, "E1.0", Unqualified, "⚗️"},
+	{"⚗️", "alembic", 7, 80, "E1.0", FullyQualified, ""},
+	{"⚙", "gear", 7, 79, "E1.0", Unqualified, "⚙️"},
+	{"⚙️", "gear", 7, 79, "E1.0", FullyQualified, ""},
+	{"⚛", "atom symbol", 8, 87, "E1.0", Unqualified, "⚛️"},
+	{"⚛️", "atom symbol", 8, 87, "E1.0", FullyQualified, ""},
+	{"⚜", "fleur-de-lis", 8, 94, "E1.0", Unqualified, "⚜️"},
+	{"⚜️", "fleur-de-lis", 8, 94, "E1.0", FullyQualified, ""},
+	{"⚠", "warning", 8, 85, "E0.6", Unqualified, "⚠️"},
+	{"⚠️", "warning", 8, 85, "E0.6", FullyQualified, ""},
+	{"⚡", "high voltage", 5, 60, "E0.6", FullyQualified, ""},
+	{"⚧", "transgender symbol", 8, 90, "E13.0", Unqualified, "⚧️"},
+	{"⚧️", "transgender symbol", 8, 90, "E13.0", FullyQualified, ""},
+	{"⚪", "white circle", 8, 97, "E0.6", FullyQualified, ""},
+	{"⚫", "black circle", 8, 97, "E0.6", FullyQualified, ""},
+	{"⚰", "coffin", 7, 83, "E1.0", Unqualified, "⚰️"},
+	{"⚰️", "coffin", 7, 83, "E1.0", FullyQualified, ""},
+	{"⚱", "funeral urn", 7, 83, "E1.0", Unqualified, "⚱️"},
+	{"⚱️", "funeral urn", 7, 83, "E1.0", FullyQualified, ""},
+	{"⚽", "soccer ball", 6, 63, "E0.6", FullyQualified, ""},
+	{"⚾", "baseball", 6, 63, "E0.6", FullyQualified, ""},
+	{"⛄", "snowman without snow", 5, 60, "E0.6", FullyQualified, ""},
+	{"⛅", "sun behind cloud", 5, 60, "E0.6", FullyQualified, ""},
+	{"⛈", "cloud with lightning and rain", 5, 60, "E0.7", Unqualified, "⛈️"},
+	{"⛈️", "cloud with lightning and rain", 5, 60, "E0.7", FullyQualified, ""},
+	{"⛎", "Ophiuchus", 8, 88, "E0.6", FullyQualified, ""},
+	{"⛏", "pick", 7, 79, "E0.7", Unqualified, "⛏️"},
+	{"⛏️", "pick", 7, 79, "E0.7", FullyQualified, ""},
+	{"⛑", "rescue worker’s helmet", 7, 66, "E0.7", Unqualified, "⛑️"},
+	{"⛑️", "rescue worker’s helmet", 7, 66, "E0.7", FullyQualified, ""},
+	{"⛓", "chains", 7, 79, "E0.7", Unqualified, "⛓️"},
+	{"⛓️", "chains", 7, 79, "E0.7", FullyQualified, ""},
+	{"⛔", "no entry", 8, 85, "E0.6", FullyQualified, ""},
+	{"⛩", "shinto shrine", 5, 53, "E0.7", Unqualified, "⛩️"},
+	{"⛩️", "shinto shrine", 5, 53, "E0.7", FullyQualified, ""},
+	{"⛪", "church", 5, 53, "E0.6", FullyQualified, ""},
+	{"⛰", "mountain", 5, 51, "E0.7", Unqualified, "⛰️"},
+	{"⛰️", "mountain", 5, 51, "E0.7", FullyQualified, ""},
+	{"⛱", "umbrella on ground", 5, 60, "E0.7", Unqualified, "⛱️"},
+	{"⛱️", "umbrella on ground", 5, 60, "E0.7", FullyQualified, ""},
+	{"⛲", "fountain", 5, 54, "E0.6", FullyQualified, ""},
+	{"⛳", "flag in hole", 6, 63, "E0.6", FullyQualified, ""},
+	{"⛴", "ferry", 5, 56, "E0.7", Unqualified, "⛴️"},
+	{"⛴️", "ferry", 5, 56, "E0.7", FullyQualified, ""},
+	{"⛵", "sailboat", 5, 56, "E0.6", FullyQualified, ""},
+	{"⛷", "skier", 1, 28, "E0.7", Unqualified, "⛷️"},
+	{"⛷️", "skier", 1, 28, "E0.7", FullyQualified, ""},
+	{"⛸", "ice skate", 6, 63, "E0.7", Unqualified, "⛸️"},
+	{"⛸️", "ice skate", 6, 63, "E0.7", FullyQualified, ""},
+	{"⛹", "person bouncing ball", 1, 28, "E0.7", Unqualified, "⛹️"},
+	{"⛹\u200d♀", "woman bouncing ball", 1, 28, "E4.0", Unqualified, "⛹️\u200d♀️"},
+	{"⛹\u200d♀️", "woman bouncing ball", 1, 28, "E4.0", Unqualified, "⛹️\u200d♀️"},
+	{"⛹\u200d♂", "man bouncing ball", 1, 28, "E4.0", Unqualified, "⛹️\u200d♂️"},
+	{"⛹\u200d♂️", "man bouncing ball", 1, 28, "E4.0", Unqualified, "⛹️\u200d♂️"},
+	{"⛹️", "person bouncing ball", 1, 28, "E0.7", FullyQualified, ""},
+	{"⛹️\u200d♀", "woman bouncing ball", 1, 28, "E4.0", MinimallyQualified, "⛹️\u200d♀️"},
+	{"⛹️\u200d♀️", "woman bouncing ball", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹️\u200d♂", "man bouncing ball", 1, 28, "E4.0", MinimallyQualified, "⛹️\u200d♂️"},
+	{"⛹️\u200d♂️", "man bouncing ball", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹🏻", "person bouncing ball: light skin tone", 1, 28, "E2.0", FullyQualified, ""},
+	{"⛹🏻\u200d♀", "woman bouncing ball: light skin tone", 1, 28, "E4.0", MinimallyQualified, "⛹🏻\u200d♀️"},
+	{"⛹🏻\u200d♀️", "woman bouncing ball: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹🏻\u200d♂", "man bouncing ball: light skin tone", 1, 28, "E4.0", MinimallyQualified, "⛹🏻\u200d♂️"},
+	{"⛹🏻\u200d♂️", "man bouncing ball: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹🏼", "person bouncing ball: medium-light skin tone", 1, 28, "E2.0", FullyQualified, ""},
+	{"⛹🏼\u200d♀", "woman bouncing ball: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "⛹🏼\u200d♀️"},
+	{"⛹🏼\u200d♀️", "woman bouncing ball: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹🏼\u200d♂", "man bouncing ball: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "⛹🏼\u200d♂️"},
+	{"⛹🏼\u200d♂️", "man bouncing ball: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹🏽", "person bouncing ball: medium skin tone", 1, 28, "E2.0", FullyQualified, ""},
+	{"⛹🏽\u200d♀", "woman bouncing ball: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "⛹🏽\u200d♀️"},
+	{"⛹🏽\u200d♀️", "woman bouncing ball: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹🏽\u200d♂", "man bouncing ball: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "⛹🏽\u200d♂️"},
+	{"⛹🏽\u200d♂️", "man bouncing ball: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹🏾", "person bouncing ball: medium-dark skin tone", 1, 28, "E2.0", FullyQualified, ""},
+	{"⛹🏾\u200d♀", "woman bouncing ball: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "⛹🏾\u200d♀️"},
+	{"⛹🏾\u200d♀️", "woman bouncing ball: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹🏾\u200d♂", "man bouncing ball: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "⛹🏾\u200d♂️"},
+	{"⛹🏾\u200d♂️", "man bouncing ball: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹🏿", "person bouncing ball: dark skin tone", 1, 28, "E2.0", FullyQualified, ""},
+	{"⛹🏿\u200d♀", "woman bouncing ball: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "⛹🏿\u200d♀️"},
+	{"⛹🏿\u200d♀️", "woman bouncing ball: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛹🏿\u200d♂", "man bouncing ball: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "⛹🏿\u200d♂️"},
+	{"⛹🏿\u200d♂️", "man bouncing ball: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"⛺", "tent", 5, 54, "E0.6", FullyQualified, ""},
+	{"⛽", "fuel pump", 5, 55, "E0.6", FullyQualified, ""},
+	{"✂", "scissors", 7, 77, "E0.6", Unqualified, "✂️"},
+	{"✂️", "scissors", 7, 77, "E0.6", FullyQualified, ""},
+	{"✅", "check mark button", 8, 94, "E0.6", FullyQualified, ""},
+	{"✈", "airplane", 5, 57, "E0.6", Unqualified, "✈️"},
+	{"✈️", "airplane", 5, 57, "E0.6", FullyQualified, ""},
+	{"✉", "envelope", 7, 75, "E0.6", Unqualified, "✉️"},
+	{"✉️", "envelope", 7, 75, "E0.6", FullyQualified, ""},
+	{"✊", "raised fist", 1, 19, "E0.6", FullyQualified, ""},
+	{"✊🏻", "raised fist: light skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"✊🏼", "raised fist: medium-light skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"✊🏽", "raised fist: medium skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"✊🏾", "raised fist: medium-dark skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"✊🏿", "raised fist: dark skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"✋", "raised hand", 1, 16, "E0.6", FullyQualified, ""},
+	{"✋🏻", "raised hand: light skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"✋🏼", "raised hand: medium-light skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"✋🏽", "raised hand: medium skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"✋🏾", "raised hand: medium-dark skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"✋🏿", "raised hand: dark skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"✌", "victory hand", 1, 17, "E0.6", Unqualified, "✌️"},
+	{"✌️", "victory hand", 1, 17, "E0.6", FullyQualified, ""},
+	{"✌🏻", "victory hand: light skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"✌🏼", "victory hand: medium-light skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"✌🏽", "victory hand: medium skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"✌🏾", "victory hand: medium-dark skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"✌🏿", "victory hand: dark skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"✍", "writing hand", 1, 21, "E0.7", Unqualified, "✍️"},
+	{"✍️", "writing hand", 1, 21, "E0.7", FullyQualified, ""},
+	{"✍🏻", "writing hand: light skin tone", 1, 21, "E1.0", FullyQualified, ""},
+	{"✍🏼", "writing hand: medium-light skin tone", 1, 21, "E1.0", FullyQualified, ""},
+	{"✍🏽", "writing hand: medium skin tone", 1, 21, "E1.0", FullyQualified, ""},
+	{"✍🏾", "writing hand: medium-dark skin tone", 1, 21, "E1.0", FullyQualified, ""},
+	{"✍🏿", "writing hand: dark skin tone", 1, 21, "E1.0", FullyQualified, ""},
+	{"✏", "pencil", 7, 76, "E0.6", Unqualified, "✏️"},
+	{"✏️", "pencil", 7, 76, "E0.6", FullyQualified, ""},
+	{"✒", "black nib", 7, 76, "E0.6", Unqualified, "✒️"},
+	{"✒️", "black nib", 7, 76, "E0.6", FullyQualified, ""},
+	{"✔", "check mark", 8, 94, "E0.6", Unqualified, "✔️"},
+	{"✔️", "check mark", 8, 94, "E0.6", FullyQualified, ""},
+	{"✖", "multiply", 8, 91, "E0.6", Unqualified, "✖️"},
+	{"✖️", "multiply", 8, 91, "E0.6", FullyQualified, ""},
+	{"✝", "latin cross", 8, 87, "E0.7", Unqualified, "✝️"},
+	{"✝️", "latin cross", 8, 87, "E0.7", FullyQualified, ""},
+	{"✡", "star of David", 8, 87, "E0.7", Unqualified, "✡️"},
+	{"✡️", "star of David", 8, 87, "E0.7", FullyQualified, ""},
+	{"✨", "sparkles", 6, 61, "E0.6", FullyQualified, ""},
+	{"✳", "eight-spoked asterisk", 8, 94, "E0.6", Unqualified, "✳️"},
+	{"✳️", "eight-spoked asterisk", 8, 94, "E0.6", FullyQualified, ""},
+	{"✴", "eight-pointed star", 8, 94, "E0.6", Unqualified, "✴️"},
+	{"✴️", "eight-pointed star", 8, 94, "E0.6", FullyQualified, ""},
+	{"❄", "snowflake", 5, 60, "E0.6", Unqualified, "❄️"},
+	{"❄️", "snowflake", 5, 60, "E0.6", FullyQualified, ""},
+	{"❇", "sparkle", 8, 94, "E0.6", Unqualified, "❇️"},
+	{"❇️", "sparkle", 8, 94, "E0.6", FullyQualified, ""},
+	{"❌", "cross mark", 8, 94, "E0.6", FullyQualified, ""},
+	{"❎", "cross mark button", 8, 94, "E0.6", FullyQualified, ""},
+	{"❓", "red question mark", 8, 92, "E0.6", FullyQualified, ""},
+	{"❔", "white question mark", 8, 92, "E0.6", FullyQualified, ""},
+	{"❕", "white exclamation mark", 8, 92, "E0.6", FullyQualified, ""},
+	{"❗", "red exclamation mark", 8, 92, "E0.6", FullyQualified, ""},
+	{"❣", "heart exclamation", 0, 14, "E1.0", Unqualified, "❣️"},
+	{"❣️", "heart exclamation", 0, 14, "E1.0", FullyQualified, ""},
+	{"❤", "red heart", 0, 14, "E0.6", Unqualified, "❤️"},
+	{"❤️", "red heart", 0, 14, "E0.6", FullyQualified, ""},
+	{"➕", "plus", 8, 91, "E0.6", FullyQualified, ""},
+	{"➖", "minus", 8, 91, "E0.6", FullyQualified, ""},
+	{"➗", "divide", 8, 91, "E0.6", FullyQualified, ""},
+	{"➡", "right arrow", 8, 86, "E0.6", Unqualified, "➡️"},
+	{"➡️", "right arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"➰", "curly loop", 8, 94, "E0.6", FullyQualified, ""},
+	{"➿", "double curly loop", 8, 94, "E1.0", FullyQualified, ""},
+	{"⤴", "right arrow curving up", 8, 86, "E0.6", Unqualified, "⤴️"},
+	{"⤴️", "right arrow curving up", 8, 86, "E0.6", FullyQualified, ""},
+	{"⤵", "right arrow curving down", 8, 86, "E0.6", Unqualified, "⤵️"},
+	{"⤵️", "right arrow curving down", 8, 86, "E0.6", FullyQualified, ""},
+	{"⬅", "left arrow", 8, 86, "E0.6", Unqualified, "⬅️"},
+	{"⬅️", "left arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"⬆", "up arrow", 8, 86, "E0.6", Unqualified, "⬆️"},
+	{"⬆️", "up arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"⬇", "down arrow", 8, 86, "E0.6", Unqualified, "⬇️"},
+	{"⬇️", "down arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"⬛", "black large square", 8, 97, "E0.6", FullyQualified, ""},
+	{"⬜", "white large square", 8, 97, "E0.6", FullyQualified, ""},
+	{"⭐", "star", 5, 60, "E0.6", FullyQualified, ""},
+	{"⭕", "hollow red circle", 8, 94, "E0.6", FullyQualified, ""},
+	{"〰", "wavy dash", 8, 92, "E0.6", Unqualified, "〰️"},
+	{"〰️", "wavy dash", 8, 92, "E0.6", FullyQualified, ""},
+	{"〽", "part alternation mark", 8, 94, "E0.6", Unqualified, "〽️"},
+	{"〽️", "part alternation mark", 8, 94, "E0.6", FullyQualified, ""},
+	{"㊗", "Japanese “congratulations” button", 8, 96, "E0.6", Unqualified, "㊗️"},
+	{"㊗️", "Japanese “congratulations” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"㊙", "Japanese “secret” button", 8, 96, "E0.6", Unqualified, "㊙️"},
+	{"㊙️", "Japanese “secret” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🀄", "mahjong red dragon", 6, 64, "E0.6", FullyQualified, ""},
+	{"🃏", "joker", 6, 64, "E0.6", FullyQualified, ""},
+	{"🅰", "A button (blood type)", 8, 96, "E0.6", Unqualified, "🅰️"},
+	{"🅰️", "A button (blood type)", 8, 96, "E0.6", FullyQualified, ""},
+	{"🅱", "B button (blood type)", 8, 96, "E0.6", Unqualified, "🅱️"},
+	{"🅱️", "B button (blood type)", 8, 96, "E0.6", FullyQualified, ""},
+	{"🅾", "O button (blood type)", 8, 96, "E0.6", Unqualified, "🅾️"},
+	{"🅾️", "O button (blood type)", 8, 96, "E0.6", FullyQualified, ""},
+	{"🅿", "P button", 8, 96, "E0.6", Unqualified, "🅿️"},
+	{"🅿️", "P button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆎", "AB button (blood type)", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆑", "CL button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆒", "COOL button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆓", "FREE button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆔", "ID button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆕", "NEW button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆖", "NG button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆗", "OK button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆘", "SOS button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆙", "UP! button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🆚", "VS button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🇦🇨", "flag: Ascension Island", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇩", "flag: Andorra", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇪", "flag: United Arab Emirates", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇫", "flag: Afghanistan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇬", "flag: Antigua & Barbuda", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇮", "flag: Anguilla", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇱", "flag: Albania", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇲", "flag: Armenia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇴", "flag: Angola", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇶", "flag: Antarctica", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇷", "flag: Argentina", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇸", "flag: American Samoa", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇹", "flag: Austria", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇺", "flag: Australia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇼", "flag: Aruba", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇽", "flag: Åland Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇦🇿", "flag: Azerbaijan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇦", "flag: Bosnia & Herzegovina", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇧", "flag: Barbados", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇩", "flag: Bangladesh", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇪", "flag: Belgium", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇫", "flag: Burkina Faso", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇬", "flag: Bulgaria", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇭", "flag: Bahrain", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇮", "flag: Burundi", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇯", "flag: Benin", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇱", "flag: St. Barthélemy", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇲", "flag: Bermuda", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇳", "flag: Brunei", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇴", "flag: Bolivia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇶", "flag: Caribbean Netherlands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇷", "flag: Brazil", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇸", "flag: Bahamas", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇹", "flag: Bhutan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇻", "flag: Bouvet Island", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇼", "flag: Botswana", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇾", "flag: Belarus", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇧🇿", "flag: Belize", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇦", "flag: Canada", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇨", "flag: Cocos (Keeling) Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇩", "flag: Congo - Kinshasa", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇫", "flag: Central African Republic", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇬", "flag: Congo - Brazzaville", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇭", "flag: Switzerland", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇮", "flag: Côte d’Ivoire", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇰", "flag: Cook Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇱", "flag: Chile", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇲", "flag: Cameroon", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇳", "flag: China", 9, 99, "E0.6", FullyQualified, ""},
+	{"🇨🇴", "flag: Colombia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇵", "flag: Clipperton Island", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇷", "flag: Costa Rica", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇺", "flag: Cuba", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇻", "flag: Cape Verde", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇼", "flag: Curaçao", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇽", "flag: Christmas Island", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇾", "flag: Cyprus", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇨🇿", "flag: Czechia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇩🇪", "flag: Germany", 9, 99, "E0.6", FullyQualified, ""},
+	{"🇩🇬", "flag: Diego Garcia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇩🇯", "flag: Djibouti", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇩🇰", "flag: Denmark", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇩🇲", "flag: Dominica", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇩🇴", "flag: Dominican Republic", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇩🇿", "flag: Algeria", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇪🇦", "flag: Ceuta & Melilla", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇪🇨", "flag: Ecuador", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇪🇪", "flag: Estonia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇪🇬", "flag: Egypt", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇪🇭", "flag: Western Sahara", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇪🇷", "flag: Eritrea", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇪🇸", "flag: Spain", 9, 99, "E0.6", FullyQualified, ""},
+	{"🇪🇹", "flag: Ethiopia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇪🇺", "flag: European Union", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇫🇮", "flag: Finland", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇫🇯", "flag: Fiji", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇫🇰", "flag: Falkland Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇫🇲", "flag: Micronesia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇫🇴", "flag: Faroe Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇫🇷", "flag: France", 9, 99, "E0.6", FullyQualified, ""},
+	{"🇬🇦", "flag: Gabon", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇧", "flag: United Kingdom", 9, 99, "E0.6", FullyQualified, ""},
+	{"🇬🇩", "flag: Grenada", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇪", "flag: Georgia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇫", "flag: French Guiana", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇬", "flag: Guernsey", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇭", "flag: Ghana", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇮", "flag: Gibraltar", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇱", "flag: Greenland", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇲", "flag: Gambia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇳", "flag: Guinea", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇵", "flag: Guadeloupe", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇶", "flag: Equatorial Guinea", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇷", "flag: Greece", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇸", "flag: South Georgia & South Sandwich Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇹", "flag: Guatemala", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇺", "flag: Guam", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇼", "flag: Guinea-Bissau", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇬🇾", "flag: Guyana", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇭🇰", "flag: Hong Kong SAR China", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇭🇲", "flag: Heard & McDonald Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇭🇳", "flag: Honduras", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇭🇷", "flag: Croatia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇭🇹", "flag: Haiti", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇭🇺", "flag: Hungary", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇨", "flag: Canary Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇩", "flag: Indonesia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇪", "flag: Ireland", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇱", "flag: Israel", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇲", "flag: Isle of Man", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇳", "flag: India", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇴", "flag: British Indian Ocean Territory", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇶", "flag: Iraq", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇷", "flag: Iran", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇸", "flag: Iceland", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇮🇹", "flag: Italy", 9, 99, "E0.6", FullyQualified, ""},
+	{"🇯🇪", "flag: Jersey", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇯🇲", "flag: Jamaica", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇯🇴", "flag: Jordan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇯🇵", "flag: Japan", 9, 99, "E0.6", FullyQualified, ""},
+	{"🇰🇪", "flag: Kenya", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇰🇬", "flag: Kyrgyzstan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇰🇭", "flag: Cambodia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇰🇮", "flag: Kiribati", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇰🇲", "flag: Comoros", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇰🇳", "flag: St. Kitts & Nevis", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇰🇵", "flag: North Korea", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇰🇷", "flag: South Korea", 9, 99, "E0.6", FullyQualified, ""},
+	{"🇰🇼", "flag: Kuwait", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇰🇾", "flag: Cayman Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇰🇿", "flag: Kazakhstan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇦", "flag: Laos", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇧", "flag: Lebanon", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇨", "flag: St. Lucia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇮", "flag: Liechtenstein", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇰", "flag: Sri Lanka", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇷", "flag: Liberia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇸", "flag: Lesotho", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇹", "flag: Lithuania", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇺", "flag: Luxembourg", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇻", "flag: Latvia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇱🇾", "flag: Libya", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇦", "flag: Morocco", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇨", "flag: Monaco", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇩", "flag: Moldova", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇪", "flag: Montenegro", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇫", "flag: St. Martin", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇬", "flag: Madagascar", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇭", "flag: Marshall Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇰", "flag: North Macedonia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇱", "flag: Mali", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇲", "flag: Myanmar (Burma)", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇳", "flag: Mongolia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇴", "flag: Macao SAR China", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇵", "flag: Northern Mariana Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇶", "flag: Martinique", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇷", "flag: Mauritania", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇸", "flag: Montserrat", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇹", "flag: Malta", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇺", "flag: Mauritius", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇻", "flag: Maldives", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇼", "flag: Malawi", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇽", "flag: Mexico", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇾", "flag: Malaysia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇲🇿", "flag: Mozambique", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇦", "flag: Namibia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇨", "flag: New Caledonia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇪", "flag: Niger", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇫", "flag: Norfolk Island", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇬", "flag: Nigeria", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇮", "flag: Nicaragua", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇱", "flag: Netherlands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇴", "flag: Norway", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇵", "flag: Nepal", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇷", "flag: Nauru", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇺", "flag: Niue", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇳🇿", "flag: New Zealand", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇴🇲", "flag: Oman", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇦", "flag: Panama", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇪", "flag: Peru", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇫", "flag: French Polynesia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇬", "flag: Papua New Guinea", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇭", "flag: Philippines", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇰", "flag: Pakistan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇱", "flag: Poland", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇲", "flag: St. Pierre & Miquelon", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇳", "flag: Pitcairn Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇷", "flag: Puerto Rico", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇸", "flag: Palestinian Territories", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇹", "flag: Portugal", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇼", "flag: Palau", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇵🇾", "flag: Paraguay", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇶🇦", "flag: Qatar", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇷🇪", "flag: Réunion", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇷🇴", "flag: Romania", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇷🇸", "flag: Serbia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇷🇺", "flag: Russia", 9, 99, "E0.6", FullyQualified, ""},
+	{"🇷🇼", "flag: Rwanda", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇦", "flag: Saudi Arabia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇧", "flag: Solomon Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇨", "flag: Seychelles", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇩", "flag: Sudan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇪", "flag: Sweden", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇬", "flag: Singapore", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇭", "flag: St. Helena", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇮", "flag: Slovenia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇯", "flag: Svalbard & Jan Mayen", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇰", "flag: Slovakia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇱", "flag: Sierra Leone", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇲", "flag: San Marino", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇳", "flag: Senegal", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇴", "flag: Somalia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇷", "flag: Suriname", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇸", "flag: South Sudan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇹", "flag: São Tomé & Príncipe", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇻", "flag: El Salvador", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇽", "flag: Sint Maarten", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇾", "flag: Syria", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇸🇿", "flag: Eswatini", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇦", "flag: Tristan da Cunha", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇨", "flag: Turks & Caicos Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇩", "flag: Chad", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇫", "flag: French Southern Territories", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇬", "flag: Togo", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇭", "flag: Thailand", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇯", "flag: Tajikistan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇰", "flag: Tokelau", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇱", "flag: Timor-Leste", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇲", "flag: Turkmenistan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇳", "flag: Tunisia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇴", "flag: Tonga", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇷", "flag: Türkiye", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇹", "flag: Trinidad & Tobago", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇻", "flag: Tuvalu", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇼", "flag: Taiwan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇹🇿", "flag: Tanzania", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇺🇦", "flag: Ukraine", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇺🇬", "flag: Uganda", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇺🇲", "flag: U.S. Outlying Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇺🇳", "flag: United Nations", 9, 99, "E4.0", FullyQualified, ""},
+	{"🇺🇸", "flag: United States", 9, 99, "E0.6", FullyQualified, ""},
+	{"🇺🇾", "flag: Uruguay", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇺🇿", "flag: Uzbekistan", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇻🇦", "flag: Vatican City", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇻🇨", "flag: St. Vincent & Grenadines", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇻🇪", "flag: Venezuela", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇻🇬", "flag: British Virgin Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇻🇮", "flag: U.S. Virgin Islands", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇻🇳", "flag: Vietnam", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇻🇺", "flag: Vanuatu", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇼🇫", "flag: Wallis & Futuna", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇼🇸", "flag: Samoa", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇽🇰", "flag: Kosovo", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇾🇪", "flag: Yemen", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇾🇹", "flag: Mayotte", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇿🇦", "flag: South Africa", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇿🇲", "flag: Zambia", 9, 99, "E2.0", FullyQualified, ""},
+	{"🇿🇼", "flag: Zimbabwe", 9, 99, "E2.0", FullyQualified, ""},
+	{"🈁", "Japanese “here” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈂", "Japanese “service charge” button", 8, 96, "E0.6", Unqualified, "🈂️"},
+	{"🈂️", "Japanese “service charge” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈚", "Japanese “free of charge” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈯", "Japanese “reserved” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈲", "Japanese “prohibited” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈳", "Japanese “vacancy” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈴", "Japanese “passing grade” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈵", "Japanese “no vacancy” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈶", "Japanese “not free of charge” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈷", "Japanese “monthly amount” button", 8, 96, "E0.6", Unqualified, "🈷️"},
+	{"🈷️", "Japanese “monthly amount” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈸", "Japanese “application” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈹", "Japanese “discount” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🈺", "Japanese “open for business” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🉐", "Japanese “bargain” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🉑", "Japanese “acceptable” button", 8, 96, "E0.6", FullyQualified, ""},
+	{"🌀", "cyclone", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌁", "foggy", 5, 54, "E0.6", FullyQualified, ""},
+	{"🌂", "closed umbrella", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌃", "night with stars", 5, 54, "E0.6", FullyQualified, ""},
+	{"🌄", "sunrise over mountains", 5, 54, "E0.6", FullyQualified, ""},
+	{"🌅", "sunrise", 5, 54, "E0.6", FullyQualified, ""},
+	{"🌆", "cityscape at dusk", 5, 54, "E0.6", FullyQualified, ""},
+	{"🌇", "sunset", 5, 54, "E0.6", FullyQualified, ""},
+	{"🌈", "rainbow", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌉", "bridge at night", 5, 54, "E0.6", FullyQualified, ""},
+	{"🌊", "water wave", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌋", "volcano", 5, 51, "E0.6", FullyQualified, ""},
+	{"🌌", "milky way", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌍", "globe showing Europe-Africa", 5, 50, "E0.7", FullyQualified, ""},
+	{"🌎", "globe showing Americas", 5, 50, "E0.7", FullyQualified, ""},
+	{"🌏", "globe showing Asia-Australia", 5, 50, "E0.6", FullyQualified, ""},
+	{"🌐", "globe with meridians", 5, 50, "E1.0", FullyQualified, ""},
+	{"🌑", "new moon", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌒", "waxing crescent moon", 5, 60, "E1.0", FullyQualified, ""},
+	{"🌓", "first quarter moon", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌔", "waxing gibbous moon", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌕", "full moon", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌖", "waning gibbous moon", 5, 60, "E1.0", FullyQualified, ""},
+	{"🌗", "last quarter moon", 5, 60, "E1.0", FullyQualified, ""},
+	{"🌘", "waning crescent moon", 5, 60, "E1.0", FullyQualified, ""},
+	{"🌙", "crescent moon", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌚", "new moon face", 5, 60, "E1.0", FullyQualified, ""},
+	{"🌛", "first quarter moon face", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌜", "last quarter moon face", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌝", "full moon face", 5, 60, "E1.0", FullyQualified, ""},
+	{"🌞", "sun with face", 5, 60, "E1.0", FullyQualified, ""},
+	{"🌟", "glowing star", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌠", "shooting star", 5, 60, "E0.6", FullyQualified, ""},
+	{"🌡", "thermometer", 5, 60, "E0.7", Unqualified, "🌡️"},
+	{"🌡️", "thermometer", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌤", "sun behind small cloud", 5, 60, "E0.7", Unqualified, "🌤️"},
+	{"🌤️", "sun behind small cloud", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌥", "sun behind large cloud", 5, 60, "E0.7", Unqualified, "🌥️"},
+	{"🌥️", "sun behind large cloud", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌦", "sun behind rain cloud", 5, 60, "E0.7", Unqualified, "🌦️"},
+	{"🌦️", "sun behind rain cloud", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌧", "cloud with rain", 5, 60, "E0.7", Unqualified, "🌧️"},
+	{"🌧️", "cloud with rain", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌨", "cloud with snow", 5, 60, "E0.7", Unqualified, "🌨️"},
+	{"🌨️", "cloud with snow", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌩", "cloud with lightning", 5, 60, "E0.7", Unqualified, "🌩️"},
+	{"🌩️", "cloud with lightning", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌪", "tornado", 5, 60, "E0.7", Unqualified, "🌪️"},
+	{"🌪️", "tornado", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌫", "fog", 5, 60, "E0.7", Unqualified, "🌫️"},
+	{"🌫️", "fog", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌬", "wind face", 5, 60, "E0.7", Unqualified, "🌬️"},
+	{"🌬️", "wind face", 5, 60, "E0.7", FullyQualified, ""},
+	{"🌭", "hot dog", 4, 44, "E1.0", FullyQualified, ""},
+	{"🌮", "taco", 4, 44, "E1.0", FullyQualified, ""},
+	{"🌯", "burrito", 4, 44, "E1.0", FullyQualified, ""},
+	{"🌰", "chestnut", 4, 43, "E0.6", FullyQualified, ""},
+	{"🌱", "seedling", 3, 41, "E0.6", FullyQualified, ""},
+	{"🌲", "evergreen tree", 3, 41, "E1.0", FullyQualified, ""},
+	{"🌳", "deciduous tree", 3, 41, "E1.0", FullyQualified, ""},
+	{"🌴", "palm tree", 3, 41, "E0.6", FullyQualified, ""},
+	{"🌵", "cactus", 3, 41, "E0.6", FullyQualified, ""},
+	{"🌶", "hot pepper", 4, 43, "E0.7", Unqualified, "🌶️"},
+	{"🌶️", "hot pepper", 4, 43, "E0.7", FullyQualified, ""},
+	{"🌷", "tulip", 3, 40, "E0.6", FullyQualified, ""},
+	{"🌸", "cherry blossom", 3, 40, "E0.6", FullyQualified, ""},
+	{"🌹", "rose", 3, 40, "E0.6", FullyQualified, ""},
+	{"🌺", "hibiscus", 3, 40, "E0.6", FullyQualified, ""},
+	{"🌻", "sunflower", 3, 40, "E0.6", FullyQualified, ""},
+	{"🌼", "blossom", 3, 40, "E0.6", FullyQualified, ""},
+	{"🌽", "ear of corn", 4, 43, "E0.6", FullyQualified, ""},
+	{"🌾", "sheaf of rice", 3, 41, "E0.6", FullyQualified, ""},
+	{"🌿", "herb", 3, 41, "E0.6", FullyQualified, ""},
+	{"🍀", "four leaf clover", 3, 41, "E0.6", FullyQualified, ""},
+	{"🍁", "maple leaf", 3, 41, "E0.6", FullyQualified, ""},
+	{"🍂", "fallen leaf", 3, 41, "E0.6", FullyQualified, ""},
+	{"🍃", "leaf fluttering in wind", 3, 41, "E0.6", FullyQualified, ""},
+	{"🍄", "mushroom", 3, 41, "E0.6", FullyQualified, ""},
+	{"🍅", "tomato", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍆", "eggplant", 4, 43, "E0.6", FullyQualified, ""},
+	{"🍇", "grapes", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍈", "melon", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍉", "watermelon", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍊", "tangerine", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍋", "lemon", 4, 42, "E1.0", FullyQualified, ""},
+	{"🍌", "banana", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍍", "pineapple", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍎", "red apple", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍏", "green apple", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍐", "pear", 4, 42, "E1.0", FullyQualified, ""},
+	{"🍑", "peach", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍒", "cherries", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍓", "strawberry", 4, 42, "E0.6", FullyQualified, ""},
+	{"🍔", "hamburger", 4, 44, "E0.6", FullyQualified, ""},
+	{"🍕", "pizza", 4, 44, "E0.6", FullyQualified, ""},
+	{"🍖", "meat on bone", 4, 44, "E0.6", FullyQualified, ""},
+	{"🍗", "poultry leg", 4, 44, "E0.6", FullyQualified, ""},
+	{"🍘", "rice cracker", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍙", "rice ball", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍚", "cooked rice", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍛", "curry rice", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍜", "steaming bowl", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍝", "spaghetti", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍞", "bread", 4, 44, "E0.6", FullyQualified, ""},
+	{"🍟", "french fries", 4, 44, "E0.6", FullyQualified, ""},
+	{"🍠", "roasted sweet potato", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍡", "dango", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍢", "oden", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍣", "sushi", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍤", "fried shrimp", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍥", "fish cake with swirl", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍦", "soft ice cream", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍧", "shaved ice", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍨", "ice cream", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍩", "doughnut", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍪", "cookie", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍫", "chocolate bar", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍬", "candy", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍭", "lollipop", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍮", "custard", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍯", "honey pot", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍰", "shortcake", 4, 47, "E0.6", FullyQualified, ""},
+	{"🍱", "bento box", 4, 45, "E0.6", FullyQualified, ""},
+	{"🍲", "pot of food", 4, 44, "E0.6", FullyQualified, ""},
+	{"🍳", "cooking", 4, 44, "E0.6", FullyQualified, ""},
+	{"🍴", "fork and knife", 4, 49, "E0.6", FullyQualified, ""},
+	{"🍵", "teacup without handle", 4, 48, "E0.6", FullyQualified, ""},
+	{"🍶", "sake", 4, 48, "E0.6", FullyQualified, ""},
+	{"🍷", "wine glass", 4, 48, "E0.6", FullyQualified, ""},
+	{"🍸", "cocktail glass", 4, 48, "E0.6", FullyQualified, ""},
+	{"🍹", "tropical drink", 4, 48, "E0.6", FullyQualified, ""},
+	{"🍺", "beer mug", 4, 48, "E0.6", FullyQualified, ""},
+	{"🍻", "clinking beer mugs", 4, 48, "E0.6", FullyQualified, ""},
+	{"🍼", "baby bottle", 4, 48, "E1.0", FullyQualified, ""},
+	{"🍽", "fork and knife with plate", 4, 49, "E0.7", Unqualified, "🍽️"},
+	{"🍽️", "fork and knife with plate", 4, 49, "E0.7", FullyQualified, ""},
+	{"🍾", "bottle with popping cork", 4, 48, "E1.0", FullyQualified, ""},
+	{"🍿", "popcorn", 4, 44, "E1.0", FullyQualified, ""},
+	{"🎀", "ribbon", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎁", "wrapped gift", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎂", "birthday cake", 4, 47, "E0.6", FullyQualified, ""},
+	{"🎃", "jack-o-lantern", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎄", "Christmas tree", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎅", "Santa Claus", 1, 26, "E0.6", FullyQualified, ""},
+	{"🎅🏻", "Santa Claus: light skin tone", 1, 26, "E1.0", FullyQualified, ""},
+	{"🎅🏼", "Santa Claus: medium-light skin tone", 1, 26, "E1.0", FullyQualified, ""},
+	{"🎅🏽", "Santa Claus: medium skin tone", 1, 26, "E1.0", FullyQualified, ""},
+	{"🎅🏾", "Santa Claus: medium-dark skin tone", 1, 26, "E1.0", FullyQualified, ""},
+	{"🎅🏿", "Santa Claus: dark skin tone", 1, 26, "E1.0", FullyQualified, ""},
+	{"🎆", "fireworks", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎇", "sparkler", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎈", "balloon", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎉", "party popper", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎊", "confetti ball", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎋", "tanabata tree", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎌", "crossed flags", 9, 98, "E0.6", FullyQualified, ""},
+	{"🎍", "pine decoration", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎎", "Japanese dolls", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎏", "carp streamer", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎐", "wind chime", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎑", "moon viewing ceremony", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎒", "backpack", 7, 66, "E0.6", FullyQualified, ""},
+	{"🎓", "graduation cap", 7, 66, "E0.6", FullyQualified, ""},
+	{"🎖", "military medal", 6, 62, "E0.7", Unqualified, "🎖️"},
+	{"🎖️", "military medal", 6, 62, "E0.7", FullyQualified, ""},
+	{"🎗", "reminder ribbon", 6, 61, "E0.7", Unqualified, "🎗️"},
+	{"🎗️", "reminder ribbon", 6, 61, "E0.7", FullyQualified, ""},
+	{"🎙", "studio microphone", 7, 68, "E0.7", Unqualified, "🎙️"},
+	{"🎙️", "studio microphone", 7, 68, "E0.7", FullyQualified, ""},
+	{"🎚", "level slider", 7, 68, "E0.7", Unqualified, "🎚️"},
+	{"🎚️", "level slider", 7, 68, "E0.7", FullyQualified, ""},
+	{"🎛", "control knobs", 7, 68, "E0.7", Unqualified, "🎛️"},
+	{"🎛️", "control knobs", 7, 68, "E0.7", FullyQualified, ""},
+	{"🎞", "film frames", 7, 72, "E0.7", Unqualified, "🎞️"},
+	{"🎞️", "film frames", 7, 72, "E0.7", FullyQualified, ""},
+	{"🎟", "admission tickets", 6, 61, "E0.7", Unqualified, "🎟️"},
+	{"🎟️", "admission tickets", 6, 61, "E0.7", FullyQualified, ""},
+	{"🎠", "carousel horse", 5, 54, "E0.6", FullyQualified, ""},
+	{"🎡", "ferris wheel", 5, 54, "E0.6", FullyQualified, ""},
+	{"🎢", "roller coaster", 5, 54, "E0.6", FullyQualified, ""},
+	{"🎣", "fishing pole", 6, 63, "E0.6", FullyQualified, ""},
+	{"🎤", "microphone", 7, 68, "E0.6", FullyQualified, ""},
+	{"🎥", "movie camera", 7, 72, "E0.6", FullyQualified, ""},
+	{"🎦", "cinema", 8, 89, "E0.6", FullyQualified, ""},
+	{"🎧", "headphone", 7, 68, "E0.6", FullyQualified, ""},
+	{"🎨", "artist palette", 6, 65, "E0.6", FullyQualified, ""},
+	{"🎩", "top hat", 7, 66, "E0.6", FullyQualified, ""},
+	{"🎪", "circus tent", 5, 54, "E0.6", FullyQualified, ""},
+	{"🎫", "ticket", 6, 61, "E0.6", FullyQualified, ""},
+	{"🎬", "clapper board", 7, 72, "E0.6", FullyQualified, ""},
+	{"🎭", "performing arts", 6, 65, "E0.6", FullyQualified, ""},
+	{"🎮", "video game", 6, 64, "E0.6", FullyQualified, ""},
+	{"🎯", "bullseye", 6, 64, "E0.6", FullyQualified, ""},
+	{"🎰", "slot machine", 6, 64, "E0.6", FullyQualified, ""},
+	{"🎱", "pool 8 ball", 6, 64, "E0.6", FullyQualified, ""},
+	{"🎲", "game die", 6, 64, "E0.6", FullyQualified, ""},
+	{"🎳", "bowling", 6, 63, "E0.6", FullyQualified, ""},
+	{"🎴", "flower playing cards", 6, 64, "E0.6", FullyQualified, ""},
+	{"🎵", "musical note", 7, 68, "E0.6", FullyQualified, ""},
+	{"🎶", "musical notes", 7, 68, "E0.6", FullyQualified, ""},
+	{"🎷", "saxophone", 7, 69, "E0.6", FullyQualified, ""},
+	{"🎸", "guitar", 7, 69, "E0.6", FullyQualified, ""},
+	{"🎹", "musical keyboard", 7, 69, "E0.6", FullyQualified, ""},
+	{"🎺", "trumpet", 7, 69, "E0.6", FullyQualified, ""},
+	{"🎻", "violin", 7, 69, "E0.6", FullyQualified, ""},
+	{"🎼", "musical score", 7, 68, "E0.6", FullyQualified, ""},
+	{"🎽", "running shirt", 6, 63, "E0.6", FullyQualified, ""},
+	{"🎾", "tennis", 6, 63, "E0.6", FullyQualified, ""},
+	{"🎿", "skis", 6, 63, "E0.6", FullyQualified, ""},
+	{"🏀", "basketball", 6, 63, "E0.6", FullyQualified, ""},
+	{"🏁", "chequered flag", 9, 98, "E0.6", FullyQualified, ""},
+	{"🏂", "snowboarder", 1, 28, "E0.6", FullyQualified, ""},
+	{"🏂🏻", "snowboarder: light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏂🏼", "snowboarder: medium-light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏂🏽", "snowboarder: medium skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏂🏾", "snowboarder: medium-dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏂🏿", "snowboarder: dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏃", "person running", 1, 27, "E0.6", FullyQualified, ""},
+	{"🏃\u200d♀", "woman running", 1, 27, "E4.0", MinimallyQualified, "🏃\u200d♀️"},
+	{"🏃\u200d♀️", "woman running", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃\u200d♂", "man running", 1, 27, "E4.0", MinimallyQualified, "🏃\u200d♂️"},
+	{"🏃\u200d♂️", "man running", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃🏻", "person running: light skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"🏃🏻\u200d♀", "woman running: light skin tone", 1, 27, "E4.0", MinimallyQualified, "🏃🏻\u200d♀️"},
+	{"🏃🏻\u200d♀️", "woman running: light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃🏻\u200d♂", "man running: light skin tone", 1, 27, "E4.0", MinimallyQualified, "🏃🏻\u200d♂️"},
+	{"🏃🏻\u200d♂️", "man running: light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃🏼", "person running: medium-light skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"🏃🏼\u200d♀", "woman running: medium-light skin tone", 1, 27, "E4.0", MinimallyQualified, "🏃🏼\u200d♀️"},
+	{"🏃🏼\u200d♀️", "woman running: medium-light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃🏼\u200d♂", "man running: medium-light skin tone", 1, 27, "E4.0", MinimallyQualified, "🏃🏼\u200d♂️"},
+	{"🏃🏼\u200d♂️", "man running: medium-light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃🏽", "person running: medium skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"🏃🏽\u200d♀", "woman running: medium skin tone", 1, 27, "E4.0", MinimallyQualified, "🏃🏽\u200d♀️"},
+	{"🏃🏽\u200d♀️", "woman running: medium skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃🏽\u200d♂", "man running: medium skin tone", 1, 27, "E4.0", MinimallyQualified, "🏃🏽\u200d♂️"},
+	{"🏃🏽\u200d♂️", "man running: medium skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃🏾", "person running: medium-dark skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"🏃🏾\u200d♀", "woman running: medium-dark skin tone", 1, 27, "E4.0", MinimallyQualified, "🏃🏾\u200d♀️"},
+	{"🏃🏾\u200d♀️", "woman running: medium-dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃🏾\u200d♂", "man running: medium-dark skin tone", 1, 27, "E4.0", MinimallyQualified, "🏃🏾\u200d♂️"},
+	{"🏃🏾\u200d♂️", "man running: medium-dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃🏿", "person running: dark skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"🏃🏿\u200d♀", "woman running: dark skin tone", 1, 27, "E4.0", MinimallyQualified, "🏃🏿\u200d♀️"},
+	{"🏃🏿\u200d♀️", "woman running: dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏃🏿\u200d♂", "man running: dark skin tone", 1, 27, "E4.0", MinimallyQualified, "🏃🏿\u200d♂️"},
+	{"🏃🏿\u200d♂️", "man running: dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🏄", "person surfing", 1, 28, "E0.6", FullyQualified, ""},
+	{"🏄\u200d♀", "woman surfing", 1, 28, "E4.0", MinimallyQualified, "🏄\u200d♀️"},
+	{"🏄\u200d♀️", "woman surfing", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄\u200d♂", "man surfing", 1, 28, "E4.0", MinimallyQualified, "🏄\u200d♂️"},
+	{"🏄\u200d♂️", "man surfing", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄🏻", "person surfing: light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏄🏻\u200d♀", "woman surfing: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏄🏻\u200d♀️"},
+	{"🏄🏻\u200d♀️", "woman surfing: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄🏻\u200d♂", "man surfing: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏄🏻\u200d♂️"},
+	{"🏄🏻\u200d♂️", "man surfing: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄🏼", "person surfing: medium-light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏄🏼\u200d♀", "woman surfing: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏄🏼\u200d♀️"},
+	{"🏄🏼\u200d♀️", "woman surfing: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄🏼\u200d♂", "man surfing: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏄🏼\u200d♂️"},
+	{"🏄🏼\u200d♂️", "man surfing: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄🏽", "person surfing: medium skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏄🏽\u200d♀", "woman surfing: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🏄🏽\u200d♀️"},
+	{"🏄🏽\u200d♀️", "woman surfing: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄🏽\u200d♂", "man surfing: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🏄🏽\u200d♂️"},
+	{"🏄🏽\u200d♂️", "man surfing: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄🏾", "person surfing: medium-dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏄🏾\u200d♀", "woman surfing: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏄🏾\u200d♀️"},
+	{"🏄🏾\u200d♀️", "woman surfing: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄🏾\u200d♂", "man surfing: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏄🏾\u200d♂️"},
+	{"🏄🏾\u200d♂️", "man surfing: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄🏿", "person surfing: dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏄🏿\u200d♀", "woman surfing: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏄🏿\u200d♀️"},
+	{"🏄🏿\u200d♀️", "woman surfing: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏄🏿\u200d♂", "man surfing: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏄🏿\u200d♂️"},
+	{"🏄🏿\u200d♂️", "man surfing: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏅", "sports medal", 6, 62, "E1.0", FullyQualified, ""},
+	{"🏆", "trophy", 6, 62, "E0.6", FullyQualified, ""},
+	{"🏇", "horse racing", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏇🏻", "horse racing: light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏇🏼", "horse racing: medium-light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏇🏽", "horse racing: medium skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏇🏾", "horse racing: medium-dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏇🏿", "horse racing: dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏈", "american football", 6, 63, "E0.6", FullyQualified, ""},
+	{"🏉", "rugby football", 6, 63, "E1.0", FullyQualified, ""},
+	{"🏊", "person swimming", 1, 28, "E0.6", FullyQualified, ""},
+	{"🏊\u200d♀", "woman swimming", 1, 28, "E4.0", MinimallyQualified, "🏊\u200d♀️"},
+	{"🏊\u200d♀️", "woman swimming", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊\u200d♂", "man swimming", 1, 28, "E4.0", MinimallyQualified, "🏊\u200d♂️"},
+	{"🏊\u200d♂️", "man swimming", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊🏻", "person swimming: light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏊🏻\u200d♀", "woman swimming: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏊🏻\u200d♀️"},
+	{"🏊🏻\u200d♀️", "woman swimming: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊🏻\u200d♂", "man swimming: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏊🏻\u200d♂️"},
+	{"🏊🏻\u200d♂️", "man swimming: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊🏼", "person swimming: medium-light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏊🏼\u200d♀", "woman swimming: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏊🏼\u200d♀️"},
+	{"🏊🏼\u200d♀️", "woman swimming: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊🏼\u200d♂", "man swimming: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏊🏼\u200d♂️"},
+	{"🏊🏼\u200d♂️", "man swimming: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊🏽", "person swimming: medium skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏊🏽\u200d♀", "woman swimming: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🏊🏽\u200d♀️"},
+	{"🏊🏽\u200d♀️", "woman swimming: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊🏽\u200d♂", "man swimming: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🏊🏽\u200d♂️"},
+	{"🏊🏽\u200d♂️", "man swimming: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊🏾", "person swimming: medium-dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏊🏾\u200d♀", "woman swimming: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏊🏾\u200d♀️"},
+	{"🏊🏾\u200d♀️", "woman swimming: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊🏾\u200d♂", "man swimming: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏊🏾\u200d♂️"},
+	{"🏊🏾\u200d♂️", "man swimming: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊🏿", "person swimming: dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🏊🏿\u200d♀", "woman swimming: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏊🏿\u200d♀️"},
+	{"🏊🏿\u200d♀️", "woman swimming: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏊🏿\u200d♂", "man swimming: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏊🏿\u200d♂️"},
+	{"🏊🏿\u200d♂️", "man swimming: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋", "person lifting weights", 1, 28, "E0.7", Unqualified, "🏋️"},
+	{"🏋\u200d♀", "woman lifting weights", 1, 28, "E4.0", Unqualified, "🏋️\u200d♀️"},
+	{"🏋\u200d♀️", "woman lifting weights", 1, 28, "E4.0", Unqualified, "🏋️\u200d♀️"},
+	{"🏋\u200d♂", "man lifting weights", 1, 28, "E4.0", Unqualified, "🏋️\u200d♂️"},
+	{"🏋\u200d♂️", "man lifting weights", 1, 28, "E4.0", Unqualified, "🏋️\u200d♂️"},
+	{"🏋️", "person lifting weights", 1, 28, "E0.7", FullyQualified, ""},
+	{"🏋️\u200d♀", "woman lifting weights", 1, 28, "E4.0", MinimallyQualified, "🏋️\u200d♀️"},
+	{"🏋️\u200d♀️", "woman lifting weights", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋️\u200d♂", "man lifting weights", 1, 28, "E4.0", MinimallyQualified, "🏋️\u200d♂️"},
+	{"🏋️\u200d♂️", "man lifting weights", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋🏻", "person lifting weights: light skin tone", 1, 28, "E2.0", FullyQualified, ""},
+	{"🏋🏻\u200d♀", "woman lifting weights: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏋🏻\u200d♀️"},
+	{"🏋🏻\u200d♀️", "woman lifting weights: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋🏻\u200d♂", "man lifting weights: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏋🏻\u200d♂️"},
+	{"🏋🏻\u200d♂️", "man lifting weights: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋🏼", "person lifting weights: medium-light skin tone", 1, 28, "E2.0", FullyQualified, ""},
+	{"🏋🏼\u200d♀", "woman lifting weights: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏋🏼\u200d♀️"},
+	{"🏋🏼\u200d♀️", "woman lifting weights: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋🏼\u200d♂", "man lifting weights: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏋🏼\u200d♂️"},
+	{"🏋🏼\u200d♂️", "man lifting weights: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋🏽", "person lifting weights: medium skin tone", 1, 28, "E2.0", FullyQualified, ""},
+	{"🏋🏽\u200d♀", "woman lifting weights: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🏋🏽\u200d♀️"},
+	{"🏋🏽\u200d♀️", "woman lifting weights: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋🏽\u200d♂", "man lifting weights: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🏋🏽\u200d♂️"},
+	{"🏋🏽\u200d♂️", "man lifting weights: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋🏾", "person lifting weights: medium-dark skin tone", 1, 28, "E2.0", FullyQualified, ""},
+	{"🏋🏾\u200d♀", "woman lifting weights: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏋🏾\u200d♀️"},
+	{"🏋🏾\u200d♀️", "woman lifting weights: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋🏾\u200d♂", "man lifting weights: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏋🏾\u200d♂️"},
+	{"🏋🏾\u200d♂️", "man lifting weights: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋🏿", "person lifting weights: dark skin tone", 1, 28, "E2.0", FullyQualified, ""},
+	{"🏋🏿\u200d♀", "woman lifting weights: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏋🏿\u200d♀️"},
+	{"🏋🏿\u200d♀️", "woman lifting weights: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏋🏿\u200d♂", "man lifting weights: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏋🏿\u200d♂️"},
+	{"🏋🏿\u200d♂️", "man lifting weights: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌", "person golfing", 1, 28, "E0.7", Unqualified, "🏌️"},
+	{"🏌\u200d♀", "woman golfing", 1, 28, "E4.0", Unqualified, "🏌️\u200d♀️"},
+	{"🏌\u200d♀️", "woman golfing", 1, 28, "E4.0", Unqualified, "🏌️\u200d♀️"},
+	{"🏌\u200d♂", "man golfing", 1, 28, "E4.0", Unqualified, "🏌️\u200d♂️"},
+	{"🏌\u200d♂️", "man golfing", 1, 28, "E4.0", Unqualified, "🏌️\u200d♂️"},
+	{"🏌️", "person golfing", 1, 28, "E0.7", FullyQualified, ""},
+	{"🏌️\u200d♀", "woman golfing", 1, 28, "E4.0", MinimallyQualified, "🏌️\u200d♀️"},
+	{"🏌️\u200d♀️", "woman golfing", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌️\u200d♂", "man golfing", 1, 28, "E4.0", MinimallyQualified, "🏌️\u200d♂️"},
+	{"🏌️\u200d♂️", "man golfing", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏻", "person golfing: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏻\u200d♀", "woman golfing: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏌🏻\u200d♀️"},
+	{"🏌🏻\u200d♀️", "woman golfing: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏻\u200d♂", "man golfing: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏌🏻\u200d♂️"},
+	{"🏌🏻\u200d♂️", "man golfing: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏼", "person golfing: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏼\u200d♀", "woman golfing: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏌🏼\u200d♀️"},
+	{"🏌🏼\u200d♀️", "woman golfing: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏼\u200d♂", "man golfing: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🏌🏼\u200d♂️"},
+	{"🏌🏼\u200d♂️", "man golfing: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏽", "person golfing: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏽\u200d♀", "woman golfing: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🏌🏽\u200d♀️"},
+	{"🏌🏽\u200d♀️", "woman golfing: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏽\u200d♂", "man golfing: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🏌🏽\u200d♂️"},
+	{"🏌🏽\u200d♂️", "man golfing: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏾", "person golfing: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏾\u200d♀", "woman golfing: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏌🏾\u200d♀️"},
+	{"🏌🏾\u200d♀️", "woman golfing: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏾\u200d♂", "man golfing: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏌🏾\u200d♂️"},
+	{"🏌🏾\u200d♂️", "man golfing: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏿", "person golfing: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏿\u200d♀", "woman golfing: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏌🏿\u200d♀️"},
+	{"🏌🏿\u200d♀️", "woman golfing: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏌🏿\u200d♂", "man golfing: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🏌🏿\u200d♂️"},
+	{"🏌🏿\u200d♂️", "man golfing: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🏍", "motorcycle", 5, 55, "E0.7", Unqualified, "🏍️"},
+	{"🏍️", "motorcycle", 5, 55, "E0.7", FullyQualified, ""},
+	{"🏎", "racing car", 5, 55, "E0.7", Unqualified, "🏎️"},
+	{"🏎️", "racing car", 5, 55, "E0.7", FullyQualified, ""},
+	{"🏏", "cricket game", 6, 63, "E1.0", FullyQualified, ""},
+	{"🏐", "volleyball", 6, 63, "E1.0", FullyQualified, ""},
+	{"🏑", "field hockey", 6, 63, "E1.0", FullyQualified, ""},
+	{"🏒", "ice hockey", 6, 63, "E1.0", FullyQualified, ""},
+	{"🏓", "ping pong", 6, 63, "E1.0", FullyQualified, ""},
+	{"🏔", "snow-capped mountain", 5, 51, "E0.7", Unqualified, "🏔️"},
+	{"🏔️", "snow-capped mountain", 5, 51, "E0.7", FullyQualified, ""},
+	{"🏕", "camping", 5, 51, "E0.7", Unqualified, "🏕️"},
+	{"🏕️", "camping", 5, 51, "E0.7", FullyQualified, ""},
+	{"🏖", "beach with umbrella", 5, 51, "E0.7", Unqualified, "🏖️"},
+	{"🏖️", "beach with umbrella", 5, 51, "E0.7", FullyQualified, ""},
+	{"🏗", "building construction", 5, 52, "E0.7", Unqualified, "🏗️"},
+	{"🏗️", "building construction", 5, 52, "E0.7", FullyQualified, ""},
+	{"🏘", "houses", 5, 52, "E0.7", Unqualified, "🏘️"},
+	{"🏘️", "houses", 5, 52, "E0.7", FullyQualified, ""},
+	{"🏙", "cityscape", 5, 54, "E0.7", Unqualified, "🏙️"},
+	{"🏙️", "cityscape", 5, 54, "E0.7", FullyQualified, ""},
+	{"🏚", "derelict house", 5, 52, "E0.7", Unqualified, "🏚️"},
+	{"🏚️", "derelict house", 5, 52, "E0.7", FullyQualified, ""},
+	{"🏛", "classical building", 5, 52, "E0.7", Unqualified, "🏛️"},
+	{"🏛️", "classical building", 5, 52, "E0.7", FullyQualified, ""},
+	{"🏜", "desert", 5, 51, "E0.7", Unqualified, "🏜️"},
+	{"🏜️", "desert", 5, 51, "E0.7", FullyQualified, ""},
+	{"🏝", "desert island", 5, 51, "E0.7", Unqualified, "🏝️"},
+	{"🏝️", "desert island", 5, 51, "E0.7", FullyQualified, ""},
+	{"🏞", "national park", 5, 51, "E0.7", Unqualified, "🏞️"},
+	{"🏞️", "national park", 5, 51, "E0.7", FullyQualified, ""},
+	{"🏟", "stadium", 5, 52, "E0.7", Unqualified, "🏟️"},
+	{"🏟️", "stadium", 5, 52, "E0.7", FullyQualified, ""},
+	{"🏠", "house", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏡", "house with garden", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏢", "office building", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏣", "Japanese post office", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏤", "post office", 5, 52, "E1.0", FullyQualified, ""},
+	{"🏥", "hospital", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏦", "bank", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏧", "ATM sign", 8, 84, "E0.6", FullyQualified, ""},
+	{"🏨", "hotel", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏩", "love hotel", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏪", "convenience store", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏫", "school", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏬", "department store", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏭", "factory", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏮", "red paper lantern", 7, 72, "E0.6", FullyQualified, ""},
+	{"🏯", "Japanese castle", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏰", "castle", 5, 52, "E0.6", FullyQualified, ""},
+	{"🏳", "white flag", 9, 98, "E0.7", Unqualified, "🏳️"},
+	{"🏳\u200d⚧", "transgender flag", 9, 98, "E13.0", Unqualified, "🏳️\u200d⚧️"},
+	{"🏳\u200d⚧️", "transgender flag", 9, 98, "E13.0", Unqualified, "🏳️\u200d⚧️"},
+	{"🏳\u200d🌈", "rainbow flag", 9, 98, "E4.0", Unqualified, "🏳️\u200d🌈"},
+	{"🏳️", "white flag", 9, 98, "E0.7", FullyQualified, ""},
+	{"🏳️\u200d⚧", "transgender flag", 9, 98, "E13.0", MinimallyQualified, "🏳️\u200d⚧️"},
+	{"🏳️\u200d⚧️", "transgender flag", 9, 98, "E13.0", FullyQualified, ""},
+	{"🏳️\u200d🌈", "rainbow flag", 9, 98, "E4.0", FullyQualified, ""},
+	{"🏴", "black flag", 9, 98, "E1.0", FullyQualified, ""},
+	{"🏴\u200d☠", "pirate flag", 9, 98, "E11.0", MinimallyQualified, "🏴\u200d☠️"},
+	{"🏴\u200d☠️", "pirate flag", 9, 98, "E11.0", FullyQualified, ""},
+	{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "flag: England", 9, 100, "E5.0", FullyQualified, ""},
+	{"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "flag: Scotland", 9, 100, "E5.0", FullyQualified, ""},
+	{"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", "flag: Wales", 9, 100, "E5.0", FullyQualified, ""},
+	{"🏵", "rosette", 3, 40, "E0.7", Unqualified, "🏵️"},
+	{"🏵️", "rosette", 3, 40, "E0.7", FullyQualified, ""},
+	{"🏷", "label", 7, 73, "E0.7", Unqualified, "🏷️"},
+	{"🏷️", "label", 7, 73, "E0.7", FullyQualified, ""},
+	{"🏸", "badminton", 6, 63, "E1.0", FullyQualified, ""},
+	{"🏹", "bow and arrow", 7, 79, "E1.0", FullyQualified, ""},
+	{"🏺", "amphora", 4, 49, "E1.0", FullyQualified, ""},
+	{"🏻", "light skin tone", 2, 32, "E1.0", Component, ""},
+	{"🏼", "medium-light skin tone", 2, 32, "E1.0", Component, ""},
+	{"🏽", "medium skin tone", 2, 32, "E1.0", Component, ""},
+	{"🏾", "medium-dark skin tone", 2, 32, "E1.0", Component, ""},
+	{"🏿", "dark skin tone", 2, 32, "E1.0", Component, ""},
+	{"🐀", "rat", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐁", "mouse", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐂", "ox", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐃", "water buffalo", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐄", "cow", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐅", "tiger", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐆", "leopard", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐇", "rabbit", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐈", "cat", 3, 34, "E0.7", FullyQualified, ""},
+	{"🐈\u200d⬛", "black cat", 3, 34, "E13.0", FullyQualified, ""},
+	{"🐉", "dragon", 3, 37, "E1.0", FullyQualified, ""},
+	{"🐊", "crocodile", 3, 37, "E1.0", FullyQualified, ""},
+	{"🐋", "whale", 3, 38, "E1.0", FullyQualified, ""},
+	{"🐌", "snail", 3, 39, "E0.6", FullyQualified, ""},
+	{"🐍", "snake", 3, 37, "E0.6", FullyQualified, ""},
+	{"🐎", "horse", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐏", "ram", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐐", "goat", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐑", "ewe", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐒", "monkey", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐓", "rooster", 3, 35, "E1.0", FullyQualified, ""},
+	{"🐔", "chicken", 3, 35, "E0.6", FullyQualified, ""},
+	{"🐕", "dog", 3, 34, "E0.7", FullyQualified, ""},
+	{"🐕\u200d🦺", "service dog", 3, 34, "E12.0", FullyQualified, ""},
+	{"🐖", "pig", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐗", "boar", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐘", "elephant", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐙", "octopus", 3, 38, "E0.6", FullyQualified, ""},
+	{"🐚", "spiral shell", 3, 38, "E0.6", FullyQualified, ""},
+	{"🐛", "bug", 3, 39, "E0.6", FullyQualified, ""},
+	{"🐜", "ant", 3, 39, "E0.6", FullyQualified, ""},
+	{"🐝", "honeybee", 3, 39, "E0.6", FullyQualified, ""},
+	{"🐞", "lady beetle", 3, 39, "E0.6", FullyQualified, ""},
+	{"🐟", "fish", 3, 38, "E0.6", FullyQualified, ""},
+	{"🐠", "tropical fish", 3, 38, "E0.6", FullyQualified, ""},
+	{"🐡", "blowfish", 3, 38, "E0.6", FullyQualified, ""},
+	{"🐢", "turtle", 3, 37, "E0.6", FullyQualified, ""},
+	{"🐣", "hatching chick", 3, 35, "E0.6", FullyQualified, ""},
+	{"🐤", "baby chick", 3, 35, "E0.6", FullyQualified, ""},
+	{"🐥", "front-facing baby chick", 3, 35, "E0.6", FullyQualified, ""},
+	{"🐦", "bird", 3, 35, "E0.6", FullyQualified, ""},
+	{"🐧", "penguin", 3, 35, "E0.6", FullyQualified, ""},
+	{"🐨", "koala", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐩", "poodle", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐪", "camel", 3, 34, "E1.0", FullyQualified, ""},
+	{"🐫", "two-hump camel", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐬", "dolphin", 3, 38, "E0.6", FullyQualified, ""},
+	{"🐭", "mouse face", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐮", "cow face", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐯", "tiger face", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐰", "rabbit face", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐱", "cat face", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐲", "dragon face", 3, 37, "E0.6", FullyQualified, ""},
+	{"🐳", "spouting whale", 3, 38, "E0.6", FullyQualified, ""},
+	{"🐴", "horse face", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐵", "monkey face", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐶", "dog face", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐷", "pig face", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐸", "frog", 3, 36, "E0.6", FullyQualified, ""},
+	{"🐹", "hamster", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐺", "wolf", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐻", "bear", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐻\u200d❄", "polar bear", 3, 34, "E13.0", MinimallyQualified, "🐻\u200d❄️"},
+	{"🐻\u200d❄️", "polar bear", 3, 34, "E13.0", FullyQualified, ""},
+	{"🐼", "panda", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐽", "pig nose", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐾", "paw prints", 3, 34, "E0.6", FullyQualified, ""},
+	{"🐿", "chipmunk", 3, 34, "E0.7", Unqualified, "🐿️"},
+	{"🐿️", "chipmunk", 3, 34, "E0.7", FullyQualified, ""},
+	{"👀", "eyes", 1, 22, "E0.6", FullyQualified, ""},
+	{"👁", "eye", 1, 22, "E0.7", Unqualified, "👁️"},
+	{"👁\u200d🗨", "eye in speech bubble", 0, 15, "E2.0", Unqualified, "👁️\u200d🗨️"},
+	{"👁\u200d🗨️", "eye in speech bubble", 0, 15, "E2.0", Unqualified, "👁️\u200d🗨️"},
+	{"👁️", "eye", 1, 22, "E0.7", FullyQualified, ""},
+	{"👁️\u200d🗨", "eye in speech bubble", 0, 15, "E2.0", MinimallyQualified, "👁️\u200d🗨️"},
+	{"👁️\u200d🗨️", "eye in speech bubble", 0, 15, "E2.0", FullyQualified, ""},
+	{"👂", "ear", 1, 22, "E0.6", FullyQualified, ""},
+	{"👂🏻", "ear: light skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"👂🏼", "ear: medium-light skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"👂🏽", "ear: medium skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"👂🏾", "ear: medium-dark skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"👂🏿", "ear: dark skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"👃", "nose", 1, 22, "E0.6", FullyQualified, ""},
+	{"👃🏻", "nose: light skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"👃🏼", "nose: medium-light skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"👃🏽", "nose: medium skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"👃🏾", "nose: medium-dark skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"👃🏿", "nose: dark skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"👄", "mouth", 1, 22, "E0.6", FullyQualified, ""},
+	{"👅", "tongue", 1, 22, "E0.6", FullyQualified, ""},
+	{"👆", "backhand index pointing up", 1, 18, "E0.6", FullyQualified, ""},
+	{"👆🏻", "backhand index pointing up: light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👆🏼", "backhand index pointing up: medium-light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👆🏽", "backhand index pointing up: medium skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👆🏾", "backhand index pointing up: medium-dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👆🏿", "backhand index pointing up: dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👇", "backhand index pointing down", 1, 18, "E0.6", FullyQualified, ""},
+	{"👇🏻", "backhand index pointing down: light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👇🏼", "backhand index pointing down: medium-light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👇🏽", "backhand index pointing down: medium skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👇🏾", "backhand index pointing down: medium-dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👇🏿", "backhand index pointing down: dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👈", "backhand index pointing left", 1, 18, "E0.6", FullyQualified, ""},
+	{"👈🏻", "backhand index pointing left: light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👈🏼", "backhand index pointing left: medium-light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👈🏽", "backhand index pointing left: medium skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👈🏾", "backhand index pointing left: medium-dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👈🏿", "backhand index pointing left: dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👉", "backhand index pointing right", 1, 18, "E0.6", FullyQualified, ""},
+	{"👉🏻", "backhand index pointing right: light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👉🏼", "backhand index pointing right: medium-light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👉🏽", "backhand index pointing right: medium skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👉🏾", "backhand index pointing right: medium-dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👉🏿", "backhand index pointing right: dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"👊", "oncoming fist", 1, 19, "E0.6", FullyQualified, ""},
+	{"👊🏻", "oncoming fist: light skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👊🏼", "oncoming fist: medium-light skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👊🏽", "oncoming fist: medium skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👊🏾", "oncoming fist: medium-dark skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👊🏿", "oncoming fist: dark skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👋", "waving hand", 1, 16, "E0.6", FullyQualified, ""},
+	{"👋🏻", "waving hand: light skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"👋🏼", "waving hand: medium-light skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"👋🏽", "waving hand: medium skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"👋🏾", "waving hand: medium-dark skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"👋🏿", "waving hand: dark skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"👌", "OK hand", 1, 17, "E0.6", FullyQualified, ""},
+	{"👌🏻", "OK hand: light skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"👌🏼", "OK hand: medium-light skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"👌🏽", "OK hand: medium skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"👌🏾", "OK hand: medium-dark skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"👌🏿", "OK hand: dark skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"👍", "thumbs up", 1, 19, "E0.6", FullyQualified, ""},
+	{"👍🏻", "thumbs up: light skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👍🏼", "thumbs up: medium-light skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👍🏽", "thumbs up: medium skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👍🏾", "thumbs up: medium-dark skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👍🏿", "thumbs up: dark skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👎", "thumbs down", 1, 19, "E0.6", FullyQualified, ""},
+	{"👎🏻", "thumbs down: light skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👎🏼", "thumbs down: medium-light skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👎🏽", "thumbs down: medium skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👎🏾", "thumbs down: medium-dark skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👎🏿", "thumbs down: dark skin tone", 1, 19, "E1.0", FullyQualified, ""},
+	{"👏", "clapping hands", 1, 20, "E0.6", FullyQualified, ""},
+	{"👏🏻", "clapping hands: light skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"👏🏼", "clapping hands: medium-light skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"👏🏽", "clapping hands: medium skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"👏🏾", "clapping hands: medium-dark skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"👏🏿", "clapping hands: dark skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"👐", "open hands", 1, 20, "E0.6", FullyQualified, ""},
+	{"👐🏻", "open hands: light skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"👐🏼", "open hands: medium-light skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"👐🏽", "open hands: medium skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"👐🏾", "open hands: medium-dark skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"👐🏿", "open hands: dark skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"👑", "crown", 7, 66, "E0.6", FullyQualified, ""},
+	{"👒", "woman’s hat", 7, 66, "E0.6", FullyQualified, ""},
+	{"👓", "glasses", 7, 66, "E0.6", FullyQualified, ""},
+	{"👔", "necktie", 7, 66, "E0.6", FullyQualified, ""},
+	{"👕", "t-shirt", 7, 66, "E0.6", FullyQualified, ""},
+	{"👖", "jeans", 7, 66, "E0.6", FullyQualified, ""},
+	{"👗", "dress", 7, 66, "E0.6", FullyQualified, ""},
+	{"👘", "kimono", 7, 66, "E0.6", FullyQualified, ""},
+	{"👙", "bikini", 7, 66, "E0.6", FullyQualified, ""},
+	{"👚", "woman’s clothes", 7, 66, "E0.6", FullyQualified, ""},
+	{"👛", "purse", 7, 66, "E0.6", FullyQualified, ""},
+	{"👜", "handbag", 7, 66, "E0.6", FullyQualified, ""},
+	{"👝", "clutch bag", 7, 66, "E0.6", FullyQualified, ""},
+	{"👞", "man’s shoe", 7, 66, "E0.6", FullyQualified, ""},
+	{"👟", "running shoe", 7, 66, "E0.6", FullyQualified, ""},
+	{"👠", "high-heeled shoe", 7, 66, "E0.6", FullyQualified, ""},
+	{"👡", "woman’s sandal", 7, 66, "E0.6", FullyQualified, ""},
+	{"👢", "woman’s boot", 7, 66, "E0.6", FullyQualified, ""},
+	{"👣", "footprints", 1, 31, "E0.6", FullyQualified, ""},
+	{"👤", "bust in silhouette", 1, 31, "E0.6", FullyQualified, ""},
+	{"👥", "busts in silhouette", 1, 31, "E1.0", FullyQualified, ""},
+	{"👦", "boy", 1, 23, "E0.6", FullyQualified, ""},
+	{"👦🏻", "boy: light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👦🏼", "boy: medium-light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👦🏽", "boy: medium skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👦🏾", "boy: medium-dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👦🏿", "boy: dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👧", "girl", 1, 23, "E0.6", FullyQualified, ""},
+	{"👧🏻", "girl: light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👧🏼", "girl: medium-light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👧🏽", "girl: medium skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👧🏾", "girl: medium-dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👧🏿", "girl: dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👨", "man", 1, 23, "E0.6", FullyQualified, ""},
+	{"👨\u200d⚕", "man health worker", 1, 25, "E4.0", MinimallyQualified, "👨\u200d⚕️"},
+	{"👨\u200d⚕️", "man health worker", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d⚖", "man judge", 1, 25, "E4.0", MinimallyQualified, "👨\u200d⚖️"},
+	{"👨\u200d⚖️", "man judge", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d✈", "man pilot", 1, 25, "E4.0", MinimallyQualified, "👨\u200d✈️"},
+	{"👨\u200d✈️", "man pilot", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d❤\u200d👨", "couple with heart: man, man", 1, 30, "E2.0", MinimallyQualified, "👨\u200d❤️\u200d👨"},
+	{"👨\u200d❤\u200d💋\u200d👨", "kiss: man, man", 1, 30, "E2.0", MinimallyQualified, "👨\u200d❤️\u200d💋\u200d👨"},
+	{"👨\u200d❤️\u200d👨", "couple with heart: man, man", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d❤️\u200d💋\u200d👨", "kiss: man, man", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d🌾", "man farmer", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🍳", "man cook", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🍼", "man feeding baby", 1, 25, "E13.0", FullyQualified, ""},
+	{"👨\u200d🎓", "man student", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🎤", "man singer", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🎨", "man artist", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🏫", "man teacher", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🏭", "man factory worker", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d👦", "family: man, boy", 1, 30, "E4.0", FullyQualified, ""},
+	{"👨\u200d👦\u200d👦", "family: man, boy, boy", 1, 30, "E4.0", FullyQualified, ""},
+	{"👨\u200d👧", "family: man, girl", 1, 30, "E4.0", FullyQualified, ""},
+	{"👨\u200d👧\u200d👦", "family: man, girl, boy", 1, 30, "E4.0", FullyQualified, ""},
+	{"👨\u200d👧\u200d👧", "family: man, girl, girl", 1, 30, "E4.0", FullyQualified, ""},
+	{"👨\u200d👨\u200d👦", "family: man, man, boy", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d👨\u200d👦\u200d👦", "family: man, man, boy, boy", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d👨\u200d👧", "family: man, man, girl", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d👨\u200d👧\u200d👦", "family: man, man, girl, boy", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d👨\u200d👧\u200d👧", "family: man, man, girl, girl", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d👩\u200d👦", "family: man, woman, boy", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d👩\u200d👦\u200d👦", "family: man, woman, boy, boy", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d👩\u200d👧", "family: man, woman, girl", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d👩\u200d👧\u200d👦", "family: man, woman, girl, boy", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d👩\u200d👧\u200d👧", "family: man, woman, girl, girl", 1, 30, "E2.0", FullyQualified, ""},
+	{"👨\u200d💻", "man technologist", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d💼", "man office worker", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🔧", "man mechanic", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🔬", "man scientist", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🚀", "man astronaut", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🚒", "man firefighter", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨\u200d🦯", "man with white cane", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨\u200d🦰", "man: red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨\u200d🦱", "man: curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨\u200d🦲", "man: bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨\u200d🦳", "man: white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨\u200d🦼", "man in motorized wheelchair", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨\u200d🦽", "man in manual wheelchair", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏻", "man: light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👨🏻\u200d⚕", "man health worker: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏻\u200d⚕️"},
+	{"👨🏻\u200d⚕️", "man health worker: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d⚖", "man judge: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏻\u200d⚖️"},
+	{"👨🏻\u200d⚖️", "man judge: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d✈", "man pilot: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏻\u200d✈️"},
+	{"👨🏻\u200d✈️", "man pilot: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🌾", "man farmer: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🍳", "man cook: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🍼", "man feeding baby: light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👨🏻\u200d🎓", "man student: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🎤", "man singer: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🎨", "man artist: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🏫", "man teacher: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🏭", "man factory worker: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d💻", "man technologist: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d💼", "man office worker: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🔧", "man mechanic: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🔬", "man scientist: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🚀", "man astronaut: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🚒", "man firefighter: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏻\u200d🤝\u200d👨🏼", "men holding hands: light skin tone, medium-light skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👨🏻\u200d🤝\u200d👨🏽", "men holding hands: light skin tone, medium skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👨🏻\u200d🤝\u200d👨🏾", "men holding hands: light skin tone, medium-dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👨🏻\u200d🤝\u200d👨🏿", "men holding hands: light skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👨🏻\u200d🦯", "man with white cane: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏻\u200d🦰", "man: light skin tone, red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏻\u200d🦱", "man: light skin tone, curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏻\u200d🦲", "man: light skin tone, bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏻\u200d🦳", "man: light skin tone, white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏻\u200d🦼", "man in motorized wheelchair: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏻\u200d🦽", "man in manual wheelchair: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏼", "man: medium-light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👨🏼\u200d⚕", "man health worker: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏼\u200d⚕️"},
+	{"👨🏼\u200d⚕️", "man health worker: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d⚖", "man judge: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏼\u200d⚖️"},
+	{"👨🏼\u200d⚖️", "man judge: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d✈", "man pilot: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏼\u200d✈️"},
+	{"👨🏼\u200d✈️", "man pilot: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🌾", "man farmer: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🍳", "man cook: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🍼", "man feeding baby: medium-light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👨🏼\u200d🎓", "man student: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🎤", "man singer: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🎨", "man artist: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🏫", "man teacher: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🏭", "man factory worker: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d💻", "man technologist: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d💼", "man office worker: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🔧", "man mechanic: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🔬", "man scientist: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🚀", "man astronaut: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🚒", "man firefighter: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏼\u200d🤝\u200d👨🏻", "men holding hands: medium-light skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👨🏼\u200d🤝\u200d👨🏽", "men holding hands: medium-light skin tone, medium skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👨🏼\u200d🤝\u200d👨🏾", "men holding hands: medium-light skin tone, medium-dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👨🏼\u200d🤝\u200d👨🏿", "men holding hands: medium-light skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👨🏼\u200d🦯", "man with white cane: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏼\u200d🦰", "man: medium-light skin tone, red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏼\u200d🦱", "man: medium-light skin tone, curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏼\u200d🦲", "man: medium-light skin tone, bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏼\u200d🦳", "man: medium-light skin tone, white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏼\u200d🦼", "man in motorized wheelchair: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏼\u200d🦽", "man in manual wheelchair: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏽", "man: medium skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👨🏽\u200d⚕", "man health worker: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏽\u200d⚕️"},
+	{"👨🏽\u200d⚕️", "man health worker: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d⚖", "man judge: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏽\u200d⚖️"},
+	{"👨🏽\u200d⚖️", "man judge: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d✈", "man pilot: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏽\u200d✈️"},
+	{"👨🏽\u200d✈️", "man pilot: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🌾", "man farmer: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🍳", "man cook: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🍼", "man feeding baby: medium skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👨🏽\u200d🎓", "man student: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🎤", "man singer: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🎨", "man artist: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🏫", "man teacher: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🏭", "man factory worker: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d💻", "man technologist: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d💼", "man office worker: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🔧", "man mechanic: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🔬", "man scientist: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🚀", "man astronaut: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🚒", "man firefighter: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏽\u200d🤝\u200d👨🏻", "men holding hands: medium skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👨🏽\u200d🤝\u200d👨🏼", "men holding hands: medium skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👨🏽\u200d🤝\u200d👨🏾", "men holding hands: medium skin tone, medium-dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👨🏽\u200d🤝\u200d👨🏿", "men holding hands: medium skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👨🏽\u200d🦯", "man with white cane: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏽\u200d🦰", "man: medium skin tone, red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏽\u200d🦱", "man: medium skin tone, curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏽\u200d🦲", "man: medium skin tone, bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏽\u200d🦳", "man: medium skin tone, white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏽\u200d🦼", "man in motorized wheelchair: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏽\u200d🦽", "man in manual wheelchair: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏾", "man: medium-dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👨🏾\u200d⚕", "man health worker: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏾\u200d⚕️"},
+	{"👨🏾\u200d⚕️", "man health worker: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d⚖", "man judge: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏾\u200d⚖️"},
+	{"👨🏾\u200d⚖️", "man judge: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d✈", "man pilot: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏾\u200d✈️"},
+	{"👨🏾\u200d✈️", "man pilot: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🌾", "man farmer: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🍳", "man cook: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🍼", "man feeding baby: medium-dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👨🏾\u200d🎓", "man student: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🎤", "man singer: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🎨", "man artist: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🏫", "man teacher: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🏭", "man factory worker: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d💻", "man technologist: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d💼", "man office worker: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🔧", "man mechanic: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🔬", "man scientist: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🚀", "man astronaut: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🚒", "man firefighter: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏾\u200d🤝\u200d👨🏻", "men holding hands: medium-dark skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👨🏾\u200d🤝\u200d👨🏼", "men holding hands: medium-dark skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👨🏾\u200d🤝\u200d👨🏽", "men holding hands: medium-dark skin tone, medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👨🏾\u200d🤝\u200d👨🏿", "men holding hands: medium-dark skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👨🏾\u200d🦯", "man with white cane: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏾\u200d🦰", "man: medium-dark skin tone, red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏾\u200d🦱", "man: medium-dark skin tone, curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏾\u200d🦲", "man: medium-dark skin tone, bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏾\u200d🦳", "man: medium-dark skin tone, white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏾\u200d🦼", "man in motorized wheelchair: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏾\u200d🦽", "man in manual wheelchair: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏿", "man: dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👨🏿\u200d⚕", "man health worker: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏿\u200d⚕️"},
+	{"👨🏿\u200d⚕️", "man health worker: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d⚖", "man judge: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏿\u200d⚖️"},
+	{"👨🏿\u200d⚖️", "man judge: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d✈", "man pilot: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👨🏿\u200d✈️"},
+	{"👨🏿\u200d✈️", "man pilot: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🌾", "man farmer: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🍳", "man cook: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🍼", "man feeding baby: dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👨🏿\u200d🎓", "man student: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🎤", "man singer: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🎨", "man artist: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🏫", "man teacher: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🏭", "man factory worker: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d💻", "man technologist: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d💼", "man office worker: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🔧", "man mechanic: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🔬", "man scientist: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🚀", "man astronaut: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🚒", "man firefighter: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👨🏿\u200d🤝\u200d👨🏻", "men holding hands: dark skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👨🏿\u200d🤝\u200d👨🏼", "men holding hands: dark skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👨🏿\u200d🤝\u200d👨🏽", "men holding hands: dark skin tone, medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👨🏿\u200d🤝\u200d👨🏾", "men holding hands: dark skin tone, medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👨🏿\u200d🦯", "man with white cane: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏿\u200d🦰", "man: dark skin tone, red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏿\u200d🦱", "man: dark skin tone, curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏿\u200d🦲", "man: dark skin tone, bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏿\u200d🦳", "man: dark skin tone, white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👨🏿\u200d🦼", "man in motorized wheelchair: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👨🏿\u200d🦽", "man in manual wheelchair: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩", "woman", 1, 23, "E0.6", FullyQualified, ""},
+	{"👩\u200d⚕", "woman health worker", 1, 25, "E4.0", MinimallyQualified, "👩\u200d⚕️"},
+	{"👩\u200d⚕️", "woman health worker", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d⚖", "woman judge", 1, 25, "E4.0", MinimallyQualified, "👩\u200d⚖️"},
+	{"👩\u200d⚖️", "woman judge", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d✈", "woman pilot", 1, 25, "E4.0", MinimallyQualified, "👩\u200d✈️"},
+	{"👩\u200d✈️", "woman pilot", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d❤\u200d👨", "couple with heart: woman, man", 1, 30, "E2.0", MinimallyQualified, "👩\u200d❤️\u200d👨"},
+	{"👩\u200d❤\u200d👩", "couple with heart: woman, woman", 1, 30, "E2.0", MinimallyQualified, "👩\u200d❤️\u200d👩"},
+	{"👩\u200d❤\u200d💋\u200d👨", "kiss: woman, man", 1, 30, "E2.0", MinimallyQualified, "👩\u200d❤️\u200d💋\u200d👨"},
+	{"👩\u200d❤\u200d💋\u200d👩", "kiss: woman, woman", 1, 30, "E2.0", MinimallyQualified, "👩\u200d❤️\u200d💋\u200d👩"},
+	{"👩\u200d❤️\u200d👨", "couple with heart: woman, man", 1, 30, "E2.0", FullyQualified, ""},
+	{"👩\u200d❤️\u200d👩", "couple with heart: woman, woman", 1, 30, "E2.0", FullyQualified, ""},
+	{"👩\u200d❤️\u200d💋\u200d👨", "kiss: woman, man", 1, 30, "E2.0", FullyQualified, ""},
+	{"👩\u200d❤️\u200d💋\u200d👩", "kiss: woman, woman", 1, 30, "E2.0", FullyQualified, ""},
+	{"👩\u200d🌾", "woman farmer", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🍳", "woman cook", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🍼", "woman feeding baby", 1, 25, "E13.0", FullyQualified, ""},
+	{"👩\u200d🎓", "woman student", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🎤", "woman singer", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🎨", "woman artist", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🏫", "woman teacher", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🏭", "woman factory worker", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d👦", "family: woman, boy", 1, 30, "E4.0", FullyQualified, ""},
+	{"👩\u200d👦\u200d👦", "family: woman, boy, boy", 1, 30, "E4.0", FullyQualified, ""},
+	{"👩\u200d👧", "family: woman, girl", 1, 30, "E4.0", FullyQualified, ""},
+	{"👩\u200d👧\u200d👦", "family: woman, girl, boy", 1, 30, "E4.0", FullyQualified, ""},
+	{"👩\u200d👧\u200d👧", "family: woman, girl, girl", 1, 30, "E4.0", FullyQualified, ""},
+	{"👩\u200d👩\u200d👦", "family: woman, woman, boy", 1, 30, "E2.0", FullyQualified, ""},
+	{"👩\u200d👩\u200d👦\u200d👦", "family: woman, woman, boy, boy", 1, 30, "E2.0", FullyQualified, ""},
+	{"👩\u200d👩\u200d👧", "family: woman, woman, girl", 1, 30, "E2.0", FullyQualified, ""},
+	{"👩\u200d👩\u200d👧\u200d👦", "family: woman, woman, girl, boy", 1, 30, "E2.0", FullyQualified, ""},
+	{"👩\u200d👩\u200d👧\u200d👧", "family: woman, woman, girl, girl", 1, 30, "E2.0", FullyQualified, ""},
+	{"👩\u200d💻", "woman technologist", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d💼", "woman office worker", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🔧", "woman mechanic", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🔬", "woman scientist", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🚀", "woman astronaut", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🚒", "woman firefighter", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩\u200d🦯", "woman with white cane", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩\u200d🦰", "woman: red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩\u200d🦱", "woman: curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩\u200d🦲", "woman: bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩\u200d🦳", "woman: white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩\u200d🦼", "woman in motorized wheelchair", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩\u200d🦽", "woman in manual wheelchair", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏻", "woman: light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👩🏻\u200d⚕", "woman health worker: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏻\u200d⚕️"},
+	{"👩🏻\u200d⚕️", "woman health worker: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d⚖", "woman judge: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏻\u200d⚖️"},
+	{"👩🏻\u200d⚖️", "woman judge: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d✈", "woman pilot: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏻\u200d✈️"},
+	{"👩🏻\u200d✈️", "woman pilot: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🌾", "woman farmer: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🍳", "woman cook: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🍼", "woman feeding baby: light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👩🏻\u200d🎓", "woman student: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🎤", "woman singer: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🎨", "woman artist: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🏫", "woman teacher: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🏭", "woman factory worker: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d💻", "woman technologist: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d💼", "woman office worker: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🔧", "woman mechanic: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🔬", "woman scientist: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🚀", "woman astronaut: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🚒", "woman firefighter: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏻\u200d🤝\u200d👨🏼", "woman and man holding hands: light skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏻\u200d🤝\u200d👨🏽", "woman and man holding hands: light skin tone, medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏻\u200d🤝\u200d👨🏾", "woman and man holding hands: light skin tone, medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏻\u200d🤝\u200d👨🏿", "woman and man holding hands: light skin tone, dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏻\u200d🤝\u200d👩🏼", "women holding hands: light skin tone, medium-light skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👩🏻\u200d🤝\u200d👩🏽", "women holding hands: light skin tone, medium skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👩🏻\u200d🤝\u200d👩🏾", "women holding hands: light skin tone, medium-dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👩🏻\u200d🤝\u200d👩🏿", "women holding hands: light skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👩🏻\u200d🦯", "woman with white cane: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏻\u200d🦰", "woman: light skin tone, red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏻\u200d🦱", "woman: light skin tone, curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏻\u200d🦲", "woman: light skin tone, bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏻\u200d🦳", "woman: light skin tone, white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏻\u200d🦼", "woman in motorized wheelchair: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏻\u200d🦽", "woman in manual wheelchair: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏼", "woman: medium-light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👩🏼\u200d⚕", "woman health worker: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏼\u200d⚕️"},
+	{"👩🏼\u200d⚕️", "woman health worker: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d⚖", "woman judge: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏼\u200d⚖️"},
+	{"👩🏼\u200d⚖️", "woman judge: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d✈", "woman pilot: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏼\u200d✈️"},
+	{"👩🏼\u200d✈️", "woman pilot: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🌾", "woman farmer: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🍳", "woman cook: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🍼", "woman feeding baby: medium-light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👩🏼\u200d🎓", "woman student: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🎤", "woman singer: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🎨", "woman artist: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🏫", "woman teacher: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🏭", "woman factory worker: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d💻", "woman technologist: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d💼", "woman office worker: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🔧", "woman mechanic: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🔬", "woman scientist: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🚀", "woman astronaut: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🚒", "woman firefighter: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏼\u200d🤝\u200d👨🏻", "woman and man holding hands: medium-light skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏼\u200d🤝\u200d👨🏽", "woman and man holding hands: medium-light skin tone, medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏼\u200d🤝\u200d👨🏾", "woman and man holding hands: medium-light skin tone, medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏼\u200d🤝\u200d👨🏿", "woman and man holding hands: medium-light skin tone, dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏼\u200d🤝\u200d👩🏻", "women holding hands: medium-light skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏼\u200d🤝\u200d👩🏽", "women holding hands: medium-light skin tone, medium skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👩🏼\u200d🤝\u200d👩🏾", "women holding hands: medium-light skin tone, medium-dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👩🏼\u200d🤝\u200d👩🏿", "women holding hands: medium-light skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👩🏼\u200d🦯", "woman with white cane: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏼\u200d🦰", "woman: medium-light skin tone, red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏼\u200d🦱", "woman: medium-light skin tone, curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏼\u200d🦲", "woman: medium-light skin tone, bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏼\u200d🦳", "woman: medium-light skin tone, white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏼\u200d🦼", "woman in motorized wheelchair: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏼\u200d🦽", "woman in manual wheelchair: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏽", "woman: medium skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👩🏽\u200d⚕", "woman health worker: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏽\u200d⚕️"},
+	{"👩🏽\u200d⚕️", "woman health worker: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d⚖", "woman judge: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏽\u200d⚖️"},
+	{"👩🏽\u200d⚖️", "woman judge: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d✈", "woman pilot: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏽\u200d✈️"},
+	{"👩🏽\u200d✈️", "woman pilot: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🌾", "woman farmer: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🍳", "woman cook: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🍼", "woman feeding baby: medium skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👩🏽\u200d🎓", "woman student: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🎤", "woman singer: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🎨", "woman artist: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🏫", "woman teacher: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🏭", "woman factory worker: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d💻", "woman technologist: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d💼", "woman office worker: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🔧", "woman mechanic: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🔬", "woman scientist: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🚀", "woman astronaut: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🚒", "woman firefighter: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏽\u200d🤝\u200d👨🏻", "woman and man holding hands: medium skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏽\u200d🤝\u200d👨🏼", "woman and man holding hands: medium skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏽\u200d🤝\u200d👨🏾", "woman and man holding hands: medium skin tone, medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏽\u200d🤝\u200d👨🏿", "woman and man holding hands: medium skin tone, dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏽\u200d🤝\u200d👩🏻", "women holding hands: medium skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏽\u200d🤝\u200d👩🏼", "women holding hands: medium skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏽\u200d🤝\u200d👩🏾", "women holding hands: medium skin tone, medium-dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👩🏽\u200d🤝\u200d👩🏿", "women holding hands: medium skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👩🏽\u200d🦯", "woman with white cane: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏽\u200d🦰", "woman: medium skin tone, red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏽\u200d🦱", "woman: medium skin tone, curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏽\u200d🦲", "woman: medium skin tone, bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏽\u200d🦳", "woman: medium skin tone, white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏽\u200d🦼", "woman in motorized wheelchair: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏽\u200d🦽", "woman in manual wheelchair: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏾", "woman: medium-dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👩🏾\u200d⚕", "woman health worker: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏾\u200d⚕️"},
+	{"👩🏾\u200d⚕️", "woman health worker: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d⚖", "woman judge: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏾\u200d⚖️"},
+	{"👩🏾\u200d⚖️", "woman judge: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d✈", "woman pilot: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏾\u200d✈️"},
+	{"👩🏾\u200d✈️", "woman pilot: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🌾", "woman farmer: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🍳", "woman cook: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🍼", "woman feeding baby: medium-dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👩🏾\u200d🎓", "woman student: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🎤", "woman singer: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🎨", "woman artist: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🏫", "woman teacher: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🏭", "woman factory worker: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d💻", "woman technologist: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d💼", "woman office worker: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🔧", "woman mechanic: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🔬", "woman scientist: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🚀", "woman astronaut: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🚒", "woman firefighter: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏾\u200d🤝\u200d👨🏻", "woman and man holding hands: medium-dark skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏾\u200d🤝\u200d👨🏼", "woman and man holding hands: medium-dark skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏾\u200d🤝\u200d👨🏽", "woman and man holding hands: medium-dark skin tone, medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏾\u200d🤝\u200d👨🏿", "woman and man holding hands: medium-dark skin tone, dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏾\u200d🤝\u200d👩🏻", "women holding hands: medium-dark skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏾\u200d🤝\u200d👩🏼", "women holding hands: medium-dark skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏾\u200d🤝\u200d👩🏽", "women holding hands: medium-dark skin tone, medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏾\u200d🤝\u200d👩🏿", "women holding hands: medium-dark skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"👩🏾\u200d🦯", "woman with white cane: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏾\u200d🦰", "woman: medium-dark skin tone, red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏾\u200d🦱", "woman: medium-dark skin tone, curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏾\u200d🦲", "woman: medium-dark skin tone, bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏾\u200d🦳", "woman: medium-dark skin tone, white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏾\u200d🦼", "woman in motorized wheelchair: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏾\u200d🦽", "woman in manual wheelchair: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏿", "woman: dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👩🏿\u200d⚕", "woman health worker: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏿\u200d⚕️"},
+	{"👩🏿\u200d⚕️", "woman health worker: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d⚖", "woman judge: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏿\u200d⚖️"},
+	{"👩🏿\u200d⚖️", "woman judge: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d✈", "woman pilot: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👩🏿\u200d✈️"},
+	{"👩🏿\u200d✈️", "woman pilot: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🌾", "woman farmer: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🍳", "woman cook: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🍼", "woman feeding baby: dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👩🏿\u200d🎓", "woman student: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🎤", "woman singer: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🎨", "woman artist: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🏫", "woman teacher: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🏭", "woman factory worker: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d💻", "woman technologist: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d💼", "woman office worker: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🔧", "woman mechanic: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🔬", "woman scientist: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🚀", "woman astronaut: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🚒", "woman firefighter: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👩🏿\u200d🤝\u200d👨🏻", "woman and man holding hands: dark skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏿\u200d🤝\u200d👨🏼", "woman and man holding hands: dark skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏿\u200d🤝\u200d👨🏽", "woman and man holding hands: dark skin tone, medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏿\u200d🤝\u200d👨🏾", "woman and man holding hands: dark skin tone, medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏿\u200d🤝\u200d👩🏻", "women holding hands: dark skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏿\u200d🤝\u200d👩🏼", "women holding hands: dark skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏿\u200d🤝\u200d👩🏽", "women holding hands: dark skin tone, medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏿\u200d🤝\u200d👩🏾", "women holding hands: dark skin tone, medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👩🏿\u200d🦯", "woman with white cane: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏿\u200d🦰", "woman: dark skin tone, red hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏿\u200d🦱", "woman: dark skin tone, curly hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏿\u200d🦲", "woman: dark skin tone, bald", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏿\u200d🦳", "woman: dark skin tone, white hair", 1, 23, "E11.0", FullyQualified, ""},
+	{"👩🏿\u200d🦼", "woman in motorized wheelchair: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👩🏿\u200d🦽", "woman in manual wheelchair: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"👪", "family", 1, 31, "E0.6", FullyQualified, ""},
+	{"👫", "woman and man holding hands", 1, 30, "E0.6", FullyQualified, ""},
+	{"👫🏻", "woman and man holding hands: light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👫🏼", "woman and man holding hands: medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👫🏽", "woman and man holding hands: medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👫🏾", "woman and man holding hands: medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👫🏿", "woman and man holding hands: dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👬", "men holding hands", 1, 30, "E1.0", FullyQualified, ""},
+	{"👬🏻", "men holding hands: light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👬🏼", "men holding hands: medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👬🏽", "men holding hands: medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👬🏾", "men holding hands: medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👬🏿", "men holding hands: dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👭", "women holding hands", 1, 30, "E1.0", FullyQualified, ""},
+	{"👭🏻", "women holding hands: light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👭🏼", "women holding hands: medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👭🏽", "women holding hands: medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👭🏾", "women holding hands: medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👭🏿", "women holding hands: dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"👮", "police officer", 1, 25, "E0.6", FullyQualified, ""},
+	{"👮\u200d♀", "woman police officer", 1, 25, "E4.0", MinimallyQualified, "👮\u200d♀️"},
+	{"👮\u200d♀️", "woman police officer", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮\u200d♂", "man police officer", 1, 25, "E4.0", MinimallyQualified, "👮\u200d♂️"},
+	{"👮\u200d♂️", "man police officer", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮🏻", "police officer: light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👮🏻\u200d♀", "woman police officer: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👮🏻\u200d♀️"},
+	{"👮🏻\u200d♀️", "woman police officer: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮🏻\u200d♂", "man police officer: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👮🏻\u200d♂️"},
+	{"👮🏻\u200d♂️", "man police officer: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮🏼", "police officer: medium-light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👮🏼\u200d♀", "woman police officer: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👮🏼\u200d♀️"},
+	{"👮🏼\u200d♀️", "woman police officer: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮🏼\u200d♂", "man police officer: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👮🏼\u200d♂️"},
+	{"👮🏼\u200d♂️", "man police officer: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮🏽", "police officer: medium skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👮🏽\u200d♀", "woman police officer: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👮🏽\u200d♀️"},
+	{"👮🏽\u200d♀️", "woman police officer: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮🏽\u200d♂", "man police officer: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👮🏽\u200d♂️"},
+	{"👮🏽\u200d♂️", "man police officer: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮🏾", "police officer: medium-dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👮🏾\u200d♀", "woman police officer: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👮🏾\u200d♀️"},
+	{"👮🏾\u200d♀️", "woman police officer: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮🏾\u200d♂", "man police officer: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👮🏾\u200d♂️"},
+	{"👮🏾\u200d♂️", "man police officer: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮🏿", "police officer: dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👮🏿\u200d♀", "woman police officer: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👮🏿\u200d♀️"},
+	{"👮🏿\u200d♀️", "woman police officer: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👮🏿\u200d♂", "man police officer: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👮🏿\u200d♂️"},
+	{"👮🏿\u200d♂️", "man police officer: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👯", "people with bunny ears", 1, 27, "E0.6", FullyQualified, ""},
+	{"👯\u200d♀", "women with bunny ears", 1, 27, "E4.0", MinimallyQualified, "👯\u200d♀️"},
+	{"👯\u200d♀️", "women with bunny ears", 1, 27, "E4.0", FullyQualified, ""},
+	{"👯\u200d♂", "men with bunny ears", 1, 27, "E4.0", MinimallyQualified, "👯\u200d♂️"},
+	{"👯\u200d♂️", "men with bunny ears", 1, 27, "E4.0", FullyQualified, ""},
+	{"👰", "person with veil", 1, 25, "E0.6", FullyQualified, ""},
+	{"👰\u200d♀", "woman with veil", 1, 25, "E13.0", MinimallyQualified, "👰\u200d♀️"},
+	{"👰\u200d♀️", "woman with veil", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰\u200d♂", "man with veil", 1, 25, "E13.0", MinimallyQualified, "👰\u200d♂️"},
+	{"👰\u200d♂️", "man with veil", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰🏻", "person with veil: light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👰🏻\u200d♀", "woman with veil: light skin tone", 1, 25, "E13.0", MinimallyQualified, "👰🏻\u200d♀️"},
+	{"👰🏻\u200d♀️", "woman with veil: light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰🏻\u200d♂", "man with veil: light skin tone", 1, 25, "E13.0", MinimallyQualified, "👰🏻\u200d♂️"},
+	{"👰🏻\u200d♂️", "man with veil: light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰🏼", "person with veil: medium-light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👰🏼\u200d♀", "woman with veil: medium-light skin tone", 1, 25, "E13.0", MinimallyQualified, "👰🏼\u200d♀️"},
+	{"👰🏼\u200d♀️", "woman with veil: medium-light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰🏼\u200d♂", "man with veil: medium-light skin tone", 1, 25, "E13.0", MinimallyQualified, "👰🏼\u200d♂️"},
+	{"👰🏼\u200d♂️", "man with veil: medium-light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰🏽", "person with veil: medium skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👰🏽\u200d♀", "woman with veil: medium skin tone", 1, 25, "E13.0", MinimallyQualified, "👰🏽\u200d♀️"},
+	{"👰🏽\u200d♀️", "woman with veil: medium skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰🏽\u200d♂", "man with veil: medium skin tone", 1, 25, "E13.0", MinimallyQualified, "👰🏽\u200d♂️"},
+	{"👰🏽\u200d♂️", "man with veil: medium skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰🏾", "person with veil: medium-dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👰🏾\u200d♀", "woman with veil: medium-dark skin tone", 1, 25, "E13.0", MinimallyQualified, "👰🏾\u200d♀️"},
+	{"👰🏾\u200d♀️", "woman with veil: medium-dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰🏾\u200d♂", "man with veil: medium-dark skin tone", 1, 25, "E13.0", MinimallyQualified, "👰🏾\u200d♂️"},
+	{"👰🏾\u200d♂️", "man with veil: medium-dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰🏿", "person with veil: dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👰🏿\u200d♀", "woman with veil: dark skin tone", 1, 25, "E13.0", MinimallyQualified, "👰🏿\u200d♀️"},
+	{"👰🏿\u200d♀️", "woman with veil: dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👰🏿\u200d♂", "man with veil: dark skin tone", 1, 25, "E13.0", MinimallyQualified, "👰🏿\u200d♂️"},
+	{"👰🏿\u200d♂️", "man with veil: dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"👱", "person: blond hair", 1, 23, "E0.6", FullyQualified, ""},
+	{"👱\u200d♀", "woman: blond hair", 1, 23, "E4.0", MinimallyQualified, "👱\u200d♀️"},
+	{"👱\u200d♀️", "woman: blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱\u200d♂", "man: blond hair", 1, 23, "E4.0", MinimallyQualified, "👱\u200d♂️"},
+	{"👱\u200d♂️", "man: blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱🏻", "person: light skin tone, blond hair", 1, 23, "E1.0", FullyQualified, ""},
+	{"👱🏻\u200d♀", "woman: light skin tone, blond hair", 1, 23, "E4.0", MinimallyQualified, "👱🏻\u200d♀️"},
+	{"👱🏻\u200d♀️", "woman: light skin tone, blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱🏻\u200d♂", "man: light skin tone, blond hair", 1, 23, "E4.0", MinimallyQualified, "👱🏻\u200d♂️"},
+	{"👱🏻\u200d♂️", "man: light skin tone, blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱🏼", "person: medium-light skin tone, blond hair", 1, 23, "E1.0", FullyQualified, ""},
+	{"👱🏼\u200d♀", "woman: medium-light skin tone, blond hair", 1, 23, "E4.0", MinimallyQualified, "👱🏼\u200d♀️"},
+	{"👱🏼\u200d♀️", "woman: medium-light skin tone, blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱🏼\u200d♂", "man: medium-light skin tone, blond hair", 1, 23, "E4.0", MinimallyQualified, "👱🏼\u200d♂️"},
+	{"👱🏼\u200d♂️", "man: medium-light skin tone, blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱🏽", "person: medium skin tone, blond hair", 1, 23, "E1.0", FullyQualified, ""},
+	{"👱🏽\u200d♀", "woman: medium skin tone, blond hair", 1, 23, "E4.0", MinimallyQualified, "👱🏽\u200d♀️"},
+	{"👱🏽\u200d♀️", "woman: medium skin tone, blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱🏽\u200d♂", "man: medium skin tone, blond hair", 1, 23, "E4.0", MinimallyQualified, "👱🏽\u200d♂️"},
+	{"👱🏽\u200d♂️", "man: medium skin tone, blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱🏾", "person: medium-dark skin tone, blond hair", 1, 23, "E1.0", FullyQualified, ""},
+	{"👱🏾\u200d♀", "woman: medium-dark skin tone, blond hair", 1, 23, "E4.0", MinimallyQualified, "👱🏾\u200d♀️"},
+	{"👱🏾\u200d♀️", "woman: medium-dark skin tone, blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱🏾\u200d♂", "man: medium-dark skin tone, blond hair", 1, 23, "E4.0", MinimallyQualified, "👱🏾\u200d♂️"},
+	{"👱🏾\u200d♂️", "man: medium-dark skin tone, blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱🏿", "person: dark skin tone, blond hair", 1, 23, "E1.0", FullyQualified, ""},
+	{"👱🏿\u200d♀", "woman: dark skin tone, blond hair", 1, 23, "E4.0", MinimallyQualified, "👱🏿\u200d♀️"},
+	{"👱🏿\u200d♀️", "woman: dark skin tone, blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👱🏿\u200d♂", "man: dark skin tone, blond hair", 1, 23, "E4.0", MinimallyQualified, "👱🏿\u200d♂️"},
+	{"👱🏿\u200d♂️", "man: dark skin tone, blond hair", 1, 23, "E4.0", FullyQualified, ""},
+	{"👲", "person with skullcap", 1, 25, "E0.6", FullyQualified, ""},
+	{"👲🏻", "person with skullcap: light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👲🏼", "person with skullcap: medium-light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👲🏽", "person with skullcap: medium skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👲🏾", "person with skullcap: medium-dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👲🏿", "person with skullcap: dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👳", "person wearing turban", 1, 25, "E0.6", FullyQualified, ""},
+	{"👳\u200d♀", "woman wearing turban", 1, 25, "E4.0", MinimallyQualified, "👳\u200d♀️"},
+	{"👳\u200d♀️", "woman wearing turban", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳\u200d♂", "man wearing turban", 1, 25, "E4.0", MinimallyQualified, "👳\u200d♂️"},
+	{"👳\u200d♂️", "man wearing turban", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳🏻", "person wearing turban: light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👳🏻\u200d♀", "woman wearing turban: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👳🏻\u200d♀️"},
+	{"👳🏻\u200d♀️", "woman wearing turban: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳🏻\u200d♂", "man wearing turban: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👳🏻\u200d♂️"},
+	{"👳🏻\u200d♂️", "man wearing turban: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳🏼", "person wearing turban: medium-light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👳🏼\u200d♀", "woman wearing turban: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👳🏼\u200d♀️"},
+	{"👳🏼\u200d♀️", "woman wearing turban: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳🏼\u200d♂", "man wearing turban: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👳🏼\u200d♂️"},
+	{"👳🏼\u200d♂️", "man wearing turban: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳🏽", "person wearing turban: medium skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👳🏽\u200d♀", "woman wearing turban: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👳🏽\u200d♀️"},
+	{"👳🏽\u200d♀️", "woman wearing turban: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳🏽\u200d♂", "man wearing turban: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👳🏽\u200d♂️"},
+	{"👳🏽\u200d♂️", "man wearing turban: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳🏾", "person wearing turban: medium-dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👳🏾\u200d♀", "woman wearing turban: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👳🏾\u200d♀️"},
+	{"👳🏾\u200d♀️", "woman wearing turban: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳🏾\u200d♂", "man wearing turban: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👳🏾\u200d♂️"},
+	{"👳🏾\u200d♂️", "man wearing turban: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳🏿", "person wearing turban: dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👳🏿\u200d♀", "woman wearing turban: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👳🏿\u200d♀️"},
+	{"👳🏿\u200d♀️", "woman wearing turban: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👳🏿\u200d♂", "man wearing turban: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👳🏿\u200d♂️"},
+	{"👳🏿\u200d♂️", "man wearing turban: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👴", "old man", 1, 23, "E0.6", FullyQualified, ""},
+	{"👴🏻", "old man: light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👴🏼", "old man: medium-light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👴🏽", "old man: medium skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👴🏾", "old man: medium-dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👴🏿", "old man: dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👵", "old woman", 1, 23, "E0.6", FullyQualified, ""},
+	{"👵🏻", "old woman: light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👵🏼", "old woman: medium-light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👵🏽", "old woman: medium skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👵🏾", "old woman: medium-dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👵🏿", "old woman: dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👶", "baby", 1, 23, "E0.6", FullyQualified, ""},
+	{"👶🏻", "baby: light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👶🏼", "baby: medium-light skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👶🏽", "baby: medium skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👶🏾", "baby: medium-dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👶🏿", "baby: dark skin tone", 1, 23, "E1.0", FullyQualified, ""},
+	{"👷", "construction worker", 1, 25, "E0.6", FullyQualified, ""},
+	{"👷\u200d♀", "woman construction worker", 1, 25, "E4.0", MinimallyQualified, "👷\u200d♀️"},
+	{"👷\u200d♀️", "woman construction worker", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷\u200d♂", "man construction worker", 1, 25, "E4.0", MinimallyQualified, "👷\u200d♂️"},
+	{"👷\u200d♂️", "man construction worker", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷🏻", "construction worker: light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👷🏻\u200d♀", "woman construction worker: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👷🏻\u200d♀️"},
+	{"👷🏻\u200d♀️", "woman construction worker: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷🏻\u200d♂", "man construction worker: light skin tone", 1, 25, "E4.0", MinimallyQualified, "👷🏻\u200d♂️"},
+	{"👷🏻\u200d♂️", "man construction worker: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷🏼", "construction worker: medium-light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👷🏼\u200d♀", "woman construction worker: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👷🏼\u200d♀️"},
+	{"👷🏼\u200d♀️", "woman construction worker: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷🏼\u200d♂", "man construction worker: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "👷🏼\u200d♂️"},
+	{"👷🏼\u200d♂️", "man construction worker: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷🏽", "construction worker: medium skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👷🏽\u200d♀", "woman construction worker: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👷🏽\u200d♀️"},
+	{"👷🏽\u200d♀️", "woman construction worker: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷🏽\u200d♂", "man construction worker: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "👷🏽\u200d♂️"},
+	{"👷🏽\u200d♂️", "man construction worker: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷🏾", "construction worker: medium-dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👷🏾\u200d♀", "woman construction worker: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👷🏾\u200d♀️"},
+	{"👷🏾\u200d♀️", "woman construction worker: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷🏾\u200d♂", "man construction worker: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👷🏾\u200d♂️"},
+	{"👷🏾\u200d♂️", "man construction worker: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷🏿", "construction worker: dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👷🏿\u200d♀", "woman construction worker: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👷🏿\u200d♀️"},
+	{"👷🏿\u200d♀️", "woman construction worker: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👷🏿\u200d♂", "man construction worker: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "👷🏿\u200d♂️"},
+	{"👷🏿\u200d♂️", "man construction worker: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"👸", "princess", 1, 25, "E0.6", FullyQualified, ""},
+	{"👸🏻", "princess: light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👸🏼", "princess: medium-light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👸🏽", "princess: medium skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👸🏾", "princess: medium-dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👸🏿", "princess: dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"👹", "ogre", 0, 11, "E0.6", FullyQualified, ""},
+	{"👺", "goblin", 0, 11, "E0.6", FullyQualified, ""},
+	{"👻", "ghost", 0, 11, "E0.6", FullyQualified, ""},
+	{"👼", "baby angel", 1, 26, "E0.6", FullyQualified, ""},
+	{"👼🏻", "baby angel: light skin tone", 1, 26, "E1.0", FullyQualified, ""},
+	{"👼🏼", "baby angel: medium-light skin tone", 1, 26, "E1.0", FullyQualified, ""},
+	{"👼🏽", "baby angel: medium skin tone", 1, 26, "E1.0", FullyQualified, ""},
+	{"👼🏾", "baby angel: medium-dark skin tone", 1, 26, "E1.0", FullyQualified, ""},
+	{"👼🏿", "baby angel: dark skin tone", 1, 26, "E1.0", FullyQualified, ""},
+	{"👽", "alien", 0, 11, "E0.6", FullyQualified, ""},
+	{"👾", "alien monster", 0, 11, "E0.6", FullyQualified, ""},
+	{"👿", "angry face with horns", 0, 10, "E0.6", FullyQualified, ""},
+	{"💀", "skull", 0, 10, "E0.6", FullyQualified, ""},
+	{"💁", "person tipping hand", 1, 24, "E0.6", FullyQualified, ""},
+	{"💁\u200d♀", "woman tipping hand", 1, 24, "E4.0", MinimallyQualified, "💁\u200d♀️"},
+	{"💁\u200d♀️", "woman tipping hand", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁\u200d♂", "man tipping hand", 1, 24, "E4.0", MinimallyQualified, "💁\u200d♂️"},
+	{"💁\u200d♂️", "man tipping hand", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁🏻", "person tipping hand: light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"💁🏻\u200d♀", "woman tipping hand: light skin tone", 1, 24, "E4.0", MinimallyQualified, "💁🏻\u200d♀️"},
+	{"💁🏻\u200d♀️", "woman tipping hand: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁🏻\u200d♂", "man tipping hand: light skin tone", 1, 24, "E4.0", MinimallyQualified, "💁🏻\u200d♂️"},
+	{"💁🏻\u200d♂️", "man tipping hand: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁🏼", "person tipping hand: medium-light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"💁🏼\u200d♀", "woman tipping hand: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "💁🏼\u200d♀️"},
+	{"💁🏼\u200d♀️", "woman tipping hand: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁🏼\u200d♂", "man tipping hand: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "💁🏼\u200d♂️"},
+	{"💁🏼\u200d♂️", "man tipping hand: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁🏽", "person tipping hand: medium skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"💁🏽\u200d♀", "woman tipping hand: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "💁🏽\u200d♀️"},
+	{"💁🏽\u200d♀️", "woman tipping hand: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁🏽\u200d♂", "man tipping hand: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "💁🏽\u200d♂️"},
+	{"💁🏽\u200d♂️", "man tipping hand: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁🏾", "person tipping hand: medium-dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"💁🏾\u200d♀", "woman tipping hand: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "💁🏾\u200d♀️"},
+	{"💁🏾\u200d♀️", "woman tipping hand: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁🏾\u200d♂", "man tipping hand: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "💁🏾\u200d♂️"},
+	{"💁🏾\u200d♂️", "man tipping hand: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁🏿", "person tipping hand: dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"💁🏿\u200d♀", "woman tipping hand: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "💁🏿\u200d♀️"},
+	{"💁🏿\u200d♀️", "woman tipping hand: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"💁🏿\u200d♂", "man tipping hand: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "💁🏿\u200d♂️"},
+	{"💁🏿\u200d♂️", "man tipping hand: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"💂", "guard", 1, 25, "E0.6", FullyQualified, ""},
+	{"💂\u200d♀", "woman guard", 1, 25, "E4.0", MinimallyQualified, "💂\u200d♀️"},
+	{"💂\u200d♀️", "woman guard", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂\u200d♂", "man guard", 1, 25, "E4.0", MinimallyQualified, "💂\u200d♂️"},
+	{"💂\u200d♂️", "man guard", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂🏻", "guard: light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"💂🏻\u200d♀", "woman guard: light skin tone", 1, 25, "E4.0", MinimallyQualified, "💂🏻\u200d♀️"},
+	{"💂🏻\u200d♀️", "woman guard: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂🏻\u200d♂", "man guard: light skin tone", 1, 25, "E4.0", MinimallyQualified, "💂🏻\u200d♂️"},
+	{"💂🏻\u200d♂️", "man guard: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂🏼", "guard: medium-light skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"💂🏼\u200d♀", "woman guard: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "💂🏼\u200d♀️"},
+	{"💂🏼\u200d♀️", "woman guard: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂🏼\u200d♂", "man guard: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "💂🏼\u200d♂️"},
+	{"💂🏼\u200d♂️", "man guard: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂🏽", "guard: medium skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"💂🏽\u200d♀", "woman guard: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "💂🏽\u200d♀️"},
+	{"💂🏽\u200d♀️", "woman guard: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂🏽\u200d♂", "man guard: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "💂🏽\u200d♂️"},
+	{"💂🏽\u200d♂️", "man guard: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂🏾", "guard: medium-dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"💂🏾\u200d♀", "woman guard: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "💂🏾\u200d♀️"},
+	{"💂🏾\u200d♀️", "woman guard: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂🏾\u200d♂", "man guard: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "💂🏾\u200d♂️"},
+	{"💂🏾\u200d♂️", "man guard: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂🏿", "guard: dark skin tone", 1, 25, "E1.0", FullyQualified, ""},
+	{"💂🏿\u200d♀", "woman guard: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "💂🏿\u200d♀️"},
+	{"💂🏿\u200d♀️", "woman guard: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"💂🏿\u200d♂", "man guard: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "💂🏿\u200d♂️"},
+	{"💂🏿\u200d♂️", "man guard: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"💃", "woman dancing", 1, 27, "E0.6", FullyQualified, ""},
+	{"💃🏻", "woman dancing: light skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💃🏼", "woman dancing: medium-light skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💃🏽", "woman dancing: medium skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💃🏾", "woman dancing: medium-dark skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💃🏿", "woman dancing: dark skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💄", "lipstick", 7, 66, "E0.6", FullyQualified, ""},
+	{"💅", "nail polish", 1, 21, "E0.6", FullyQualified, ""},
+	{"💅🏻", "nail polish: light skin tone", 1, 21, "E1.0", FullyQualified, ""},
+	{"💅🏼", "nail polish: medium-light skin tone", 1, 21, "E1.0", FullyQualified, ""},
+	{"💅🏽", "nail polish: medium skin tone", 1, 21, "E1.0", FullyQualified, ""},
+	{"💅🏾", "nail polish: medium-dark skin tone", 1, 21, "E1.0", FullyQualified, ""},
+	{"💅🏿", "nail polish: dark skin tone", 1, 21, "E1.0", FullyQualified, ""},
+	{"💆", "person getting massage", 1, 27, "E0.6", FullyQualified, ""},
+	{"💆\u200d♀", "woman getting massage", 1, 27, "E4.0", MinimallyQualified, "💆\u200d♀️"},
+	{"💆\u200d♀️", "woman getting massage", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆\u200d♂", "man getting massage", 1, 27, "E4.0", MinimallyQualified, "💆\u200d♂️"},
+	{"💆\u200d♂️", "man getting massage", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆🏻", "person getting massage: light skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💆🏻\u200d♀", "woman getting massage: light skin tone", 1, 27, "E4.0", MinimallyQualified, "💆🏻\u200d♀️"},
+	{"💆🏻\u200d♀️", "woman getting massage: light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆🏻\u200d♂", "man getting massage: light skin tone", 1, 27, "E4.0", MinimallyQualified, "💆🏻\u200d♂️"},
+	{"💆🏻\u200d♂️", "man getting massage: light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆🏼", "person getting massage: medium-light skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💆🏼\u200d♀", "woman getting massage: medium-light skin tone", 1, 27, "E4.0", MinimallyQualified, "💆🏼\u200d♀️"},
+	{"💆🏼\u200d♀️", "woman getting massage: medium-light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆🏼\u200d♂", "man getting massage: medium-light skin tone", 1, 27, "E4.0", MinimallyQualified, "💆🏼\u200d♂️"},
+	{"💆🏼\u200d♂️", "man getting massage: medium-light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆🏽", "person getting massage: medium skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💆🏽\u200d♀", "woman getting massage: medium skin tone", 1, 27, "E4.0", MinimallyQualified, "💆🏽\u200d♀️"},
+	{"💆🏽\u200d♀️", "woman getting massage: medium skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆🏽\u200d♂", "man getting massage: medium skin tone", 1, 27, "E4.0", MinimallyQualified, "💆🏽\u200d♂️"},
+	{"💆🏽\u200d♂️", "man getting massage: medium skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆🏾", "person getting massage: medium-dark skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💆🏾\u200d♀", "woman getting massage: medium-dark skin tone", 1, 27, "E4.0", MinimallyQualified, "💆🏾\u200d♀️"},
+	{"💆🏾\u200d♀️", "woman getting massage: medium-dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆🏾\u200d♂", "man getting massage: medium-dark skin tone", 1, 27, "E4.0", MinimallyQualified, "💆🏾\u200d♂️"},
+	{"💆🏾\u200d♂️", "man getting massage: medium-dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆🏿", "person getting massage: dark skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💆🏿\u200d♀", "woman getting massage: dark skin tone", 1, 27, "E4.0", MinimallyQualified, "💆🏿\u200d♀️"},
+	{"💆🏿\u200d♀️", "woman getting massage: dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💆🏿\u200d♂", "man getting massage: dark skin tone", 1, 27, "E4.0", MinimallyQualified, "💆🏿\u200d♂️"},
+	{"💆🏿\u200d♂️", "man getting massage: dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇", "person getting haircut", 1, 27, "E0.6", FullyQualified, ""},
+	{"💇\u200d♀", "woman getting haircut", 1, 27, "E4.0", MinimallyQualified, "💇\u200d♀️"},
+	{"💇\u200d♀️", "woman getting haircut", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇\u200d♂", "man getting haircut", 1, 27, "E4.0", MinimallyQualified, "💇\u200d♂️"},
+	{"💇\u200d♂️", "man getting haircut", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇🏻", "person getting haircut: light skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💇🏻\u200d♀", "woman getting haircut: light skin tone", 1, 27, "E4.0", MinimallyQualified, "💇🏻\u200d♀️"},
+	{"💇🏻\u200d♀️", "woman getting haircut: light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇🏻\u200d♂", "man getting haircut: light skin tone", 1, 27, "E4.0", MinimallyQualified, "💇🏻\u200d♂️"},
+	{"💇🏻\u200d♂️", "man getting haircut: light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇🏼", "person getting haircut: medium-light skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💇🏼\u200d♀", "woman getting haircut: medium-light skin tone", 1, 27, "E4.0", MinimallyQualified, "💇🏼\u200d♀️"},
+	{"💇🏼\u200d♀️", "woman getting haircut: medium-light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇🏼\u200d♂", "man getting haircut: medium-light skin tone", 1, 27, "E4.0", MinimallyQualified, "💇🏼\u200d♂️"},
+	{"💇🏼\u200d♂️", "man getting haircut: medium-light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇🏽", "person getting haircut: medium skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💇🏽\u200d♀", "woman getting haircut: medium skin tone", 1, 27, "E4.0", MinimallyQualified, "💇🏽\u200d♀️"},
+	{"💇🏽\u200d♀️", "woman getting haircut: medium skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇🏽\u200d♂", "man getting haircut: medium skin tone", 1, 27, "E4.0", MinimallyQualified, "💇🏽\u200d♂️"},
+	{"💇🏽\u200d♂️", "man getting haircut: medium skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇🏾", "person getting haircut: medium-dark skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💇🏾\u200d♀", "woman getting haircut: medium-dark skin tone", 1, 27, "E4.0", MinimallyQualified, "💇🏾\u200d♀️"},
+	{"💇🏾\u200d♀️", "woman getting haircut: medium-dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇🏾\u200d♂", "man getting haircut: medium-dark skin tone", 1, 27, "E4.0", MinimallyQualified, "💇🏾\u200d♂️"},
+	{"💇🏾\u200d♂️", "man getting haircut: medium-dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇🏿", "person getting haircut: dark skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"💇🏿\u200d♀", "woman getting haircut: dark skin tone", 1, 27, "E4.0", MinimallyQualified, "💇🏿\u200d♀️"},
+	{"💇🏿\u200d♀️", "woman getting haircut: dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💇🏿\u200d♂", "man getting haircut: dark skin tone", 1, 27, "E4.0", MinimallyQualified, "💇🏿\u200d♂️"},
+	{"💇🏿\u200d♂️", "man getting haircut: dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"💈", "barber pole", 5, 54, "E0.6", FullyQualified, ""},
+	{"💉", "syringe", 7, 81, "E0.6", FullyQualified, ""},
+	{"💊", "pill", 7, 81, "E0.6", FullyQualified, ""},
+	{"💋", "kiss mark", 0, 15, "E0.6", FullyQualified, ""},
+	{"💌", "love letter", 0, 14, "E0.6", FullyQualified, ""},
+	{"💍", "ring", 7, 66, "E0.6", FullyQualified, ""},
+	{"💎", "gem stone", 7, 66, "E0.6", FullyQualified, ""},
+	{"💏", "kiss", 1, 30, "E0.6", FullyQualified, ""},
+	{"💐", "bouquet", 3, 40, "E0.6", FullyQualified, ""},
+	{"💑", "couple with heart", 1, 30, "E0.6", FullyQualified, ""},
+	{"💒", "wedding", 5, 52, "E0.6", FullyQualified, ""},
+	{"💓", "beating heart", 0, 14, "E0.6", FullyQualified, ""},
+	{"💔", "broken heart", 0, 14, "E0.6", FullyQualified, ""},
+	{"💕", "two hearts", 0, 14, "E0.6", FullyQualified, ""},
+	{"💖", "sparkling heart", 0, 14, "E0.6", FullyQualified, ""},
+	{"💗", "growing heart", 0, 14, "E0.6", FullyQualified, ""},
+	{"💘", "heart with arrow", 0, 14, "E0.6", FullyQualified, ""},
+	{"💙", "blue heart", 0, 14, "E0.6", FullyQualified, ""},
+	{"💚", "green heart", 0, 14, "E0.6", FullyQualified, ""},
+	{"💛", "yellow heart", 0, 14, "E0.6", FullyQualified, ""},
+	{"💜", "purple heart", 0, 14, "E0.6", FullyQualified, ""},
+	{"💝", "heart with ribbon", 0, 14, "E0.6", FullyQualified, ""},
+	{"💞", "revolving hearts", 0, 14, "E0.6", FullyQualified, ""},
+	{"💟", "heart decoration", 0, 14, "E0.6", FullyQualified, ""},
+	{"💠", "diamond with a dot", 8, 97, "E0.6", FullyQualified, ""},
+	{"💡", "light bulb", 7, 72, "E0.6", FullyQualified, ""},
+	{"💢", "anger symbol", 0, 15, "E0.6", FullyQualified, ""},
+	{"💣", "bomb", 7, 79, "E0.6", FullyQualified, ""},
+	{"💤", "ZZZ", 0, 15, "E0.6", FullyQualified, ""},
+	{"💥", "collision", 0, 15, "E0.6", FullyQualified, ""},
+	{"💦", "sweat droplets", 0, 15, "E0.6", FullyQualified, ""},
+	{"💧", "droplet", 5, 60, "E0.6", FullyQualified, ""},
+	{"💨", "dashing away", 0, 15, "E0.6", FullyQualified, ""},
+	{"💩", "pile of poo", 0, 11, "E0.6", FullyQualified, ""},
+	{"💪", "flexed biceps", 1, 22, "E0.6", FullyQualified, ""},
+	{"💪🏻", "flexed biceps: light skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"💪🏼", "flexed biceps: medium-light skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"💪🏽", "flexed biceps: medium skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"💪🏾", "flexed biceps: medium-dark skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"💪🏿", "flexed biceps: dark skin tone", 1, 22, "E1.0", FullyQualified, ""},
+	{"💫", "dizzy", 0, 15, "E0.6", FullyQualified, ""},
+	{"💬", "speech balloon", 0, 15, "E0.6", FullyQualified, ""},
+	{"💭", "thought balloon", 0, 15, "E1.0", FullyQualified, ""},
+	{"💮", "white flower", 3, 40, "E0.6", FullyQualified, ""},
+	{"💯", "hundred points", 0, 15, "E0.6", FullyQualified, ""},
+	{"💰", "money bag", 7, 74, "E0.6", FullyQualified, ""},
+	{"💱", "currency exchange", 8, 93, "E0.6", FullyQualified, ""},
+	{"💲", "heavy dollar sign", 8, 93, "E0.6", FullyQualified, ""},
+	{"💳", "credit card", 7, 74, "E0.6", FullyQualified, ""},
+	{"💴", "yen banknote", 7, 74, "E0.6", FullyQualified, ""},
+	{"💵", "dollar banknote", 7, 74, "E0.6", FullyQualified, ""},
+	{"💶", "euro banknote", 7, 74, "E1.0", FullyQualified, ""},
+	{"💷", "pound banknote", 7, 74, "E1.0", FullyQualified, ""},
+	{"💸", "money with wings", 7, 74, "E0.6", FullyQualified, ""},
+	{"💹", "chart increasing with yen", 7, 74, "E0.6", FullyQualified, ""},
+	{"💺", "seat", 5, 57, "E0.6", FullyQualified, ""},
+	{"💻", "laptop", 7, 71, "E0.6", FullyQualified, ""},
+	{"💼", "briefcase", 7, 77, "E0.6", FullyQualified, ""},
+	{"💽", "computer disk", 7, 71, "E0.6", FullyQualified, ""},
+	{"💾", "floppy disk", 7, 71, "E0.6", FullyQualified, ""},
+	{"💿", "optical disk", 7, 71, "E0.6", FullyQualified, ""},
+	{"📀", "dvd", 7, 71, "E0.6", FullyQualified, ""},
+	{"📁", "file folder", 7, 77, "E0.6", FullyQualified, ""},
+	{"📂", "open file folder", 7, 77, "E0.6", FullyQualified, ""},
+	{"📃", "page with curl", 7, 73, "E0.6", FullyQualified, ""},
+	{"📄", "page facing up", 7, 73, "E0.6", FullyQualified, ""},
+	{"📅", "calendar", 7, 77, "E0.6", FullyQualified, ""},
+	{"📆", "tear-off calendar", 7, 77, "E0.6", FullyQualified, ""},
+	{"📇", "card index", 7, 77, "E0.6", FullyQualified, ""},
+	{"📈", "chart increasing", 7, 77, "E0.6", FullyQualified, ""},
+	{"📉", "chart decreasing", 7, 77, "E0.6", FullyQualified, ""},
+	{"📊", "bar chart", 7, 77, "E0.6", FullyQualified, ""},
+	{"📋", "clipboard", 7, 77, "E0.6", FullyQualified, ""},
+	{"📌", "pushpin", 7, 77, "E0.6", FullyQualified, ""},
+	{"📍", "round pushpin", 7, 77, "E0.6", FullyQualified, ""},
+	{"📎", "paperclip", 7, 77, "E0.6", FullyQualified, ""},
+	{"📏", "straight ruler", 7, 77, "E0.6", FullyQualified, ""},
+	{"📐", "triangular ruler", 7, 77, "E0.6", FullyQualified, ""},
+	{"📑", "bookmark tabs", 7, 73, "E0.6", FullyQualified, ""},
+	{"📒", "ledger", 7, 73, "E0.6", FullyQualified, ""},
+	{"📓", "notebook", 7, 73, "E0.6", FullyQualified, ""},
+	{"📔", "notebook with decorative cover", 7, 73, "E0.6", FullyQualified, ""},
+	{"📕", "closed book", 7, 73, "E0.6", FullyQualified, ""},
+	{"📖", "open book", 7, 73, "E0.6", FullyQualified, ""},
+	{"📗", "green book", 7, 73, "E0.6", FullyQualified, ""},
+	{"📘", "blue book", 7, 73, "E0.6", FullyQualified, ""},
+	{"📙", "orange book", 7, 73, "E0.6", FullyQualified, ""},
+	{"📚", "books", 7, 73, "E0.6", FullyQualified, ""},
+	{"📛", "name badge", 8, 94, "E0.6", FullyQualified, ""},
+	{"📜", "scroll", 7, 73, "E0.6", FullyQualified, ""},
+	{"📝", "memo", 7, 76, "E0.6", FullyQualified, ""},
+	{"📞", "telephone receiver", 7, 70, "E0.6", FullyQualified, ""},
+	{"📟", "pager", 7, 70, "E0.6", FullyQualified, ""},
+	{"📠", "fax machine", 7, 70, "E0.6", FullyQualified, ""},
+	{"📡", "satellite antenna", 7, 80, "E0.6", FullyQualified, ""},
+	{"📢", "loudspeaker", 7, 67, "E0.6", FullyQualified, ""},
+	{"📣", "megaphone", 7, 67, "E0.6", FullyQualified, ""},
+	{"📤", "outbox tray", 7, 75, "E0.6", FullyQualified, ""},
+	{"📥", "inbox tray", 7, 75, "E0.6", FullyQualified, ""},
+	{"📦", "package", 7, 75, "E0.6", FullyQualified, ""},
+	{"📧", "e-mail", 7, 75, "E0.6", FullyQualified, ""},
+	{"📨", "incoming envelope", 7, 75, "E0.6", FullyQualified, ""},
+	{"📩", "envelope with arrow", 7, 75, "E0.6", FullyQualified, ""},
+	{"📪", "closed mailbox with lowered flag", 7, 75, "E0.6", FullyQualified, ""},
+	{"📫", "closed mailbox with raised flag", 7, 75, "E0.6", FullyQualified, ""},
+	{"📬", "open mailbox with raised flag", 7, 75, "E0.7", FullyQualified, ""},
+	{"📭", "open mailbox with lowered flag", 7, 75, "E0.7", FullyQualified, ""},
+	{"📮", "postbox", 7, 75, "E0.6", FullyQualified, ""},
+	{"📯", "postal horn", 7, 67, "E1.0", FullyQualified, ""},
+	{"📰", "newspaper", 7, 73, "E0.6", FullyQualified, ""},
+	{"📱", "mobile phone", 7, 70, "E0.6", FullyQualified, ""},
+	{"📲", "mobile phone with arrow", 7, 70, "E0.6", FullyQualified, ""},
+	{"📳", "vibration mode", 8, 89, "E0.6", FullyQualified, ""},
+	{"📴", "mobile phone off", 8, 89, "E0.6", FullyQualified, ""},
+	{"📵", "no mobile phones", 8, 85, "E1.0", FullyQualified, ""},
+	{"📶", "antenna bars", 8, 89, "E0.6", FullyQualified, ""},
+	{"📷", "camera", 7, 72, "E0.6", FullyQualified, ""},
+	{"📸", "camera with flash", 7, 72, "E1.0", FullyQualified, ""},
+	{"📹", "video camera", 7, 72, "E0.6", FullyQualified, ""},
+	{"📺", "television", 7, 72, "E0.6", FullyQualified, ""},
+	{"📻", "radio", 7, 68, "E0.6", FullyQualified, ""},
+	{"📼", "videocassette", 7, 72, "E0.6", FullyQualified, ""},
+	{"📽", "film projector", 7, 72, "E0.7", Unqualified, "📽️"},
+	{"📽️", "film projector", 7, 72, "E0.7", FullyQualified, ""},
+	{"📿", "prayer beads", 7, 66, "E1.0", FullyQualified, ""},
+	{"🔀", "shuffle tracks button", 8, 89, "E1.0", FullyQualified, ""},
+	{"🔁", "repeat button", 8, 89, "E1.0", FullyQualified, ""},
+	{"🔂", "repeat single button", 8, 89, "E1.0", FullyQualified, ""},
+	{"🔃", "clockwise vertical arrows", 8, 86, "E0.6", FullyQualified, ""},
+	{"🔄", "counterclockwise arrows button", 8, 86, "E1.0", FullyQualified, ""},
+	{"🔅", "dim button", 8, 89, "E1.0", FullyQualified, ""},
+	{"🔆", "bright button", 8, 89, "E1.0", FullyQualified, ""},
+	{"🔇", "muted speaker", 7, 67, "E1.0", FullyQualified, ""},
+	{"🔈", "speaker low volume", 7, 67, "E0.7", FullyQualified, ""},
+	{"🔉", "speaker medium volume", 7, 67, "E1.0", FullyQualified, ""},
+	{"🔊", "speaker high volume", 7, 67, "E0.6", FullyQualified, ""},
+	{"🔋", "battery", 7, 71, "E0.6", FullyQualified, ""},
+	{"🔌", "electric plug", 7, 71, "E0.6", FullyQualified, ""},
+	{"🔍", "magnifying glass tilted left", 7, 72, "E0.6", FullyQualified, ""},
+	{"🔎", "magnifying glass tilted right", 7, 72, "E0.6", FullyQualified, ""},
+	{"🔏", "locked with pen", 7, 78, "E0.6", FullyQualified, ""},
+	{"🔐", "locked with key", 7, 78, "E0.6", FullyQualified, ""},
+	{"🔑", "key", 7, 78, "E0.6", FullyQualified, ""},
+	{"🔒", "locked", 7, 78, "E0.6", FullyQualified, ""},
+	{"🔓", "unlocked", 7, 78, "E0.6", FullyQualified, ""},
+	{"🔔", "bell", 7, 67, "E0.6", FullyQualified, ""},
+	{"🔕", "bell with slash", 7, 67, "E1.0", FullyQualified, ""},
+	{"🔖", "bookmark", 7, 73, "E0.6", FullyQualified, ""},
+	{"🔗", "link", 7, 79, "E0.6", FullyQualified, ""},
+	{"🔘", "radio button", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔙", "BACK arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"🔚", "END arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"🔛", "ON! arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"🔜", "SOON arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"🔝", "TOP arrow", 8, 86, "E0.6", FullyQualified, ""},
+	{"🔞", "no one under eighteen", 8, 85, "E0.6", FullyQualified, ""},
+	{"🔟", "keycap: 10", 8, 95, "E0.6", FullyQualified, ""},
+	{"🔠", "input latin uppercase", 8, 96, "E0.6", FullyQualified, ""},
+	{"🔡", "input latin lowercase", 8, 96, "E0.6", FullyQualified, ""},
+	{"🔢", "input numbers", 8, 96, "E0.6", FullyQualified, ""},
+	{"🔣", "input symbols", 8, 96, "E0.6", FullyQualified, ""},
+	{"🔤", "input latin letters", 8, 96, "E0.6", FullyQualified, ""},
+	{"🔥", "fire", 5, 60, "E0.6", FullyQualified, ""},
+	{"🔦", "flashlight", 7, 72, "E0.6", FullyQualified, ""},
+	{"🔧", "wrench", 7, 79, "E0.6", FullyQualified, ""},
+	{"🔨", "hammer", 7, 79, "E0.6", FullyQualified, ""},
+	{"🔩", "nut and bolt", 7, 79, "E0.6", FullyQualified, ""},
+	{"🔪", "kitchen knife", 4, 49, "E0.6", FullyQualified, ""},
+	{"🔫", "water pistol", 6, 64, "E0.6", FullyQualified, ""},
+	{"🔬", "microscope", 7, 80, "E1.0", FullyQualified, ""},
+	{"🔭", "telescope", 7, 80, "E1.0", FullyQualified, ""},
+	{"🔮", "crystal ball", 6, 64, "E0.6", FullyQualified, ""},
+	{"🔯", "dotted six-pointed star", 8, 87, "E0.6", FullyQualified, ""},
+	{"🔰", "Japanese symbol for beginner", 8, 94, "E0.6", FullyQualified, ""},
+	{"🔱", "trident emblem", 8, 94, "E0.6", FullyQualified, ""},
+	{"🔲", "black square button", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔳", "white square button", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔴", "red circle", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔵", "blue circle", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔶", "large orange diamond", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔷", "large blue diamond", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔸", "small orange diamond", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔹", "small blue diamond", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔺", "red triangle pointed up", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔻", "red triangle pointed down", 8, 97, "E0.6", FullyQualified, ""},
+	{"🔼", "upwards button", 8, 89, "E0.6", FullyQualified, ""},
+	{"🔽", "downwards button", 8, 89, "E0.6", FullyQualified, ""},
+	{"🕉", "om", 8, 87, "E0.7", Unqualified, "🕉️"},
+	{"🕉️", "om", 8, 87, "E0.7", FullyQualified, ""},
+	{"🕊", "dove", 3, 35, "E0.7", Unqualified, "🕊️"},
+	{"🕊️", "dove", 3, 35, "E0.7", FullyQualified, ""},
+	{"🕋", "kaaba", 5, 53, "E1.0", FullyQualified, ""},
+	{"🕌", "mosque", 5, 53, "E1.0", FullyQualified, ""},
+	{"🕍", "synagogue", 5, 53, "E1.0", FullyQualified, ""},
+	{"🕎", "menorah", 8, 87, "E1.0", FullyQualified, ""},
+	{"🕐", "one o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕑", "two o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕒", "three o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕓", "four o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕔", "five o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕕", "six o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕖", "seven o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕗", "eight o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕘", "nine o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕙", "ten o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕚", "eleven o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕛", "twelve o’clock", 5, 59, "E0.6", FullyQualified, ""},
+	{"🕜", "one-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕝", "two-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕞", "three-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕟", "four-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕠", "five-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕡", "six-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕢", "seven-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕣", "eight-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕤", "nine-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕥", "ten-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕦", "eleven-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕧", "twelve-thirty", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕯", "candle", 7, 72, "E0.7", Unqualified, "🕯️"},
+	{"🕯️", "candle", 7, 72, "E0.7", FullyQualified, ""},
+	{"🕰", "mantelpiece clock", 5, 59, "E0.7", Unqualified, "🕰️"},
+	{"🕰️", "mantelpiece clock", 5, 59, "E0.7", FullyQualified, ""},
+	{"🕳", "hole", 0, 15, "E0.7", Unqualified, "🕳️"},
+	{"🕳️", "hole", 0, 15, "E0.7", FullyQualified, ""},
+	{"🕴", "person in suit levitating", 1, 27, "E0.7", Unqualified, "🕴️"},
+	{"🕴️", "person in suit levitating", 1, 27, "E0.7", FullyQualified, ""},
+	{"🕴🏻", "person in suit levitating: light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🕴🏼", "person in suit levitating: medium-light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🕴🏽", "person in suit levitating: medium skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🕴🏾", "person in suit levitating: medium-dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🕴🏿", "person in suit levitating: dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🕵", "detective", 1, 25, "E0.7", Unqualified, "🕵️"},
+	{"🕵\u200d♀", "woman detective", 1, 25, "E4.0", Unqualified, "🕵️\u200d♀️"},
+	{"🕵\u200d♀️", "woman detective", 1, 25, "E4.0", Unqualified, "🕵️\u200d♀️"},
+	{"🕵\u200d♂", "man detective", 1, 25, "E4.0", Unqualified, "🕵️\u200d♂️"},
+	{"🕵\u200d♂️", "man detective", 1, 25, "E4.0", Unqualified, "🕵️\u200d♂️"},
+	{"🕵️", "detective", 1, 25, "E0.7", FullyQualified, ""},
+	{"🕵️\u200d♀", "woman detective", 1, 25, "E4.0", MinimallyQualified, "🕵️\u200d♀️"},
+	{"🕵️\u200d♀️", "woman detective", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵️\u200d♂", "man detective", 1, 25, "E4.0", MinimallyQualified, "🕵️\u200d♂️"},
+	{"🕵️\u200d♂️", "man detective", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵🏻", "detective: light skin tone", 1, 25, "E2.0", FullyQualified, ""},
+	{"🕵🏻\u200d♀", "woman detective: light skin tone", 1, 25, "E4.0", MinimallyQualified, "🕵🏻\u200d♀️"},
+	{"🕵🏻\u200d♀️", "woman detective: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵🏻\u200d♂", "man detective: light skin tone", 1, 25, "E4.0", MinimallyQualified, "🕵🏻\u200d♂️"},
+	{"🕵🏻\u200d♂️", "man detective: light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵🏼", "detective: medium-light skin tone", 1, 25, "E2.0", FullyQualified, ""},
+	{"🕵🏼\u200d♀", "woman detective: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "🕵🏼\u200d♀️"},
+	{"🕵🏼\u200d♀️", "woman detective: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵🏼\u200d♂", "man detective: medium-light skin tone", 1, 25, "E4.0", MinimallyQualified, "🕵🏼\u200d♂️"},
+	{"🕵🏼\u200d♂️", "man detective: medium-light skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵🏽", "detective: medium skin tone", 1, 25, "E2.0", FullyQualified, ""},
+	{"🕵🏽\u200d♀", "woman detective: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "🕵🏽\u200d♀️"},
+	{"🕵🏽\u200d♀️", "woman detective: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵🏽\u200d♂", "man detective: medium skin tone", 1, 25, "E4.0", MinimallyQualified, "🕵🏽\u200d♂️"},
+	{"🕵🏽\u200d♂️", "man detective: medium skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵🏾", "detective: medium-dark skin tone", 1, 25, "E2.0", FullyQualified, ""},
+	{"🕵🏾\u200d♀", "woman detective: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "🕵🏾\u200d♀️"},
+	{"🕵🏾\u200d♀️", "woman detective: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵🏾\u200d♂", "man detective: medium-dark skin tone", 1, 25, "E4.0", MinimallyQualified, "🕵🏾\u200d♂️"},
+	{"🕵🏾\u200d♂️", "man detective: medium-dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵🏿", "detective: dark skin tone", 1, 25, "E2.0", FullyQualified, ""},
+	{"🕵🏿\u200d♀", "woman detective: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "🕵🏿\u200d♀️"},
+	{"🕵🏿\u200d♀️", "woman detective: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕵🏿\u200d♂", "man detective: dark skin tone", 1, 25, "E4.0", MinimallyQualified, "🕵🏿\u200d♂️"},
+	{"🕵🏿\u200d♂️", "man detective: dark skin tone", 1, 25, "E4.0", FullyQualified, ""},
+	{"🕶", "sunglasses", 7, 66, "E0.7", Unqualified, "🕶️"},
+	{"🕶️", "sunglasses", 7, 66, "E0.7", FullyQualified, ""},
+	{"🕷", "spider", 3, 39, "E0.7", Unqualified, "🕷️"},
+	{"🕷️", "spider", 3, 39, "E0.7", FullyQualified, ""},
+	{"🕸", "spider web", 3, 39, "E0.7", Unqualified, "🕸️"},
+	{"🕸️", "spider web", 3, 39, "E0.7", FullyQualified, ""},
+	{"🕹", "joystick", 6, 64, "E0.7", Unqualified, "🕹️"},
+	{"🕹️", "joystick", 6, 64, "E0.7", FullyQualified, ""},
+	{"🕺", "man dancing", 1, 27, "E3.0", FullyQualified, ""},
+	{"🕺🏻", "man dancing: light skin tone", 1, 27, "E3.0", FullyQualified, ""},
+	{"🕺🏼", "man dancing: medium-light skin tone", 1, 27, "E3.0", FullyQualified, ""},
+	{"🕺🏽", "man dancing: medium skin tone", 1, 27, "E3.0", FullyQualified, ""},
+	{"🕺🏾", "man dancing: medium-dark skin tone", 1, 27, "E3.0", FullyQualified, ""},
+	{"🕺🏿", "man dancing: dark skin tone", 1, 27, "E3.0", FullyQualified, ""},
+	{"🖇", "linked paperclips", 7, 77, "E0.7", Unqualified, "🖇️"},
+	{"🖇️", "linked paperclips", 7, 77, "E0.7", FullyQualified, ""},
+	{"🖊", "pen", 7, 76, "E0.7", Unqualified, "🖊️"},
+	{"🖊️", "pen", 7, 76, "E0.7", FullyQualified, ""},
+	{"🖋", "fountain pen", 7, 76, "E0.7", Unqualified, "🖋️"},
+	{"🖋️", "fountain pen", 7, 76, "E0.7", FullyQualified, ""},
+	{"🖌", "paintbrush", 7, 76, "E0.7", Unqualified, "🖌️"},
+	{"🖌️", "paintbrush", 7, 76, "E0.7", FullyQualified, ""},
+	{"🖍", "crayon", 7, 76, "E0.7", Unqualified, "🖍️"},
+	{"🖍️", "crayon", 7, 76, "E0.7", FullyQualified, ""},
+	{"🖐", "hand with fingers splayed", 1, 16, "E0.7", Unqualified, "🖐️"},
+	{"🖐️", "hand with fingers splayed", 1, 16, "E0.7", FullyQualified, ""},
+	{"🖐🏻", "hand with fingers splayed: light skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖐🏼", "hand with fingers splayed: medium-light skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖐🏽", "hand with fingers splayed: medium skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖐🏾", "hand with fingers splayed: medium-dark skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖐🏿", "hand with fingers splayed: dark skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖕", "middle finger", 1, 18, "E1.0", FullyQualified, ""},
+	{"🖕🏻", "middle finger: light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"🖕🏼", "middle finger: medium-light skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"🖕🏽", "middle finger: medium skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"🖕🏾", "middle finger: medium-dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"🖕🏿", "middle finger: dark skin tone", 1, 18, "E1.0", FullyQualified, ""},
+	{"🖖", "vulcan salute", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖖🏻", "vulcan salute: light skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖖🏼", "vulcan salute: medium-light skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖖🏽", "vulcan salute: medium skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖖🏾", "vulcan salute: medium-dark skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖖🏿", "vulcan salute: dark skin tone", 1, 16, "E1.0", FullyQualified, ""},
+	{"🖤", "black heart", 0, 14, "E3.0", FullyQualified, ""},
+	{"🖥", "desktop computer", 7, 71, "E0.7", Unqualified, "🖥️"},
+	{"🖥️", "desktop computer", 7, 71, "E0.7", FullyQualified, ""},
+	{"🖨", "printer", 7, 71, "E0.7", Unqualified, "🖨️"},
+	{"🖨️", "printer", 7, 71, "E0.7", FullyQualified, ""},
+	{"🖱", "computer mouse", 7, 71, "E0.7", Unqualified, "🖱️"},
+	{"🖱️", "computer mouse", 7, 71, "E0.7", FullyQualified, ""},
+	{"🖲", "trackball", 7, 71, "E0.7", Unqualified, "🖲️"},
+	{"🖲️", "trackball", 7, 71, "E0.7", FullyQualified, ""},
+	{"🖼", "framed picture", 6, 65, "E0.7", Unqualified, "🖼️"},
+	{"🖼️", "framed picture", 6, 65, "E0.7", FullyQualified, ""},
+	{"🗂", "card index dividers", 7, 77, "E0.7", Unqualified, "🗂️"},
+	{"🗂️", "card index dividers", 7, 77, "E0.7", FullyQualified, ""},
+	{"🗃", "card file box", 7, 77, "E0.7", Unqualified, "🗃️"},
+	{"🗃️", "card file box", 7, 77, "E0.7", FullyQualified, ""},
+	{"🗄", "file cabinet", 7, 77, "E0.7", Unqualified, "🗄️"},
+	{"🗄️", "file cabinet", 7, 77, "E0.7", FullyQualified, ""},
+	{"🗑", "wastebasket", 7, 77, "E0.7", Unqualified, "🗑️"},
+	{"🗑️", "wastebasket", 7, 77, "E0.7", FullyQualified, ""},
+	{"🗒", "spiral notepad", 7, 77, "E0.7", Unqualified, "🗒️"},
+	{"🗒️", "spiral notepad", 7, 77, "E0.7", FullyQualified, ""},
+	{"🗓", "spiral calendar", 7, 77, "E0.7", Unqualified, "🗓️"},
+	{"🗓️", "spiral calendar", 7, 77, "E0.7", FullyQualified, ""},
+	{"🗜", "clamp", 7, 79, "E0.7", Unqualified, "🗜️"},
+	{"🗜️", "clamp", 7, 79, "E0.7", FullyQualified, ""},
+	{"🗝", "old key", 7, 78, "E0.7", Unqualified, "🗝️"},
+	{"🗝️", "old key", 7, 78, "E0.7", FullyQualified, ""},
+	{"🗞", "rolled-up newspaper", 7, 73, "E0.7", Unqualified, "🗞️"},
+	{"🗞️", "rolled-up newspaper", 7, 73, "E0.7", FullyQualified, ""},
+	{"🗡", "dagger", 7, 79, "E0.7", Unqualified, "🗡️"},
+	{"🗡️", "dagger", 7, 79, "E0.7", FullyQualified, ""},
+	{"🗣", "speaking head", 1, 31, "E0.7", Unqualified, "🗣️"},
+	{"🗣️", "speaking head", 1, 31, "E0.7", FullyQualified, ""},
+	{"🗨", "left speech bubble", 0, 15, "E2.0", Unqualified, "🗨️"},
+	{"🗨️", "left speech bubble", 0, 15, "E2.0", FullyQualified, ""},
+	{"🗯", "right anger bubble", 0, 15, "E0.7", Unqualified, "🗯️"},
+	{"🗯️", "right anger bubble", 0, 15, "E0.7", FullyQualified, ""},
+	{"🗳", "ballot box with ballot", 7, 75, "E0.7", Unqualified, "🗳️"},
+	{"🗳️", "ballot box with ballot", 7, 75, "E0.7", FullyQualified, ""},
+	{"🗺", "world map", 5, 50, "E0.7", Unqualified, "🗺️"},
+	{"🗺️", "world map", 5, 50, "E0.7", FullyQualified, ""},
+	{"🗻", "mount fuji", 5, 51, "E0.6", FullyQualified, ""},
+	{"🗼", "Tokyo tower", 5, 52, "E0.6", FullyQualified, ""},
+	{"🗽", "Statue of Liberty", 5, 52, "E0.6", FullyQualified, ""},
+	{"🗾", "map of Japan", 5, 50, "E0.6", FullyQualified, ""},
+	{"🗿", "moai", 7, 83, "E0.6", FullyQualified, ""},
+	{"😀", "grinning face", 0, 0, "E1.0", FullyQualified, ""},
+	{"😁", "beaming face with smiling eyes", 0, 0, "E0.6", FullyQualified, ""},
+	{"😂", "face with tears of joy", 0, 0, "E0.6", FullyQualified, ""},
+	{"😃", "grinning face with big eyes", 0, 0, "E0.6", FullyQualified, ""},
+	{"😄", "grinning face with smiling eyes", 0, 0, "E0.6", FullyQualified, ""},
+	{"😅", "grinning face with sweat", 0, 0, "E0.6", FullyQualified, ""},
+	{"😆", "grinning squinting face", 0, 0, "E0.6", FullyQualified, ""},
+	{"😇", "smiling face with halo", 0, 0, "E1.0", FullyQualified, ""},
+	{"😈", "smiling face with horns", 0, 10, "E1.0", FullyQualified, ""},
+	{"😉", "winking face", 0, 0, "E0.6", FullyQualified, ""},
+	{"😊", "smiling face with smiling eyes", 0, 0, "E0.6", FullyQualified, ""},
+	{"😋", "face savoring food", 0, 2, "E0.6", FullyQualified, ""},
+	{"😌", "relieved face", 0, 5, "E0.6", FullyQualified, ""},
+	{"😍", "smiling face with heart-eyes", 0, 1, "E0.6", FullyQualified, ""},
+	{"😎", "smiling face with sunglasses", 0, 8, "E1.0", FullyQualified, ""},
+	{"😏", "smirking face", 0, 4, "E0.6", FullyQualified, ""},
+	{"😐", "neutral face", 0, 4, "E0.7", FullyQualified, ""},
+	{"😑", "expressionless face", 0, 4, "E1.0", FullyQualified, ""},
+	{"😒", "unamused face", 0, 4, "E0.6", FullyQualified, ""},
+	{"😓", "downcast face with sweat", 0, 9, "E0.6", FullyQualified, ""},
+	{"😔", "pensive face", 0, 5, "E0.6", FullyQualified, ""},
+	{"😕", "confused face", 0, 9, "E1.0", FullyQualified, ""},
+	{"😖", "confounded face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😗", "kissing face", 0, 1, "E1.0", FullyQualified, ""},
+	{"😘", "face blowing a kiss", 0, 1, "E0.6", FullyQualified, ""},
+	{"😙", "kissing face with smiling eyes", 0, 1, "E1.0", FullyQualified, ""},
+	{"😚", "kissing face with closed eyes", 0, 1, "E0.6", FullyQualified, ""},
+	{"😛", "face with tongue", 0, 2, "E1.0", FullyQualified, ""},
+	{"😜", "winking face with tongue", 0, 2, "E0.6", FullyQualified, ""},
+	{"😝", "squinting face with tongue", 0, 2, "E0.6", FullyQualified, ""},
+	{"😞", "disappointed face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😟", "worried face", 0, 9, "E1.0", FullyQualified, ""},
+	{"😠", "angry face", 0, 10, "E0.6", FullyQualified, ""},
+	{"😡", "enraged face", 0, 10, "E0.6", FullyQualified, ""},
+	{"😢", "crying face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😣", "persevering face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😤", "face with steam from nose", 0, 10, "E0.6", FullyQualified, ""},
+	{"😥", "sad but relieved face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😦", "frowning face with open mouth", 0, 9, "E1.0", FullyQualified, ""},
+	{"😧", "anguished face", 0, 9, "E1.0", FullyQualified, ""},
+	{"😨", "fearful face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😩", "weary face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😪", "sleepy face", 0, 5, "E0.6", FullyQualified, ""},
+	{"😫", "tired face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😬", "grimacing face", 0, 4, "E1.0", FullyQualified, ""},
+	{"😭", "loudly crying face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😮", "face with open mouth", 0, 9, "E1.0", FullyQualified, ""},
+	{"😯", "hushed face", 0, 9, "E1.0", FullyQualified, ""},
+	{"😰", "anxious face with sweat", 0, 9, "E0.6", FullyQualified, ""},
+	{"😱", "face screaming in fear", 0, 9, "E0.6", FullyQualified, ""},
+	{"😲", "astonished face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😳", "flushed face", 0, 9, "E0.6", FullyQualified, ""},
+	{"😴", "sleeping face", 0, 5, "E1.0", FullyQualified, ""},
+	{"😵", "face with crossed-out eyes", 0, 6, "E0.6", FullyQualified, ""},
+	{"😶", "face without mouth", 0, 4, "E1.0", FullyQualified, ""},
+	{"😷", "face with medical mask", 0, 6, "E0.6", FullyQualified, ""},
+	{"😸", "grinning cat with smiling eyes", 0, 12, "E0.6", FullyQualified, ""},
+	{"😹", "cat with tears of joy", 0, 12, "E0.6", FullyQualified, ""},
+	{"😺", "grinning cat", 0, 12, "E0.6", FullyQualified, ""},
+	{"😻", "smiling cat with heart-eyes", 0, 12, "E0.6", FullyQualified, ""},
+	{"😼", "cat with wry smile", 0, 12, "E0.6", FullyQualified, ""},
+	{"😽", "kissing cat", 0, 12, "E0.6", FullyQualified, ""},
+	{"😾", "pouting cat", 0, 12, "E0.6", FullyQualified, ""},
+	{"😿", "crying cat", 0, 12, "E0.6", FullyQualified, ""},
+	{"🙀", "weary cat", 0, 12, "E0.6", FullyQualified, ""},
+	{"🙁", "slightly frowning face", 0, 9, "E1.0", FullyQualified, ""},
+	{"🙂", "slightly smiling face", 0, 0, "E1.0", FullyQualified, ""},
+	{"🙃", "upside-down face", 0, 0, "E1.0", FullyQualified, ""},
+	{"🙄", "face with rolling eyes", 0, 4, "E1.0", FullyQualified, ""},
+	{"🙅", "person gesturing NO", 1, 24, "E0.6", FullyQualified, ""},
+	{"🙅\u200d♀", "woman gesturing NO", 1, 24, "E4.0", MinimallyQualified, "🙅\u200d♀️"},
+	{"🙅\u200d♀️", "woman gesturing NO", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅\u200d♂", "man gesturing NO", 1, 24, "E4.0", MinimallyQualified, "🙅\u200d♂️"},
+	{"🙅\u200d♂️", "man gesturing NO", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅🏻", "person gesturing NO: light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙅🏻\u200d♀", "woman gesturing NO: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙅🏻\u200d♀️"},
+	{"🙅🏻\u200d♀️", "woman gesturing NO: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅🏻\u200d♂", "man gesturing NO: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙅🏻\u200d♂️"},
+	{"🙅🏻\u200d♂️", "man gesturing NO: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅🏼", "person gesturing NO: medium-light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙅🏼\u200d♀", "woman gesturing NO: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙅🏼\u200d♀️"},
+	{"🙅🏼\u200d♀️", "woman gesturing NO: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅🏼\u200d♂", "man gesturing NO: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙅🏼\u200d♂️"},
+	{"🙅🏼\u200d♂️", "man gesturing NO: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅🏽", "person gesturing NO: medium skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙅🏽\u200d♀", "woman gesturing NO: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙅🏽\u200d♀️"},
+	{"🙅🏽\u200d♀️", "woman gesturing NO: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅🏽\u200d♂", "man gesturing NO: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙅🏽\u200d♂️"},
+	{"🙅🏽\u200d♂️", "man gesturing NO: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅🏾", "person gesturing NO: medium-dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙅🏾\u200d♀", "woman gesturing NO: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙅🏾\u200d♀️"},
+	{"🙅🏾\u200d♀️", "woman gesturing NO: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅🏾\u200d♂", "man gesturing NO: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙅🏾\u200d♂️"},
+	{"🙅🏾\u200d♂️", "man gesturing NO: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅🏿", "person gesturing NO: dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙅🏿\u200d♀", "woman gesturing NO: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙅🏿\u200d♀️"},
+	{"🙅🏿\u200d♀️", "woman gesturing NO: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙅🏿\u200d♂", "man gesturing NO: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙅🏿\u200d♂️"},
+	{"🙅🏿\u200d♂️", "man gesturing NO: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆", "person gesturing OK", 1, 24, "E0.6", FullyQualified, ""},
+	{"🙆\u200d♀", "woman gesturing OK", 1, 24, "E4.0", MinimallyQualified, "🙆\u200d♀️"},
+	{"🙆\u200d♀️", "woman gesturing OK", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆\u200d♂", "man gesturing OK", 1, 24, "E4.0", MinimallyQualified, "🙆\u200d♂️"},
+	{"🙆\u200d♂️", "man gesturing OK", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆🏻", "person gesturing OK: light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙆🏻\u200d♀", "woman gesturing OK: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙆🏻\u200d♀️"},
+	{"🙆🏻\u200d♀️", "woman gesturing OK: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆🏻\u200d♂", "man gesturing OK: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙆🏻\u200d♂️"},
+	{"🙆🏻\u200d♂️", "man gesturing OK: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆🏼", "person gesturing OK: medium-light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙆🏼\u200d♀", "woman gesturing OK: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙆🏼\u200d♀️"},
+	{"🙆🏼\u200d♀️", "woman gesturing OK: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆🏼\u200d♂", "man gesturing OK: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙆🏼\u200d♂️"},
+	{"🙆🏼\u200d♂️", "man gesturing OK: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆🏽", "person gesturing OK: medium skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙆🏽\u200d♀", "woman gesturing OK: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙆🏽\u200d♀️"},
+	{"🙆🏽\u200d♀️", "woman gesturing OK: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆🏽\u200d♂", "man gesturing OK: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙆🏽\u200d♂️"},
+	{"🙆🏽\u200d♂️", "man gesturing OK: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆🏾", "person gesturing OK: medium-dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙆🏾\u200d♀", "woman gesturing OK: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙆🏾\u200d♀️"},
+	{"🙆🏾\u200d♀️", "woman gesturing OK: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆🏾\u200d♂", "man gesturing OK: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙆🏾\u200d♂️"},
+	{"🙆🏾\u200d♂️", "man gesturing OK: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆🏿", "person gesturing OK: dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙆🏿\u200d♀", "woman gesturing OK: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙆🏿\u200d♀️"},
+	{"🙆🏿\u200d♀️", "woman gesturing OK: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙆🏿\u200d♂", "man gesturing OK: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙆🏿\u200d♂️"},
+	{"🙆🏿\u200d♂️", "man gesturing OK: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇", "person bowing", 1, 24, "E0.6", FullyQualified, ""},
+	{"🙇\u200d♀", "woman bowing", 1, 24, "E4.0", MinimallyQualified, "🙇\u200d♀️"},
+	{"🙇\u200d♀️", "woman bowing", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇\u200d♂", "man bowing", 1, 24, "E4.0", MinimallyQualified, "🙇\u200d♂️"},
+	{"🙇\u200d♂️", "man bowing", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇🏻", "person bowing: light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙇🏻\u200d♀", "woman bowing: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙇🏻\u200d♀️"},
+	{"🙇🏻\u200d♀️", "woman bowing: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇🏻\u200d♂", "man bowing: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙇🏻\u200d♂️"},
+	{"🙇🏻\u200d♂️", "man bowing: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇🏼", "person bowing: medium-light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙇🏼\u200d♀", "woman bowing: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙇🏼\u200d♀️"},
+	{"🙇🏼\u200d♀️", "woman bowing: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇🏼\u200d♂", "man bowing: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙇🏼\u200d♂️"},
+	{"🙇🏼\u200d♂️", "man bowing: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇🏽", "person bowing: medium skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙇🏽\u200d♀", "woman bowing: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙇🏽\u200d♀️"},
+	{"🙇🏽\u200d♀️", "woman bowing: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇🏽\u200d♂", "man bowing: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙇🏽\u200d♂️"},
+	{"🙇🏽\u200d♂️", "man bowing: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇🏾", "person bowing: medium-dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙇🏾\u200d♀", "woman bowing: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙇🏾\u200d♀️"},
+	{"🙇🏾\u200d♀️", "woman bowing: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇🏾\u200d♂", "man bowing: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙇🏾\u200d♂️"},
+	{"🙇🏾\u200d♂️", "man bowing: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇🏿", "person bowing: dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙇🏿\u200d♀", "woman bowing: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙇🏿\u200d♀️"},
+	{"🙇🏿\u200d♀️", "woman bowing: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙇🏿\u200d♂", "man bowing: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙇🏿\u200d♂️"},
+	{"🙇🏿\u200d♂️", "man bowing: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙈", "see-no-evil monkey", 0, 13, "E0.6", FullyQualified, ""},
+	{"🙉", "hear-no-evil monkey", 0, 13, "E0.6", FullyQualified, ""},
+	{"🙊", "speak-no-evil monkey", 0, 13, "E0.6", FullyQualified, ""},
+	{"🙋", "person raising hand", 1, 24, "E0.6", FullyQualified, ""},
+	{"🙋\u200d♀", "woman raising hand", 1, 24, "E4.0", MinimallyQualified, "🙋\u200d♀️"},
+	{"🙋\u200d♀️", "woman raising hand", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋\u200d♂", "man raising hand", 1, 24, "E4.0", MinimallyQualified, "🙋\u200d♂️"},
+	{"🙋\u200d♂️", "man raising hand", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋🏻", "person raising hand: light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙋🏻\u200d♀", "woman raising hand: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙋🏻\u200d♀️"},
+	{"🙋🏻\u200d♀️", "woman raising hand: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋🏻\u200d♂", "man raising hand: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙋🏻\u200d♂️"},
+	{"🙋🏻\u200d♂️", "man raising hand: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋🏼", "person raising hand: medium-light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙋🏼\u200d♀", "woman raising hand: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙋🏼\u200d♀️"},
+	{"🙋🏼\u200d♀️", "woman raising hand: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋🏼\u200d♂", "man raising hand: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙋🏼\u200d♂️"},
+	{"🙋🏼\u200d♂️", "man raising hand: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋🏽", "person raising hand: medium skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙋🏽\u200d♀", "woman raising hand: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙋🏽\u200d♀️"},
+	{"🙋🏽\u200d♀️", "woman raising hand: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋🏽\u200d♂", "man raising hand: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙋🏽\u200d♂️"},
+	{"🙋🏽\u200d♂️", "man raising hand: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋🏾", "person raising hand: medium-dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙋🏾\u200d♀", "woman raising hand: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙋🏾\u200d♀️"},
+	{"🙋🏾\u200d♀️", "woman raising hand: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋🏾\u200d♂", "man raising hand: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙋🏾\u200d♂️"},
+	{"🙋🏾\u200d♂️", "man raising hand: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋🏿", "person raising hand: dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙋🏿\u200d♀", "woman raising hand: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙋🏿\u200d♀️"},
+	{"🙋🏿\u200d♀️", "woman raising hand: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙋🏿\u200d♂", "man raising hand: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙋🏿\u200d♂️"},
+	{"🙋🏿\u200d♂️", "man raising hand: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙌", "raising hands", 1, 20, "E0.6", FullyQualified, ""},
+	{"🙌🏻", "raising hands: light skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"🙌🏼", "raising hands: medium-light skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"🙌🏽", "raising hands: medium skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"🙌🏾", "raising hands: medium-dark skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"🙌🏿", "raising hands: dark skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"🙍", "person frowning", 1, 24, "E0.6", FullyQualified, ""},
+	{"🙍\u200d♀", "woman frowning", 1, 24, "E4.0", MinimallyQualified, "🙍\u200d♀️"},
+	{"🙍\u200d♀️", "woman frowning", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍\u200d♂", "man frowning", 1, 24, "E4.0", MinimallyQualified, "🙍\u200d♂️"},
+	{"🙍\u200d♂️", "man frowning", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍🏻", "person frowning: light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙍🏻\u200d♀", "woman frowning: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙍🏻\u200d♀️"},
+	{"🙍🏻\u200d♀️", "woman frowning: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍🏻\u200d♂", "man frowning: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙍🏻\u200d♂️"},
+	{"🙍🏻\u200d♂️", "man frowning: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍🏼", "person frowning: medium-light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙍🏼\u200d♀", "woman frowning: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙍🏼\u200d♀️"},
+	{"🙍🏼\u200d♀️", "woman frowning: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍🏼\u200d♂", "man frowning: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙍🏼\u200d♂️"},
+	{"🙍🏼\u200d♂️", "man frowning: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍🏽", "person frowning: medium skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙍🏽\u200d♀", "woman frowning: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙍🏽\u200d♀️"},
+	{"🙍🏽\u200d♀️", "woman frowning: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍🏽\u200d♂", "man frowning: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙍🏽\u200d♂️"},
+	{"🙍🏽\u200d♂️", "man frowning: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍🏾", "person frowning: medium-dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙍🏾\u200d♀", "woman frowning: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙍🏾\u200d♀️"},
+	{"🙍🏾\u200d♀️", "woman frowning: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍🏾\u200d♂", "man frowning: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙍🏾\u200d♂️"},
+	{"🙍🏾\u200d♂️", "man frowning: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍🏿", "person frowning: dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙍🏿\u200d♀", "woman frowning: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙍🏿\u200d♀️"},
+	{"🙍🏿\u200d♀️", "woman frowning: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙍🏿\u200d♂", "man frowning: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙍🏿\u200d♂️"},
+	{"🙍🏿\u200d♂️", "man frowning: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎", "person pouting", 1, 24, "E0.6", FullyQualified, ""},
+	{"🙎\u200d♀", "woman pouting", 1, 24, "E4.0", MinimallyQualified, "🙎\u200d♀️"},
+	{"🙎\u200d♀️", "woman pouting", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎\u200d♂", "man pouting", 1, 24, "E4.0", MinimallyQualified, "🙎\u200d♂️"},
+	{"🙎\u200d♂️", "man pouting", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎🏻", "person pouting: light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙎🏻\u200d♀", "woman pouting: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙎🏻\u200d♀️"},
+	{"🙎🏻\u200d♀️", "woman pouting: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎🏻\u200d♂", "man pouting: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙎🏻\u200d♂️"},
+	{"🙎🏻\u200d♂️", "man pouting: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎🏼", "person pouting: medium-light skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙎🏼\u200d♀", "woman pouting: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙎🏼\u200d♀️"},
+	{"🙎🏼\u200d♀️", "woman pouting: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎🏼\u200d♂", "man pouting: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🙎🏼\u200d♂️"},
+	{"🙎🏼\u200d♂️", "man pouting: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎🏽", "person pouting: medium skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙎🏽\u200d♀", "woman pouting: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙎🏽\u200d♀️"},
+	{"🙎🏽\u200d♀️", "woman pouting: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎🏽\u200d♂", "man pouting: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🙎🏽\u200d♂️"},
+	{"🙎🏽\u200d♂️", "man pouting: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎🏾", "person pouting: medium-dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙎🏾\u200d♀", "woman pouting: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙎🏾\u200d♀️"},
+	{"🙎🏾\u200d♀️", "woman pouting: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎🏾\u200d♂", "man pouting: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙎🏾\u200d♂️"},
+	{"🙎🏾\u200d♂️", "man pouting: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎🏿", "person pouting: dark skin tone", 1, 24, "E1.0", FullyQualified, ""},
+	{"🙎🏿\u200d♀", "woman pouting: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙎🏿\u200d♀️"},
+	{"🙎🏿\u200d♀️", "woman pouting: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙎🏿\u200d♂", "man pouting: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🙎🏿\u200d♂️"},
+	{"🙎🏿\u200d♂️", "man pouting: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🙏", "folded hands", 1, 20, "E0.6", FullyQualified, ""},
+	{"🙏🏻", "folded hands: light skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"🙏🏼", "folded hands: medium-light skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"🙏🏽", "folded hands: medium skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"🙏🏾", "folded hands: medium-dark skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"🙏🏿", "folded hands: dark skin tone", 1, 20, "E1.0", FullyQualified, ""},
+	{"🚀", "rocket", 5, 57, "E0.6", FullyQualified, ""},
+	{"🚁", "helicopter", 5, 57, "E1.0", FullyQualified, ""},
+	{"🚂", "locomotive", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚃", "railway car", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚄", "high-speed train", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚅", "bullet train", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚆", "train", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚇", "metro", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚈", "light rail", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚉", "station", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚊", "tram", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚋", "tram car", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚌", "bus", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚍", "oncoming bus", 5, 55, "E0.7", FullyQualified, ""},
+	{"🚎", "trolleybus", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚏", "bus stop", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚐", "minibus", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚑", "ambulance", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚒", "fire engine", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚓", "police car", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚔", "oncoming police car", 5, 55, "E0.7", FullyQualified, ""},
+	{"🚕", "taxi", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚖", "oncoming taxi", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚗", "automobile", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚘", "oncoming automobile", 5, 55, "E0.7", FullyQualified, ""},
+	{"🚙", "sport utility vehicle", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚚", "delivery truck", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚛", "articulated lorry", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚜", "tractor", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚝", "monorail", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚞", "mountain railway", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚟", "suspension railway", 5, 57, "E1.0", FullyQualified, ""},
+	{"🚠", "mountain cableway", 5, 57, "E1.0", FullyQualified, ""},
+	{"🚡", "aerial tramway", 5, 57, "E1.0", FullyQualified, ""},
+	{"🚢", "ship", 5, 56, "E0.6", FullyQualified, ""},
+	{"🚣", "person rowing boat", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚣\u200d♀", "woman rowing boat", 1, 28, "E4.0", MinimallyQualified, "🚣\u200d♀️"},
+	{"🚣\u200d♀️", "woman rowing boat", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣\u200d♂", "man rowing boat", 1, 28, "E4.0", MinimallyQualified, "🚣\u200d♂️"},
+	{"🚣\u200d♂️", "man rowing boat", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣🏻", "person rowing boat: light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚣🏻\u200d♀", "woman rowing boat: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚣🏻\u200d♀️"},
+	{"🚣🏻\u200d♀️", "woman rowing boat: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣🏻\u200d♂", "man rowing boat: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚣🏻\u200d♂️"},
+	{"🚣🏻\u200d♂️", "man rowing boat: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣🏼", "person rowing boat: medium-light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚣🏼\u200d♀", "woman rowing boat: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚣🏼\u200d♀️"},
+	{"🚣🏼\u200d♀️", "woman rowing boat: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣🏼\u200d♂", "man rowing boat: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚣🏼\u200d♂️"},
+	{"🚣🏼\u200d♂️", "man rowing boat: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣🏽", "person rowing boat: medium skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚣🏽\u200d♀", "woman rowing boat: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🚣🏽\u200d♀️"},
+	{"🚣🏽\u200d♀️", "woman rowing boat: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣🏽\u200d♂", "man rowing boat: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🚣🏽\u200d♂️"},
+	{"🚣🏽\u200d♂️", "man rowing boat: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣🏾", "person rowing boat: medium-dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚣🏾\u200d♀", "woman rowing boat: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚣🏾\u200d♀️"},
+	{"🚣🏾\u200d♀️", "woman rowing boat: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣🏾\u200d♂", "man rowing boat: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚣🏾\u200d♂️"},
+	{"🚣🏾\u200d♂️", "man rowing boat: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣🏿", "person rowing boat: dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚣🏿\u200d♀", "woman rowing boat: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚣🏿\u200d♀️"},
+	{"🚣🏿\u200d♀️", "woman rowing boat: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚣🏿\u200d♂", "man rowing boat: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚣🏿\u200d♂️"},
+	{"🚣🏿\u200d♂️", "man rowing boat: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚤", "speedboat", 5, 56, "E0.6", FullyQualified, ""},
+	{"🚥", "horizontal traffic light", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚦", "vertical traffic light", 5, 55, "E1.0", FullyQualified, ""},
+	{"🚧", "construction", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚨", "police car light", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚩", "triangular flag", 9, 98, "E0.6", FullyQualified, ""},
+	{"🚪", "door", 7, 82, "E0.6", FullyQualified, ""},
+	{"🚫", "prohibited", 8, 85, "E0.6", FullyQualified, ""},
+	{"🚬", "cigarette", 7, 83, "E0.6", FullyQualified, ""},
+	{"🚭", "no smoking", 8, 85, "E0.6", FullyQualified, ""},
+	{"🚮", "litter in bin sign", 8, 84, "E1.0", FullyQualified, ""},
+	{"🚯", "no littering", 8, 85, "E1.0", FullyQualified, ""},
+	{"🚰", "potable water", 8, 84, "E1.0", FullyQualified, ""},
+	{"🚱", "non-potable water", 8, 85, "E1.0", FullyQualified, ""},
+	{"🚲", "bicycle", 5, 55, "E0.6", FullyQualified, ""},
+	{"🚳", "no bicycles", 8, 85, "E1.0", FullyQualified, ""},
+	{"🚴", "person biking", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚴\u200d♀", "woman biking", 1, 28, "E4.0", MinimallyQualified, "🚴\u200d♀️"},
+	{"🚴\u200d♀️", "woman biking", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴\u200d♂", "man biking", 1, 28, "E4.0", MinimallyQualified, "🚴\u200d♂️"},
+	{"🚴\u200d♂️", "man biking", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴🏻", "person biking: light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚴🏻\u200d♀", "woman biking: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚴🏻\u200d♀️"},
+	{"🚴🏻\u200d♀️", "woman biking: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴🏻\u200d♂", "man biking: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚴🏻\u200d♂️"},
+	{"🚴🏻\u200d♂️", "man biking: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴🏼", "person biking: medium-light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚴🏼\u200d♀", "woman biking: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚴🏼\u200d♀️"},
+	{"🚴🏼\u200d♀️", "woman biking: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴🏼\u200d♂", "man biking: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚴🏼\u200d♂️"},
+	{"🚴🏼\u200d♂️", "man biking: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴🏽", "person biking: medium skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚴🏽\u200d♀", "woman biking: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🚴🏽\u200d♀️"},
+	{"🚴🏽\u200d♀️", "woman biking: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴🏽\u200d♂", "man biking: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🚴🏽\u200d♂️"},
+	{"🚴🏽\u200d♂️", "man biking: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴🏾", "person biking: medium-dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚴🏾\u200d♀", "woman biking: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚴🏾\u200d♀️"},
+	{"🚴🏾\u200d♀️", "woman biking: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴🏾\u200d♂", "man biking: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚴🏾\u200d♂️"},
+	{"🚴🏾\u200d♂️", "man biking: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴🏿", "person biking: dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚴🏿\u200d♀", "woman biking: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚴🏿\u200d♀️"},
+	{"🚴🏿\u200d♀️", "woman biking: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚴🏿\u200d♂", "man biking: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚴🏿\u200d♂️"},
+	{"🚴🏿\u200d♂️", "man biking: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵", "person mountain biking", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚵\u200d♀", "woman mountain biking", 1, 28, "E4.0", MinimallyQualified, "🚵\u200d♀️"},
+	{"🚵\u200d♀️", "woman mountain biking", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵\u200d♂", "man mountain biking", 1, 28, "E4.0", MinimallyQualified, "🚵\u200d♂️"},
+	{"🚵\u200d♂️", "man mountain biking", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵🏻", "person mountain biking: light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚵🏻\u200d♀", "woman mountain biking: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚵🏻\u200d♀️"},
+	{"🚵🏻\u200d♀️", "woman mountain biking: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵🏻\u200d♂", "man mountain biking: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚵🏻\u200d♂️"},
+	{"🚵🏻\u200d♂️", "man mountain biking: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵🏼", "person mountain biking: medium-light skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚵🏼\u200d♀", "woman mountain biking: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚵🏼\u200d♀️"},
+	{"🚵🏼\u200d♀️", "woman mountain biking: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵🏼\u200d♂", "man mountain biking: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🚵🏼\u200d♂️"},
+	{"🚵🏼\u200d♂️", "man mountain biking: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵🏽", "person mountain biking: medium skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚵🏽\u200d♀", "woman mountain biking: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🚵🏽\u200d♀️"},
+	{"🚵🏽\u200d♀️", "woman mountain biking: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵🏽\u200d♂", "man mountain biking: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🚵🏽\u200d♂️"},
+	{"🚵🏽\u200d♂️", "man mountain biking: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵🏾", "person mountain biking: medium-dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚵🏾\u200d♀", "woman mountain biking: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚵🏾\u200d♀️"},
+	{"🚵🏾\u200d♀️", "woman mountain biking: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵🏾\u200d♂", "man mountain biking: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚵🏾\u200d♂️"},
+	{"🚵🏾\u200d♂️", "man mountain biking: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵🏿", "person mountain biking: dark skin tone", 1, 28, "E1.0", FullyQualified, ""},
+	{"🚵🏿\u200d♀", "woman mountain biking: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚵🏿\u200d♀️"},
+	{"🚵🏿\u200d♀️", "woman mountain biking: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚵🏿\u200d♂", "man mountain biking: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🚵🏿\u200d♂️"},
+	{"🚵🏿\u200d♂️", "man mountain biking: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🚶", "person walking", 1, 27, "E0.6", FullyQualified, ""},
+	{"🚶\u200d♀", "woman walking", 1, 27, "E4.0", MinimallyQualified, "🚶\u200d♀️"},
+	{"🚶\u200d♀️", "woman walking", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶\u200d♂", "man walking", 1, 27, "E4.0", MinimallyQualified, "🚶\u200d♂️"},
+	{"🚶\u200d♂️", "man walking", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶🏻", "person walking: light skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"🚶🏻\u200d♀", "woman walking: light skin tone", 1, 27, "E4.0", MinimallyQualified, "🚶🏻\u200d♀️"},
+	{"🚶🏻\u200d♀️", "woman walking: light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶🏻\u200d♂", "man walking: light skin tone", 1, 27, "E4.0", MinimallyQualified, "🚶🏻\u200d♂️"},
+	{"🚶🏻\u200d♂️", "man walking: light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶🏼", "person walking: medium-light skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"🚶🏼\u200d♀", "woman walking: medium-light skin tone", 1, 27, "E4.0", MinimallyQualified, "🚶🏼\u200d♀️"},
+	{"🚶🏼\u200d♀️", "woman walking: medium-light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶🏼\u200d♂", "man walking: medium-light skin tone", 1, 27, "E4.0", MinimallyQualified, "🚶🏼\u200d♂️"},
+	{"🚶🏼\u200d♂️", "man walking: medium-light skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶🏽", "person walking: medium skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"🚶🏽\u200d♀", "woman walking: medium skin tone", 1, 27, "E4.0", MinimallyQualified, "🚶🏽\u200d♀️"},
+	{"🚶🏽\u200d♀️", "woman walking: medium skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶🏽\u200d♂", "man walking: medium skin tone", 1, 27, "E4.0", MinimallyQualified, "🚶🏽\u200d♂️"},
+	{"🚶🏽\u200d♂️", "man walking: medium skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶🏾", "person walking: medium-dark skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"🚶🏾\u200d♀", "woman walking: medium-dark skin tone", 1, 27, "E4.0", MinimallyQualified, "🚶🏾\u200d♀️"},
+	{"🚶🏾\u200d♀️", "woman walking: medium-dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶🏾\u200d♂", "man walking: medium-dark skin tone", 1, 27, "E4.0", MinimallyQualified, "🚶🏾\u200d♂️"},
+	{"🚶🏾\u200d♂️", "man walking: medium-dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶🏿", "person walking: dark skin tone", 1, 27, "E1.0", FullyQualified, ""},
+	{"🚶🏿\u200d♀", "woman walking: dark skin tone", 1, 27, "E4.0", MinimallyQualified, "🚶🏿\u200d♀️"},
+	{"🚶🏿\u200d♀️", "woman walking: dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚶🏿\u200d♂", "man walking: dark skin tone", 1, 27, "E4.0", MinimallyQualified, "🚶🏿\u200d♂️"},
+	{"🚶🏿\u200d♂️", "man walking: dark skin tone", 1, 27, "E4.0", FullyQualified, ""},
+	{"🚷", "no pedestrians", 8, 85, "E1.0", FullyQualified, ""},
+	{"🚸", "children crossing", 8, 85, "E1.0", FullyQualified, ""},
+	{"🚹", "men’s room", 8, 84, "E0.6", FullyQualified, ""},
+	{"🚺", "women’s room", 8, 84, "E0.6", FullyQualified, ""},
+	{"🚻", "restroom", 8, 84, "E0.6", FullyQualified, ""},
+	{"🚼", "baby symbol", 8, 84, "E0.6", FullyQualified, ""},
+	{"🚽", "toilet", 7, 82, "E0.6", FullyQualified, ""},
+	{"🚾", "water closet", 8, 84, "E0.6", FullyQualified, ""},
+	{"🚿", "shower", 7, 82, "E1.0", FullyQualified, ""},
+	{"🛀", "person taking bath", 1, 29, "E0.6", FullyQualified, ""},
+	{"🛀🏻", "person taking bath: light skin tone", 1, 29, "E1.0", FullyQualified, ""},
+	{"🛀🏼", "person taking bath: medium-light skin tone", 1, 29, "E1.0", FullyQualified, ""},
+	{"🛀🏽", "person taking bath: medium skin tone", 1, 29, "E1.0", FullyQualified, ""},
+	{"🛀🏾", "person taking bath: medium-dark skin tone", 1, 29, "E1.0", FullyQualified, ""},
+	{"🛀🏿", "person taking bath: dark skin tone", 1, 29, "E1.0", FullyQualified, ""},
+	{"🛁", "bathtub", 7, 82, "E1.0", FullyQualified, ""},
+	{"🛂", "passport control", 8, 84, "E1.0", FullyQualified, ""},
+	{"🛃", "customs", 8, 84, "E1.0", FullyQualified, ""},
+	{"🛄", "baggage claim", 8, 84, "E1.0", FullyQualified, ""},
+	{"🛅", "left luggage", 8, 84, "E1.0", FullyQualified, ""},
+	{"🛋", "couch and lamp", 7, 82, "E0.7", Unqualified, "🛋️"},
+	{"🛋️", "couch and lamp", 7, 82, "E0.7", FullyQualified, ""},
+	{"🛌", "person in bed", 1, 29, "E1.0", FullyQualified, ""},
+	{"🛌🏻", "person in bed: light skin tone", 1, 29, "E4.0", FullyQualified, ""},
+	{"🛌🏼", "person in bed: medium-light skin tone", 1, 29, "E4.0", FullyQualified, ""},
+	{"🛌🏽", "person in bed: medium skin tone", 1, 29, "E4.0", FullyQualified, ""},
+	{"🛌🏾", "person in bed: medium-dark skin tone", 1, 29, "E4.0", FullyQualified, ""},
+	{"🛌🏿", "person in bed: dark skin tone", 1, 29, "E4.0", FullyQualified, ""},
+	{"🛍", "shopping bags", 7, 66, "E0.7", Unqualified, "🛍️"},
+	{"🛍️", "shopping bags", 7, 66, "E0.7", FullyQualified, ""},
+	{"🛎", "bellhop bell", 5, 58, "E0.7", Unqualified, "🛎️"},
+	{"🛎️", "bellhop bell", 5, 58, "E0.7", FullyQualified, ""},
+	{"🛏", "bed", 7, 82, "E0.7", Unqualified, "🛏️"},
+	{"🛏️", "bed", 7, 82, "E0.7", FullyQualified, ""},
+	{"🛐", "place of worship", 8, 87, "E1.0", FullyQualified, ""},
+	{"🛑", "stop sign", 5, 55, "E3.0", FullyQualified, ""},
+	{"🛒", "shopping cart", 7, 82, "E3.0", FullyQualified, ""},
+	{"🛕", "hindu temple", 5, 53, "E12.0", FullyQualified, ""},
+	{"🛖", "hut", 5, 52, "E13.0", FullyQualified, ""},
+	{"🛗", "elevator", 7, 82, "E13.0", FullyQualified, ""},
+	{"🛠", "hammer and wrench", 7, 79, "E0.7", Unqualified, "🛠️"},
+	{"🛠️", "hammer and wrench", 7, 79, "E0.7", FullyQualified, ""},
+	{"🛡", "shield", 7, 79, "E0.7", Unqualified, "🛡️"},
+	{"🛡️", "shield", 7, 79, "E0.7", FullyQualified, ""},
+	{"🛢", "oil drum", 5, 55, "E0.7", Unqualified, "🛢️"},
+	{"🛢️", "oil drum", 5, 55, "E0.7", FullyQualified, ""},
+	{"🛣", "motorway", 5, 55, "E0.7", Unqualified, "🛣️"},
+	{"🛣️", "motorway", 5, 55, "E0.7", FullyQualified, ""},
+	{"🛤", "railway track", 5, 55, "E0.7", Unqualified, "🛤️"},
+	{"🛤️", "railway track", 5, 55, "E0.7", FullyQualified, ""},
+	{"🛥", "motor boat", 5, 56, "E0.7", Unqualified, "🛥️"},
+	{"🛥️", "motor boat", 5, 56, "E0.7", FullyQualified, ""},
+	{"🛩", "small airplane", 5, 57, "E0.7", Unqualified, "🛩️"},
+	{"🛩️", "small airplane", 5, 57, "E0.7", FullyQualified, ""},
+	{"🛫", "airplane departure", 5, 57, "E1.0", FullyQualified, ""},
+	{"🛬", "airplane arrival", 5, 57, "E1.0", FullyQualified, ""},
+	{"🛰", "satellite", 5, 57, "E0.7", Unqualified, "🛰️"},
+	{"🛰️", "satellite", 5, 57, "E0.7", FullyQualified, ""},
+	{"🛳", "passenger ship", 5, 56, "E0.7", Unqualified, "🛳️"},
+	{"🛳️", "passenger ship", 5, 56, "E0.7", FullyQualified, ""},
+	{"🛴", "kick scooter", 5, 55, "E3.0", FullyQualified, ""},
+	{"🛵", "motor scooter", 5, 55, "E3.0", FullyQualified, ""},
+	{"🛶", "canoe", 5, 56, "E3.0", FullyQualified, ""},
+	{"🛷", "sled", 6, 63, "E5.0", FullyQualified, ""},
+	{"🛸", "flying saucer", 5, 57, "E5.0", FullyQualified, ""},
+	{"🛹", "skateboard", 5, 55, "E11.0", FullyQualified, ""},
+	{"🛺", "auto rickshaw", 5, 55, "E12.0", FullyQualified, ""},
+	{"🛻", "pickup truck", 5, 55, "E13.0", FullyQualified, ""},
+	{"🛼", "roller skate", 5, 55, "E13.0", FullyQualified, ""},
+	{"🟠", "orange circle", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟡", "yellow circle", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟢", "green circle", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟣", "purple circle", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟤", "brown circle", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟥", "red square", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟦", "blue square", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟧", "orange square", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟨", "yellow square", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟩", "green square", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟪", "purple square", 8, 97, "E12.0", FullyQualified, ""},
+	{"🟫", "brown square", 8, 97, "E12.0", FullyQualified, ""},
+	{"🤌", "pinched fingers", 1, 17, "E13.0", FullyQualified, ""},
+	{"🤌🏻", "pinched fingers: light skin tone", 1, 17, "E13.0", FullyQualified, ""},
+	{"🤌🏼", "pinched fingers: medium-light skin tone", 1, 17, "E13.0", FullyQualified, ""},
+	{"🤌🏽", "pinched fingers: medium skin tone", 1, 17, "E13.0", FullyQualified, ""},
+	{"🤌🏾", "pinched fingers: medium-dark skin tone", 1, 17, "E13.0", FullyQualified, ""},
+	{"🤌🏿", "pinched fingers: dark skin tone", 1, 17, "E13.0", FullyQualified, ""},
+	{"🤍", "white heart", 0, 14, "E12.0", FullyQualified, ""},
+	{"🤎", "brown heart", 0, 14, "E12.0", FullyQualified, ""},
+	{"🤏", "pinching hand", 1, 17, "E12.0", FullyQualified, ""},
+	{"🤏🏻", "pinching hand: light skin tone", 1, 17, "E12.0", FullyQualified, ""},
+	{"🤏🏼", "pinching hand: medium-light skin tone", 1, 17, "E12.0", FullyQualified, ""},
+	{"🤏🏽", "pinching hand: medium skin tone", 1, 17, "E12.0", FullyQualified, ""},
+	{"🤏🏾", "pinching hand: medium-dark skin tone", 1, 17, "E12.0", FullyQualified, ""},
+	{"🤏🏿", "pinching hand: dark skin tone", 1, 17, "E12.0", FullyQualified, ""},
+	{"🤐", "zipper-mouth face", 0, 4, "E1.0", FullyQualified, ""},
+	{"🤑", "money-mouth face", 0, 2, "E1.0", FullyQualified, ""},
+	{"🤒", "face with thermometer", 0, 6, "E1.0", FullyQualified, ""},
+	{"🤓", "nerd face", 0, 8, "E1.0", FullyQualified, ""},
+	{"🤔", "thinking face", 0, 3, "E1.0", FullyQualified, ""},
+	{"🤕", "face with head-bandage", 0, 6, "E1.0", FullyQualified, ""},
+	{"🤖", "robot", 0, 11, "E1.0", FullyQualified, ""},
+	{"🤗", "smiling face with open hands", 0, 3, "E1.0", FullyQualified, ""},
+	{"🤘", "sign of the horns", 1, 17, "E1.0", FullyQualified, ""},
+	{"🤘🏻", "sign of the horns: light skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"🤘🏼", "sign of the horns: medium-light skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"🤘🏽", "sign of the horns: medium skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"🤘🏾", "sign of the horns: medium-dark skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"🤘🏿", "sign of the horns: dark skin tone", 1, 17, "E1.0", FullyQualified, ""},
+	{"🤙", "call me hand", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤙🏻", "call me hand: light skin tone", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤙🏼", "call me hand: medium-light skin tone", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤙🏽", "call me hand: medium skin tone", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤙🏾", "call me hand: medium-dark skin tone", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤙🏿", "call me hand: dark skin tone", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤚", "raised back of hand", 1, 16, "E3.0", FullyQualified, ""},
+	{"🤚🏻", "raised back of hand: light skin tone", 1, 16, "E3.0", FullyQualified, ""},
+	{"🤚🏼", "raised back of hand: medium-light skin tone", 1, 16, "E3.0", FullyQualified, ""},
+	{"🤚🏽", "raised back of hand: medium skin tone", 1, 16, "E3.0", FullyQualified, ""},
+	{"🤚🏾", "raised back of hand: medium-dark skin tone", 1, 16, "E3.0", FullyQualified, ""},
+	{"🤚🏿", "raised back of hand: dark skin tone", 1, 16, "E3.0", FullyQualified, ""},
+	{"🤛", "left-facing fist", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤛🏻", "left-facing fist: light skin tone", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤛🏼", "left-facing fist: medium-light skin tone", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤛🏽", "left-facing fist: medium skin tone", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤛🏾", "left-facing fist: medium-dark skin tone", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤛🏿", "left-facing fist: dark skin tone", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤜", "right-facing fist", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤜🏻", "right-facing fist: light skin tone", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤜🏼", "right-facing fist: medium-light skin tone", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤜🏽", "right-facing fist: medium skin tone", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤜🏾", "right-facing fist: medium-dark skin tone", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤜🏿", "right-facing fist: dark skin tone", 1, 19, "E3.0", FullyQualified, ""},
+	{"🤝", "handshake", 1, 20, "E3.0", FullyQualified, ""},
+	{"🤞", "crossed fingers", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤞🏻", "crossed fingers: light skin tone", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤞🏼", "crossed fingers: medium-light skin tone", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤞🏽", "crossed fingers: medium skin tone", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤞🏾", "crossed fingers: medium-dark skin tone", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤞🏿", "crossed fingers: dark skin tone", 1, 17, "E3.0", FullyQualified, ""},
+	{"🤟", "love-you gesture", 1, 17, "E5.0", FullyQualified, ""},
+	{"🤟🏻", "love-you gesture: light skin tone", 1, 17, "E5.0", FullyQualified, ""},
+	{"🤟🏼", "love-you gesture: medium-light skin tone", 1, 17, "E5.0", FullyQualified, ""},
+	{"🤟🏽", "love-you gesture: medium skin tone", 1, 17, "E5.0", FullyQualified, ""},
+	{"🤟🏾", "love-you gesture: medium-dark skin tone", 1, 17, "E5.0", FullyQualified, ""},
+	{"🤟🏿", "love-you gesture: dark skin tone", 1, 17, "E5.0", FullyQualified, ""},
+	{"🤠", "cowboy hat face", 0, 7, "E3.0", FullyQualified, ""},
+	{"🤡", "clown face", 0, 11, "E3.0", FullyQualified, ""},
+	{"🤢", "nauseated face", 0, 6, "E3.0", FullyQualified, ""},
+	{"🤣", "rolling on the floor laughing", 0, 0, "E3.0", FullyQualified, ""},
+	{"🤤", "drooling face", 0, 5, "E3.0", FullyQualified, ""},
+	{"🤥", "lying face", 0, 4, "E3.0", FullyQualified, ""},
+	{"🤦", "person facepalming", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤦\u200d♀", "woman facepalming", 1, 24, "E4.0", MinimallyQualified, "🤦\u200d♀️"},
+	{"🤦\u200d♀️", "woman facepalming", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦\u200d♂", "man facepalming", 1, 24, "E4.0", MinimallyQualified, "🤦\u200d♂️"},
+	{"🤦\u200d♂️", "man facepalming", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦🏻", "person facepalming: light skin tone", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤦🏻\u200d♀", "woman facepalming: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🤦🏻\u200d♀️"},
+	{"🤦🏻\u200d♀️", "woman facepalming: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦🏻\u200d♂", "man facepalming: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🤦🏻\u200d♂️"},
+	{"🤦🏻\u200d♂️", "man facepalming: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦🏼", "person facepalming: medium-light skin tone", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤦🏼\u200d♀", "woman facepalming: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🤦🏼\u200d♀️"},
+	{"🤦🏼\u200d♀️", "woman facepalming: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦🏼\u200d♂", "man facepalming: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🤦🏼\u200d♂️"},
+	{"🤦🏼\u200d♂️", "man facepalming: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦🏽", "person facepalming: medium skin tone", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤦🏽\u200d♀", "woman facepalming: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🤦🏽\u200d♀️"},
+	{"🤦🏽\u200d♀️", "woman facepalming: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦🏽\u200d♂", "man facepalming: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🤦🏽\u200d♂️"},
+	{"🤦🏽\u200d♂️", "man facepalming: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦🏾", "person facepalming: medium-dark skin tone", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤦🏾\u200d♀", "woman facepalming: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🤦🏾\u200d♀️"},
+	{"🤦🏾\u200d♀️", "woman facepalming: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦🏾\u200d♂", "man facepalming: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🤦🏾\u200d♂️"},
+	{"🤦🏾\u200d♂️", "man facepalming: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦🏿", "person facepalming: dark skin tone", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤦🏿\u200d♀", "woman facepalming: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🤦🏿\u200d♀️"},
+	{"🤦🏿\u200d♀️", "woman facepalming: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤦🏿\u200d♂", "man facepalming: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🤦🏿\u200d♂️"},
+	{"🤦🏿\u200d♂️", "man facepalming: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤧", "sneezing face", 0, 6, "E3.0", FullyQualified, ""},
+	{"🤨", "face with raised eyebrow", 0, 4, "E5.0", FullyQualified, ""},
+	{"🤩", "star-struck", 0, 1, "E5.0", FullyQualified, ""},
+	{"🤪", "zany face", 0, 2, "E5.0", FullyQualified, ""},
+	{"🤫", "shushing face", 0, 3, "E5.0", FullyQualified, ""},
+	{"🤬", "face with symbols on mouth", 0, 10, "E5.0", FullyQualified, ""},
+	{"🤭", "face with hand over mouth", 0, 3, "E5.0", FullyQualified, ""},
+	{"🤮", "face vomiting", 0, 6, "E5.0", FullyQualified, ""},
+	{"🤯", "exploding head", 0, 6, "E5.0", FullyQualified, ""},
+	{"🤰", "pregnant woman", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤰🏻", "pregnant woman: light skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤰🏼", "pregnant woman: medium-light skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤰🏽", "pregnant woman: medium skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤰🏾", "pregnant woman: medium-dark skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤰🏿", "pregnant woman: dark skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤱", "breast-feeding", 1, 25, "E5.0", FullyQualified, ""},
+	{"🤱🏻", "breast-feeding: light skin tone", 1, 25, "E5.0", FullyQualified, ""},
+	{"🤱🏼", "breast-feeding: medium-light skin tone", 1, 25, "E5.0", FullyQualified, ""},
+	{"🤱🏽", "breast-feeding: medium skin tone", 1, 25, "E5.0", FullyQualified, ""},
+	{"🤱🏾", "breast-feeding: medium-dark skin tone", 1, 25, "E5.0", FullyQualified, ""},
+	{"🤱🏿", "breast-feeding: dark skin tone", 1, 25, "E5.0", FullyQualified, ""},
+	{"🤲", "palms up together", 1, 20, "E5.0", FullyQualified, ""},
+	{"🤲🏻", "palms up together: light skin tone", 1, 20, "E5.0", FullyQualified, ""},
+	{"🤲🏼", "palms up together: medium-light skin tone", 1, 20, "E5.0", FullyQualified, ""},
+	{"🤲🏽", "palms up together: medium skin tone", 1, 20, "E5.0", FullyQualified, ""},
+	{"🤲🏾", "palms up together: medium-dark skin tone", 1, 20, "E5.0", FullyQualified, ""},
+	{"🤲🏿", "palms up together: dark skin tone", 1, 20, "E5.0", FullyQualified, ""},
+	{"🤳", "selfie", 1, 21, "E3.0", FullyQualified, ""},
+	{"🤳🏻", "selfie: light skin tone", 1, 21, "E3.0", FullyQualified, ""},
+	{"🤳🏼", "selfie: medium-light skin tone", 1, 21, "E3.0", FullyQualified, ""},
+	{"🤳🏽", "selfie: medium skin tone", 1, 21, "E3.0", FullyQualified, ""},
+	{"🤳🏾", "selfie: medium-dark skin tone", 1, 21, "E3.0", FullyQualified, ""},
+	{"🤳🏿", "selfie: dark skin tone", 1, 21, "E3.0", FullyQualified, ""},
+	{"🤴", "prince", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤴🏻", "prince: light skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤴🏼", "prince: medium-light skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤴🏽", "prince: medium skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤴🏾", "prince: medium-dark skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤴🏿", "prince: dark skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤵", "person in tuxedo", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤵\u200d♀", "woman in tuxedo", 1, 25, "E13.0", MinimallyQualified, "🤵\u200d♀️"},
+	{"🤵\u200d♀️", "woman in tuxedo", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵\u200d♂", "man in tuxedo", 1, 25, "E13.0", MinimallyQualified, "🤵\u200d♂️"},
+	{"🤵\u200d♂️", "man in tuxedo", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵🏻", "person in tuxedo: light skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤵🏻\u200d♀", "woman in tuxedo: light skin tone", 1, 25, "E13.0", MinimallyQualified, "🤵🏻\u200d♀️"},
+	{"🤵🏻\u200d♀️", "woman in tuxedo: light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵🏻\u200d♂", "man in tuxedo: light skin tone", 1, 25, "E13.0", MinimallyQualified, "🤵🏻\u200d♂️"},
+	{"🤵🏻\u200d♂️", "man in tuxedo: light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵🏼", "person in tuxedo: medium-light skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤵🏼\u200d♀", "woman in tuxedo: medium-light skin tone", 1, 25, "E13.0", MinimallyQualified, "🤵🏼\u200d♀️"},
+	{"🤵🏼\u200d♀️", "woman in tuxedo: medium-light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵🏼\u200d♂", "man in tuxedo: medium-light skin tone", 1, 25, "E13.0", MinimallyQualified, "🤵🏼\u200d♂️"},
+	{"🤵🏼\u200d♂️", "man in tuxedo: medium-light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵🏽", "person in tuxedo: medium skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤵🏽\u200d♀", "woman in tuxedo: medium skin tone", 1, 25, "E13.0", MinimallyQualified, "🤵🏽\u200d♀️"},
+	{"🤵🏽\u200d♀️", "woman in tuxedo: medium skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵🏽\u200d♂", "man in tuxedo: medium skin tone", 1, 25, "E13.0", MinimallyQualified, "🤵🏽\u200d♂️"},
+	{"🤵🏽\u200d♂️", "man in tuxedo: medium skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵🏾", "person in tuxedo: medium-dark skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤵🏾\u200d♀", "woman in tuxedo: medium-dark skin tone", 1, 25, "E13.0", MinimallyQualified, "🤵🏾\u200d♀️"},
+	{"🤵🏾\u200d♀️", "woman in tuxedo: medium-dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵🏾\u200d♂", "man in tuxedo: medium-dark skin tone", 1, 25, "E13.0", MinimallyQualified, "🤵🏾\u200d♂️"},
+	{"🤵🏾\u200d♂️", "man in tuxedo: medium-dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵🏿", "person in tuxedo: dark skin tone", 1, 25, "E3.0", FullyQualified, ""},
+	{"🤵🏿\u200d♀", "woman in tuxedo: dark skin tone", 1, 25, "E13.0", MinimallyQualified, "🤵🏿\u200d♀️"},
+	{"🤵🏿\u200d♀️", "woman in tuxedo: dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤵🏿\u200d♂", "man in tuxedo: dark skin tone", 1, 25, "E13.0", MinimallyQualified, "🤵🏿\u200d♂️"},
+	{"🤵🏿\u200d♂️", "man in tuxedo: dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🤶", "Mrs. Claus", 1, 26, "E3.0", FullyQualified, ""},
+	{"🤶🏻", "Mrs. Claus: light skin tone", 1, 26, "E3.0", FullyQualified, ""},
+	{"🤶🏼", "Mrs. Claus: medium-light skin tone", 1, 26, "E3.0", FullyQualified, ""},
+	{"🤶🏽", "Mrs. Claus: medium skin tone", 1, 26, "E3.0", FullyQualified, ""},
+	{"🤶🏾", "Mrs. Claus: medium-dark skin tone", 1, 26, "E3.0", FullyQualified, ""},
+	{"🤶🏿", "Mrs. Claus: dark skin tone", 1, 26, "E3.0", FullyQualified, ""},
+	{"🤷", "person shrugging", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤷\u200d♀", "woman shrugging", 1, 24, "E4.0", MinimallyQualified, "🤷\u200d♀️"},
+	{"🤷\u200d♀️", "woman shrugging", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷\u200d♂", "man shrugging", 1, 24, "E4.0", MinimallyQualified, "🤷\u200d♂️"},
+	{"🤷\u200d♂️", "man shrugging", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷🏻", "person shrugging: light skin tone", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤷🏻\u200d♀", "woman shrugging: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🤷🏻\u200d♀️"},
+	{"🤷🏻\u200d♀️", "woman shrugging: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷🏻\u200d♂", "man shrugging: light skin tone", 1, 24, "E4.0", MinimallyQualified, "🤷🏻\u200d♂️"},
+	{"🤷🏻\u200d♂️", "man shrugging: light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷🏼", "person shrugging: medium-light skin tone", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤷🏼\u200d♀", "woman shrugging: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🤷🏼\u200d♀️"},
+	{"🤷🏼\u200d♀️", "woman shrugging: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷🏼\u200d♂", "man shrugging: medium-light skin tone", 1, 24, "E4.0", MinimallyQualified, "🤷🏼\u200d♂️"},
+	{"🤷🏼\u200d♂️", "man shrugging: medium-light skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷🏽", "person shrugging: medium skin tone", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤷🏽\u200d♀", "woman shrugging: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🤷🏽\u200d♀️"},
+	{"🤷🏽\u200d♀️", "woman shrugging: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷🏽\u200d♂", "man shrugging: medium skin tone", 1, 24, "E4.0", MinimallyQualified, "🤷🏽\u200d♂️"},
+	{"🤷🏽\u200d♂️", "man shrugging: medium skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷🏾", "person shrugging: medium-dark skin tone", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤷🏾\u200d♀", "woman shrugging: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🤷🏾\u200d♀️"},
+	{"🤷🏾\u200d♀️", "woman shrugging: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷🏾\u200d♂", "man shrugging: medium-dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🤷🏾\u200d♂️"},
+	{"🤷🏾\u200d♂️", "man shrugging: medium-dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷🏿", "person shrugging: dark skin tone", 1, 24, "E3.0", FullyQualified, ""},
+	{"🤷🏿\u200d♀", "woman shrugging: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🤷🏿\u200d♀️"},
+	{"🤷🏿\u200d♀️", "woman shrugging: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤷🏿\u200d♂", "man shrugging: dark skin tone", 1, 24, "E4.0", MinimallyQualified, "🤷🏿\u200d♂️"},
+	{"🤷🏿\u200d♂️", "man shrugging: dark skin tone", 1, 24, "E4.0", FullyQualified, ""},
+	{"🤸", "person cartwheeling", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤸\u200d♀", "woman cartwheeling", 1, 28, "E4.0", MinimallyQualified, "🤸\u200d♀️"},
+	{"🤸\u200d♀️", "woman cartwheeling", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸\u200d♂", "man cartwheeling", 1, 28, "E4.0", MinimallyQualified, "🤸\u200d♂️"},
+	{"🤸\u200d♂️", "man cartwheeling", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸🏻", "person cartwheeling: light skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤸🏻\u200d♀", "woman cartwheeling: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤸🏻\u200d♀️"},
+	{"🤸🏻\u200d♀️", "woman cartwheeling: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸🏻\u200d♂", "man cartwheeling: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤸🏻\u200d♂️"},
+	{"🤸🏻\u200d♂️", "man cartwheeling: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸🏼", "person cartwheeling: medium-light skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤸🏼\u200d♀", "woman cartwheeling: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤸🏼\u200d♀️"},
+	{"🤸🏼\u200d♀️", "woman cartwheeling: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸🏼\u200d♂", "man cartwheeling: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤸🏼\u200d♂️"},
+	{"🤸🏼\u200d♂️", "man cartwheeling: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸🏽", "person cartwheeling: medium skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤸🏽\u200d♀", "woman cartwheeling: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🤸🏽\u200d♀️"},
+	{"🤸🏽\u200d♀️", "woman cartwheeling: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸🏽\u200d♂", "man cartwheeling: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🤸🏽\u200d♂️"},
+	{"🤸🏽\u200d♂️", "man cartwheeling: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸🏾", "person cartwheeling: medium-dark skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤸🏾\u200d♀", "woman cartwheeling: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤸🏾\u200d♀️"},
+	{"🤸🏾\u200d♀️", "woman cartwheeling: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸🏾\u200d♂", "man cartwheeling: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤸🏾\u200d♂️"},
+	{"🤸🏾\u200d♂️", "man cartwheeling: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸🏿", "person cartwheeling: dark skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤸🏿\u200d♀", "woman cartwheeling: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤸🏿\u200d♀️"},
+	{"🤸🏿\u200d♀️", "woman cartwheeling: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤸🏿\u200d♂", "man cartwheeling: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤸🏿\u200d♂️"},
+	{"🤸🏿\u200d♂️", "man cartwheeling: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹", "person juggling", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤹\u200d♀", "woman juggling", 1, 28, "E4.0", MinimallyQualified, "🤹\u200d♀️"},
+	{"🤹\u200d♀️", "woman juggling", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹\u200d♂", "man juggling", 1, 28, "E4.0", MinimallyQualified, "🤹\u200d♂️"},
+	{"🤹\u200d♂️", "man juggling", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹🏻", "person juggling: light skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤹🏻\u200d♀", "woman juggling: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤹🏻\u200d♀️"},
+	{"🤹🏻\u200d♀️", "woman juggling: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹🏻\u200d♂", "man juggling: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤹🏻\u200d♂️"},
+	{"🤹🏻\u200d♂️", "man juggling: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹🏼", "person juggling: medium-light skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤹🏼\u200d♀", "woman juggling: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤹🏼\u200d♀️"},
+	{"🤹🏼\u200d♀️", "woman juggling: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹🏼\u200d♂", "man juggling: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤹🏼\u200d♂️"},
+	{"🤹🏼\u200d♂️", "man juggling: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹🏽", "person juggling: medium skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤹🏽\u200d♀", "woman juggling: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🤹🏽\u200d♀️"},
+	{"🤹🏽\u200d♀️", "woman juggling: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹🏽\u200d♂", "man juggling: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🤹🏽\u200d♂️"},
+	{"🤹🏽\u200d♂️", "man juggling: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹🏾", "person juggling: medium-dark skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤹🏾\u200d♀", "woman juggling: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤹🏾\u200d♀️"},
+	{"🤹🏾\u200d♀️", "woman juggling: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹🏾\u200d♂", "man juggling: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤹🏾\u200d♂️"},
+	{"🤹🏾\u200d♂️", "man juggling: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹🏿", "person juggling: dark skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤹🏿\u200d♀", "woman juggling: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤹🏿\u200d♀️"},
+	{"🤹🏿\u200d♀️", "woman juggling: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤹🏿\u200d♂", "man juggling: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤹🏿\u200d♂️"},
+	{"🤹🏿\u200d♂️", "man juggling: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤺", "person fencing", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤼", "people wrestling", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤼\u200d♀", "women wrestling", 1, 28, "E4.0", MinimallyQualified, "🤼\u200d♀️"},
+	{"🤼\u200d♀️", "women wrestling", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤼\u200d♂", "men wrestling", 1, 28, "E4.0", MinimallyQualified, "🤼\u200d♂️"},
+	{"🤼\u200d♂️", "men wrestling", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽", "person playing water polo", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤽\u200d♀", "woman playing water polo", 1, 28, "E4.0", MinimallyQualified, "🤽\u200d♀️"},
+	{"🤽\u200d♀️", "woman playing water polo", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽\u200d♂", "man playing water polo", 1, 28, "E4.0", MinimallyQualified, "🤽\u200d♂️"},
+	{"🤽\u200d♂️", "man playing water polo", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽🏻", "person playing water polo: light skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤽🏻\u200d♀", "woman playing water polo: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤽🏻\u200d♀️"},
+	{"🤽🏻\u200d♀️", "woman playing water polo: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽🏻\u200d♂", "man playing water polo: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤽🏻\u200d♂️"},
+	{"🤽🏻\u200d♂️", "man playing water polo: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽🏼", "person playing water polo: medium-light skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤽🏼\u200d♀", "woman playing water polo: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤽🏼\u200d♀️"},
+	{"🤽🏼\u200d♀️", "woman playing water polo: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽🏼\u200d♂", "man playing water polo: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤽🏼\u200d♂️"},
+	{"🤽🏼\u200d♂️", "man playing water polo: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽🏽", "person playing water polo: medium skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤽🏽\u200d♀", "woman playing water polo: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🤽🏽\u200d♀️"},
+	{"🤽🏽\u200d♀️", "woman playing water polo: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽🏽\u200d♂", "man playing water polo: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🤽🏽\u200d♂️"},
+	{"🤽🏽\u200d♂️", "man playing water polo: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽🏾", "person playing water polo: medium-dark skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤽🏾\u200d♀", "woman playing water polo: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤽🏾\u200d♀️"},
+	{"🤽🏾\u200d♀️", "woman playing water polo: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽🏾\u200d♂", "man playing water polo: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤽🏾\u200d♂️"},
+	{"🤽🏾\u200d♂️", "man playing water polo: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽🏿", "person playing water polo: dark skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤽🏿\u200d♀", "woman playing water polo: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤽🏿\u200d♀️"},
+	{"🤽🏿\u200d♀️", "woman playing water polo: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤽🏿\u200d♂", "man playing water polo: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤽🏿\u200d♂️"},
+	{"🤽🏿\u200d♂️", "man playing water polo: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾", "person playing handball", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤾\u200d♀", "woman playing handball", 1, 28, "E4.0", MinimallyQualified, "🤾\u200d♀️"},
+	{"🤾\u200d♀️", "woman playing handball", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾\u200d♂", "man playing handball", 1, 28, "E4.0", MinimallyQualified, "🤾\u200d♂️"},
+	{"🤾\u200d♂️", "man playing handball", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾🏻", "person playing handball: light skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤾🏻\u200d♀", "woman playing handball: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤾🏻\u200d♀️"},
+	{"🤾🏻\u200d♀️", "woman playing handball: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾🏻\u200d♂", "man playing handball: light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤾🏻\u200d♂️"},
+	{"🤾🏻\u200d♂️", "man playing handball: light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾🏼", "person playing handball: medium-light skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤾🏼\u200d♀", "woman playing handball: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤾🏼\u200d♀️"},
+	{"🤾🏼\u200d♀️", "woman playing handball: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾🏼\u200d♂", "man playing handball: medium-light skin tone", 1, 28, "E4.0", MinimallyQualified, "🤾🏼\u200d♂️"},
+	{"🤾🏼\u200d♂️", "man playing handball: medium-light skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾🏽", "person playing handball: medium skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤾🏽\u200d♀", "woman playing handball: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🤾🏽\u200d♀️"},
+	{"🤾🏽\u200d♀️", "woman playing handball: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾🏽\u200d♂", "man playing handball: medium skin tone", 1, 28, "E4.0", MinimallyQualified, "🤾🏽\u200d♂️"},
+	{"🤾🏽\u200d♂️", "man playing handball: medium skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾🏾", "person playing handball: medium-dark skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤾🏾\u200d♀", "woman playing handball: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤾🏾\u200d♀️"},
+	{"🤾🏾\u200d♀️", "woman playing handball: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾🏾\u200d♂", "man playing handball: medium-dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤾🏾\u200d♂️"},
+	{"🤾🏾\u200d♂️", "man playing handball: medium-dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾🏿", "person playing handball: dark skin tone", 1, 28, "E3.0", FullyQualified, ""},
+	{"🤾🏿\u200d♀", "woman playing handball: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤾🏿\u200d♀️"},
+	{"🤾🏿\u200d♀️", "woman playing handball: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤾🏿\u200d♂", "man playing handball: dark skin tone", 1, 28, "E4.0", MinimallyQualified, "🤾🏿\u200d♂️"},
+	{"🤾🏿\u200d♂️", "man playing handball: dark skin tone", 1, 28, "E4.0", FullyQualified, ""},
+	{"🤿", "diving mask", 6, 63, "E12.0", FullyQualified, ""},
+	{"🥀", "wilted flower", 3, 40, "E3.0", FullyQualified, ""},
+	{"🥁", "drum", 7, 69, "E3.0", FullyQualified, ""},
+	{"🥂", "clinking glasses", 4, 48, "E3.0", FullyQualified, ""},
+	{"🥃", "tumbler glass", 4, 48, "E3.0", FullyQualified, ""},
+	{"🥄", "spoon", 4, 49, "E3.0", FullyQualified, ""},
+	{"🥅", "goal net", 6, 63, "E3.0", FullyQualified, ""},
+	{"🥇", "1st place medal", 6, 62, "E3.0", FullyQualified, ""},
+	{"🥈", "2nd place medal", 6, 62, "E3.0", FullyQualified, ""},
+	{"🥉", "3rd place medal", 6, 62, "E3.0", FullyQualified, ""},
+	{"🥊", "boxing glove", 6, 63, "E3.0", FullyQualified, ""},
+	{"🥋", "martial arts uniform", 6, 63, "E3.0", FullyQualified, ""},
+	{"🥌", "curling stone", 6, 63, "E5.0", FullyQualified, ""},
+	{"🥍", "lacrosse", 6, 63, "E11.0", FullyQualified, ""},
+	{"🥎", "softball", 6, 63, "E11.0", FullyQualified, ""},
+	{"🥏", "flying disc", 6, 63, "E11.0", FullyQualified, ""},
+	{"🥐", "croissant", 4, 44, "E3.0", FullyQualified, ""},
+	{"🥑", "avocado", 4, 43, "E3.0", FullyQualified, ""},
+	{"🥒", "cucumber", 4, 43, "E3.0", FullyQualified, ""},
+	{"🥓", "bacon", 4, 44, "E3.0", FullyQualified, ""},
+	{"🥔", "potato", 4, 43, "E3.0", FullyQualified, ""},
+	{"🥕", "carrot", 4, 43, "E3.0", FullyQualified, ""},
+	{"🥖", "baguette bread", 4, 44, "E3.0", FullyQualified, ""},
+	{"🥗", "green salad", 4, 44, "E3.0", FullyQualified, ""},
+	{"🥘", "shallow pan of food", 4, 44, "E3.0", FullyQualified, ""},
+	{"🥙", "stuffed flatbread", 4, 44, "E3.0", FullyQualified, ""},
+	{"🥚", "egg", 4, 44, "E3.0", FullyQualified, ""},
+	{"🥛", "glass of milk", 4, 48, "E3.0", FullyQualified, ""},
+	{"🥜", "peanuts", 4, 43, "E3.0", FullyQualified, ""},
+	{"🥝", "kiwi fruit", 4, 42, "E3.0", FullyQualified, ""},
+	{"🥞", "pancakes", 4, 44, "E3.0", FullyQualified, ""},
+	{"🥟", "dumpling", 4, 45, "E5.0", FullyQualified, ""},
+	{"🥠", "fortune cookie", 4, 45, "E5.0", FullyQualified, ""},
+	{"🥡", "takeout box", 4, 45, "E5.0", FullyQualified, ""},
+	{"🥢", "chopsticks", 4, 49, "E5.0", FullyQualified, ""},
+	{"🥣", "bowl with spoon", 4, 44, "E5.0", FullyQualified, ""},
+	{"🥤", "cup with straw", 4, 48, "E5.0", FullyQualified, ""},
+	{"🥥", "coconut", 4, 42, "E5.0", FullyQualified, ""},
+	{"🥦", "broccoli", 4, 43, "E5.0", FullyQualified, ""},
+	{"🥧", "pie", 4, 47, "E5.0", FullyQualified, ""},
+	{"🥨", "pretzel", 4, 44, "E5.0", FullyQualified, ""},
+	{"🥩", "cut of meat", 4, 44, "E5.0", FullyQualified, ""},
+	{"🥪", "sandwich", 4, 44, "E5.0", FullyQualified, ""},
+	{"🥫", "canned food", 4, 44, "E5.0", FullyQualified, ""},
+	{"🥬", "leafy green", 4, 43, "E11.0", FullyQualified, ""},
+	{"🥭", "mango", 4, 42, "E11.0", FullyQualified, ""},
+	{"🥮", "moon cake", 4, 45, "E11.0", FullyQualified, ""},
+	{"🥯", "bagel", 4, 44, "E11.0", FullyQualified, ""},
+	{"🥰", "smiling face with hearts", 0, 1, "E11.0", FullyQualified, ""},
+	{"🥱", "yawning face", 0, 9, "E12.0", FullyQualified, ""},
+	{"🥲", "smiling face with tear", 0, 1, "E13.0", FullyQualified, ""},
+	{"🥳", "partying face", 0, 7, "E11.0", FullyQualified, ""},
+	{"🥴", "woozy face", 0, 6, "E11.0", FullyQualified, ""},
+	{"🥵", "hot face", 0, 6, "E11.0", FullyQualified, ""},
+	{"🥶", "cold face", 0, 6, "E11.0", FullyQualified, ""},
+	{"🥷", "ninja", 1, 25, "E13.0", FullyQualified, ""},
+	{"🥷🏻", "ninja: light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🥷🏼", "ninja: medium-light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🥷🏽", "ninja: medium skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🥷🏾", "ninja: medium-dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🥷🏿", "ninja: dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🥸", "disguised face", 0, 7, "E13.0", FullyQualified, ""},
+	{"🥺", "pleading face", 0, 9, "E11.0", FullyQualified, ""},
+	{"🥻", "sari", 7, 66, "E12.0", FullyQualified, ""},
+	{"🥼", "lab coat", 7, 66, "E11.0", FullyQualified, ""},
+	{"🥽", "goggles", 7, 66, "E11.0", FullyQualified, ""},
+	{"🥾", "hiking boot", 7, 66, "E11.0", FullyQualified, ""},
+	{"🥿", "flat shoe", 7, 66, "E11.0", FullyQualified, ""},
+	{"🦀", "crab", 4, 46, "E1.0", FullyQualified, ""},
+	{"🦁", "lion", 3, 34, "E1.0", FullyQualified, ""},
+	{"🦂", "scorpion", 3, 39, "E1.0", FullyQualified, ""},
+	{"🦃", "turkey", 3, 35, "E1.0", FullyQualified, ""},
+	{"🦄", "unicorn", 3, 34, "E1.0", FullyQualified, ""},
+	{"🦅", "eagle", 3, 35, "E3.0", FullyQualified, ""},
+	{"🦆", "duck", 3, 35, "E3.0", FullyQualified, ""},
+	{"🦇", "bat", 3, 34, "E3.0", FullyQualified, ""},
+	{"🦈", "shark", 3, 38, "E3.0", FullyQualified, ""},
+	{"🦉", "owl", 3, 35, "E3.0", FullyQualified, ""},
+	{"🦊", "fox", 3, 34, "E3.0", FullyQualified, ""},
+	{"🦋", "butterfly", 3, 39, "E3.0", FullyQualified, ""},
+	{"🦌", "deer", 3, 34, "E3.0", FullyQualified, ""},
+	{"🦍", "gorilla", 3, 34, "E3.0", FullyQualified, ""},
+	{"🦎", "lizard", 3, 37, "E3.0", FullyQualified, ""},
+	{"🦏", "rhinoceros", 3, 34, "E3.0", FullyQualified, ""},
+	{"🦐", "shrimp", 4, 46, "E3.0", FullyQualified, ""},
+	{"🦑", "squid", 4, 46, "E3.0", FullyQualified, ""},
+	{"🦒", "giraffe", 3, 34, "E5.0", FullyQualified, ""},
+	{"🦓", "zebra", 3, 34, "E5.0", FullyQualified, ""},
+	{"🦔", "hedgehog", 3, 34, "E5.0", FullyQualified, ""},
+	{"🦕", "sauropod", 3, 37, "E5.0", FullyQualified, ""},
+	{"🦖", "T-Rex", 3, 37, "E5.0", FullyQualified, ""},
+	{"🦗", "cricket", 3, 39, "E5.0", FullyQualified, ""},
+	{"🦘", "kangaroo", 3, 34, "E11.0", FullyQualified, ""},
+	{"🦙", "llama", 3, 34, "E11.0", FullyQualified, ""},
+	{"🦚", "peacock", 3, 35, "E11.0", FullyQualified, ""},
+	{"🦛", "hippopotamus", 3, 34, "E11.0", FullyQualified, ""},
+	{"🦜", "parrot", 3, 35, "E11.0", FullyQualified, ""},
+	{"🦝", "raccoon", 3, 34, "E11.0", FullyQualified, ""},
+	{"🦞", "lobster", 4, 46, "E11.0", FullyQualified, ""},
+	{"🦟", "mosquito", 3, 39, "E11.0", FullyQualified, ""},
+	{"🦠", "microbe", 3, 39, "E11.0", FullyQualified, ""},
+	{"🦡", "badger", 3, 34, "E11.0", FullyQualified, ""},
+	{"🦢", "swan", 3, 35, "E11.0", FullyQualified, ""},
+	{"🦣", "mammoth", 3, 34, "E13.0", FullyQualified, ""},
+	{"🦤", "dodo", 3, 35, "E13.0", FullyQualified, ""},
+	{"🦥", "sloth", 3, 34, "E12.0", FullyQualified, ""},
+	{"🦦", "otter", 3, 34, "E12.0", FullyQualified, ""},
+	{"🦧", "orangutan", 3, 34, "E12.0", FullyQualified, ""},
+	{"🦨", "skunk", 3, 34, "E12.0", FullyQualified, ""},
+	{"🦩", "flamingo", 3, 35, "E12.0", FullyQualified, ""},
+	{"🦪", "oyster", 4, 46, "E12.0", FullyQualified, ""},
+	{"🦫", "beaver", 3, 34, "E13.0", FullyQualified, ""},
+	{"🦬", "bison", 3, 34, "E13.0", FullyQualified, ""},
+	{"🦭", "seal", 3, 38, "E13.0", FullyQualified, ""},
+	{"🦮", "guide dog", 3, 34, "E12.0", FullyQualified, ""},
+	{"🦯", "white cane", 7, 79, "E12.0", FullyQualified, ""},
+	{"🦰", "red hair", 2, 33, "E11.0", Component, ""},
+	{"🦱", "curly hair", 2, 33, "E11.0", Component, ""},
+	{"🦲", "bald", 2, 33, "E11.0", Component, ""},
+	{"🦳", "white hair", 2, 33, "E11.0", Component, ""},
+	{"🦴", "bone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦵", "leg", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦵🏻", "leg: light skin tone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦵🏼", "leg: medium-light skin tone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦵🏽", "leg: medium skin tone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦵🏾", "leg: medium-dark skin tone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦵🏿", "leg: dark skin tone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦶", "foot", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦶🏻", "foot: light skin tone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦶🏼", "foot: medium-light skin tone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦶🏽", "foot: medium skin tone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦶🏾", "foot: medium-dark skin tone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦶🏿", "foot: dark skin tone", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦷", "tooth", 1, 22, "E11.0", FullyQualified, ""},
+	{"🦸", "superhero", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸\u200d♀", "woman superhero", 1, 26, "E11.0", MinimallyQualified, "🦸\u200d♀️"},
+	{"🦸\u200d♀️", "woman superhero", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸\u200d♂", "man superhero", 1, 26, "E11.0", MinimallyQualified, "🦸\u200d♂️"},
+	{"🦸\u200d♂️", "man superhero", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏻", "superhero: light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏻\u200d♀", "woman superhero: light skin tone", 1, 26, "E11.0", MinimallyQualified, "🦸🏻\u200d♀️"},
+	{"🦸🏻\u200d♀️", "woman superhero: light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏻\u200d♂", "man superhero: light skin tone", 1, 26, "E11.0", MinimallyQualified, "🦸🏻\u200d♂️"},
+	{"🦸🏻\u200d♂️", "man superhero: light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏼", "superhero: medium-light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏼\u200d♀", "woman superhero: medium-light skin tone", 1, 26, "E11.0", MinimallyQualified, "🦸🏼\u200d♀️"},
+	{"🦸🏼\u200d♀️", "woman superhero: medium-light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏼\u200d♂", "man superhero: medium-light skin tone", 1, 26, "E11.0", MinimallyQualified, "🦸🏼\u200d♂️"},
+	{"🦸🏼\u200d♂️", "man superhero: medium-light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏽", "superhero: medium skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏽\u200d♀", "woman superhero: medium skin tone", 1, 26, "E11.0", MinimallyQualified, "🦸🏽\u200d♀️"},
+	{"🦸🏽\u200d♀️", "woman superhero: medium skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏽\u200d♂", "man superhero: medium skin tone", 1, 26, "E11.0", MinimallyQualified, "🦸🏽\u200d♂️"},
+	{"🦸🏽\u200d♂️", "man superhero: medium skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏾", "superhero: medium-dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏾\u200d♀", "woman superhero: medium-dark skin tone", 1, 26, "E11.0", MinimallyQualified, "🦸🏾\u200d♀️"},
+	{"🦸🏾\u200d♀️", "woman superhero: medium-dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏾\u200d♂", "man superhero: medium-dark skin tone", 1, 26, "E11.0", MinimallyQualified, "🦸🏾\u200d♂️"},
+	{"🦸🏾\u200d♂️", "man superhero: medium-dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏿", "superhero: dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏿\u200d♀", "woman superhero: dark skin tone", 1, 26, "E11.0", MinimallyQualified, "🦸🏿\u200d♀️"},
+	{"🦸🏿\u200d♀️", "woman superhero: dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦸🏿\u200d♂", "man superhero: dark skin tone", 1, 26, "E11.0", MinimallyQualified, "🦸🏿\u200d♂️"},
+	{"🦸🏿\u200d♂️", "man superhero: dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹", "supervillain", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹\u200d♀", "woman supervillain", 1, 26, "E11.0", MinimallyQualified, "🦹\u200d♀️"},
+	{"🦹\u200d♀️", "woman supervillain", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹\u200d♂", "man supervillain", 1, 26, "E11.0", MinimallyQualified, "🦹\u200d♂️"},
+	{"🦹\u200d♂️", "man supervillain", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏻", "supervillain: light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏻\u200d♀", "woman supervillain: light skin tone", 1, 26, "E11.0", MinimallyQualified, "🦹🏻\u200d♀️"},
+	{"🦹🏻\u200d♀️", "woman supervillain: light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏻\u200d♂", "man supervillain: light skin tone", 1, 26, "E11.0", MinimallyQualified, "🦹🏻\u200d♂️"},
+	{"🦹🏻\u200d♂️", "man supervillain: light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏼", "supervillain: medium-light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏼\u200d♀", "woman supervillain: medium-light skin tone", 1, 26, "E11.0", MinimallyQualified, "🦹🏼\u200d♀️"},
+	{"🦹🏼\u200d♀️", "woman supervillain: medium-light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏼\u200d♂", "man supervillain: medium-light skin tone", 1, 26, "E11.0", MinimallyQualified, "🦹🏼\u200d♂️"},
+	{"🦹🏼\u200d♂️", "man supervillain: medium-light skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏽", "supervillain: medium skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏽\u200d♀", "woman supervillain: medium skin tone", 1, 26, "E11.0", MinimallyQualified, "🦹🏽\u200d♀️"},
+	{"🦹🏽\u200d♀️", "woman supervillain: medium skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏽\u200d♂", "man supervillain: medium skin tone", 1, 26, "E11.0", MinimallyQualified, "🦹🏽\u200d♂️"},
+	{"🦹🏽\u200d♂️", "man supervillain: medium skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏾", "supervillain: medium-dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏾\u200d♀", "woman supervillain: medium-dark skin tone", 1, 26, "E11.0", MinimallyQualified, "🦹🏾\u200d♀️"},
+	{"🦹🏾\u200d♀️", "woman supervillain: medium-dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏾\u200d♂", "man supervillain: medium-dark skin tone", 1, 26, "E11.0", MinimallyQualified, "🦹🏾\u200d♂️"},
+	{"🦹🏾\u200d♂️", "man supervillain: medium-dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏿", "supervillain: dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏿\u200d♀", "woman supervillain: dark skin tone", 1, 26, "E11.0", MinimallyQualified, "🦹🏿\u200d♀️"},
+	{"🦹🏿\u200d♀️", "woman supervillain: dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦹🏿\u200d♂", "man supervillain: dark skin tone", 1, 26, "E11.0", MinimallyQualified, "🦹🏿\u200d♂️"},
+	{"🦹🏿\u200d♂️", "man supervillain: dark skin tone", 1, 26, "E11.0", FullyQualified, ""},
+	{"🦺", "safety vest", 7, 66, "E12.0", FullyQualified, ""},
+	{"🦻", "ear with hearing aid", 1, 22, "E12.0", FullyQualified, ""},
+	{"🦻🏻", "ear with hearing aid: light skin tone", 1, 22, "E12.0", FullyQualified, ""},
+	{"🦻🏼", "ear with hearing aid: medium-light skin tone", 1, 22, "E12.0", FullyQualified, ""},
+	{"🦻🏽", "ear with hearing aid: medium skin tone", 1, 22, "E12.0", FullyQualified, ""},
+	{"🦻🏾", "ear with hearing aid: medium-dark skin tone", 1, 22, "E12.0", FullyQualified, ""},
+	{"🦻🏿", "ear with hearing aid: dark skin tone", 1, 22, "E12.0", FullyQualified, ""},
+	{"🦼", "motorized wheelchair", 5, 55, "E12.0", FullyQualified, ""},
+	{"🦽", "manual wheelchair", 5, 55, "E12.0", FullyQualified, ""},
+	{"🦾", "mechanical arm", 1, 22, "E12.0", FullyQualified, ""},
+	{"🦿", "mechanical leg", 1, 22, "E12.0", FullyQualified, ""},
+	{"🧀", "cheese wedge", 4, 44, "E1.0", FullyQualified, ""},
+	{"🧁", "cupcake", 4, 47, "E11.0", FullyQualified, ""},
+	{"🧂", "salt", 4, 44, "E11.0", FullyQualified, ""},
+	{"🧃", "beverage box", 4, 48, "E12.0", FullyQualified, ""},
+	{"🧄", "garlic", 4, 43, "E12.0", FullyQualified, ""},
+	{"🧅", "onion", 4, 43, "E12.0", FullyQualified, ""},
+	{"🧆", "falafel", 4, 44, "E12.0", FullyQualified, ""},
+	{"🧇", "waffle", 4, 44, "E12.0", FullyQualified, ""},
+	{"🧈", "butter", 4, 44, "E12.0", FullyQualified, ""},
+	{"🧉", "mate", 4, 48, "E12.0", FullyQualified, ""},
+	{"🧊", "ice", 4, 48, "E12.0", FullyQualified, ""},
+	{"🧋", "bubble tea", 4, 48, "E13.0", FullyQualified, ""},
+	{"🧍", "person standing", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍\u200d♀", "woman standing", 1, 27, "E12.0", MinimallyQualified, "🧍\u200d♀️"},
+	{"🧍\u200d♀️", "woman standing", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍\u200d♂", "man standing", 1, 27, "E12.0", MinimallyQualified, "🧍\u200d♂️"},
+	{"🧍\u200d♂️", "man standing", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏻", "person standing: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏻\u200d♀", "woman standing: light skin tone", 1, 27, "E12.0", MinimallyQualified, "🧍🏻\u200d♀️"},
+	{"🧍🏻\u200d♀️", "woman standing: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏻\u200d♂", "man standing: light skin tone", 1, 27, "E12.0", MinimallyQualified, "🧍🏻\u200d♂️"},
+	{"🧍🏻\u200d♂️", "man standing: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏼", "person standing: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏼\u200d♀", "woman standing: medium-light skin tone", 1, 27, "E12.0", MinimallyQualified, "🧍🏼\u200d♀️"},
+	{"🧍🏼\u200d♀️", "woman standing: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏼\u200d♂", "man standing: medium-light skin tone", 1, 27, "E12.0", MinimallyQualified, "🧍🏼\u200d♂️"},
+	{"🧍🏼\u200d♂️", "man standing: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏽", "person standing: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏽\u200d♀", "woman standing: medium skin tone", 1, 27, "E12.0", MinimallyQualified, "🧍🏽\u200d♀️"},
+	{"🧍🏽\u200d♀️", "woman standing: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏽\u200d♂", "man standing: medium skin tone", 1, 27, "E12.0", MinimallyQualified, "🧍🏽\u200d♂️"},
+	{"🧍🏽\u200d♂️", "man standing: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏾", "person standing: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏾\u200d♀", "woman standing: medium-dark skin tone", 1, 27, "E12.0", MinimallyQualified, "🧍🏾\u200d♀️"},
+	{"🧍🏾\u200d♀️", "woman standing: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏾\u200d♂", "man standing: medium-dark skin tone", 1, 27, "E12.0", MinimallyQualified, "🧍🏾\u200d♂️"},
+	{"🧍🏾\u200d♂️", "man standing: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏿", "person standing: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏿\u200d♀", "woman standing: dark skin tone", 1, 27, "E12.0", MinimallyQualified, "🧍🏿\u200d♀️"},
+	{"🧍🏿\u200d♀️", "woman standing: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧍🏿\u200d♂", "man standing: dark skin tone", 1, 27, "E12.0", MinimallyQualified, "🧍🏿\u200d♂️"},
+	{"🧍🏿\u200d♂️", "man standing: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎", "person kneeling", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎\u200d♀", "woman kneeling", 1, 27, "E12.0", MinimallyQualified, "🧎\u200d♀️"},
+	{"🧎\u200d♀️", "woman kneeling", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎\u200d♂", "man kneeling", 1, 27, "E12.0", MinimallyQualified, "🧎\u200d♂️"},
+	{"🧎\u200d♂️", "man kneeling", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏻", "person kneeling: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏻\u200d♀", "woman kneeling: light skin tone", 1, 27, "E12.0", MinimallyQualified, "🧎🏻\u200d♀️"},
+	{"🧎🏻\u200d♀️", "woman kneeling: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏻\u200d♂", "man kneeling: light skin tone", 1, 27, "E12.0", MinimallyQualified, "🧎🏻\u200d♂️"},
+	{"🧎🏻\u200d♂️", "man kneeling: light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏼", "person kneeling: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏼\u200d♀", "woman kneeling: medium-light skin tone", 1, 27, "E12.0", MinimallyQualified, "🧎🏼\u200d♀️"},
+	{"🧎🏼\u200d♀️", "woman kneeling: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏼\u200d♂", "man kneeling: medium-light skin tone", 1, 27, "E12.0", MinimallyQualified, "🧎🏼\u200d♂️"},
+	{"🧎🏼\u200d♂️", "man kneeling: medium-light skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏽", "person kneeling: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏽\u200d♀", "woman kneeling: medium skin tone", 1, 27, "E12.0", MinimallyQualified, "🧎🏽\u200d♀️"},
+	{"🧎🏽\u200d♀️", "woman kneeling: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏽\u200d♂", "man kneeling: medium skin tone", 1, 27, "E12.0", MinimallyQualified, "🧎🏽\u200d♂️"},
+	{"🧎🏽\u200d♂️", "man kneeling: medium skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏾", "person kneeling: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏾\u200d♀", "woman kneeling: medium-dark skin tone", 1, 27, "E12.0", MinimallyQualified, "🧎🏾\u200d♀️"},
+	{"🧎🏾\u200d♀️", "woman kneeling: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏾\u200d♂", "man kneeling: medium-dark skin tone", 1, 27, "E12.0", MinimallyQualified, "🧎🏾\u200d♂️"},
+	{"🧎🏾\u200d♂️", "man kneeling: medium-dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏿", "person kneeling: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏿\u200d♀", "woman kneeling: dark skin tone", 1, 27, "E12.0", MinimallyQualified, "🧎🏿\u200d♀️"},
+	{"🧎🏿\u200d♀️", "woman kneeling: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧎🏿\u200d♂", "man kneeling: dark skin tone", 1, 27, "E12.0", MinimallyQualified, "🧎🏿\u200d♂️"},
+	{"🧎🏿\u200d♂️", "man kneeling: dark skin tone", 1, 27, "E12.0", FullyQualified, ""},
+	{"🧏", "deaf person", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏\u200d♀", "deaf woman", 1, 24, "E12.0", MinimallyQualified, "🧏\u200d♀️"},
+	{"🧏\u200d♀️", "deaf woman", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏\u200d♂", "deaf man", 1, 24, "E12.0", MinimallyQualified, "🧏\u200d♂️"},
+	{"🧏\u200d♂️", "deaf man", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏻", "deaf person: light skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏻\u200d♀", "deaf woman: light skin tone", 1, 24, "E12.0", MinimallyQualified, "🧏🏻\u200d♀️"},
+	{"🧏🏻\u200d♀️", "deaf woman: light skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏻\u200d♂", "deaf man: light skin tone", 1, 24, "E12.0", MinimallyQualified, "🧏🏻\u200d♂️"},
+	{"🧏🏻\u200d♂️", "deaf man: light skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏼", "deaf person: medium-light skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏼\u200d♀", "deaf woman: medium-light skin tone", 1, 24, "E12.0", MinimallyQualified, "🧏🏼\u200d♀️"},
+	{"🧏🏼\u200d♀️", "deaf woman: medium-light skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏼\u200d♂", "deaf man: medium-light skin tone", 1, 24, "E12.0", MinimallyQualified, "🧏🏼\u200d♂️"},
+	{"🧏🏼\u200d♂️", "deaf man: medium-light skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏽", "deaf person: medium skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏽\u200d♀", "deaf woman: medium skin tone", 1, 24, "E12.0", MinimallyQualified, "🧏🏽\u200d♀️"},
+	{"🧏🏽\u200d♀️", "deaf woman: medium skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏽\u200d♂", "deaf man: medium skin tone", 1, 24, "E12.0", MinimallyQualified, "🧏🏽\u200d♂️"},
+	{"🧏🏽\u200d♂️", "deaf man: medium skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏾", "deaf person: medium-dark skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏾\u200d♀", "deaf woman: medium-dark skin tone", 1, 24, "E12.0", MinimallyQualified, "🧏🏾\u200d♀️"},
+	{"🧏🏾\u200d♀️", "deaf woman: medium-dark skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏾\u200d♂", "deaf man: medium-dark skin tone", 1, 24, "E12.0", MinimallyQualified, "🧏🏾\u200d♂️"},
+	{"🧏🏾\u200d♂️", "deaf man: medium-dark skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏿", "deaf person: dark skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏿\u200d♀", "deaf woman: dark skin tone", 1, 24, "E12.0", MinimallyQualified, "🧏🏿\u200d♀️"},
+	{"🧏🏿\u200d♀️", "deaf woman: dark skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧏🏿\u200d♂", "deaf man: dark skin tone", 1, 24, "E12.0", MinimallyQualified, "🧏🏿\u200d♂️"},
+	{"🧏🏿\u200d♂️", "deaf man: dark skin tone", 1, 24, "E12.0", FullyQualified, ""},
+	{"🧐", "face with monocle", 0, 8, "E5.0", FullyQualified, ""},
+	{"🧑", "person", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧑\u200d⚕", "health worker", 1, 25, "E12.1", MinimallyQualified, "🧑\u200d⚕️"},
+	{"🧑\u200d⚕️", "health worker", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d⚖", "judge", 1, 25, "E12.1", MinimallyQualified, "🧑\u200d⚖️"},
+	{"🧑\u200d⚖️", "judge", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d✈", "pilot", 1, 25, "E12.1", MinimallyQualified, "🧑\u200d✈️"},
+	{"🧑\u200d✈️", "pilot", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🌾", "farmer", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🍳", "cook", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🍼", "person feeding baby", 1, 25, "E13.0", FullyQualified, ""},
+	{"🧑\u200d🎄", "mx claus", 1, 26, "E13.0", FullyQualified, ""},
+	{"🧑\u200d🎓", "student", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🎤", "singer", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🎨", "artist", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🏫", "teacher", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🏭", "factory worker", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d💻", "technologist", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d💼", "office worker", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🔧", "mechanic", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🔬", "scientist", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🚀", "astronaut", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🚒", "firefighter", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🤝\u200d🧑", "people holding hands", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑\u200d🦯", "person with white cane", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🦰", "person: red hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🦱", "person: curly hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🦲", "person: bald", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🦳", "person: white hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🦼", "person in motorized wheelchair", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑\u200d🦽", "person in manual wheelchair", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏻", "person: light skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧑🏻\u200d⚕", "health worker: light skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏻\u200d⚕️"},
+	{"🧑🏻\u200d⚕️", "health worker: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d⚖", "judge: light skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏻\u200d⚖️"},
+	{"🧑🏻\u200d⚖️", "judge: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d✈", "pilot: light skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏻\u200d✈️"},
+	{"🧑🏻\u200d✈️", "pilot: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🌾", "farmer: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🍳", "cook: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🍼", "person feeding baby: light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🧑🏻\u200d🎄", "mx claus: light skin tone", 1, 26, "E13.0", FullyQualified, ""},
+	{"🧑🏻\u200d🎓", "student: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🎤", "singer: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🎨", "artist: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🏫", "teacher: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🏭", "factory worker: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d💻", "technologist: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d💼", "office worker: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🔧", "mechanic: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🔬", "scientist: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🚀", "astronaut: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🚒", "firefighter: light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🤝\u200d🧑🏻", "people holding hands: light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏻\u200d🤝\u200d🧑🏼", "people holding hands: light skin tone, medium-light skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🤝\u200d🧑🏽", "people holding hands: light skin tone, medium skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🤝\u200d🧑🏾", "people holding hands: light skin tone, medium-dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🤝\u200d🧑🏿", "people holding hands: light skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🦯", "person with white cane: light skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🦰", "person: light skin tone, red hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🦱", "person: light skin tone, curly hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🦲", "person: light skin tone, bald", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🦳", "person: light skin tone, white hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🦼", "person in motorized wheelchair: light skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏻\u200d🦽", "person in manual wheelchair: light skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏼", "person: medium-light skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧑🏼\u200d⚕", "health worker: medium-light skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏼\u200d⚕️"},
+	{"🧑🏼\u200d⚕️", "health worker: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d⚖", "judge: medium-light skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏼\u200d⚖️"},
+	{"🧑🏼\u200d⚖️", "judge: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d✈", "pilot: medium-light skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏼\u200d✈️"},
+	{"🧑🏼\u200d✈️", "pilot: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🌾", "farmer: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🍳", "cook: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🍼", "person feeding baby: medium-light skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🧑🏼\u200d🎄", "mx claus: medium-light skin tone", 1, 26, "E13.0", FullyQualified, ""},
+	{"🧑🏼\u200d🎓", "student: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🎤", "singer: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🎨", "artist: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🏫", "teacher: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🏭", "factory worker: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d💻", "technologist: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d💼", "office worker: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🔧", "mechanic: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🔬", "scientist: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🚀", "astronaut: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🚒", "firefighter: medium-light skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🤝\u200d🧑🏻", "people holding hands: medium-light skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏼\u200d🤝\u200d🧑🏼", "people holding hands: medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏼\u200d🤝\u200d🧑🏽", "people holding hands: medium-light skin tone, medium skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🤝\u200d🧑🏾", "people holding hands: medium-light skin tone, medium-dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🤝\u200d🧑🏿", "people holding hands: medium-light skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🦯", "person with white cane: medium-light skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🦰", "person: medium-light skin tone, red hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🦱", "person: medium-light skin tone, curly hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🦲", "person: medium-light skin tone, bald", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🦳", "person: medium-light skin tone, white hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🦼", "person in motorized wheelchair: medium-light skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏼\u200d🦽", "person in manual wheelchair: medium-light skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏽", "person: medium skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧑🏽\u200d⚕", "health worker: medium skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏽\u200d⚕️"},
+	{"🧑🏽\u200d⚕️", "health worker: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d⚖", "judge: medium skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏽\u200d⚖️"},
+	{"🧑🏽\u200d⚖️", "judge: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d✈", "pilot: medium skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏽\u200d✈️"},
+	{"🧑🏽\u200d✈️", "pilot: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🌾", "farmer: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🍳", "cook: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🍼", "person feeding baby: medium skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🧑🏽\u200d🎄", "mx claus: medium skin tone", 1, 26, "E13.0", FullyQualified, ""},
+	{"🧑🏽\u200d🎓", "student: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🎤", "singer: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🎨", "artist: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🏫", "teacher: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🏭", "factory worker: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d💻", "technologist: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d💼", "office worker: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🔧", "mechanic: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🔬", "scientist: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🚀", "astronaut: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🚒", "firefighter: medium skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🤝\u200d🧑🏻", "people holding hands: medium skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏽\u200d🤝\u200d🧑🏼", "people holding hands: medium skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏽\u200d🤝\u200d🧑🏽", "people holding hands: medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏽\u200d🤝\u200d🧑🏾", "people holding hands: medium skin tone, medium-dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🤝\u200d🧑🏿", "people holding hands: medium skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🦯", "person with white cane: medium skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🦰", "person: medium skin tone, red hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🦱", "person: medium skin tone, curly hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🦲", "person: medium skin tone, bald", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🦳", "person: medium skin tone, white hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🦼", "person in motorized wheelchair: medium skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏽\u200d🦽", "person in manual wheelchair: medium skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏾", "person: medium-dark skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧑🏾\u200d⚕", "health worker: medium-dark skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏾\u200d⚕️"},
+	{"🧑🏾\u200d⚕️", "health worker: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d⚖", "judge: medium-dark skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏾\u200d⚖️"},
+	{"🧑🏾\u200d⚖️", "judge: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d✈", "pilot: medium-dark skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏾\u200d✈️"},
+	{"🧑🏾\u200d✈️", "pilot: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🌾", "farmer: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🍳", "cook: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🍼", "person feeding baby: medium-dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🧑🏾\u200d🎄", "mx claus: medium-dark skin tone", 1, 26, "E13.0", FullyQualified, ""},
+	{"🧑🏾\u200d🎓", "student: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🎤", "singer: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🎨", "artist: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🏫", "teacher: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🏭", "factory worker: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d💻", "technologist: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d💼", "office worker: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🔧", "mechanic: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🔬", "scientist: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🚀", "astronaut: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🚒", "firefighter: medium-dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🤝\u200d🧑🏻", "people holding hands: medium-dark skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏾\u200d🤝\u200d🧑🏼", "people holding hands: medium-dark skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏾\u200d🤝\u200d🧑🏽", "people holding hands: medium-dark skin tone, medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏾\u200d🤝\u200d🧑🏾", "people holding hands: medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏾\u200d🤝\u200d🧑🏿", "people holding hands: medium-dark skin tone, dark skin tone", 1, 30, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🦯", "person with white cane: medium-dark skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🦰", "person: medium-dark skin tone, red hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🦱", "person: medium-dark skin tone, curly hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🦲", "person: medium-dark skin tone, bald", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🦳", "person: medium-dark skin tone, white hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🦼", "person in motorized wheelchair: medium-dark skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏾\u200d🦽", "person in manual wheelchair: medium-dark skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏿", "person: dark skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧑🏿\u200d⚕", "health worker: dark skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏿\u200d⚕️"},
+	{"🧑🏿\u200d⚕️", "health worker: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d⚖", "judge: dark skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏿\u200d⚖️"},
+	{"🧑🏿\u200d⚖️", "judge: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d✈", "pilot: dark skin tone", 1, 25, "E12.1", MinimallyQualified, "🧑🏿\u200d✈️"},
+	{"🧑🏿\u200d✈️", "pilot: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🌾", "farmer: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🍳", "cook: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🍼", "person feeding baby: dark skin tone", 1, 25, "E13.0", FullyQualified, ""},
+	{"🧑🏿\u200d🎄", "mx claus: dark skin tone", 1, 26, "E13.0", FullyQualified, ""},
+	{"🧑🏿\u200d🎓", "student: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🎤", "singer: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🎨", "artist: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🏫", "teacher: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🏭", "factory worker: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d💻", "technologist: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d💼", "office worker: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🔧", "mechanic: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🔬", "scientist: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🚀", "astronaut: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🚒", "firefighter: dark skin tone", 1, 25, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🤝\u200d🧑🏻", "people holding hands: dark skin tone, light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏿\u200d🤝\u200d🧑🏼", "people holding hands: dark skin tone, medium-light skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏿\u200d🤝\u200d🧑🏽", "people holding hands: dark skin tone, medium skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏿\u200d🤝\u200d🧑🏾", "people holding hands: dark skin tone, medium-dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏿\u200d🤝\u200d🧑🏿", "people holding hands: dark skin tone", 1, 30, "E12.0", FullyQualified, ""},
+	{"🧑🏿\u200d🦯", "person with white cane: dark skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🦰", "person: dark skin tone, red hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🦱", "person: dark skin tone, curly hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🦲", "person: dark skin tone, bald", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🦳", "person: dark skin tone, white hair", 1, 23, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🦼", "person in motorized wheelchair: dark skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧑🏿\u200d🦽", "person in manual wheelchair: dark skin tone", 1, 27, "E12.1", FullyQualified, ""},
+	{"🧒", "child", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧒🏻", "child: light skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧒🏼", "child: medium-light skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧒🏽", "child: medium skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧒🏾", "child: medium-dark skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧒🏿", "child: dark skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧓", "older person", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧓🏻", "older person: light skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧓🏼", "older person: medium-light skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧓🏽", "older person: medium skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧓🏾", "older person: medium-dark skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧓🏿", "older person: dark skin tone", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧔", "person: beard", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧔🏻", "person: light skin tone, beard", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧔🏼", "person: medium-light skin tone, beard", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧔🏽", "person: medium skin tone, beard", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧔🏾", "person: medium-dark skin tone, beard", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧔🏿", "person: dark skin tone, beard", 1, 23, "E5.0", FullyQualified, ""},
+	{"🧕", "woman with headscarf", 1, 25, "E5.0", FullyQualified, ""},
+	{"🧕🏻", "woman with headscarf: light skin tone", 1, 25, "E5.0", FullyQualified, ""},
+	{"🧕🏼", "woman with headscarf: medium-light skin tone", 1, 25, "E5.0", FullyQualified, ""},
+	{"🧕🏽", "woman with headscarf: medium skin tone", 1, 25, "E5.0", FullyQualified, ""},
+	{"🧕🏾", "woman with headscarf: medium-dark skin tone", 1, 25, "E5.0", FullyQualified, ""},
+	{"🧕🏿", "woman with headscarf: dark skin tone", 1, 25, "E5.0", FullyQualified, ""},
+	{"🧖", "person in steamy room", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖\u200d♀", "woman in steamy room", 1, 27, "E5.0", MinimallyQualified, "🧖\u200d♀️"},
+	{"🧖\u200d♀️", "woman in steamy room", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖\u200d♂", "man in steamy room", 1, 27, "E5.0", MinimallyQualified, "🧖\u200d♂️"},
+	{"🧖\u200d♂️", "man in steamy room", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏻", "person in steamy room: light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏻\u200d♀", "woman in steamy room: light skin tone", 1, 27, "E5.0", MinimallyQualified, "🧖🏻\u200d♀️"},
+	{"🧖🏻\u200d♀️", "woman in steamy room: light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏻\u200d♂", "man in steamy room: light skin tone", 1, 27, "E5.0", MinimallyQualified, "🧖🏻\u200d♂️"},
+	{"🧖🏻\u200d♂️", "man in steamy room: light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏼", "person in steamy room: medium-light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏼\u200d♀", "woman in steamy room: medium-light skin tone", 1, 27, "E5.0", MinimallyQualified, "🧖🏼\u200d♀️"},
+	{"🧖🏼\u200d♀️", "woman in steamy room: medium-light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏼\u200d♂", "man in steamy room: medium-light skin tone", 1, 27, "E5.0", MinimallyQualified, "🧖🏼\u200d♂️"},
+	{"🧖🏼\u200d♂️", "man in steamy room: medium-light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏽", "person in steamy room: medium skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏽\u200d♀", "woman in steamy room: medium skin tone", 1, 27, "E5.0", MinimallyQualified, "🧖🏽\u200d♀️"},
+	{"🧖🏽\u200d♀️", "woman in steamy room: medium skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏽\u200d♂", "man in steamy room: medium skin tone", 1, 27, "E5.0", MinimallyQualified, "🧖🏽\u200d♂️"},
+	{"🧖🏽\u200d♂️", "man in steamy room: medium skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏾", "person in steamy room: medium-dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏾\u200d♀", "woman in steamy room: medium-dark skin tone", 1, 27, "E5.0", MinimallyQualified, "🧖🏾\u200d♀️"},
+	{"🧖🏾\u200d♀️", "woman in steamy room: medium-dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏾\u200d♂", "man in steamy room: medium-dark skin tone", 1, 27, "E5.0", MinimallyQualified, "🧖🏾\u200d♂️"},
+	{"🧖🏾\u200d♂️", "man in steamy room: medium-dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏿", "person in steamy room: dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏿\u200d♀", "woman in steamy room: dark skin tone", 1, 27, "E5.0", MinimallyQualified, "🧖🏿\u200d♀️"},
+	{"🧖🏿\u200d♀️", "woman in steamy room: dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧖🏿\u200d♂", "man in steamy room: dark skin tone", 1, 27, "E5.0", MinimallyQualified, "🧖🏿\u200d♂️"},
+	{"🧖🏿\u200d♂️", "man in steamy room: dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗", "person climbing", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗\u200d♀", "woman climbing", 1, 27, "E5.0", MinimallyQualified, "🧗\u200d♀️"},
+	{"🧗\u200d♀️", "woman climbing", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗\u200d♂", "man climbing", 1, 27, "E5.0", MinimallyQualified, "🧗\u200d♂️"},
+	{"🧗\u200d♂️", "man climbing", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏻", "person climbing: light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏻\u200d♀", "woman climbing: light skin tone", 1, 27, "E5.0", MinimallyQualified, "🧗🏻\u200d♀️"},
+	{"🧗🏻\u200d♀️", "woman climbing: light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏻\u200d♂", "man climbing: light skin tone", 1, 27, "E5.0", MinimallyQualified, "🧗🏻\u200d♂️"},
+	{"🧗🏻\u200d♂️", "man climbing: light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏼", "person climbing: medium-light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏼\u200d♀", "woman climbing: medium-light skin tone", 1, 27, "E5.0", MinimallyQualified, "🧗🏼\u200d♀️"},
+	{"🧗🏼\u200d♀️", "woman climbing: medium-light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏼\u200d♂", "man climbing: medium-light skin tone", 1, 27, "E5.0", MinimallyQualified, "🧗🏼\u200d♂️"},
+	{"🧗🏼\u200d♂️", "man climbing: medium-light skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏽", "person climbing: medium skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏽\u200d♀", "woman climbing: medium skin tone", 1, 27, "E5.0", MinimallyQualified, "🧗🏽\u200d♀️"},
+	{"🧗🏽\u200d♀️", "woman climbing: medium skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏽\u200d♂", "man climbing: medium skin tone", 1, 27, "E5.0", MinimallyQualified, "🧗🏽\u200d♂️"},
+	{"🧗🏽\u200d♂️", "man climbing: medium skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏾", "person climbing: medium-dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏾\u200d♀", "woman climbing: medium-dark skin tone", 1, 27, "E5.0", MinimallyQualified, "🧗🏾\u200d♀️"},
+	{"🧗🏾\u200d♀️", "woman climbing: medium-dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏾\u200d♂", "man climbing: medium-dark skin tone", 1, 27, "E5.0", MinimallyQualified, "🧗🏾\u200d♂️"},
+	{"🧗🏾\u200d♂️", "man climbing: medium-dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏿", "person climbing: dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏿\u200d♀", "woman climbing: dark skin tone", 1, 27, "E5.0", MinimallyQualified, "🧗🏿\u200d♀️"},
+	{"🧗🏿\u200d♀️", "woman climbing: dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧗🏿\u200d♂", "man climbing: dark skin tone", 1, 27, "E5.0", MinimallyQualified, "🧗🏿\u200d♂️"},
+	{"🧗🏿\u200d♂️", "man climbing: dark skin tone", 1, 27, "E5.0", FullyQualified, ""},
+	{"🧘", "person in lotus position", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘\u200d♀", "woman in lotus position", 1, 29, "E5.0", MinimallyQualified, "🧘\u200d♀️"},
+	{"🧘\u200d♀️", "woman in lotus position", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘\u200d♂", "man in lotus position", 1, 29, "E5.0", MinimallyQualified, "🧘\u200d♂️"},
+	{"🧘\u200d♂️", "man in lotus position", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏻", "person in lotus position: light skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏻\u200d♀", "woman in lotus position: light skin tone", 1, 29, "E5.0", MinimallyQualified, "🧘🏻\u200d♀️"},
+	{"🧘🏻\u200d♀️", "woman in lotus position: light skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏻\u200d♂", "man in lotus position: light skin tone", 1, 29, "E5.0", MinimallyQualified, "🧘🏻\u200d♂️"},
+	{"🧘🏻\u200d♂️", "man in lotus position: light skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏼", "person in lotus position: medium-light skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏼\u200d♀", "woman in lotus position: medium-light skin tone", 1, 29, "E5.0", MinimallyQualified, "🧘🏼\u200d♀️"},
+	{"🧘🏼\u200d♀️", "woman in lotus position: medium-light skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏼\u200d♂", "man in lotus position: medium-light skin tone", 1, 29, "E5.0", MinimallyQualified, "🧘🏼\u200d♂️"},
+	{"🧘🏼\u200d♂️", "man in lotus position: medium-light skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏽", "person in lotus position: medium skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏽\u200d♀", "woman in lotus position: medium skin tone", 1, 29, "E5.0", MinimallyQualified, "🧘🏽\u200d♀️"},
+	{"🧘🏽\u200d♀️", "woman in lotus position: medium skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏽\u200d♂", "man in lotus position: medium skin tone", 1, 29, "E5.0", MinimallyQualified, "🧘🏽\u200d♂️"},
+	{"🧘🏽\u200d♂️", "man in lotus position: medium skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏾", "person in lotus position: medium-dark skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏾\u200d♀", "woman in lotus position: medium-dark skin tone", 1, 29, "E5.0", MinimallyQualified, "🧘🏾\u200d♀️"},
+	{"🧘🏾\u200d♀️", "woman in lotus position: medium-dark skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏾\u200d♂", "man in lotus position: medium-dark skin tone", 1, 29, "E5.0", MinimallyQualified, "🧘🏾\u200d♂️"},
+	{"🧘🏾\u200d♂️", "man in lotus position: medium-dark skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏿", "person in lotus position: dark skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏿\u200d♀", "woman in lotus position: dark skin tone", 1, 29, "E5.0", MinimallyQualified, "🧘🏿\u200d♀️"},
+	{"🧘🏿\u200d♀️", "woman in lotus position: dark skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧘🏿\u200d♂", "man in lotus position: dark skin tone", 1, 29, "E5.0", MinimallyQualified, "🧘🏿\u200d♂️"},
+	{"🧘🏿\u200d♂️", "man in lotus position: dark skin tone", 1, 29, "E5.0", FullyQualified, ""},
+	{"🧙", "mage", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙\u200d♀", "woman mage", 1, 26, "E5.0", MinimallyQualified, "🧙\u200d♀️"},
+	{"🧙\u200d♀️", "woman mage", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙\u200d♂", "man mage", 1, 26, "E5.0", MinimallyQualified, "🧙\u200d♂️"},
+	{"🧙\u200d♂️", "man mage", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏻", "mage: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏻\u200d♀", "woman mage: light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧙🏻\u200d♀️"},
+	{"🧙🏻\u200d♀️", "woman mage: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏻\u200d♂", "man mage: light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧙🏻\u200d♂️"},
+	{"🧙🏻\u200d♂️", "man mage: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏼", "mage: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏼\u200d♀", "woman mage: medium-light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧙🏼\u200d♀️"},
+	{"🧙🏼\u200d♀️", "woman mage: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏼\u200d♂", "man mage: medium-light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧙🏼\u200d♂️"},
+	{"🧙🏼\u200d♂️", "man mage: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏽", "mage: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏽\u200d♀", "woman mage: medium skin tone", 1, 26, "E5.0", MinimallyQualified, "🧙🏽\u200d♀️"},
+	{"🧙🏽\u200d♀️", "woman mage: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏽\u200d♂", "man mage: medium skin tone", 1, 26, "E5.0", MinimallyQualified, "🧙🏽\u200d♂️"},
+	{"🧙🏽\u200d♂️", "man mage: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏾", "mage: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏾\u200d♀", "woman mage: medium-dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧙🏾\u200d♀️"},
+	{"🧙🏾\u200d♀️", "woman mage: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏾\u200d♂", "man mage: medium-dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧙🏾\u200d♂️"},
+	{"🧙🏾\u200d♂️", "man mage: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏿", "mage: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏿\u200d♀", "woman mage: dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧙🏿\u200d♀️"},
+	{"🧙🏿\u200d♀️", "woman mage: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧙🏿\u200d♂", "man mage: dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧙🏿\u200d♂️"},
+	{"🧙🏿\u200d♂️", "man mage: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚", "fairy", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚\u200d♀", "woman fairy", 1, 26, "E5.0", MinimallyQualified, "🧚\u200d♀️"},
+	{"🧚\u200d♀️", "woman fairy", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚\u200d♂", "man fairy", 1, 26, "E5.0", MinimallyQualified, "🧚\u200d♂️"},
+	{"🧚\u200d♂️", "man fairy", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏻", "fairy: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏻\u200d♀", "woman fairy: light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧚🏻\u200d♀️"},
+	{"🧚🏻\u200d♀️", "woman fairy: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏻\u200d♂", "man fairy: light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧚🏻\u200d♂️"},
+	{"🧚🏻\u200d♂️", "man fairy: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏼", "fairy: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏼\u200d♀", "woman fairy: medium-light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧚🏼\u200d♀️"},
+	{"🧚🏼\u200d♀️", "woman fairy: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏼\u200d♂", "man fairy: medium-light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧚🏼\u200d♂️"},
+	{"🧚🏼\u200d♂️", "man fairy: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏽", "fairy: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏽\u200d♀", "woman fairy: medium skin tone", 1, 26, "E5.0", MinimallyQualified, "🧚🏽\u200d♀️"},
+	{"🧚🏽\u200d♀️", "woman fairy: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏽\u200d♂", "man fairy: medium skin tone", 1, 26, "E5.0", MinimallyQualified, "🧚🏽\u200d♂️"},
+	{"🧚🏽\u200d♂️", "man fairy: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏾", "fairy: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏾\u200d♀", "woman fairy: medium-dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧚🏾\u200d♀️"},
+	{"🧚🏾\u200d♀️", "woman fairy: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏾\u200d♂", "man fairy: medium-dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧚🏾\u200d♂️"},
+	{"🧚🏾\u200d♂️", "man fairy: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏿", "fairy: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏿\u200d♀", "woman fairy: dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧚🏿\u200d♀️"},
+	{"🧚🏿\u200d♀️", "woman fairy: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧚🏿\u200d♂", "man fairy: dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧚🏿\u200d♂️"},
+	{"🧚🏿\u200d♂️", "man fairy: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛", "vampire", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛\u200d♀", "woman vampire", 1, 26, "E5.0", MinimallyQualified, "🧛\u200d♀️"},
+	{"🧛\u200d♀️", "woman vampire", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛\u200d♂", "man vampire", 1, 26, "E5.0", MinimallyQualified, "🧛\u200d♂️"},
+	{"🧛\u200d♂️", "man vampire", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏻", "vampire: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏻\u200d♀", "woman vampire: light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧛🏻\u200d♀️"},
+	{"🧛🏻\u200d♀️", "woman vampire: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏻\u200d♂", "man vampire: light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧛🏻\u200d♂️"},
+	{"🧛🏻\u200d♂️", "man vampire: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏼", "vampire: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏼\u200d♀", "woman vampire: medium-light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧛🏼\u200d♀️"},
+	{"🧛🏼\u200d♀️", "woman vampire: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏼\u200d♂", "man vampire: medium-light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧛🏼\u200d♂️"},
+	{"🧛🏼\u200d♂️", "man vampire: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏽", "vampire: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏽\u200d♀", "woman vampire: medium skin tone", 1, 26, "E5.0", MinimallyQualified, "🧛🏽\u200d♀️"},
+	{"🧛🏽\u200d♀️", "woman vampire: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏽\u200d♂", "man vampire: medium skin tone", 1, 26, "E5.0", MinimallyQualified, "🧛🏽\u200d♂️"},
+	{"🧛🏽\u200d♂️", "man vampire: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏾", "vampire: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏾\u200d♀", "woman vampire: medium-dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧛🏾\u200d♀️"},
+	{"🧛🏾\u200d♀️", "woman vampire: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏾\u200d♂", "man vampire: medium-dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧛🏾\u200d♂️"},
+	{"🧛🏾\u200d♂️", "man vampire: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏿", "vampire: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏿\u200d♀", "woman vampire: dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧛🏿\u200d♀️"},
+	{"🧛🏿\u200d♀️", "woman vampire: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧛🏿\u200d♂", "man vampire: dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧛🏿\u200d♂️"},
+	{"🧛🏿\u200d♂️", "man vampire: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜", "merperson", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜\u200d♀", "mermaid", 1, 26, "E5.0", MinimallyQualified, "🧜\u200d♀️"},
+	{"🧜\u200d♀️", "mermaid", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜\u200d♂", "merman", 1, 26, "E5.0", MinimallyQualified, "🧜\u200d♂️"},
+	{"🧜\u200d♂️", "merman", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏻", "merperson: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏻\u200d♀", "mermaid: light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧜🏻\u200d♀️"},
+	{"🧜🏻\u200d♀️", "mermaid: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏻\u200d♂", "merman: light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧜🏻\u200d♂️"},
+	{"🧜🏻\u200d♂️", "merman: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏼", "merperson: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏼\u200d♀", "mermaid: medium-light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧜🏼\u200d♀️"},
+	{"🧜🏼\u200d♀️", "mermaid: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏼\u200d♂", "merman: medium-light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧜🏼\u200d♂️"},
+	{"🧜🏼\u200d♂️", "merman: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏽", "merperson: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏽\u200d♀", "mermaid: medium skin tone", 1, 26, "E5.0", MinimallyQualified, "🧜🏽\u200d♀️"},
+	{"🧜🏽\u200d♀️", "mermaid: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏽\u200d♂", "merman: medium skin tone", 1, 26, "E5.0", MinimallyQualified, "🧜🏽\u200d♂️"},
+	{"🧜🏽\u200d♂️", "merman: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏾", "merperson: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏾\u200d♀", "mermaid: medium-dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧜🏾\u200d♀️"},
+	{"🧜🏾\u200d♀️", "mermaid: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏾\u200d♂", "merman: medium-dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧜🏾\u200d♂️"},
+	{"🧜🏾\u200d♂️", "merman: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏿", "merperson: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏿\u200d♀", "mermaid: dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧜🏿\u200d♀️"},
+	{"🧜🏿\u200d♀️", "mermaid: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧜🏿\u200d♂", "merman: dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧜🏿\u200d♂️"},
+	{"🧜🏿\u200d♂️", "merman: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝", "elf", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝\u200d♀", "woman elf", 1, 26, "E5.0", MinimallyQualified, "🧝\u200d♀️"},
+	{"🧝\u200d♀️", "woman elf", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝\u200d♂", "man elf", 1, 26, "E5.0", MinimallyQualified, "🧝\u200d♂️"},
+	{"🧝\u200d♂️", "man elf", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏻", "elf: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏻\u200d♀", "woman elf: light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧝🏻\u200d♀️"},
+	{"🧝🏻\u200d♀️", "woman elf: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏻\u200d♂", "man elf: light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧝🏻\u200d♂️"},
+	{"🧝🏻\u200d♂️", "man elf: light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏼", "elf: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏼\u200d♀", "woman elf: medium-light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧝🏼\u200d♀️"},
+	{"🧝🏼\u200d♀️", "woman elf: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏼\u200d♂", "man elf: medium-light skin tone", 1, 26, "E5.0", MinimallyQualified, "🧝🏼\u200d♂️"},
+	{"🧝🏼\u200d♂️", "man elf: medium-light skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏽", "elf: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏽\u200d♀", "woman elf: medium skin tone", 1, 26, "E5.0", MinimallyQualified, "🧝🏽\u200d♀️"},
+	{"🧝🏽\u200d♀️", "woman elf: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏽\u200d♂", "man elf: medium skin tone", 1, 26, "E5.0", MinimallyQualified, "🧝🏽\u200d♂️"},
+	{"🧝🏽\u200d♂️", "man elf: medium skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏾", "elf: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏾\u200d♀", "woman elf: medium-dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧝🏾\u200d♀️"},
+	{"🧝🏾\u200d♀️", "woman elf: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏾\u200d♂", "man elf: medium-dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧝🏾\u200d♂️"},
+	{"🧝🏾\u200d♂️", "man elf: medium-dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏿", "elf: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏿\u200d♀", "woman elf: dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧝🏿\u200d♀️"},
+	{"🧝🏿\u200d♀️", "woman elf: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧝🏿\u200d♂", "man elf: dark skin tone", 1, 26, "E5.0", MinimallyQualified, "🧝🏿\u200d♂️"},
+	{"🧝🏿\u200d♂️", "man elf: dark skin tone", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧞", "genie", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧞\u200d♀", "woman genie", 1, 26, "E5.0", MinimallyQualified, "🧞\u200d♀️"},
+	{"🧞\u200d♀️", "woman genie", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧞\u200d♂", "man genie", 1, 26, "E5.0", MinimallyQualified, "🧞\u200d♂️"},
+	{"🧞\u200d♂️", "man genie", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧟", "zombie", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧟\u200d♀", "woman zombie", 1, 26, "E5.0", MinimallyQualified, "🧟\u200d♀️"},
+	{"🧟\u200d♀️", "woman zombie", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧟\u200d♂", "man zombie", 1, 26, "E5.0", MinimallyQualified, "🧟\u200d♂️"},
+	{"🧟\u200d♂️", "man zombie", 1, 26, "E5.0", FullyQualified, ""},
+	{"🧠", "brain", 1, 22, "E5.0", FullyQualified, ""},
+	{"🧡", "orange heart", 0, 14, "E5.0", FullyQualified, ""},
+	{"🧢", "billed cap", 7, 66, "E5.0", FullyQualified, ""},
+	{"🧣", "scarf", 7, 66, "E5.0", FullyQualified, ""},
+	{"🧤", "gloves", 7, 66, "E5.0", FullyQualified, ""},
+	{"🧥", "coat", 7, 66, "E5.0", FullyQualified, ""},
+	{"🧦", "socks", 7, 66, "E5.0", FullyQualified, ""},
+	{"🧧", "red envelope", 6, 61, "E11.0", FullyQualified, ""},
+	{"🧨", "firecracker", 6, 61, "E11.0", FullyQualified, ""},
+	{"🧩", "puzzle piece", 6, 64, "E11.0", FullyQualified, ""},
+	{"🧪", "test tube", 7, 80, "E11.0", FullyQualified, ""},
+	{"🧫", "petri dish", 7, 80, "E11.0", FullyQualified, ""},
+	{"🧬", "dna", 7, 80, "E11.0", FullyQualified, ""},
+	{"🧭", "compass", 5, 50, "E11.0", FullyQualified, ""},
+	{"🧮", "abacus", 7, 71, "E11.0", FullyQualified, ""},
+	{"🧯", "fire extinguisher", 7, 82, "E11.0", FullyQualified, ""},
+	{"🧰", "toolbox", 7, 79, "E11.0", FullyQualified, ""},
+	{"🧱", "brick", 5, 52, "E11.0", FullyQualified, ""},
+	{"🧲", "magnet", 7, 79, "E11.0", FullyQualified, ""},
+	{"🧳", "luggage", 5, 58, "E11.0", FullyQualified, ""},
+	{"🧴", "lotion bottle", 7, 82, "E11.0", FullyQualified, ""},
+	{"🧵", "thread", 6, 65, "E11.0", FullyQualified, ""},
+	{"🧶", "yarn", 6, 65, "E11.0", FullyQualified, ""},
+	{"🧷", "safety pin", 7, 82, "E11.0", FullyQualified, ""},
+	{"🧸", "teddy bear", 6, 64, "E11.0", FullyQualified, ""},
+	{"🧹", "broom", 7, 82, "E11.0", FullyQualified, ""},
+	{"🧺", "basket", 7, 82, "E11.0", FullyQualified, ""},
+	{"🧻", "roll of paper", 7, 82, "E11.0", FullyQualified, ""},
+	{"🧼", "soap", 7, 82, "E11.0", FullyQualified, ""},
+	{"🧽", "sponge", 7, 82, "E11.0", FullyQualified, ""},
+	{"🧾", "receipt", 7, 74, "E11.0", FullyQualified, ""},
+	{"🧿", "nazar amulet", 7, 83, "E11.0", FullyQualified, ""},
+	{"🩰", "ballet shoes", 7, 66, "E12.0", FullyQualified, ""},
+	{"🩱", "one-piece swimsuit", 7, 66, "E12.0", FullyQualified, ""},
+	{"🩲", "briefs", 7, 66, "E12.0", FullyQualified, ""},
+	{"🩳", "shorts", 7, 66, "E12.0", FullyQualified, ""},
+	{"🩴", "thong sandal", 7, 66, "E13.0", FullyQualified, ""},
+	{"🩸", "drop of blood", 7, 81, "E12.0", FullyQualified, ""},
+	{"🩹", "adhesive bandage", 7, 81, "E12.0", FullyQualified, ""},
+	{"🩺", "stethoscope", 7, 81, "E12.0", FullyQualified, ""},
+	{"🪀", "yo-yo", 6, 64, "E12.0", FullyQualified, ""},
+	{"🪁", "kite", 6, 64, "E12.0", FullyQualified, ""},
+	{"🪂", "parachute", 5, 57, "E12.0", FullyQualified, ""},
+	{"🪃", "boomerang", 7, 79, "E13.0", FullyQualified, ""},
+	{"🪄", "magic wand", 6, 64, "E13.0", FullyQualified, ""},
+	{"🪅", "piñata", 6, 64, "E13.0", FullyQualified, ""},
+	{"🪆", "nesting dolls", 6, 64, "E13.0", FullyQualified, ""},
+	{"🪐", "ringed planet", 5, 60, "E12.0", FullyQualified, ""},
+	{"🪑", "chair", 7, 82, "E12.0", FullyQualified, ""},
+	{"🪒", "razor", 7, 82, "E12.0", FullyQualified, ""},
+	{"🪓", "axe", 7, 79, "E12.0", FullyQualified, ""},
+	{"🪔", "diya lamp", 7, 72, "E12.0", FullyQualified, ""},
+	{"🪕", "banjo", 7, 69, "E12.0", FullyQualified, ""},
+	{"🪖", "military helmet", 7, 66, "E13.0", FullyQualified, ""},
+	{"🪗", "accordion", 7, 69, "E13.0", FullyQualified, ""},
+	{"🪘", "long drum", 7, 69, "E13.0", FullyQualified, ""},
+	{"🪙", "coin", 7, 74, "E13.0", FullyQualified, ""},
+	{"🪚", "carpentry saw", 7, 79, "E13.0", FullyQualified, ""},
+	{"🪛", "screwdriver", 7, 79, "E13.0", FullyQualified, ""},
+	{"🪜", "ladder", 7, 79, "E13.0", FullyQualified, ""},
+	{"🪝", "hook", 7, 79, "E13.0", FullyQualified, ""},
+	{"🪞", "mirror", 7, 82, "E13.0", FullyQualified, ""},
+	{"🪟", "window", 7, 82, "E13.0", FullyQualified, ""},
+	{"🪠", "plunger", 7, 82, "E13.0", FullyQualified, ""},
+	{"🪡", "sewing needle", 6, 65, "E13.0", FullyQualified, ""},
+	{"🪢", "knot", 6, 65, "E13.0", FullyQualified, ""},
+	{"🪣", "bucket", 7, 82, "E13.0", FullyQualified, ""},
+	{"🪤", "mouse trap", 7, 82, "E13.0", FullyQualified, ""},
+	{"🪥", "toothbrush", 7, 82, "E13.0", FullyQualified, ""},
+	{"🪦", "headstone", 7, 83, "E13.0", FullyQualified, ""},
+	{"🪧", "placard", 7, 83, "E13.0", FullyQualified, ""},
+	{"🪨", "rock", 5, 52, "E13.0", FullyQualified, ""},
+	{"🪰", "fly", 3, 39, "E13.0", FullyQualified, ""},
+	{"🪱", "worm", 3, 39, "E13.0", FullyQualified, ""},
+	{"🪲", "beetle", 3, 39, "E13.0", FullyQualified, ""},
+	{"🪳", "cockroach", 3, 39, "E13.0", FullyQualified, ""},
+	{"🪴", "potted plant", 3, 41, "E13.0", FullyQualified, ""},
+	{"🪵", "wood", 5, 52, "E13.0", FullyQualified, ""},
+	{"🪶", "feather", 3, 35, "E13.0", FullyQualified, ""},
+	{"🫀", "anatomical heart", 1, 22, "E13.0", FullyQualified, ""},
+	{"🫁", "lungs", 1, 22, "E13.0", FullyQualified, ""},
+	{"🫂", "people hugging", 1, 31, "E13.0", FullyQualified, ""},
+	{"🫐", "blueberries", 4, 42, "E13.0", FullyQualified, ""},
+	{"🫑", "bell pepper", 4, 43, "E13.0", FullyQualified, ""},
+	{"🫒", "olive", 4, 42, "E13.0", FullyQualified, ""},
+	{"🫓", "flatbread", 4, 44, "E13.0", FullyQualified, ""},
+	{"🫔", "tamale", 4, 44, "E13.0", FullyQualified, ""},
+	{"🫕", "fondue", 4, 44, "E13.0", FullyQualified, ""},
+	{"🫖", "teapot", 4, 48, "E13.0", FullyQualified, ""},
+}
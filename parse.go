@@ -0,0 +1,161 @@
+package emoji
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// SequenceKind is the type of an emoji sequence
+// as listed in https://www.unicode.org/reports/tr51/#Definitions
+type SequenceKind uint8
+
+const (
+	// BasicSequence is a single emoji, optionally with a presentation selector such as ☺️
+	BasicSequence SequenceKind = iota + 1
+	// KeycapSequence is a keycap base followed by \x{20E3} such as 1️⃣
+	KeycapSequence
+	// ModifierSequence is a modifier base followed by a skin tone such as 👍🏽
+	ModifierSequence
+	// FlagSequence is a pair of regional indicators such as 🇯🇵
+	FlagSequence
+	// TagSequence is an emoji followed by tags and a cancel tag such as 🏴󠁧󠁢󠁳󠁣󠁴󠁿
+	TagSequence
+	// ZWJSequence is several elements joined by \x{200D} such as 👩‍❤️‍👨
+	ZWJSequence
+)
+
+func (k SequenceKind) String() string {
+	switch k {
+	case BasicSequence:
+		return "basic"
+	case KeycapSequence:
+		return "keycap"
+	case ModifierSequence:
+		return "modifier"
+	case FlagSequence:
+		return "flag"
+	case TagSequence:
+		return "tag"
+	case ZWJSequence:
+		return "zwj"
+	}
+	return "unknown sequence"
+}
+
+// Element is an emoji with its modifications, one of the parts of a zwj sequence
+type Element struct {
+	Base rune
+	// Modifier is the skin tone following Base or 0
+	Modifier rune
+	// Selector is \x{FE0F}, \x{FE0E} or 0
+	Selector rune
+	// Keycap is true if Base is followed by \x{20E3}
+	Keycap bool
+	// Tags are the tag characters shifted to ascii without the cancel tag, such as "gbsct" for 🏴󠁧󠁢󠁳󠁣󠁴󠁿
+	Tags string
+}
+
+// String encodes the element back to code points
+func (e Element) String() string {
+	var b strings.Builder
+	b.WriteRune(e.Base)
+	if e.Modifier != 0 {
+		b.WriteRune(e.Modifier)
+	}
+	if e.Selector != 0 {
+		b.WriteRune(e.Selector)
+	}
+	if e.Keycap {
+		b.WriteRune(enclosingKeycap)
+	}
+	if e.Tags != "" {
+		for _, c := range e.Tags {
+			b.WriteRune(c + tagOffset)
+		}
+		b.WriteRune(termTag)
+	}
+	return b.String()
+}
+
+// Sequence is the structure of a single emoji
+// a flag has its two regional indicators as elements
+type Sequence struct {
+	Kind     SequenceKind
+	Elements []Element
+}
+
+// String encodes the sequence back to code points
+// so a parsed Sequence can be modified and written again
+func (s Sequence) String() string {
+	sep := string(zeroWidthJoiner)
+	if s.Kind == FlagSequence {
+		sep = ""
+	}
+	parts := make([]string, len(s.Elements))
+	for i, e := range s.Elements {
+		parts[i] = e.String()
+	}
+	return strings.Join(parts, sep)
+}
+
+// ErrNotEmoji is returned by Parse when the string is not exactly one emoji
+var ErrNotEmoji = errors.New("emoji: not a single emoji")
+
+// Parse returns the structure of the emoji s
+// s must be exactly one emoji as decoded by DecodeString, otherwise the *SequenceError of Validate
+// or ErrNotEmoji for well formed strings is returned
+func Parse(s string) (Sequence, error) {
+	if g, ok, _ := DecodeString(s); !ok || g != s {
+		if err := Validate(s); err != nil {
+			return Sequence{}, err
+		}
+		return Sequence{}, ErrNotEmoji
+	}
+	r1, n1 := utf8.DecodeRuneInString(s)
+	if isRegionalIndicator(r1) {
+		r2, _ := utf8.DecodeRuneInString(s[n1:])
+		return Sequence{Kind: FlagSequence, Elements: []Element{{Base: r1}, {Base: r2}}}, nil
+	}
+
+	var seq Sequence
+	for _, part := range strings.Split(s, string(zeroWidthJoiner)) {
+		seq.Elements = append(seq.Elements, parseElement(part))
+	}
+	e := seq.Elements[0]
+	switch {
+	case len(seq.Elements) > 1:
+		seq.Kind = ZWJSequence
+	case e.Tags != "":
+		seq.Kind = TagSequence
+	case e.Keycap:
+		seq.Kind = KeycapSequence
+	case e.Modifier != 0:
+		seq.Kind = ModifierSequence
+	default:
+		seq.Kind = BasicSequence
+	}
+	return seq, nil
+}
+
+// parseElement splits a zwj element already validated by DecodeString
+func parseElement(s string) Element {
+	var e Element
+	var tags strings.Builder
+	for i, r := range s {
+		switch {
+		case i == 0:
+			e.Base = r
+		case r == emojiVS || r == textVS:
+			e.Selector = r
+		case r == enclosingKeycap:
+			e.Keycap = true
+		case isEmod(r):
+			e.Modifier = r
+		case isTag(r):
+			tags.WriteRune(r - tagOffset)
+		}
+	}
+	e.Tags = tags.String()
+	return e
+}
@@ -0,0 +1,70 @@
+package emoji
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_Parse(t *testing.T) {
+	for _, test := range []struct {
+		s        string
+		expected Sequence
+	}{
+		{"😀", Sequence{BasicSequence, []Element{{Base: '😀'}}}},
+		{"☺️", Sequence{BasicSequence, []Element{{Base: '☺', Selector: emojiVS}}}},
+		{"☺︎", Sequence{BasicSequence, []Element{{Base: '☺', Selector: textVS}}}},
+		{"1️⃣", Sequence{KeycapSequence, []Element{{Base: '1', Selector: emojiVS, Keycap: true}}}},
+		{"#⃣", Sequence{KeycapSequence, []Element{{Base: '#', Keycap: true}}}},
+		{"👍🏽", Sequence{ModifierSequence, []Element{{Base: '👍', Modifier: 0x1F3FD}}}},
+		{"🇯🇵", Sequence{FlagSequence, []Element{{Base: 0x1F1EF}, {Base: 0x1F1F5}}}},
+		{"🏴󠁧󠁢󠁳󠁣󠁴󠁿", Sequence{TagSequence, []Element{{Base: '🏴', Tags: "gbsct"}}}},
+		{"👩‍❤️‍👨", Sequence{ZWJSequence, []Element{{Base: '👩'}, {Base: '❤', Selector: emojiVS}, {Base: '👨'}}}},
+		{"👩🏽‍🦰", Sequence{ZWJSequence, []Element{{Base: '👩', Modifier: 0x1F3FD}, {Base: '🦰'}}}},
+	} {
+		seq, err := Parse(test.s)
+		if err != nil || !reflect.DeepEqual(seq, test.expected) {
+			t.Errorf("%q is parsed as %+v %v instead of %+v", test.s, seq, err, test.expected)
+		}
+		if seq.String() != test.s {
+			t.Errorf("%q is encoded back as %q", test.s, seq.String())
+		}
+	}
+}
+
+func Test_ParseInvalid(t *testing.T) {
+	for _, test := range []struct {
+		s   string
+		err error
+	}{
+		{"", ErrNotEmoji},
+		{"a", ErrNotEmoji},
+		{"1", ErrNotEmoji},
+		{"👍👍", ErrNotEmoji},
+		{"👍 ", ErrNotEmoji},
+		{"🇯", ErrUnpairedRegionalIndicator},
+		{"👩‍", ErrDanglingZWJ},
+		{"🏴\U000E0067\U000E0062", ErrUnterminatedTag},
+		{"🏿\uFE0F", ErrModifierWithoutBase},
+		{"🏿\u200D😀", ErrModifierWithoutBase},
+		{"😀\u200D🏿", ErrModifierWithoutBase},
+	} {
+		if seq, err := Parse(test.s); !errors.Is(err, test.err) {
+			t.Errorf("%q is parsed as %+v %v instead of %v", test.s, seq, err, test.err)
+		}
+	}
+}
+
+func Test_SequenceRewrite(t *testing.T) {
+	seq, err := Parse("👩🏽‍🦰")
+	if err != nil {
+		t.Fatal(err)
+	}
+	seq.Elements[0].Modifier = 0
+	if seq.String() != "👩‍🦰" {
+		t.Errorf("rewritten sequence is %q", seq.String())
+	}
+	if seq.Kind.String() != "zwj" || SequenceKind(0).String() != "unknown sequence" {
+		t.Errorf("unexpected kind names %s %s", seq.Kind, SequenceKind(0))
+	}
+}
@@ -0,0 +1,93 @@
+package emoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Presentation is the way a glyph is displayed
+// as defined in https://www.unicode.org/reports/tr51/#Presentation_Style
+type Presentation uint8
+
+const (
+	// TextStyle is a glyph displayed like a regular, usually monochrome, character
+	TextStyle Presentation = iota + 1
+	// EmojiStyle is a glyph displayed as a colorful emoji
+	EmojiStyle
+)
+
+func (p Presentation) String() string {
+	switch p {
+	case TextStyle:
+		return "text style"
+	case EmojiStyle:
+		return "emoji style"
+	}
+	return "unknown style"
+}
+
+// PresentationOf returns the way the glyph s will be displayed
+// a \x{FE0E} or \x{FE0F} selector after the first character decides,
+// otherwise sequences such as zwj, flag or modifier sequences are EmojiStyle
+// and single characters follow the EmojiPresentation property, so ☺ is TextStyle but 😀 is EmojiStyle
+// anything that is not a possible glyph is TextStyle
+func PresentationOf(s string) Presentation {
+	if !PossibleGlyphString(s) {
+		return TextStyle
+	}
+	r, n := utf8.DecodeRuneInString(s)
+	switch next, _ := utf8.DecodeRuneInString(s[n:]); {
+	case next == textVS:
+		return TextStyle
+	case next == emojiVS:
+		return EmojiStyle
+	case n < len(s):
+		return EmojiStyle
+	case unicode.Is(EmojiPresentation, r):
+		return EmojiStyle
+	}
+	return TextStyle
+}
+
+// ForceEmojiPresentation adds \x{FE0F} to every emoji in s that has an emoji presentation sequence
+// replacing \x{FE0E} if needed, so ☺︎ and ☺ become ☺️
+// emoji with a default emoji presentation are only changed when they have a \x{FE0E}
+// in zwj sequences every element is changed, and emoji followed by a modifier are left untouched
+func ForceEmojiPresentation(s string) string {
+	return ReplaceString(s, -1, func(g string) string {
+		elements := strings.Split(g, zeroWidthJoinerS)
+		for i, element := range elements {
+			r, n := utf8.DecodeRuneInString(element)
+			if !unicode.Is(variationBase, r) {
+				continue
+			}
+			rest := strings.TrimPrefix(element[n:], string(textVS))
+			if len(rest) == len(element[n:]) && unicode.Is(EmojiPresentation, r) {
+				// already displayed as emoji by default
+				continue
+			}
+			if next, _ := utf8.DecodeRuneInString(rest); next == emojiVS || isEmod(next) {
+				continue
+			}
+			elements[i] = string(r) + string(emojiVS) + rest
+		}
+		return strings.Join(elements, zeroWidthJoinerS)
+	})
+}
+
+// ForceTextPresentation adds \x{FE0E} to every single character emoji in s that has a text presentation sequence
+// replacing \x{FE0F} if needed, so ☺️ and ☺ become ☺︎
+// sequences such as zwj, flag, keycap or modifier sequences have no text presentation and are left untouched
+func ForceTextPresentation(s string) string {
+	return ReplaceString(s, -1, func(g string) string {
+		r, n := utf8.DecodeRuneInString(g)
+		if !unicode.Is(variationBase, r) {
+			return g
+		}
+		if rest := g[n:]; rest != "" && rest != string(emojiVS) && rest != string(textVS) {
+			return g
+		}
+		return string(r) + string(textVS)
+	})
+}
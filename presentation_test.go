@@ -0,0 +1,106 @@
+package emoji
+
+import "testing"
+
+func Test_DecodeTextPresentation(t *testing.T) {
+	tests := []struct {
+		s  string
+		g  string
+		ok bool
+	}{
+		{"☺︎", "☺︎", true},
+		{"☺︎a", "☺︎", true},
+		{"⌚︎", "⌚︎", true},
+		{"#︎", "#︎", true},
+		{"😀︎", "😀", true},
+		{"a︎", "a", false},
+	}
+	for _, test := range tests {
+		g, ok, n := DecodeString(test.s)
+		if g != test.g || ok != test.ok || n != len(g) {
+			t.Errorf("DecodeString(%q) returned %q %v %d not %q %v", test.s, g, ok, n, test.g, test.ok)
+		}
+		b, ok, n := Decode([]byte(test.s))
+		if string(b) != test.g || ok != test.ok || n != len(b) {
+			t.Errorf("Decode(%q) returned %q %v %d not %q %v", test.s, b, ok, n, test.g, test.ok)
+		}
+	}
+}
+
+func Test_PresentationOf(t *testing.T) {
+	tests := []struct {
+		s            string
+		presentation Presentation
+	}{
+		{"☺", TextStyle},
+		{"☺️", EmojiStyle},
+		{"☺︎", TextStyle},
+		{"😀", EmojiStyle},
+		{"⌚", EmojiStyle},
+		{"⌚︎", TextStyle},
+		{"1️⃣", EmojiStyle},
+		{"🇯🇵", EmojiStyle},
+		{"👍🏽", EmojiStyle},
+		{"👁‍🗨", EmojiStyle},
+		{"a", TextStyle},
+		{"", TextStyle},
+	}
+	for _, test := range tests {
+		if p := PresentationOf(test.s); p != test.presentation {
+			t.Errorf("PresentationOf(%q) returned %s not %s", test.s, p, test.presentation)
+		}
+	}
+}
+
+func Test_ForceEmojiPresentation(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected string
+	}{
+		{"☺", "☺️"},
+		{"☺︎", "☺️"},
+		{"☺️", "☺️"},
+		{"⌚︎", "⌚️"},
+		{"⌚", "⌚"},
+		{"😀", "😀"},
+		{"☝🏻", "☝🏻"},
+		{"👁‍🗨", "👁️‍🗨️"},
+		{"1⃣", "1️⃣"},
+		{"I ☺︎ 123 ©", "I ☺️ 123 ©️"},
+	}
+	for _, test := range tests {
+		if s := ForceEmojiPresentation(test.s); s != test.expected {
+			t.Errorf("ForceEmojiPresentation(%q) returned %q not %q", test.s, s, test.expected)
+		}
+	}
+	for _, s := range rgiSequences {
+		if f := ForceEmojiPresentation(s); f != s {
+			t.Errorf("ForceEmojiPresentation(%q) returned %q", s, f)
+		}
+	}
+}
+
+func Test_ForceTextPresentation(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected string
+	}{
+		{"☺", "☺︎"},
+		{"☺️", "☺︎"},
+		{"☺︎", "☺︎"},
+		{"⌚", "⌚︎"},
+		{"😀", "😀"},
+		{"☝🏻", "☝🏻"},
+		{"1️⃣", "1️⃣"},
+		{"👁️‍🗨️", "👁️‍🗨️"},
+		{"I ☺️ 123 ©️", "I ☺︎ 123 ©︎"},
+	}
+	for _, test := range tests {
+		if s := ForceTextPresentation(test.s); s != test.expected {
+			t.Errorf("ForceTextPresentation(%q) returned %q not %q", test.s, s, test.expected)
+		}
+		if p := PresentationOf(ForceTextPresentation(test.s)); p != TextStyle && ForceTextPresentation(test.s) != test.s {
+			t.Errorf("ForceTextPresentation(%q) is not text style", test.s)
+		}
+	}
+}
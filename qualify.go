@@ -0,0 +1,67 @@
+package emoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var zeroWidthJoinerS = string(zeroWidthJoiner)
+
+// Qualification returns the Status of the glyph s
+// Emoji listed in emoji-test.txt get their listed status, other possible glyphs
+// such as invented zwj sequences are qualified per https://www.unicode.org/reports/tr51/#def_fully_qualified_emoji
+// every zwj element starting with a character without emoji presentation by default
+// must be followed by \x{FE0F} or an emoji modifier to be fully-qualified
+// It returns 0 if s is not a possible glyph
+func Qualification(s string) Status {
+	if e, ok := lookup(s); ok {
+		return e.status
+	}
+	if !PossibleGlyphString(s) {
+		return 0
+	}
+	status := FullyQualified
+	for i, element := range strings.Split(s, zeroWidthJoinerS) {
+		r, n := utf8.DecodeRuneInString(element)
+		if unicode.Is(EmojiPresentation, r) {
+			continue
+		}
+		next, _ := utf8.DecodeRuneInString(element[n:])
+		if next == emojiVS || isEmod(next) {
+			continue
+		}
+		if i == 0 {
+			return Unqualified
+		}
+		status = MinimallyQualified
+	}
+	return status
+}
+
+// Qualify returns the fully-qualified form of the emoji s
+// such as ❤️ for ❤ or 👁️‍🗨️ for 👁‍🗨
+// s is returned unchanged if it is already fully-qualified or not listed in emoji-test.txt
+func Qualify(s string) string {
+	if e, ok := lookup(s); ok && e.qualified != "" {
+		return e.qualified
+	}
+	return s
+}
+
+// Normalize replaces every emoji in b with its fully-qualified form
+// so that the same emoji is always encoded the same way
+func Normalize(b []byte) []byte {
+	return Replace(b, -1, func(g []byte) []byte {
+		if e, ok := lookup(string(g)); ok && e.qualified != "" {
+			return []byte(e.qualified)
+		}
+		return g
+	})
+}
+
+// NormalizeString replaces every emoji in s with its fully-qualified form
+// so that the same emoji is always encoded the same way
+func NormalizeString(s string) string {
+	return ReplaceString(s, -1, Qualify)
+}
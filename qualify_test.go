@@ -0,0 +1,72 @@
+package emoji
+
+import "testing"
+
+func Test_Qualification(t *testing.T) {
+	tests := []struct {
+		s      string
+		status Status
+	}{
+		{"❤️", FullyQualified},
+		{"❤", Unqualified},
+		{"👁️‍🗨️", FullyQualified},
+		{"👁️‍🗨", MinimallyQualified},
+		{"👁‍🗨", Unqualified},
+		{"🏼", Component},
+		// not listed in emoji-test.txt
+		{"👨‍🦖", FullyQualified},
+		{"👨‍☠️", FullyQualified},
+		{"👨‍☠", MinimallyQualified},
+		{"☠‍👨", Unqualified},
+		{"a", 0},
+		{"😀😀", 0},
+	}
+	for _, test := range tests {
+		if status := Qualification(test.s); status != test.status {
+			t.Errorf("Qualification(%q) returned %s not %s", test.s, status, test.status)
+		}
+	}
+	for _, s := range rgiSequences {
+		// skin tones and hair styles are RGI components
+		if status := Qualification(s); status != FullyQualified && status != Component {
+			t.Errorf("Qualification(%q) returned %s", s, status)
+		}
+	}
+}
+
+func Test_Qualify(t *testing.T) {
+	tests := []struct {
+		s         string
+		qualified string
+	}{
+		{"❤", "❤️"},
+		{"❤️", "❤️"},
+		{"👁‍🗨", "👁️‍🗨️"},
+		{"👁️‍🗨", "👁️‍🗨️"},
+		{"🏌‍♂", "🏌️‍♂️"},
+		{"😀", "😀"},
+		{"👨‍🦖", "👨‍🦖"},
+		{"a", "a"},
+	}
+	for _, test := range tests {
+		if q := Qualify(test.s); q != test.qualified {
+			t.Errorf("Qualify(%q) returned %q not %q", test.s, q, test.qualified)
+		}
+	}
+	for _, e := range metadata {
+		if q := Qualify(e.sequence); e.status != Component && !IsRGIString(q) {
+			t.Errorf("Qualify(%q) returned %q which is not rgi", e.sequence, q)
+		}
+	}
+}
+
+func Test_Normalize(t *testing.T) {
+	s := "I ❤ you ❤️, 👁‍🗨 and ☺x😀"
+	expected := "I ❤️ you ❤️, 👁️‍🗨️ and ☺️x😀"
+	if n := NormalizeString(s); n != expected {
+		t.Errorf("NormalizeString(%q) returned %q not %q", s, n, expected)
+	}
+	if n := Normalize([]byte(s)); string(n) != expected {
+		t.Errorf("Normalize(%q) returned %q not %q", s, n, expected)
+	}
+}
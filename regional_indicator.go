@@ -1,9 +1,65 @@
 package emoji
 
-import "unicode"
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
 var RegionalIndicator = &unicode.RangeTable{
 	R32: []unicode.Range32{
 		{Lo: uint32('🇦'), Hi: uint32('🇿'), Stride: 1},
 	},
 }
+
+// ErrInvalidRegion is returned by RegionFlag for codes without an RGI flag
+var ErrInvalidRegion = errors.New("emoji: invalid region code")
+
+func isRegionalIndicator(r rune) bool {
+	u := uint32(r)
+	return RegionalIndicator.R32[0].Lo <= u && u <= RegionalIndicator.R32[0].Hi
+}
+
+// FlagRegion returns the uppercase ISO 3166-1 alpha-2 region code of the flag s
+// such as "JP" for 🇯🇵
+// s must be exactly one RGI flag sequence, so 🇦🇦 is rejected
+func FlagRegion(s string) (string, bool) {
+	r1, n1 := utf8.DecodeRuneInString(s)
+	r2, n2 := utf8.DecodeRuneInString(s[n1:])
+	if !isRegionalIndicator(r1) || !isRegionalIndicator(r2) || n1+n2 != len(s) || !IsRGIString(s) {
+		return "", false
+	}
+	return string([]rune{r1 - '🇦' + 'A', r2 - '🇦' + 'A'}), true
+}
+
+// RegionFlag returns the flag of the ISO 3166-1 alpha-2 region code
+// such as 🇯🇵 for "JP" or "jp"
+// it returns ErrInvalidRegion if there is no RGI flag for this code
+func RegionFlag(code string) (string, error) {
+	if len(code) != 2 {
+		return "", ErrInvalidRegion
+	}
+	var b strings.Builder
+	for _, c := range strings.ToUpper(code) {
+		if c < 'A' || c > 'Z' {
+			return "", ErrInvalidRegion
+		}
+		b.WriteRune(c - 'A' + '🇦')
+	}
+	if !IsRGIString(b.String()) {
+		return "", ErrInvalidRegion
+	}
+	return b.String(), nil
+}
+
+// Regions returns the region code of every RGI flag, sorted
+func Regions() []string {
+	var regions []string
+	for _, s := range rgiSequences {
+		if code, ok := FlagRegion(s); ok {
+			regions = append(regions, code)
+		}
+	}
+	return regions
+}
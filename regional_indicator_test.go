@@ -22,3 +22,53 @@ func Test_RegionalIndicator_Test(t *testing.T) {
 		}
 	}
 }
+
+func Test_FlagRegion(t *testing.T) {
+	for _, code := range []string{"JP", "FR", "BN", "HM", "EU", "UN"} {
+		flag := countrymoji.Alpha2ToFlag(code)
+		region, ok := FlagRegion(flag)
+		if !ok || region != code {
+			t.Errorf("FlagRegion(%q) returned %q %v not %q", flag, region, ok, code)
+		}
+	}
+
+	for _, s := range []string{"", "a", "JP", "🇦🇦", "🇯", "🇯🇵🇯🇵", "🇯🇵a", "😀"} {
+		if region, ok := FlagRegion(s); ok {
+			t.Errorf("FlagRegion(%q) returned positive %q", s, region)
+		}
+	}
+}
+
+func Test_RegionFlag(t *testing.T) {
+	for _, code := range []string{"JP", "jp", "Fr", "BN", "HM"} {
+		flag, err := RegionFlag(code)
+		if err != nil {
+			t.Errorf("RegionFlag(%q) returned %v", code, err)
+		}
+		if flag != countrymoji.Alpha2ToFlag(code) {
+			t.Errorf("RegionFlag(%q) returned %q", code, flag)
+		}
+	}
+
+	for _, code := range []string{"", "A", "AA", "ZZ", "JPN", "J1", "é"} {
+		if flag, err := RegionFlag(code); err != ErrInvalidRegion {
+			t.Errorf("RegionFlag(%q) returned %q %v", code, flag, err)
+		}
+	}
+}
+
+func Test_Regions(t *testing.T) {
+	regions := Regions()
+	if len(regions) != 258 {
+		t.Errorf("Regions returned %d regions", len(regions))
+	}
+	for _, code := range regions {
+		flag, err := RegionFlag(code)
+		if err != nil {
+			t.Errorf("RegionFlag(%q) returned %v", code, err)
+		}
+		if region, ok := FlagRegion(flag); !ok || region != code {
+			t.Errorf("FlagRegion(%q) returned %q %v not %q", flag, region, ok, code)
+		}
+	}
+}
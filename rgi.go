@@ -0,0 +1,68 @@
+package emoji
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// IsRGI checks if b is exactly one emoji Recommended for General Interchange
+// of version 13.0 or earlier, as listed in https://www.unicode.org/Public/emoji/15.1/emoji-sequences.txt
+// and https://www.unicode.org/Public/emoji/15.1/emoji-zwj-sequences.txt (in data/15.1)
+//
+// Unlike PossibleGlyph it has no false positive: invented zwj sequences,
+// inexistant flags or unknown tag sequences are rejected
+func IsRGI(b []byte) bool {
+	i := sort.Search(len(rgiSequences), func(i int) bool { return rgiSequences[i] >= string(b) })
+	return i < len(rgiSequences) && rgiSequences[i] == string(b)
+}
+
+// IsRGIString checks if s is exactly one emoji Recommended for General Interchange
+// of version 13.0 or earlier, as listed in https://www.unicode.org/Public/emoji/15.1/emoji-sequences.txt
+// and https://www.unicode.org/Public/emoji/15.1/emoji-zwj-sequences.txt (in data/15.1)
+//
+// Unlike PossibleGlyphString it has no false positive: invented zwj sequences,
+// inexistant flags or unknown tag sequences are rejected
+func IsRGIString(s string) bool {
+	i := sort.SearchStrings(rgiSequences, s)
+	return i < len(rgiSequences) && rgiSequences[i] == s
+}
+
+// DecodeStrict behaves like Decode but only reports RGI emoji
+// When the glyph found by Decode is not RGI, the longest RGI prefix is returned instead
+// so an invented zwj sequence such as 👨‍🦖 is read as 👨 then the zwj then 🦖
+// If there is no such prefix, the first rune is returned as a non emoji sequence
+func DecodeStrict(b []byte) ([]byte, bool, int) {
+	g, ok, n := Decode(b)
+	if !ok || IsRGI(g) {
+		return g, ok, n
+	}
+	for i := len(g); i > 0; {
+		_, size := utf8.DecodeLastRune(g[:i])
+		i -= size
+		if i > 0 && IsRGI(g[:i]) {
+			return g[:i], true, i
+		}
+	}
+	_, n = utf8.DecodeRune(g)
+	return g[:n], false, n
+}
+
+// DecodeStringStrict behaves like DecodeString but only reports RGI emoji
+// When the glyph found by DecodeString is not RGI, the longest RGI prefix is returned instead
+// so an invented zwj sequence such as 👨‍🦖 is read as 👨 then the zwj then 🦖
+// If there is no such prefix, the first rune is returned as a non emoji sequence
+func DecodeStringStrict(s string) (string, bool, int) {
+	g, ok, n := DecodeString(s)
+	if !ok || IsRGIString(g) {
+		return g, ok, n
+	}
+	for i := len(g); i > 0; {
+		_, size := utf8.DecodeLastRuneInString(g[:i])
+		i -= size
+		if i > 0 && IsRGIString(g[:i]) {
+			return g[:i], true, i
+		}
+	}
+	_, n = utf8.DecodeRuneInString(g)
+	return g[:n], false, n
+}
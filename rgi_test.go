@@ -0,0 +1,161 @@
+package emoji
+
+import (
+	"sort"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+var rgiTest = []string{
+	"©️",
+	"😀",
+	"👍",
+	"⛰️",
+	"0️⃣",
+	"#️⃣",
+	"🙍‍♂️",
+	"🧑‍⚕️",
+	"👨‍👩‍👦‍👦",
+	"👋🏼",
+	"👩🏼‍🦰",
+	"👩‍❤️‍👩",
+	"👁️‍🗨️",
+	"🏳️‍⚧️",
+	"🇧🇳",
+	"🇭🇲",
+	"🏴󠁧󠁢󠁳󠁣󠁴󠁿",
+}
+
+var notRGITest = []string{
+	"",
+	"a",
+	"😀😀",
+	"😀b",
+	// unqualified or minimally qualified
+	"©",
+	"⛰",
+	"0⃣",
+	"👁‍🗨",
+	// invented zwj sequences
+	"👨‍🦖",
+	"😀‍😀",
+	// modifier on a base without rgi sequence
+	"👯🏼‍♀️",
+	// inexistant flags
+	"🇦🇦",
+	"🇧",
+	// unknown subdivision
+	"🏴󠁵󠁳󠁴󠁸󠁿",
+	"🏴" + string(rune(0xE0067)) + string(rune(0xE0062)),
+	string(rune(0x200D)),
+}
+
+func Test_rgiSequences_is_sorted(t *testing.T) {
+	if !sort.StringsAreSorted(rgiSequences) {
+		t.Errorf("rgiSequences is not sorted")
+	}
+	for i := 1; i < len(rgiSequences); i++ {
+		if rgiSequences[i-1] == rgiSequences[i] {
+			t.Errorf("rgiSequences has duplicate %q", rgiSequences[i])
+		}
+	}
+}
+
+func Test_IsRGI(t *testing.T) {
+	for _, s := range rgiTest {
+		if !IsRGI([]byte(s)) {
+			t.Errorf("%q returned negative", s)
+		}
+		if !IsRGIString(s) {
+			t.Errorf("%q returned negative", s)
+		}
+		if !PossibleGlyphString(s) {
+			t.Errorf("%q is not a possible glyph", s)
+		}
+	}
+
+	for _, s := range notRGITest {
+		if IsRGI([]byte(s)) {
+			t.Errorf("%q returned positive", s)
+		}
+		if IsRGIString(s) {
+			t.Errorf("%q returned positive", s)
+		}
+	}
+}
+
+// rgiGlyphs lists the RGI emoji but the skin tone modifiers, they are RGI on their own but not glyphs
+func rgiGlyphs() []string {
+	var glyphs []string
+	for _, s := range rgiSequences {
+		if r, _ := utf8.DecodeRuneInString(s); len(s) == utf8.RuneLen(r) && unicode.Is(EmojiModifier, r) {
+			continue
+		}
+		glyphs = append(glyphs, s)
+	}
+	return glyphs
+}
+
+func Test_rgiSequences_are_glyph(t *testing.T) {
+	for _, s := range rgiGlyphs() {
+		if !PossibleGlyphString(s) {
+			t.Errorf("%q (%X) is rgi but not a possible glyph", s, s)
+		}
+	}
+}
+
+func Test_DecodeStrict(t *testing.T) {
+	type glyph struct {
+		g  string
+		ok bool
+	}
+	zwj := string(rune(0x200D))
+	tests := []struct {
+		s        string
+		expected []glyph
+	}{
+		{"a😀", []glyph{{"a", false}, {"😀", true}}},
+		{"👨‍🦖", []glyph{{"👨", true}, {zwj, false}, {"🦖", true}}},
+		{"👨‍👩‍👦‍🦖", []glyph{{"👨‍👩‍👦", true}, {zwj, false}, {"🦖", true}}},
+		{"👯🏼‍♀️", []glyph{{"👯", true}, {"🏼", false}, {zwj, false}, {"♀️", true}}},
+		{"🇦🇦🇧🇳", []glyph{{"🇦", false}, {"🇦", false}, {"🇧🇳", true}}},
+		{"©x", []glyph{{"©", false}, {"x", false}}},
+	}
+	for _, test := range tests {
+		s := test.s
+		var got []glyph
+		for {
+			g, ok, n := DecodeStringStrict(s)
+			if n == 0 {
+				break
+			}
+			if len(g) != n {
+				t.Errorf("DecodeStringStrict(%q) returned incoherent len", s)
+			}
+			bg, bok, bn := DecodeStrict([]byte(s))
+			if string(bg) != g || bok != ok || bn != n {
+				t.Errorf("DecodeStrict(%q) = %q %v %d, DecodeStringStrict returned %q %v %d", s, bg, bok, bn, g, ok, n)
+			}
+			got = append(got, glyph{g, ok})
+			s = s[n:]
+		}
+		if len(got) != len(test.expected) {
+			t.Errorf("DecodeStringStrict(%q) got %v not %v", test.s, got, test.expected)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.expected[i] {
+				t.Errorf("DecodeStringStrict(%q) got %v not %v", test.s, got, test.expected)
+				break
+			}
+		}
+	}
+
+	for _, s := range rgiTest {
+		g, ok, n := DecodeStringStrict(s + "a")
+		if !ok || g != s || n != len(s) {
+			t.Errorf("DecodeStringStrict(%q) returned %q %v %d", s+"a", g, ok, n)
+		}
+	}
+}
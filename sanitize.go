@@ -0,0 +1,124 @@
+package emoji
+
+import (
+	"errors"
+	"strings"
+)
+
+// SanitizeOptions changes how Sanitize repairs a string
+type SanitizeOptions struct {
+	// Replacement is written instead of each removed fragment, such as "�", by default fragments are dropped
+	Replacement string
+	// KeepModifiers keeps the isolated skin tone modifiers, some platforms render them as color swatches
+	KeepModifiers bool
+	// KeepUnterminatedTags keeps the tag runs missing their cancel tag, only their base is rendered
+	KeepUnterminatedTags bool
+}
+
+// Sanitize removes the malformed emoji fragments reported by Validate:
+// orphan zero width joiners, stray variation selectors and keycaps, lone regional indicators,
+// unterminated or stray tags and isolated skin tone modifiers
+// well formed emoji and the rest of the text are left untouched, s is returned as is if there is nothing to repair
+// removing a fragment can join others, such as 👍‍‍, so fragments are removed until nothing changes,
+// then each run of removed bytes is replaced once by opts.Replacement, itself sanitized beforehand
+func Sanitize(s string, opts SanitizeOptions) string {
+	if _, _, err := malformed(s); err == nil {
+		return s
+	}
+	kept := []span{{0, len(s)}}
+	for {
+		cuts := removals(join(s, kept), opts)
+		if len(cuts) == 0 {
+			break
+		}
+		for i := len(cuts) - 1; i >= 0; i-- {
+			kept = cut(kept, cuts[i])
+		}
+	}
+	replacement := opts.Replacement
+	if replacement != "" {
+		opts.Replacement = ""
+		replacement = Sanitize(replacement, opts)
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	end := 0
+	for _, k := range kept {
+		if k.start > end {
+			b.WriteString(replacement)
+		}
+		b.WriteString(s[k.start:k.end])
+		end = k.end
+	}
+	if end < len(s) {
+		b.WriteString(replacement)
+	}
+	return b.String()
+}
+
+// span is a byte range of a string
+type span struct {
+	start, end int
+}
+
+// join concatenates the kept spans of s
+func join(s string, kept []span) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, k := range kept {
+		b.WriteString(s[k.start:k.end])
+	}
+	return b.String()
+}
+
+// removals returns the malformed fragments of s found in a single pass
+// the fragments kept by opts are skipped
+func removals(s string, opts SanitizeOptions) []span {
+	var cuts []span
+	pos := 0
+	for {
+		offset, n, err := malformed(s[pos:])
+		if err == nil {
+			return cuts
+		}
+		if !opts.keep(err) {
+			cuts = append(cuts, span{pos + offset, pos + offset + n})
+		}
+		pos += offset + n
+	}
+}
+
+// cut removes c from the kept spans, c is a range of their concatenation
+func cut(kept []span, c span) []span {
+	res := make([]span, 0, len(kept)+1)
+	pos := 0
+	for _, k := range kept {
+		n := k.end - k.start
+		if pos < c.start {
+			end := k.end
+			if pos+n > c.start {
+				end = k.start + c.start - pos
+			}
+			res = append(res, span{k.start, end})
+		}
+		if pos+n > c.end {
+			start := k.start
+			if c.end > pos {
+				start = k.start + c.end - pos
+			}
+			res = append(res, span{start, k.end})
+		}
+		pos += n
+	}
+	return res
+}
+
+func (opts SanitizeOptions) keep(err error) bool {
+	switch {
+	case errors.Is(err, ErrModifierWithoutBase):
+		return opts.KeepModifiers
+	case errors.Is(err, ErrUnterminatedTag):
+		return opts.KeepUnterminatedTags
+	}
+	return false
+}
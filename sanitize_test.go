@@ -0,0 +1,48 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func Test_Sanitize(t *testing.T) {
+	for _, test := range []struct {
+		s        string
+		opts     SanitizeOptions
+		expected string
+	}{
+		{"", SanitizeOptions{}, ""},
+		{"hello 👋 क्‍ष", SanitizeOptions{}, "hello 👋 क्‍ष"},
+		{"👍🏽 1️⃣ ☺︎ 🇯🇵 🏴󠁧󠁢󠁳󠁣󠁴󠁿 👩‍❤️‍👨", SanitizeOptions{}, "👍🏽 1️⃣ ☺︎ 🇯🇵 🏴󠁧󠁢󠁳󠁣󠁴󠁿 👩‍❤️‍👨"},
+		{"hi 👩‍", SanitizeOptions{}, "hi 👩"},
+		{"👩‍a", SanitizeOptions{}, "👩a"},
+		{"‍👩", SanitizeOptions{}, "👩"},
+		{"x️y", SanitizeOptions{}, "xy"},
+		{"👍️️", SanitizeOptions{}, "👍️"},
+		{"a🇯b", SanitizeOptions{}, "ab"},
+		{"🇯🇵🇫", SanitizeOptions{}, "🇯🇵"},
+		{"🏴\U000E0067\U000E0062 x", SanitizeOptions{}, "🏴 x"},
+		{"🏴\U000E0067\U000E0062 x", SanitizeOptions{KeepUnterminatedTags: true}, "🏴\U000E0067\U000E0062 x"},
+		{"a\U000E0067\U000E007Fb", SanitizeOptions{}, "ab"},
+		{"a🏽 😀🏽", SanitizeOptions{}, "a 😀"},
+		{"a🏽 😀🏽", SanitizeOptions{KeepModifiers: true}, "a🏽 😀🏽"},
+		{"a⃣", SanitizeOptions{}, "a"},
+		{"a🇯b‍👩", SanitizeOptions{Replacement: "�"}, "a�b�👩"},
+		// removing a fragment joins the next one
+		{"👍\u200d\u200d", SanitizeOptions{}, "👍"},
+		{"☺🏽\u200dx", SanitizeOptions{}, "☺x"},
+		{"👍\u200d\u200d!", SanitizeOptions{Replacement: "�"}, "👍�!"},
+		// a malformed replacement is sanitized once
+		{"🏻", SanitizeOptions{Replacement: "a🏻"}, "a"},
+	} {
+		res := Sanitize(test.s, test.opts)
+		if res != test.expected {
+			t.Errorf("%q is sanitized as %q instead of %q", test.s, res, test.expected)
+		}
+		if err := Validate(res); test.opts == (SanitizeOptions{}) && err != nil {
+			t.Errorf("sanitized %q is still invalid: %v", res, err)
+		}
+		if again := Sanitize(res, test.opts); again != res {
+			t.Errorf("sanitized %q is sanitized again as %q", res, again)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package emoji
+
+import (
+	"bufio"
+	"io"
+	"unicode/utf8"
+)
+
+// ScanEmoji is a bufio.SplitFunc returning each emoji of the input, text between emoji is skipped
+// it asks for more data instead of returning an emoji that could go on in the next read
+func ScanEmoji(data []byte, atEOF bool) (int, []byte, error) {
+	var advance int
+	for {
+		n, token, emoji := scanSegment(data[advance:], atEOF)
+		if n == 0 {
+			return advance, nil, nil
+		}
+		if emoji {
+			return advance + n, token, nil
+		}
+		advance += n
+	}
+}
+
+// ScanSegments is a bufio.SplitFunc returning each segment of the input, emoji or text between emoji
+// long text runs can be split in several segments
+func ScanSegments(data []byte, atEOF bool) (int, []byte, error) {
+	n, token, _ := scanSegment(data, atEOF)
+	return n, token, nil
+}
+
+// scanSegment returns the length of the first segment of data and whether it is an emoji
+// or 0 if more data is needed to find its end
+func scanSegment(data []byte, atEOF bool) (int, []byte, bool) {
+	n, g, ok := scanGlyph(data, atEOF)
+	if n == 0 || ok {
+		return n, g, ok
+	}
+	end := n
+	for end < len(data) {
+		n, _, ok := scanGlyph(data[end:], atEOF)
+		if n == 0 || ok {
+			break
+		}
+		end += n
+	}
+	return end, data[:end], false
+}
+
+// scanGlyph is Decode for partial input, it returns 0 if more data is needed
+// to know where the first glyph of data ends
+func scanGlyph(data []byte, atEOF bool) (int, []byte, bool) {
+	if !atEOF {
+		// Decode reads past the end of a glyph to check it does not go on
+		// so the glyph is only final if the character after it is fully read
+		data = fullRunes(data)
+	}
+	g, ok, n := Decode(data)
+	if n == 0 || (n == len(data) && !atEOF) {
+		return 0, nil, false
+	}
+	return n, g, ok
+}
+
+// fullRunes returns data without its trailing incomplete character if any
+func fullRunes(data []byte) []byte {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				return data[:i]
+			}
+			break
+		}
+	}
+	return data
+}
+
+// Scanner reads the segments of an io.Reader, emoji or text between emoji
+// so large inputs can be processed without loading them in memory
+//
+//	s := NewScanner(r)
+//	for s.Scan() {
+//		if s.IsEmoji() {
+//			fmt.Println(s.Offset(), s.Text())
+//		}
+//	}
+//	if err := s.Err(); err != nil {
+//		...
+//	}
+type Scanner struct {
+	scanner *bufio.Scanner
+	emoji   bool
+	offset  int64
+	next    int64
+}
+
+// NewScanner returns a Scanner reading from r
+func NewScanner(r io.Reader) *Scanner {
+	s := &Scanner{scanner: bufio.NewScanner(r)}
+	s.scanner.Split(s.split)
+	return s
+}
+
+func (s *Scanner) split(data []byte, atEOF bool) (int, []byte, error) {
+	n, token, emoji := scanSegment(data, atEOF)
+	if n > 0 {
+		s.emoji = emoji
+	}
+	return n, token, nil
+}
+
+// Buffer sets the initial buffer and the maximum segment size, as bufio.Scanner.Buffer
+func (s *Scanner) Buffer(buf []byte, max int) {
+	s.scanner.Buffer(buf, max)
+}
+
+// Scan advances to the next segment, it returns false at the end of the input or on error
+func (s *Scanner) Scan() bool {
+	if !s.scanner.Scan() {
+		return false
+	}
+	s.offset = s.next
+	s.next += int64(len(s.scanner.Bytes()))
+	return true
+}
+
+// Bytes returns the current segment, the underlying array may be overwritten by the next call to Scan
+func (s *Scanner) Bytes() []byte {
+	return s.scanner.Bytes()
+}
+
+// Text returns the current segment as a string
+func (s *Scanner) Text() string {
+	return s.scanner.Text()
+}
+
+// IsEmoji reports whether the current segment is an emoji
+func (s *Scanner) IsEmoji() bool {
+	return s.emoji
+}
+
+// Offset returns the byte offset of the current segment in the input
+func (s *Scanner) Offset() int64 {
+	return s.offset
+}
+
+// Err returns the first non-EOF error encountered by the Scanner
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
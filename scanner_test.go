@@ -0,0 +1,130 @@
+package emoji
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var scannerTests = []string{
+	"",
+	"abc",
+	"I ❤️ 🇯🇵!",
+	"👩‍👩‍👧 family",
+	"👍🏽👍🏽",
+	"🏴󠁧󠁢󠁳󠁣󠁴󠁿 and 🏴",
+	"123 #️⃣ 1⃣",
+	"☺︎☺️☺",
+	"\xff😀\xf0\x9f",
+	"🧑🏻‍🤝‍🧑🏿",
+}
+
+func Test_ScanEmoji(t *testing.T) {
+	for _, test := range scannerTests {
+		// one byte at a time so every sequence is split across reads
+		s := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(test)))
+		s.Split(ScanEmoji)
+		emojis := []string{}
+		for s.Scan() {
+			emojis = append(emojis, s.Text())
+		}
+		if err := s.Err(); err != nil {
+			t.Errorf("ScanEmoji(%q) returned %v", test, err)
+		}
+		if expected := FindString(test, -1); !reflect.DeepEqual(emojis, expected) {
+			t.Errorf("ScanEmoji(%q) returned %q not %q", test, emojis, expected)
+		}
+	}
+}
+
+func Test_ScanSegments(t *testing.T) {
+	for _, test := range scannerTests {
+		s := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(test)))
+		s.Split(ScanSegments)
+		var b strings.Builder
+		for s.Scan() {
+			b.WriteString(s.Text())
+		}
+		if b.String() != test {
+			t.Errorf("ScanSegments(%q) returned %q", test, b.String())
+		}
+	}
+}
+
+func Test_Scanner(t *testing.T) {
+	for _, test := range iteratorTests {
+		var segments []segment
+		s := NewScanner(strings.NewReader(test.s))
+		for s.Scan() {
+			segments = append(segments, segment{int(s.Offset()), s.Text(), s.IsEmoji()})
+		}
+		if err := s.Err(); err != nil {
+			t.Errorf("Scanner(%q) returned %v", test.s, err)
+		}
+		if !reflect.DeepEqual(mergeText(segments), test.segments) {
+			t.Errorf("Scanner(%q) returned %v not %v", test.s, segments, test.segments)
+		}
+	}
+}
+
+// mergeText joins consecutive text segments, the Scanner can split them at read boundaries
+func mergeText(segments []segment) []segment {
+	var merged []segment
+	for _, s := range segments {
+		if l := len(merged) - 1; l >= 0 && !s.emoji && !merged[l].emoji {
+			merged[l].segment += s.segment
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+func Test_ScannerOneByte(t *testing.T) {
+	for _, test := range scannerTests {
+		s := NewScanner(iotest.OneByteReader(strings.NewReader(test)))
+		var offset int64
+		emojis := []string{}
+		for s.Scan() {
+			if s.Offset() != offset {
+				t.Errorf("Scanner(%q) returned offset %d not %d", test, s.Offset(), offset)
+			}
+			offset += int64(len(s.Bytes()))
+			if s.IsEmoji() {
+				emojis = append(emojis, s.Text())
+			}
+		}
+		if expected := FindString(test, -1); !reflect.DeepEqual(emojis, expected) {
+			t.Errorf("Scanner(%q) returned %q not %q", test, emojis, expected)
+		}
+	}
+}
+
+func Test_ScannerTooLong(t *testing.T) {
+	s := NewScanner(strings.NewReader(strings.Repeat("👩‍", 100)))
+	s.Buffer(nil, 64)
+	for s.Scan() {
+	}
+	if s.Err() != bufio.ErrTooLong {
+		t.Errorf("Scanner returned %v not %v", s.Err(), bufio.ErrTooLong)
+	}
+}
+
+func Test_ScanEmojiRGI(t *testing.T) {
+	glyphs := rgiGlyphs()
+	text := strings.Join(glyphs, " ")
+	s := bufio.NewScanner(iotest.HalfReader(strings.NewReader(text)))
+	s.Split(ScanEmoji)
+	var i int
+	for s.Scan() {
+		if i < len(glyphs) && s.Text() != glyphs[i] {
+			t.Errorf("ScanEmoji returned %q not %q", s.Text(), glyphs[i])
+		}
+		i++
+	}
+	if i != len(glyphs) {
+		t.Errorf("ScanEmoji returned %d emoji not %d", i, len(glyphs))
+	}
+}
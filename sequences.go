@@ -0,0 +1,6 @@
+// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+var rgiSequences = []string{"#️⃣", "*️⃣", "0️⃣", "1️⃣", "2️⃣", "3️⃣", "4️⃣", "5️⃣", "6️⃣", "7️⃣", "8️⃣", "9️⃣", "©️", "®️", "‼️", "⁉️", "™️", "ℹ️", "↔️", "↕️", "↖️", "↗️", "↘️", "↙️", "↩️", "↪️", "⌚", "⌛", "⌨️", "⏏️", "⏩", "⏪", "⏫", "⏬", "⏭️", "⏮️", "⏯️", "⏰", "⏱️", "⏲️", "⏳", "⏸️", "⏹️", "⏺️", "Ⓜ️", "▪️", "▫️", "▶️", "◀️", "◻️", "◼️", "◽", "◾", "☀️", "☁️", "☂️", "☃️", "☄️", "☎️", "☑️", "☔", "☕", "☘️", "☝️", "☝🏻", "☝🏼", "☝🏽", "☝🏾", "☝🏿", "☠️", "☢️", "☣️", "☦️", "☪️", "☮️", "☯️", "☸️", "☹️", "☺️", "♀️", "♂️", "♈", "♉", "♊", "♋", "♌", "♍", "♎", "♏", "♐", "♑", "♒", "♓", "♟️", "♠️", "♣️", "♥️", "♦️", "♨️", "♻️", "♾️", "♿", "⚒️", "⚓", "⚔️", "⚕️", "⚖️", "⚗️", "⚙️", "⚛️", "⚜️", "⚠️", "⚡", "⚧️", "⚪", "⚫", "⚰️", "⚱️", "⚽", "⚾", "⛄", "⛅", "⛈️", "⛎", "⛏️", "⛑️", "⛓️", "⛔", "⛩️", "⛪", "⛰️", "⛱️", "⛲", "⛳", "⛴️", "⛵", "⛷️", "⛸️", "⛹️", "⛹️\u200d♀️", "⛹️\u200d♂️", "⛹🏻", "⛹🏻\u200d♀️", "⛹🏻\u200d♂️", "⛹🏼", "⛹🏼\u200d♀️", "⛹🏼\u200d♂️", "⛹🏽", "⛹🏽\u200d♀️", "⛹🏽\u200d♂️", "⛹🏾", "⛹🏾\u200d♀️", "⛹🏾\u200d♂️", "⛹🏿", "⛹🏿\u200d♀️", "⛹🏿\u200d♂️", "⛺", "⛽", "✂️", "✅", "✈️", "✉️", "✊", "✊🏻", "✊🏼", "✊🏽", "✊🏾", "✊🏿", "✋", "✋🏻", "✋🏼", "✋🏽", "✋🏾", "✋🏿", "✌️", "✌🏻", "✌🏼", "✌🏽", "✌🏾", "✌🏿", "✍️", "✍🏻", "✍🏼", "✍🏽", "✍🏾", "✍🏿", "✏️", "✒️", "✔️", "✖️", "✝️", "✡️", "✨", "✳️", "✴️", "❄️", "❇️", "❌", "❎", "❓", "❔", "❕", "❗", "❣️", "❤️", "➕", "➖", "➗", "➡️", "➰", "➿", "⤴️", "⤵️", "⬅️", "⬆️", "⬇️", "⬛", "⬜", "⭐", "⭕", "〰️", "〽️", "㊗️", "㊙️", "🀄", "🃏", "🅰️", "🅱️", "🅾️", "🅿️", "🆎", "🆑", "🆒", "🆓", "🆔", "🆕", "🆖", "🆗", "🆘", "🆙", "🆚", "🇦🇨", "🇦🇩", "🇦🇪", "🇦🇫", "🇦🇬", "🇦🇮", "🇦🇱", "🇦🇲", "🇦🇴", "🇦🇶", "🇦🇷", "🇦🇸", "🇦🇹", "🇦🇺", "🇦🇼", "🇦🇽", "🇦🇿", "🇧🇦", "🇧🇧", "🇧🇩", "🇧🇪", "🇧🇫", "🇧🇬", "🇧🇭", "🇧🇮", "🇧🇯", "🇧🇱", "🇧🇲", "🇧🇳", "🇧🇴", "🇧🇶", "🇧🇷", "🇧🇸", "🇧🇹", "🇧🇻", "🇧🇼", "🇧🇾", "🇧🇿", "🇨🇦", "🇨🇨", "🇨🇩", "🇨🇫", "🇨🇬", "🇨🇭", "🇨🇮", "🇨🇰", "🇨🇱", "🇨🇲", "🇨🇳", "🇨🇴", "🇨🇵", "🇨🇷", "🇨🇺", "🇨🇻", "🇨🇼", "🇨🇽", "🇨🇾", "🇨🇿", "🇩🇪", "🇩🇬", "🇩🇯", "🇩🇰", "🇩🇲", "🇩🇴", "🇩🇿", "🇪🇦", "🇪🇨", "🇪🇪", "🇪🇬", "🇪🇭", "🇪🇷", "🇪🇸", "🇪🇹", "🇪🇺", "🇫🇮", "🇫🇯", "🇫🇰", "🇫🇲", "🇫🇴", "🇫🇷", "🇬🇦", "🇬🇧", "🇬🇩", "🇬🇪", "🇬🇫", "🇬🇬", "🇬🇭", "🇬🇮", "🇬🇱", "🇬🇲", "🇬🇳", "🇬🇵", "🇬🇶", "🇬🇷", "🇬🇸", "🇬🇹", "🇬🇺", "🇬🇼", "🇬🇾", "🇭🇰", "🇭🇲", "🇭🇳", "🇭🇷", "🇭🇹", "🇭🇺", "🇮🇨", "🇮🇩", "🇮🇪", "🇮🇱", "🇮🇲", "🇮🇳", "🇮🇴", "🇮🇶", "🇮🇷", "🇮🇸", "🇮🇹", "🇯🇪", "🇯🇲", "🇯🇴", "🇯🇵", "🇰🇪", "🇰🇬", "🇰🇭", "🇰🇮", "🇰🇲", "🇰🇳", "🇰🇵", "🇰🇷", "🇰🇼", "🇰🇾", "🇰🇿", "🇱🇦", "🇱🇧", "🇱🇨", "🇱🇮", "🇱🇰", "🇱🇷", "🇱🇸", "🇱🇹", "🇱🇺", "🇱🇻", "🇱🇾", "🇲🇦", "🇲🇨", "🇲🇩", "🇲🇪", "🇲🇫", "🇲🇬", "🇲🇭", "🇲🇰", "🇲🇱", "🇲🇲", "🇲🇳", "🇲🇴", "🇲🇵", "🇲🇶", "🇲🇷", "🇲🇸", "🇲🇹", "🇲🇺", "🇲🇻", "🇲🇼", "🇲🇽", "🇲🇾", "🇲🇿", "🇳🇦", "🇳🇨", "🇳🇪", "🇳🇫", "🇳🇬", "🇳🇮", "🇳🇱", "🇳🇴", "🇳🇵", "🇳🇷", "🇳🇺", "🇳🇿", "🇴🇲", "🇵🇦", "🇵🇪", "🇵🇫", "🇵🇬", "🇵🇭", "🇵🇰", "🇵🇱", "🇵🇲", "🇵🇳", "🇵🇷", "🇵🇸", "🇵🇹", "🇵🇼", "🇵🇾", "🇶🇦", "🇷🇪", "🇷🇴", "🇷🇸", "🇷🇺", "🇷🇼", "🇸🇦", "🇸🇧", "🇸🇨", "🇸🇩", "🇸🇪", "🇸🇬", "🇸🇭", "🇸🇮", "🇸🇯", "🇸🇰", "🇸🇱", "🇸🇲", "🇸🇳", "🇸🇴", "🇸🇷", "🇸🇸", "🇸🇹", "🇸🇻", "🇸🇽", "🇸🇾", "🇸🇿", "🇹🇦", "🇹🇨", "🇹🇩", "🇹🇫", "🇹🇬", "🇹🇭", "🇹🇯", "🇹🇰", "🇹🇱", "🇹🇲", "🇹🇳", "🇹🇴", "🇹🇷", "🇹🇹", "🇹🇻", "🇹🇼", "🇹🇿", "🇺🇦", "🇺🇬", "🇺🇲", "🇺🇳", "🇺🇸", "🇺🇾", "🇺🇿", "🇻🇦", "🇻🇨", "🇻🇪", "🇻🇬", "🇻🇮", "🇻🇳", "🇻🇺", "🇼🇫", "🇼🇸", "🇽🇰", "🇾🇪", "🇾🇹", "🇿🇦", "🇿🇲", "🇿🇼", "🈁", "🈂️", "🈚", "🈯", "🈲", "🈳", "🈴", "🈵", "🈶", "🈷️", "🈸", "🈹", "🈺", "🉐", "🉑", "🌀", "🌁", "🌂", "🌃", "🌄", "🌅", "🌆", "🌇", "🌈", "🌉", "🌊", "🌋", "🌌", "🌍", "🌎", "🌏", "🌐", "🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘", "🌙", "🌚", "🌛", "🌜", "🌝", "🌞", "🌟", "🌠", "🌡️", "🌤️", "🌥️", "🌦️", "🌧️", "🌨️", "🌩️", "🌪️", "🌫️", "🌬️", "🌭", "🌮", "🌯", "🌰", "🌱", "🌲", "🌳", "🌴", "🌵", "🌶️", "🌷", "🌸", "🌹", "🌺", "🌻", "🌼", "🌽", "🌾", "🌿", "🍀", "🍁", "🍂", "🍃", "🍄", "🍅", "🍆", "🍇", "🍈", "🍉", "🍊", "🍋", "🍌", "🍍", "🍎", "🍏", "🍐", "🍑", "🍒", "🍓", "🍔", "🍕", "🍖", "🍗", "🍘", "🍙", "🍚", "🍛", "🍜", "🍝", "🍞", "🍟", "🍠", "🍡", "🍢", "🍣", "🍤", "🍥", "🍦", "🍧", "🍨", "🍩", "🍪", "🍫", "🍬", "🍭", "🍮", "🍯", "🍰", "🍱", "🍲", "🍳", "🍴", "🍵", "🍶", "🍷", "🍸", "🍹", "🍺", "🍻", "🍼", "🍽️", "🍾", "🍿", "🎀", "🎁", "🎂", "🎃", "🎄", "🎅", "🎅🏻", "🎅🏼", "🎅🏽", "🎅🏾", "🎅🏿", "🎆", "🎇", "🎈", "🎉", "🎊", "🎋", "🎌", "🎍", "🎎", "🎏", "🎐", "🎑", "🎒", "🎓", "🎖️", "🎗️", "🎙️", "🎚️", "🎛️", "🎞️", "🎟️", "🎠", "🎡", "🎢", "🎣", "🎤", "🎥", "🎦", "🎧", "🎨", "🎩", "🎪", "🎫", "🎬", "🎭", "🎮", "🎯", "🎰", "🎱", "🎲", "🎳", "🎴", "🎵", "🎶", "🎷", "🎸", "🎹", "🎺", "🎻", "🎼", "🎽", "🎾", "🎿", "🏀", "🏁", "🏂", "🏂🏻", "🏂🏼", "🏂🏽", "🏂🏾", "🏂🏿", "🏃", "🏃\u200d♀️", "🏃\u200d♂️", "🏃🏻", "🏃🏻\u200d♀️", "🏃🏻\u200d♂️", "🏃🏼", "🏃🏼\u200d♀️", "🏃🏼\u200d♂️", "🏃🏽", "🏃🏽\u200d♀️", "🏃🏽\u200d♂️", "🏃🏾", "🏃🏾\u200d♀️", "🏃🏾\u200d♂️", "🏃🏿", "🏃🏿\u200d♀️", "🏃🏿\u200d♂️", "🏄", "🏄\u200d♀️", "🏄\u200d♂️", "🏄🏻", "🏄🏻\u200d♀️", "🏄🏻\u200d♂️", "🏄🏼", "🏄🏼\u200d♀️", "🏄🏼\u200d♂️", "🏄🏽", "🏄🏽\u200d♀️", "🏄🏽\u200d♂️", "🏄🏾", "🏄🏾\u200d♀️", "🏄🏾\u200d♂️", "🏄🏿", "🏄🏿\u200d♀️", "🏄🏿\u200d♂️", "🏅", "🏆", "🏇", "🏇🏻", "🏇🏼", "🏇🏽", "🏇🏾", "🏇🏿", "🏈", "🏉", "🏊", "🏊\u200d♀️", "🏊\u200d♂️", "🏊🏻", "🏊🏻\u200d♀️", "🏊🏻\u200d♂️", "🏊🏼", "🏊🏼\u200d♀️", "🏊🏼\u200d♂️", "🏊🏽", "🏊🏽\u200d♀️", "🏊🏽\u200d♂️", "🏊🏾", "🏊🏾\u200d♀️", "🏊🏾\u200d♂️", "🏊🏿", "🏊🏿\u200d♀️", "🏊🏿\u200d♂️", "🏋️", "🏋️\u200d♀️", "🏋️\u200d♂️", "🏋🏻", "🏋🏻\u200d♀️", "🏋🏻\u200d♂️", "🏋🏼", "🏋🏼\u200d♀️", "🏋🏼\u200d♂️", "🏋🏽", "🏋🏽\u200d♀️", "🏋🏽\u200d♂️", "🏋🏾", "🏋🏾\u200d♀️", "🏋🏾\u200d♂️", "🏋🏿", "🏋🏿\u200d♀️", "🏋🏿\u200d♂️", "🏌️", "🏌️\u200d♀️", "🏌️\u200d♂️", "🏌🏻", "🏌🏻\u200d♀️", "🏌🏻\u200d♂️", "🏌🏼", "🏌🏼\u200d♀️", "🏌🏼\u200d♂️", "🏌🏽", "🏌🏽\u200d♀️", "🏌🏽\u200d♂️", "🏌🏾", "🏌🏾\u200d♀️", "🏌🏾\u200d♂️", "🏌🏿", "🏌🏿\u200d♀️", "🏌🏿\u200d♂️", "🏍️", "🏎️", "🏏", "🏐", "🏑", "🏒", "🏓", "🏔️", "🏕️", "🏖️", "🏗️", "🏘️", "🏙️", "🏚️", "🏛️", "🏜️", "🏝️", "🏞️", "🏟️", "🏠", "🏡", "🏢", "🏣", "🏤", "🏥", "🏦", "🏧", "🏨", "🏩", "🏪", "🏫", "🏬", "🏭", "🏮", "🏯", "🏰", "🏳️", "🏳️\u200d⚧️", "🏳️\u200d🌈", "🏴", "🏴\u200d☠️", "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", "🏵️", "🏷️", "🏸", "🏹", "🏺", "🏻", "🏼", "🏽", "🏾", "🏿", "🐀", "🐁", "🐂", "🐃", "🐄", "🐅", "🐆", "🐇", "🐈", "🐈\u200d⬛", "🐉", "🐊", "🐋", "🐌", "🐍", "🐎", "🐏", "🐐", "🐑", "🐒", "🐓", "🐔", "🐕", "🐕\u200d🦺", "🐖", "🐗", "🐘", "🐙", "🐚", "🐛", "🐜", "🐝", "🐞", "🐟", "🐠", "🐡", "🐢", "🐣", "🐤", "🐥", "🐦", "🐧", "🐨", "🐩", "🐪", "🐫", "🐬", "🐭", "🐮", "🐯", "🐰", "🐱", "🐲", "🐳", "🐴", "🐵", "🐶", "🐷", "🐸", "🐹", "🐺", "🐻", "🐻\u200d❄️", "🐼", "🐽", "🐾", "🐿️", "👀", "👁️", "👁️\u200d🗨️", "👂", "👂🏻", "👂🏼", "👂🏽", "👂🏾", "👂🏿", "👃", "👃🏻", "👃🏼", "👃🏽", "👃🏾", "👃🏿", "👄", "👅", "👆", "👆🏻", "👆🏼", "👆🏽", "👆🏾", "👆🏿", "👇", "👇🏻", "👇🏼", "👇🏽", "👇🏾", "👇🏿", "👈", "👈🏻", "👈🏼", "👈🏽", "👈🏾", "👈🏿", "👉", "👉🏻", "👉🏼", "👉🏽", "👉🏾", "👉🏿", "👊", "👊🏻", "👊🏼", "👊🏽", "👊🏾", "👊🏿", "👋", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿", "👌", "👌🏻", "👌🏼", "👌🏽", "👌🏾", "👌🏿", "👍", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿", "👎", "👎🏻", "👎🏼", "👎🏽", "👎🏾", "👎🏿", "👏", "👏🏻", "👏🏼", "👏🏽", "👏🏾", "👏🏿", "👐", "👐🏻", "👐🏼", "👐🏽", "👐🏾", "👐🏿", "👑", "👒", "👓", "👔", "👕", "👖", "👗", "👘", "👙", "👚", "👛", "👜", "👝", "👞", "👟", "👠", "👡", "👢", "👣", "👤", "👥", "👦", "👦🏻", "👦🏼", "👦🏽", "👦🏾", "👦🏿", "👧", "👧🏻", "👧🏼", "👧🏽", "👧🏾", "👧🏿", "👨", "👨\u200d⚕️", "👨\u200d⚖️", "👨\u200d✈️", "👨\u200d❤️\u200d👨", "👨\u200d❤️\u200d💋\u200d👨", "👨\u200d🌾", "👨\u200d🍳", "👨\u200d🍼", "👨\u200d🎓", "👨\u200d🎤", "👨\u200d🎨", "👨\u200d🏫", "👨\u200d🏭", "👨\u200d👦", "👨\u200d👦\u200d👦", "👨\u200d👧", "👨\u200d👧\u200d👦", "👨\u200d👧\u200d👧", "👨\u200d👨\u200d👦", "👨\u200d👨\u200d👦\u200d👦", "👨\u200d👨\u200d👧", "👨\u200d👨\u200d👧\u200d👦", "👨\u200d👨\u200d👧\u200d👧", "👨\u200d👩\u200d👦", "👨\u200d👩\u200d👦\u200d👦", "👨\u200d👩\u200d👧", "👨\u200d👩\u200d👧\u200d👦", "👨\u200d👩\u200d👧\u200d👧", "👨\u200d💻", "👨\u200d💼", "👨\u200d🔧", "👨\u200d🔬", "👨\u200d🚀", "👨\u200d🚒", "👨\u200d🦯", "👨\u200d🦰", "👨\u200d🦱", "👨\u200d🦲", "👨\u200d🦳", "👨\u200d🦼", "👨\u200d🦽", "👨🏻", "👨🏻\u200d⚕️", "👨🏻\u200d⚖️", "👨🏻\u200d✈️", "👨🏻\u200d🌾", "👨🏻\u200d🍳", "👨🏻\u200d🍼", "👨🏻\u200d🎓", "👨🏻\u200d🎤", "👨🏻\u200d🎨", "👨🏻\u200d🏫", "👨🏻\u200d🏭", "👨🏻\u200d💻", "👨🏻\u200d💼", "👨🏻\u200d🔧", "👨🏻\u200d🔬", "👨🏻\u200d🚀", "👨🏻\u200d🚒", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏻\u200d🦯", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦲", "👨🏻\u200d🦳", "👨🏻\u200d🦼", "👨🏻\u200d🦽", "👨🏼", "👨🏼\u200d⚕️", "👨🏼\u200d⚖️", "👨🏼\u200d✈️", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏼\u200d🦯", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏼\u200d🦼", "👨🏼\u200d🦽", "👨🏽", "👨🏽\u200d⚕️", "👨🏽\u200d⚖️", "👨🏽\u200d✈️", "👨🏽\u200d🌾", "👨🏽\u200d🍳", "👨🏽\u200d🍼", "👨🏽\u200d🎓", "👨🏽\u200d🎤", "👨🏽\u200d🎨", "👨🏽\u200d🏫", "👨🏽\u200d🏭", "👨🏽\u200d💻", "👨🏽\u200d💼", "👨🏽\u200d🔧", "👨🏽\u200d🔬", "👨🏽\u200d🚀", "👨🏽\u200d🚒", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏽\u200d🦯", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦲", "👨🏽\u200d🦳", "👨🏽\u200d🦼", "👨🏽\u200d🦽", "👨🏾", "👨🏾\u200d⚕️", "👨🏾\u200d⚖️", "👨🏾\u200d✈️", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏾\u200d🦯", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏾\u200d🦼", "👨🏾\u200d🦽", "👨🏿", "👨🏿\u200d⚕️", "👨🏿\u200d⚖️", "👨🏿\u200d✈️", "👨🏿\u200d🌾", "👨🏿\u200d🍳", "👨🏿\u200d🍼", "👨🏿\u200d🎓", "👨🏿\u200d🎤", "👨🏿\u200d🎨", "👨🏿\u200d🏫", "👨🏿\u200d🏭", "👨🏿\u200d💻", "👨🏿\u200d💼", "👨🏿\u200d🔧", "👨🏿\u200d🔬", "👨🏿\u200d🚀", "👨🏿\u200d🚒", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👨🏿\u200d🦯", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦲", "👨🏿\u200d🦳", "👨🏿\u200d🦼", "👨🏿\u200d🦽", "👩", "👩\u200d⚕️", "👩\u200d⚖️", "👩\u200d✈️", "👩\u200d❤️\u200d👨", "👩\u200d❤️\u200d👩", "👩\u200d❤️\u200d💋\u200d👨", "👩\u200d❤️\u200d💋\u200d👩", "👩\u200d🌾", "👩\u200d🍳", "👩\u200d🍼", "👩\u200d🎓", "👩\u200d🎤", "👩\u200d🎨", "👩\u200d🏫", "👩\u200d🏭", "👩\u200d👦", "👩\u200d👦\u200d👦", "👩\u200d👧", "👩\u200d👧\u200d👦", "👩\u200d👧\u200d👧", "👩\u200d👩\u200d👦", "👩\u200d👩\u200d👦\u200d👦", "👩\u200d👩\u200d👧", "👩\u200d👩\u200d👧\u200d👦", "👩\u200d👩\u200d👧\u200d👧", "👩\u200d💻", "👩\u200d💼", "👩\u200d🔧", "👩\u200d🔬", "👩\u200d🚀", "👩\u200d🚒", "👩\u200d🦯", "👩\u200d🦰", "👩\u200d🦱", "👩\u200d🦲", "👩\u200d🦳", "👩\u200d🦼", "👩\u200d🦽", "👩🏻", "👩🏻\u200d⚕️", "👩🏻\u200d⚖️", "👩🏻\u200d✈️", "👩🏻\u200d🌾", "👩🏻\u200d🍳", "👩🏻\u200d🍼", "👩🏻\u200d🎓", "👩🏻\u200d🎤", "👩🏻\u200d🎨", "👩🏻\u200d🏫", "👩🏻\u200d🏭", "👩🏻\u200d💻", "👩🏻\u200d💼", "👩🏻\u200d🔧", "👩🏻\u200d🔬", "👩🏻\u200d🚀", "👩🏻\u200d🚒", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏻\u200d🦯", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦲", "👩🏻\u200d🦳", "👩🏻\u200d🦼", "👩🏻\u200d🦽", "👩🏼", "👩🏼\u200d⚕️", "👩🏼\u200d⚖️", "👩🏼\u200d✈️", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏼\u200d🦯", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏼\u200d🦼", "👩🏼\u200d🦽", "👩🏽", "👩🏽\u200d⚕️", "👩🏽\u200d⚖️", "👩🏽\u200d✈️", "👩🏽\u200d🌾", "👩🏽\u200d🍳", "👩🏽\u200d🍼", "👩🏽\u200d🎓", "👩🏽\u200d🎤", "👩🏽\u200d🎨", "👩🏽\u200d🏫", "👩🏽\u200d🏭", "👩🏽\u200d💻", "👩🏽\u200d💼", "👩🏽\u200d🔧", "👩🏽\u200d🔬", "👩🏽\u200d🚀", "👩🏽\u200d🚒", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏽\u200d🦯", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦲", "👩🏽\u200d🦳", "👩🏽\u200d🦼", "👩🏽\u200d🦽", "👩🏾", "👩🏾\u200d⚕️", "👩🏾\u200d⚖️", "👩🏾\u200d✈️", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏾\u200d🦯", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏾\u200d🦼", "👩🏾\u200d🦽", "👩🏿", "👩🏿\u200d⚕️", "👩🏿\u200d⚖️", "👩🏿\u200d✈️", "👩🏿\u200d🌾", "👩🏿\u200d🍳", "👩🏿\u200d🍼", "👩🏿\u200d🎓", "👩🏿\u200d🎤", "👩🏿\u200d🎨", "👩🏿\u200d🏫", "👩🏿\u200d🏭", "👩🏿\u200d💻", "👩🏿\u200d💼", "👩🏿\u200d🔧", "👩🏿\u200d🔬", "👩🏿\u200d🚀", "👩🏿\u200d🚒", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👩🏿\u200d🦯", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦲", "👩🏿\u200d🦳", "👩🏿\u200d🦼", "👩🏿\u200d🦽", "👪", "👫", "👫🏻", "👫🏼", "👫🏽", "👫🏾", "👫🏿", "👬", "👬🏻", "👬🏼", "👬🏽", "👬🏾", "👬🏿", "👭", "👭🏻", "👭🏼", "👭🏽", "👭🏾", "👭🏿", "👮", "👮\u200d♀️", "👮\u200d♂️", "👮🏻", "👮🏻\u200d♀️", "👮🏻\u200d♂️", "👮🏼", "👮🏼\u200d♀️", "👮🏼\u200d♂️", "👮🏽", "👮🏽\u200d♀️", "👮🏽\u200d♂️", "👮🏾", "👮🏾\u200d♀️", "👮🏾\u200d♂️", "👮🏿", "👮🏿\u200d♀️", "👮🏿\u200d♂️", "👯", "👯\u200d♀️", "👯\u200d♂️", "👰", "👰\u200d♀️", "👰\u200d♂️", "👰🏻", "👰🏻\u200d♀️", "👰🏻\u200d♂️", "👰🏼", "👰🏼\u200d♀️", "👰🏼\u200d♂️", "👰🏽", "👰🏽\u200d♀️", "👰🏽\u200d♂️", "👰🏾", "👰🏾\u200d♀️", "👰🏾\u200d♂️", "👰🏿", "👰🏿\u200d♀️", "👰🏿\u200d♂️", "👱", "👱\u200d♀️", "👱\u200d♂️", "👱🏻", "👱🏻\u200d♀️", "👱🏻\u200d♂️", "👱🏼", "👱🏼\u200d♀️", "👱🏼\u200d♂️", "👱🏽", "👱🏽\u200d♀️", "👱🏽\u200d♂️", "👱🏾", "👱🏾\u200d♀️", "👱🏾\u200d♂️", "👱🏿", "👱🏿\u200d♀️", "👱🏿\u200d♂️", "👲", "👲🏻", "👲🏼", "👲🏽", "👲🏾", "👲🏿", "👳", "👳\u200d♀️", "👳\u200d♂️", "👳🏻", "👳🏻\u200d♀️", "👳🏻\u200d♂️", "👳🏼", "👳🏼\u200d♀️", "👳🏼\u200d♂️", "👳🏽", "👳🏽\u200d♀️", "👳🏽\u200d♂️", "👳🏾", "👳🏾\u200d♀️", "👳🏾\u200d♂️", "👳🏿", "👳🏿\u200d♀️", "👳🏿\u200d♂️", "👴", "👴🏻", "👴🏼", "👴🏽", "👴🏾", "👴🏿", "👵", "👵🏻", "👵🏼", "👵🏽", "👵🏾", "👵🏿", "👶", "👶🏻", "👶🏼", "👶🏽", "👶🏾", "👶🏿", "👷", "👷\u200d♀️", "👷\u200d♂️", "👷🏻", "👷🏻\u200d♀️", "👷🏻\u200d♂️", "👷🏼", "👷🏼\u200d♀️", "👷🏼\u200d♂️", "👷🏽", "👷🏽\u200d♀️", "👷🏽\u200d♂️", "👷🏾", "👷🏾\u200d♀️", "👷🏾\u200d♂️", "👷🏿", "👷🏿\u200d♀️", "👷🏿\u200d♂️", "👸", "👸🏻", "👸🏼", "👸🏽", "👸🏾", "👸🏿", "👹", "👺", "👻", "👼", "👼🏻", "👼🏼", "👼🏽", "👼🏾", "👼🏿", "👽", "👾", "👿", "💀", "💁", "💁\u200d♀️", "💁\u200d♂️", "💁🏻", "💁🏻\u200d♀️", "💁🏻\u200d♂️", "💁🏼", "💁🏼\u200d♀️", "💁🏼\u200d♂️", "💁🏽", "💁🏽\u200d♀️", "💁🏽\u200d♂️", "💁🏾", "💁🏾\u200d♀️", "💁🏾\u200d♂️", "💁🏿", "💁🏿\u200d♀️", "💁🏿\u200d♂️", "💂", "💂\u200d♀️", "💂\u200d♂️", "💂🏻", "💂🏻\u200d♀️", "💂🏻\u200d♂️", "💂🏼", "💂🏼\u200d♀️", "💂🏼\u200d♂️", "💂🏽", "💂🏽\u200d♀️", "💂🏽\u200d♂️", "💂🏾", "💂🏾\u200d♀️", "💂🏾\u200d♂️", "💂🏿", "💂🏿\u200d♀️", "💂🏿\u200d♂️", "💃", "💃🏻", "💃🏼", "💃🏽", "💃🏾", "💃🏿", "💄", "💅", "💅🏻", "💅🏼", "💅🏽", "💅🏾", "💅🏿", "💆", "💆\u200d♀️", "💆\u200d♂️", "💆🏻", "💆🏻\u200d♀️", "💆🏻\u200d♂️", "💆🏼", "💆🏼\u200d♀️", "💆🏼\u200d♂️", "💆🏽", "💆🏽\u200d♀️", "💆🏽\u200d♂️", "💆🏾", "💆🏾\u200d♀️", "💆🏾\u200d♂️", "💆🏿", "💆🏿\u200d♀️", "💆🏿\u200d♂️", "💇", "💇\u200d♀️", "💇\u200d♂️", "💇🏻", "💇🏻\u200d♀️", "💇🏻\u200d♂️", "💇🏼", "💇🏼\u200d♀️", "💇🏼\u200d♂️", "💇🏽", "💇🏽\u200d♀️", "💇🏽\u200d♂️", "💇🏾", "💇🏾\u200d♀️", "💇🏾\u200d♂️", "💇🏿", "💇🏿\u200d♀️", "💇🏿\u200d♂️", "💈", "💉", "💊", "💋", "💌", "💍", "💎", "💏", "💐", "💑", "💒", "💓", "💔", "💕", "💖", "💗", "💘", "💙", "💚", "💛", "💜", "💝", "💞", "💟", "💠", "💡", "💢", "💣", "💤", "💥", "💦", "💧", "💨", "💩", "💪", "💪🏻", "💪🏼", "💪🏽", "💪🏾", "💪🏿", "💫", "💬", "💭", "💮", "💯", "💰", "💱", "💲", "💳", "💴", "💵", "💶", "💷", "💸", "💹", "💺", "💻", "💼", "💽", "💾", "💿", "📀", "📁", "📂", "📃", "📄", "📅", "📆", "📇", "📈", "📉", "📊", "📋", "📌", "📍", "📎", "📏", "📐", "📑", "📒", "📓", "📔", "📕", "📖", "📗", "📘", "📙", "📚", "📛", "📜", "📝", "📞", "📟", "📠", "📡", "📢", "📣", "📤", "📥", "📦", "📧", "📨", "📩", "📪", "📫", "📬", "📭", "📮", "📯", "📰", "📱", "📲", "📳", "📴", "📵", "📶", "📷", "📸", "📹", "📺", "📻", "📼", "📽️", "📿", "🔀", "🔁", "🔂", "🔃", "🔄", "🔅", "🔆", "🔇", "🔈", "🔉", "🔊", "🔋", "🔌", "🔍", "🔎", "🔏", "🔐", "🔑", "🔒", "🔓", "🔔", "🔕", "🔖", "🔗", "🔘", "🔙", "🔚", "🔛", "🔜", "🔝", "🔞", "🔟", "🔠", "🔡", "🔢", "🔣", "🔤", "🔥", "🔦", "🔧", "🔨", "🔩", "🔪", "🔫", "🔬", "🔭", "🔮", "🔯", "🔰", "🔱", "🔲", "🔳", "🔴", "🔵", "🔶", "🔷", "🔸", "🔹", "🔺", "🔻", "🔼", "🔽", "🕉️", "🕊️", "🕋", "🕌", "🕍", "🕎", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚", "🕛", "🕜", "🕝", "🕞", "🕟", "🕠", "🕡", "🕢", "🕣", "🕤", "🕥", "🕦", "🕧", "🕯️", "🕰️", "🕳️", "🕴️", "🕴🏻", "🕴🏼", "🕴🏽", "🕴🏾", "🕴🏿", "🕵️", "🕵️\u200d♀️", "🕵️\u200d♂️", "🕵🏻", "🕵🏻\u200d♀️", "🕵🏻\u200d♂️", "🕵🏼", "🕵🏼\u200d♀️", "🕵🏼\u200d♂️", "🕵🏽", "🕵🏽\u200d♀️", "🕵🏽\u200d♂️", "🕵🏾", "🕵🏾\u200d♀️", "🕵🏾\u200d♂️", "🕵🏿", "🕵🏿\u200d♀️", "🕵🏿\u200d♂️", "🕶️", "🕷️", "🕸️", "🕹️", "🕺", "🕺🏻", "🕺🏼", "🕺🏽", "🕺🏾", "🕺🏿", "🖇️", "🖊️", "🖋️", "🖌️", "🖍️", "🖐️", "🖐🏻", "🖐🏼", "🖐🏽", "🖐🏾", "🖐🏿", "🖕", "🖕🏻", "🖕🏼", "🖕🏽", "🖕🏾", "🖕🏿", "🖖", "🖖🏻", "🖖🏼", "🖖🏽", "🖖🏾", "🖖🏿", "🖤", "🖥️", "🖨️", "🖱️", "🖲️", "🖼️", "🗂️", "🗃️", "🗄️", "🗑️", "🗒️", "🗓️", "🗜️", "🗝️", "🗞️", "🗡️", "🗣️", "🗨️", "🗯️", "🗳️", "🗺️", "🗻", "🗼", "🗽", "🗾", "🗿", "😀", "😁", "😂", "😃", "😄", "😅", "😆", "😇", "😈", "😉", "😊", "😋", "😌", "😍", "😎", "😏", "😐", "😑", "😒", "😓", "😔", "😕", "😖", "😗", "😘", "😙", "😚", "😛", "😜", "😝", "😞", "😟", "😠", "😡", "😢", "😣", "😤", "😥", "😦", "😧", "😨", "😩", "😪", "😫", "😬", "😭", "😮", "😯", "😰", "😱", "😲", "😳", "😴", "😵", "😶", "😷", "😸", "😹", "😺", "😻", "😼", "😽", "😾", "😿", "🙀", "🙁", "🙂", "🙃", "🙄", "🙅", "🙅\u200d♀️", "🙅\u200d♂️", "🙅🏻", "🙅🏻\u200d♀️", "🙅🏻\u200d♂️", "🙅🏼", "🙅🏼\u200d♀️", "🙅🏼\u200d♂️", "🙅🏽", "🙅🏽\u200d♀️", "🙅🏽\u200d♂️", "🙅🏾", "🙅🏾\u200d♀️", "🙅🏾\u200d♂️", "🙅🏿", "🙅🏿\u200d♀️", "🙅🏿\u200d♂️", "🙆", "🙆\u200d♀️", "🙆\u200d♂️", "🙆🏻", "🙆🏻\u200d♀️", "🙆🏻\u200d♂️", "🙆🏼", "🙆🏼\u200d♀️", "🙆🏼\u200d♂️", "🙆🏽", "🙆🏽\u200d♀️", "🙆🏽\u200d♂️", "🙆🏾", "🙆🏾\u200d♀️", "🙆🏾\u200d♂️", "🙆🏿", "🙆🏿\u200d♀️", "🙆🏿\u200d♂️", "🙇", "🙇\u200d♀️", "🙇\u200d♂️", "🙇🏻", "🙇🏻\u200d♀️", "🙇🏻\u200d♂️", "🙇🏼", "🙇🏼\u200d♀️", "🙇🏼\u200d♂️", "🙇🏽", "🙇🏽\u200d♀️", "🙇🏽\u200d♂️", "🙇🏾", "🙇🏾\u200d♀️", "🙇🏾\u200d♂️", "🙇🏿", "🙇🏿\u200d♀️", "🙇🏿\u200d♂️", "🙈", "🙉", "🙊", "🙋", "🙋\u200d♀️", "🙋\u200d♂️", "🙋🏻", "🙋🏻\u200d♀️", "🙋🏻\u200d♂️", "🙋🏼", "🙋🏼\u200d♀️", "🙋🏼\u200d♂️", "🙋🏽", "🙋🏽\u200d♀️", "🙋🏽\u200d♂️", "🙋🏾", "🙋🏾\u200d♀️", "🙋🏾\u200d♂️", "🙋🏿", "🙋🏿\u200d♀️", "🙋🏿\u200d♂️", "🙌", "🙌🏻", "🙌🏼", "🙌🏽", "🙌🏾", "🙌🏿", "🙍", "🙍\u200d♀️", "🙍\u200d♂️", "🙍🏻", "🙍🏻\u200d♀️", "🙍🏻\u200d♂️", "🙍🏼", "🙍🏼\u200d♀️", "🙍🏼\u200d♂️", "🙍🏽", "🙍🏽\u200d♀️", "🙍🏽\u200d♂️", "🙍🏾", "🙍🏾\u200d♀️", "🙍🏾\u200d♂️", "🙍🏿", "🙍🏿\u200d♀️", "🙍🏿\u200d♂️", "🙎", "🙎\u200d♀️", "🙎\u200d♂️", "🙎🏻", "🙎🏻\u200d♀️", "🙎🏻\u200d♂️", "🙎🏼", "🙎🏼\u200d♀️", "🙎🏼\u200d♂️", "🙎🏽", "🙎🏽\u200d♀️", "🙎🏽\u200d♂️", "🙎🏾", "🙎🏾\u200d♀️", "🙎🏾\u200d♂️", "🙎🏿", "🙎🏿\u200d♀️", "🙎🏿\u200d♂️", "🙏", "🙏🏻", "🙏🏼", "🙏🏽", "🙏🏾", "🙏🏿", "🚀", "🚁", "🚂", "🚃", "🚄", "🚅", "🚆", "🚇", "🚈", "🚉", "🚊", "🚋", "🚌", "🚍", "🚎", "🚏", "🚐", "🚑", "🚒", "🚓", "🚔", "🚕", "🚖", "🚗", "🚘", "🚙", "🚚", "🚛", "🚜", "🚝", "🚞", "🚟", "🚠", "🚡", "🚢", "🚣", "🚣\u200d♀️", "🚣\u200d♂️", "🚣🏻", "🚣🏻\u200d♀️", "🚣🏻\u200d♂️", "🚣🏼", "🚣🏼\u200d♀️", "🚣🏼\u200d♂️", "🚣🏽", "🚣🏽\u200d♀️", "🚣🏽\u200d♂️", "🚣🏾", "🚣🏾\u200d♀️", "🚣🏾\u200d♂️", "🚣🏿", "🚣🏿\u200d♀️", "🚣🏿\u200d♂️", "🚤", "🚥", "🚦", "🚧", "🚨", "🚩", "🚪", "🚫", "🚬", "🚭", "🚮", "🚯", "🚰", "🚱", "🚲", "🚳", "🚴", "🚴\u200d♀️", "🚴\u200d♂️", "🚴🏻", "🚴🏻\u200d♀️", "🚴🏻\u200d♂️", "🚴🏼", "🚴🏼\u200d♀️", "🚴🏼\u200d♂️", "🚴🏽", "🚴🏽\u200d♀️", "🚴🏽\u200d♂️", "🚴🏾", "🚴🏾\u200d♀️", "🚴🏾\u200d♂️", "🚴🏿", "🚴🏿\u200d♀️", "🚴🏿\u200d♂️", "🚵", "🚵\u200d♀️", "🚵\u200d♂️", "🚵🏻", "🚵🏻\u200d♀️", "🚵🏻\u200d♂️", "🚵🏼", "🚵🏼\u200d♀️", "🚵🏼\u200d♂️", "🚵🏽", "🚵🏽\u200d♀️", "🚵🏽\u200d♂️", "🚵🏾", "🚵🏾\u200d♀️", "🚵🏾\u200d♂️", "🚵🏿", "🚵🏿\u200d♀️", "🚵🏿\u200d♂️", "🚶", "🚶\u200d♀️", "🚶\u200d♂️", "🚶🏻", "🚶🏻\u200d♀️", "🚶🏻\u200d♂️", "🚶🏼", "🚶🏼\u200d♀️", "🚶🏼\u200d♂️", "🚶🏽", "🚶🏽\u200d♀️", "🚶🏽\u200d♂️", "🚶🏾", "🚶🏾\u200d♀️", "🚶🏾\u200d♂️", "🚶🏿", "🚶🏿\u200d♀️", "🚶🏿\u200d♂️", "🚷", "🚸", "🚹", "🚺", "🚻", "🚼", "🚽", "🚾", "🚿", "🛀", "🛀🏻", "🛀🏼", "🛀🏽", "🛀🏾", "🛀🏿", "🛁", "🛂", "🛃", "🛄", "🛅", "🛋️", "🛌", "🛌🏻", "🛌🏼", "🛌🏽", "🛌🏾", "🛌🏿", "🛍️", "🛎️", "🛏️", "🛐", "🛑", "🛒", "🛕", "🛖", "🛗", "🛠️", "🛡️", "🛢️", "🛣️", "🛤️", "🛥️", "🛩️", "🛫", "🛬", "🛰️", "🛳️", "🛴", "🛵", "🛶", "🛷", "🛸", "🛹", "🛺", "🛻", "🛼", "🟠", "🟡", "🟢", "🟣", "🟤", "🟥", "🟦", "🟧", "🟨", "🟩", "🟪", "🟫", "🤌", "🤌🏻", "🤌🏼", "🤌🏽", "🤌🏾", "🤌🏿", "🤍", "🤎", "🤏", "🤏🏻", "🤏🏼", "🤏🏽", "🤏🏾", "🤏🏿", "🤐", "🤑", "🤒", "🤓", "🤔", "🤕", "🤖", "🤗", "🤘", "🤘🏻", "🤘🏼", "🤘🏽", "🤘🏾", "🤘🏿", "🤙", "🤙🏻", "🤙🏼", "🤙🏽", "🤙🏾", "🤙🏿", "🤚", "🤚🏻", "🤚🏼", "🤚🏽", "🤚🏾", "🤚🏿", "🤛", "🤛🏻", "🤛🏼", "🤛🏽", "🤛🏾", "🤛🏿", "🤜", "🤜🏻", "🤜🏼", "🤜🏽", "🤜🏾", "🤜🏿", "🤝", "🤞", "🤞🏻", "🤞🏼", "🤞🏽", "🤞🏾", "🤞🏿", "🤟", "🤟🏻", "🤟🏼", "🤟🏽", "🤟🏾", "🤟🏿", "🤠", "🤡", "🤢", "🤣", "🤤", "🤥", "🤦", "🤦\u200d♀️", "🤦\u200d♂️", "🤦🏻", "🤦🏻\u200d♀️", "🤦🏻\u200d♂️", "🤦🏼", "🤦🏼\u200d♀️", "🤦🏼\u200d♂️", "🤦🏽", "🤦🏽\u200d♀️", "🤦🏽\u200d♂️", "🤦🏾", "🤦🏾\u200d♀️", "🤦🏾\u200d♂️", "🤦🏿", "🤦🏿\u200d♀️", "🤦🏿\u200d♂️", "🤧", "🤨", "🤩", "🤪", "🤫", "🤬", "🤭", "🤮", "🤯", "🤰", "🤰🏻", "🤰🏼", "🤰🏽", "🤰🏾", "🤰🏿", "🤱", "🤱🏻", "🤱🏼", "🤱🏽", "🤱🏾", "🤱🏿", "🤲", "🤲🏻", "🤲🏼", "🤲🏽", "🤲🏾", "🤲🏿", "🤳", "🤳🏻", "🤳🏼", "🤳🏽", "🤳🏾", "🤳🏿", "🤴", "🤴🏻", "🤴🏼", "🤴🏽", "🤴🏾", "🤴🏿", "🤵", "🤵\u200d♀️", "🤵\u200d♂️", "🤵🏻", "🤵🏻\u200d♀️", "🤵🏻\u200d♂️", "🤵🏼", "🤵🏼\u200d♀️", "🤵🏼\u200d♂️", "🤵🏽", "🤵🏽\u200d♀️", "🤵🏽\u200d♂️", "🤵🏾", "🤵🏾\u200d♀️", "🤵🏾\u200d♂️", "🤵🏿", "🤵🏿\u200d♀️", "🤵🏿\u200d♂️", "🤶", "🤶🏻", "🤶🏼", "🤶🏽", "🤶🏾", "🤶🏿", "🤷", "🤷\u200d♀️", "🤷\u200d♂️", "🤷🏻", "🤷🏻\u200d♀️", "🤷🏻\u200d♂️", "🤷🏼", "🤷🏼\u200d♀️", "🤷🏼\u200d♂️", "🤷🏽", "🤷🏽\u200d♀️", "🤷🏽\u200d♂️", "🤷🏾", "🤷🏾\u200d♀️", "🤷🏾\u200d♂️", "🤷🏿", "🤷🏿\u200d♀️", "🤷🏿\u200d♂️", "🤸", "🤸\u200d♀️", "🤸\u200d♂️", "🤸🏻", "🤸🏻\u200d♀️", "🤸🏻\u200d♂️", "🤸🏼", "🤸🏼\u200d♀️", "🤸🏼\u200d♂️", "🤸🏽", "🤸🏽\u200d♀️", "🤸🏽\u200d♂️", "🤸🏾", "🤸🏾\u200d♀️", "🤸🏾\u200d♂️", "🤸🏿", "🤸🏿\u200d♀️", "🤸🏿\u200d♂️", "🤹", "🤹\u200d♀️", "🤹\u200d♂️", "🤹🏻", "🤹🏻\u200d♀️", "🤹🏻\u200d♂️", "🤹🏼", "🤹🏼\u200d♀️", "🤹🏼\u200d♂️", "🤹🏽", "🤹🏽\u200d♀️", "🤹🏽\u200d♂️", "🤹🏾", "🤹🏾\u200d♀️", "🤹🏾\u200d♂️", "🤹🏿", "🤹🏿\u200d♀️", "🤹🏿\u200d♂️", "🤺", "🤼", "🤼\u200d♀️", "🤼\u200d♂️", "🤽", "🤽\u200d♀️", "🤽\u200d♂️", "🤽🏻", "🤽🏻\u200d♀️", "🤽🏻\u200d♂️", "🤽🏼", "🤽🏼\u200d♀️", "🤽🏼\u200d♂️", "🤽🏽", "🤽🏽\u200d♀️", "🤽🏽\u200d♂️", "🤽🏾", "🤽🏾\u200d♀️", "🤽🏾\u200d♂️", "🤽🏿", "🤽🏿\u200d♀️", "🤽🏿\u200d♂️", "🤾", "🤾\u200d♀️", "🤾\u200d♂️", "🤾🏻", "🤾🏻\u200d♀️", "🤾🏻\u200d♂️", "🤾🏼", "🤾🏼\u200d♀️", "🤾🏼\u200d♂️", "🤾🏽", "🤾🏽\u200d♀️", "🤾🏽\u200d♂️", "🤾🏾", "🤾🏾\u200d♀️", "🤾🏾\u200d♂️", "🤾🏿", "🤾🏿\u200d♀️", "🤾🏿\u200d♂️", "🤿", "🥀", "🥁", "🥂", "🥃", "🥄", "🥅", "🥇", "🥈", "🥉", "🥊", "🥋", "🥌", "🥍", "🥎", "🥏", "🥐", "🥑", "🥒", "🥓", "🥔", "🥕", "🥖", "🥗", "🥘", "🥙", "🥚", "🥛", "🥜", "🥝", "🥞", "🥟", "🥠", "🥡", "🥢", "🥣", "🥤", "🥥", "🥦", "🥧", "🥨", "🥩", "🥪", "🥫", "🥬", "🥭", "🥮", "🥯", "🥰", "🥱", "🥲", "🥳", "🥴", "🥵", "🥶", "🥷", "🥷🏻", "🥷🏼", "🥷🏽", "🥷🏾", "🥷🏿", "🥸", "🥺", "🥻", "🥼", "🥽", "🥾", "🥿", "🦀", "🦁", "🦂", "🦃", "🦄", "🦅", "🦆", "🦇", "🦈", "🦉", "🦊", "🦋", "🦌", "🦍", "🦎", "🦏", "🦐", "🦑", "🦒", "🦓", "🦔", "🦕", "🦖", "🦗", "🦘", "🦙", "🦚", "🦛", "🦜", "🦝", "🦞", "🦟", "🦠", "🦡", "🦢", "🦣", "🦤", "🦥", "🦦", "🦧", "🦨", "🦩", "🦪", "🦫", "🦬", "🦭", "🦮", "🦯", "🦰", "🦱", "🦲", "🦳", "🦴", "🦵", "🦵🏻", "🦵🏼", "🦵🏽", "🦵🏾", "🦵🏿", "🦶", "🦶🏻", "🦶🏼", "🦶🏽", "🦶🏾", "🦶🏿", "🦷", "🦸", "🦸\u200d♀️", "🦸\u200d♂️", "🦸🏻", "🦸🏻\u200d♀️", "🦸🏻\u200d♂️", "🦸🏼", "🦸🏼\u200d♀️", "🦸🏼\u200d♂️", "🦸🏽", "🦸🏽\u200d♀️", "🦸🏽\u200d♂️", "🦸🏾", "🦸🏾\u200d♀️", "🦸🏾\u200d♂️", "🦸🏿", "🦸🏿\u200d♀️", "🦸🏿\u200d♂️", "🦹", "🦹\u200d♀️", "🦹\u200d♂️", "🦹🏻", "🦹🏻\u200d♀️", "🦹🏻\u200d♂️", "🦹🏼", "🦹🏼\u200d♀️", "🦹🏼\u200d♂️", "🦹🏽", "🦹🏽\u200d♀️", "🦹🏽\u200d♂️", "🦹🏾", "🦹🏾\u200d♀️", "🦹🏾\u200d♂️", "🦹🏿", "🦹🏿\u200d♀️", "🦹🏿\u200d♂️", "🦺", "🦻", "🦻🏻", "🦻🏼", "🦻🏽", "🦻🏾", "🦻🏿", "🦼", "🦽", "🦾", "🦿", "🧀", "🧁", "🧂", "🧃", "🧄", "🧅", "🧆", "🧇", "🧈", "🧉", "🧊", "🧋", "🧍", "🧍\u200d♀️", "🧍\u200d♂️", "🧍🏻", "🧍🏻\u200d♀️", "🧍🏻\u200d♂️", "🧍🏼", "🧍🏼\u200d♀️", "🧍🏼\u200d♂️", "🧍🏽", "🧍🏽\u200d♀️", "🧍🏽\u200d♂️", "🧍🏾", "🧍🏾\u200d♀️", "🧍🏾\u200d♂️", "🧍🏿", "🧍🏿\u200d♀️", "🧍🏿\u200d♂️", "🧎", "🧎\u200d♀️", "🧎\u200d♂️", "🧎🏻", "🧎🏻\u200d♀️", "🧎🏻\u200d♂️", "🧎🏼", "🧎🏼\u200d♀️", "🧎🏼\u200d♂️", "🧎🏽", "🧎🏽\u200d♀️", "🧎🏽\u200d♂️", "🧎🏾", "🧎🏾\u200d♀️", "🧎🏾\u200d♂️", "🧎🏿", "🧎🏿\u200d♀️", "🧎🏿\u200d♂️", "🧏", "🧏\u200d♀️", "🧏\u200d♂️", "🧏🏻", "🧏🏻\u200d♀️", "🧏🏻\u200d♂️", "🧏🏼", "🧏🏼\u200d♀️", "🧏🏼\u200d♂️", "🧏🏽", "🧏🏽\u200d♀️", "🧏🏽\u200d♂️", "🧏🏾", "🧏🏾\u200d♀️", "🧏🏾\u200d♂️", "🧏🏿", "🧏🏿\u200d♀️", "🧏🏿\u200d♂️", "🧐", "🧑", "🧑\u200d⚕️", "🧑\u200d⚖️", "🧑\u200d✈️", "🧑\u200d🌾", "🧑\u200d🍳", "🧑\u200d🍼", "🧑\u200d🎄", "🧑\u200d🎓", "🧑\u200d🎤", "🧑\u200d🎨", "🧑\u200d🏫", "🧑\u200d🏭", "🧑\u200d💻", "🧑\u200d💼", "🧑\u200d🔧", "🧑\u200d🔬", "🧑\u200d🚀", "🧑\u200d🚒", "🧑\u200d🤝\u200d🧑", "🧑\u200d🦯", "🧑\u200d🦰", "🧑\u200d🦱", "🧑\u200d🦲", "🧑\u200d🦳", "🧑\u200d🦼", "🧑\u200d🦽", "🧑🏻", "🧑🏻\u200d⚕️", "🧑🏻\u200d⚖️", "🧑🏻\u200d✈️", "🧑🏻\u200d🌾", "🧑🏻\u200d🍳", "🧑🏻\u200d🍼", "🧑🏻\u200d🎄", "🧑🏻\u200d🎓", "🧑🏻\u200d🎤", "🧑🏻\u200d🎨", "🧑🏻\u200d🏫", "🧑🏻\u200d🏭", "🧑🏻\u200d💻", "🧑🏻\u200d💼", "🧑🏻\u200d🔧", "🧑🏻\u200d🔬", "🧑🏻\u200d🚀", "🧑🏻\u200d🚒", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏻\u200d🦯", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏻\u200d🦼", "🧑🏻\u200d🦽", "🧑🏼", "🧑🏼\u200d⚕️", "🧑🏼\u200d⚖️", "🧑🏼\u200d✈️", "🧑🏼\u200d🌾", "🧑🏼\u200d🍳", "🧑🏼\u200d🍼", "🧑🏼\u200d🎄", "🧑🏼\u200d🎓", "🧑🏼\u200d🎤", "🧑🏼\u200d🎨", "🧑🏼\u200d🏫", "🧑🏼\u200d🏭", "🧑🏼\u200d💻", "🧑🏼\u200d💼", "🧑🏼\u200d🔧", "🧑🏼\u200d🔬", "🧑🏼\u200d🚀", "🧑🏼\u200d🚒", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🦯", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏼\u200d🦼", "🧑🏼\u200d🦽", "🧑🏽", "🧑🏽\u200d⚕️", "🧑🏽\u200d⚖️", "🧑🏽\u200d✈️", "🧑🏽\u200d🌾", "🧑🏽\u200d🍳", "🧑🏽\u200d🍼", "🧑🏽\u200d🎄", "🧑🏽\u200d🎓", "🧑🏽\u200d🎤", "🧑🏽\u200d🎨", "🧑🏽\u200d🏫", "🧑🏽\u200d🏭", "🧑🏽\u200d💻", "🧑🏽\u200d💼", "🧑🏽\u200d🔧", "🧑🏽\u200d🔬", "🧑🏽\u200d🚀", "🧑🏽\u200d🚒", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🦯", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏽\u200d🦼", "🧑🏽\u200d🦽", "🧑🏾", "🧑🏾\u200d⚕️", "🧑🏾\u200d⚖️", "🧑🏾\u200d✈️", "🧑🏾\u200d🌾", "🧑🏾\u200d🍳", "🧑🏾\u200d🍼", "🧑🏾\u200d🎄", "🧑🏾\u200d🎓", "🧑🏾\u200d🎤", "🧑🏾\u200d🎨", "🧑🏾\u200d🏫", "🧑🏾\u200d🏭", "🧑🏾\u200d💻", "🧑🏾\u200d💼", "🧑🏾\u200d🔧", "🧑🏾\u200d🔬", "🧑🏾\u200d🚀", "🧑🏾\u200d🚒", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🦯", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏾\u200d🦼", "🧑🏾\u200d🦽", "🧑🏿", "🧑🏿\u200d⚕️", "🧑🏿\u200d⚖️", "🧑🏿\u200d✈️", "🧑🏿\u200d🌾", "🧑🏿\u200d🍳", "🧑🏿\u200d🍼", "🧑🏿\u200d🎄", "🧑🏿\u200d🎓", "🧑🏿\u200d🎤", "🧑🏿\u200d🎨", "🧑🏿\u200d🏫", "🧑🏿\u200d🏭", "🧑🏿\u200d💻", "🧑🏿\u200d💼", "🧑🏿\u200d🔧", "🧑🏿\u200d🔬", "🧑🏿\u200d🚀", "🧑🏿\u200d🚒", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🦯", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧑🏿\u200d🦼", "🧑🏿\u200d🦽", "🧒", "🧒🏻", "🧒🏼", "🧒🏽", "🧒🏾", "🧒🏿", "🧓", "🧓🏻", "🧓🏼", "🧓🏽", "🧓🏾", "🧓🏿", "🧔", "🧔🏻", "🧔🏼", "🧔🏽", "🧔🏾", "🧔🏿", "🧕", "🧕🏻", "🧕🏼", "🧕🏽", "🧕🏾", "🧕🏿", "🧖", "🧖\u200d♀️", "🧖\u200d♂️", "🧖🏻", "🧖🏻\u200d♀️", "🧖🏻\u200d♂️", "🧖🏼", "🧖🏼\u200d♀️", "🧖🏼\u200d♂️", "🧖🏽", "🧖🏽\u200d♀️", "🧖🏽\u200d♂️", "🧖🏾", "🧖🏾\u200d♀️", "🧖🏾\u200d♂️", "🧖🏿", "🧖🏿\u200d♀️", "🧖🏿\u200d♂️", "🧗", "🧗\u200d♀️", "🧗\u200d♂️", "🧗🏻", "🧗🏻\u200d♀️", "🧗🏻\u200d♂️", "🧗🏼", "🧗🏼\u200d♀️", "🧗🏼\u200d♂️", "🧗🏽", "🧗🏽\u200d♀️", "🧗🏽\u200d♂️", "🧗🏾", "🧗🏾\u200d♀️", "🧗🏾\u200d♂️", "🧗🏿", "🧗🏿\u200d♀️", "🧗🏿\u200d♂️", "🧘", "🧘\u200d♀️", "🧘\u200d♂️", "🧘🏻", "🧘🏻\u200d♀️", "🧘🏻\u200d♂️", "🧘🏼", "🧘🏼\u200d♀️", "🧘🏼\u200d♂️", "🧘🏽", "🧘🏽\u200d♀️", "🧘🏽\u200d♂️", "🧘🏾", "🧘🏾\u200d♀️", "🧘🏾\u200d♂️", "🧘🏿", "🧘🏿\u200d♀️", "🧘🏿\u200d♂️", "🧙", "🧙\u200d♀️", "🧙\u200d♂️", "🧙🏻", "🧙🏻\u200d♀️", "🧙🏻\u200d♂️", "🧙🏼", "🧙🏼\u200d♀️", "🧙🏼\u200d♂️", "🧙🏽", "🧙🏽\u200d♀️", "🧙🏽\u200d♂️", "🧙🏾", "🧙🏾\u200d♀️", "🧙🏾\u200d♂️", "🧙🏿", "🧙🏿\u200d♀️", "🧙🏿\u200d♂️", "🧚", "🧚\u200d♀️", "🧚\u200d♂️", "🧚🏻", "🧚🏻\u200d♀️", "🧚🏻\u200d♂️", "🧚🏼", "🧚🏼\u200d♀️", "🧚🏼\u200d♂️", "🧚🏽", "🧚🏽\u200d♀️", "🧚🏽\u200d♂️", "🧚🏾", "🧚🏾\u200d♀️", "🧚🏾\u200d♂️", "🧚🏿", "🧚🏿\u200d♀️", "🧚🏿\u200d♂️", "🧛", "🧛\u200d♀️", "🧛\u200d♂️", "🧛🏻", "🧛🏻\u200d♀️", "🧛🏻\u200d♂️", "🧛🏼", "🧛🏼\u200d♀️", "🧛🏼\u200d♂️", "🧛🏽", "🧛🏽\u200d♀️", "🧛🏽\u200d♂️", "🧛🏾", "🧛🏾\u200d♀️", "🧛🏾\u200d♂️", "🧛🏿", "🧛🏿\u200d♀️", "🧛🏿\u200d♂️", "🧜", "🧜\u200d♀️", "🧜\u200d♂️", "🧜🏻", "🧜🏻\u200d♀️", "🧜🏻\u200d♂️", "🧜🏼", "🧜🏼\u200d♀️", "🧜🏼\u200d♂️", "🧜🏽", "🧜🏽\u200d♀️", "🧜🏽\u200d♂️", "🧜🏾", "🧜🏾\u200d♀️", "🧜🏾\u200d♂️", "🧜🏿", "🧜🏿\u200d♀️", "🧜🏿\u200d♂️", "🧝", "🧝\u200d♀️", "🧝\u200d♂️", "🧝🏻", "🧝🏻\u200d♀️", "🧝🏻\u200d♂️", "🧝🏼", "🧝🏼\u200d♀️", "🧝🏼\u200d♂️", "🧝🏽", "🧝🏽\u200d♀️", "🧝🏽\u200d♂️", "🧝🏾", "🧝🏾\u200d♀️", "🧝🏾\u200d♂️", "🧝🏿", "🧝🏿\u200d♀️", "🧝🏿\u200d♂️", "🧞", "🧞\u200d♀️", "🧞\u200d♂️", "🧟", "🧟\u200d♀️", "🧟\u200d♂️", "🧠", "🧡", "🧢", "🧣", "🧤", "🧥", "🧦", "🧧", "🧨", "🧩", "🧪", "🧫", "🧬", "🧭", "🧮", "🧯", "🧰", "🧱", "🧲", "🧳", "🧴", "🧵", "🧶", "🧷", "🧸", "🧹", "🧺", "🧻", "🧼", "🧽", "🧾", "🧿", "🩰", "🩱", "🩲", "🩳", "🩴", "🩸", "🩹", "🩺", "🪀", "🪁", "🪂", "🪃", "🪄", "🪅", "🪆", "🪐", "🪑", "🪒", "🪓", "🪔", "🪕", "🪖", "🪗", "🪘", "🪙", "🪚", "🪛", "🪜", "🪝", "🪞", "🪟", "🪠", "🪡", "🪢", "🪣", "🪤", "🪥", "🪦", "🪧", "🪨", "🪰", "🪱", "🪲", "🪳", "🪴", "🪵", "🪶", "🫀", "🫁", "🫂", "🫐", "🫑", "🫒", "🫓", "🫔", "🫕", "🫖"}
@@ -0,0 +1,184 @@
+package emoji
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"sync"
+)
+
+// Dialect is a set of colon shortcodes such as :thumbsup:
+// CLDR and GitHub are built in, other dialects such as the Slack or Discord ones are added with RegisterDialect
+type Dialect uint8
+
+const (
+	// CLDR shortcodes are the emoji-test.txt names in snake case such as :thumbs_up:, every emoji has one
+	CLDR Dialect = iota + 1
+	// GitHub shortcodes are the gemoji aliases known to GitHub such as :+1:
+	// emoji without such an alias, such as 👍🏽, have no GitHub shortcode
+	GitHub
+)
+
+var allDialects = []Dialect{CLDR, GitHub}
+
+// dialectNames are the names of the built in and registered dialects, indexed by Dialect
+var dialectNames = []string{"", "cldr", "github"}
+
+func (d Dialect) String() string {
+	dialectsMu.RLock()
+	defer dialectsMu.RUnlock()
+	if d == 0 || int(d) >= len(dialectNames) {
+		return "unknown"
+	}
+	return dialectNames[d]
+}
+
+// ErrUnknownDialect is returned by ParseDialect for unknown dialect names
+var ErrUnknownDialect = errors.New("emoji: unknown shortcode dialect")
+
+// ParseDialect returns the Dialect named name, as returned by Dialect.String
+// registered dialects are found too
+func ParseDialect(name string) (Dialect, error) {
+	dialectsMu.RLock()
+	defer dialectsMu.RUnlock()
+	if d, ok := lookupDialect(name); ok {
+		return d, nil
+	}
+	return 0, ErrUnknownDialect
+}
+
+func lookupDialect(name string) (Dialect, bool) {
+	for d := 1; d < len(dialectNames); d++ {
+		if strings.EqualFold(name, dialectNames[d]) {
+			return Dialect(d), true
+		}
+	}
+	return 0, false
+}
+
+// RegisterDialect adds the dialect name, whose codes map shortcodes without colons, such as "thumbsup", to their emoji
+// emoji are stored fully-qualified, an emoji named by several codes gets the shortest one, then the first in alphabetical order
+// RegisterDialect panics if name is empty or already used, names are compared ignoring case as in ParseDialect
+func RegisterDialect(name string, codes map[string]string) Dialect {
+	shortcodesOnce.Do(initShortcodes)
+	dialectsMu.Lock()
+	defer dialectsMu.Unlock()
+	if name == "" {
+		panic("emoji: RegisterDialect with an empty name")
+	}
+	if _, ok := lookupDialect(name); ok {
+		panic("emoji: RegisterDialect called twice for " + name)
+	}
+	if len(dialectNames) > math.MaxUint8 {
+		panic("emoji: too many dialects")
+	}
+	d := Dialect(len(dialectNames))
+	dialectNames = append(dialectNames, name)
+	toCode[d] = make(map[string]string, len(codes))
+	toEmoji[d] = make(map[string]string, len(codes))
+	for code, s := range codes {
+		s = Qualify(s)
+		toEmoji[d][code] = s
+		if prev, ok := toCode[d][s]; !ok || len(code) < len(prev) || len(code) == len(prev) && code < prev {
+			toCode[d][s] = code
+		}
+	}
+	return d
+}
+
+type shortcode struct {
+	sequence string
+	code     string
+	dialect  Dialect
+}
+
+// toCode and toEmoji map, for each dialect, fully-qualified emoji to their preferred shortcode
+// and every shortcode to its emoji
+// dialectsMu guards them and dialectNames against RegisterDialect
+var toCode, toEmoji map[Dialect]map[string]string
+var shortcodesOnce sync.Once
+var dialectsMu sync.RWMutex
+
+func initShortcodes() {
+	toCode = make(map[Dialect]map[string]string)
+	toEmoji = make(map[Dialect]map[string]string)
+	for _, d := range allDialects {
+		toCode[d] = make(map[string]string)
+		toEmoji[d] = make(map[string]string)
+	}
+	for _, c := range shortcodes {
+		if _, ok := toCode[c.dialect][c.sequence]; !ok {
+			toCode[c.dialect][c.sequence] = c.code
+		}
+		toEmoji[c.dialect][c.code] = c.sequence
+	}
+}
+
+// Shortcode returns the shortcode of the emoji s in the dialect d, colons included, such as ":+1:"
+// s must be exactly one emoji listed in emoji-test.txt, fully-qualified or not
+func Shortcode(s string, d Dialect) (string, bool) {
+	shortcodesOnce.Do(initShortcodes)
+	s = Qualify(s)
+	dialectsMu.RLock()
+	code, ok := toCode[d][s]
+	dialectsMu.RUnlock()
+	if !ok {
+		return "", false
+	}
+	return ":" + code + ":", true
+}
+
+// ShortcodeEmoji returns the fully-qualified emoji named by code in the dialect d
+// code can be given with or without its colons, ":+1:" or "+1"
+func ShortcodeEmoji(code string, d Dialect) (string, bool) {
+	shortcodesOnce.Do(initShortcodes)
+	if len(code) > 1 && code[0] == ':' && code[len(code)-1] == ':' {
+		code = code[1 : len(code)-1]
+	}
+	dialectsMu.RLock()
+	s, ok := toEmoji[d][code]
+	dialectsMu.RUnlock()
+	return s, ok
+}
+
+// ToShortcodes replaces every emoji of s that has a shortcode in the dialect d by this shortcode
+func ToShortcodes(s string, d Dialect) string {
+	return ReplaceString(s, -1, func(g string) string {
+		if code, ok := Shortcode(g, d); ok {
+			return code
+		}
+		return g
+	})
+}
+
+// FromShortcodes replaces every shortcode of the dialect d in s by its emoji
+// unknown shortcodes and lone colons are left untouched
+func FromShortcodes(s string, d Dialect) string {
+	shortcodesOnce.Do(initShortcodes)
+	dialectsMu.RLock()
+	defer dialectsMu.RUnlock()
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(s, ':')
+		if i == -1 {
+			break
+		}
+		j := strings.IndexByte(s[i+1:], ':')
+		if j == -1 {
+			break
+		}
+		j += i + 1
+		e, ok := toEmoji[d][s[i+1:j]]
+		if !ok {
+			// the closing colon can open the next shortcode
+			b.WriteString(s[:j])
+			s = s[j:]
+			continue
+		}
+		b.WriteString(s[:i])
+		s = s[j+1:]
+		b.WriteString(e)
+	}
+	b.WriteString(s)
+	return b.String()
+}
@@ -0,0 +1,137 @@
+package emoji
+
+import "testing"
+
+func Test_Shortcode(t *testing.T) {
+	tests := []struct {
+		s       string
+		dialect Dialect
+		code    string
+		ok      bool
+	}{
+		{"👍", CLDR, ":thumbs_up:", true},
+		{"👍", GitHub, ":+1:", true},
+		{"👍🏽", CLDR, ":thumbs_up_medium_skin_tone:", true},
+		{"👍🏽", GitHub, "", false},
+		{"😀", CLDR, ":grinning_face:", true},
+		{"😀", GitHub, ":grinning:", true},
+		{"4️⃣", GitHub, ":four:", true},
+		{"4⃣", GitHub, ":four:", true},
+		{"☺", CLDR, ":smiling_face:", true},
+		{"🇨🇮", CLDR, ":flag_cote_divoire:", true},
+		{"#️⃣", CLDR, ":keycap_hash:", true},
+		{"#️⃣", GitHub, ":hash:", true},
+		{"🦖", GitHub, ":t-rex:", true},
+		{"a", CLDR, "", false},
+		{"👍", Dialect(0), "", false},
+	}
+	for _, test := range tests {
+		code, ok := Shortcode(test.s, test.dialect)
+		if code != test.code || ok != test.ok {
+			t.Errorf("Shortcode(%q, %s) returned %q %v not %q %v", test.s, test.dialect, code, ok, test.code, test.ok)
+		}
+	}
+}
+
+func Test_ShortcodeEmoji(t *testing.T) {
+	tests := []struct {
+		code    string
+		dialect Dialect
+		s       string
+		ok      bool
+	}{
+		{":+1:", GitHub, "👍", true},
+		{"thumbsup", GitHub, "👍", true},
+		{"thumbs_up", GitHub, "", false},
+		{"thumbs_up", CLDR, "👍", true},
+		{"+1", CLDR, "", false},
+		{":cat:", GitHub, "🐱", true},
+		{":cat:", CLDR, "🐈", true},
+		{":nope:", GitHub, "", false},
+	}
+	for _, test := range tests {
+		s, ok := ShortcodeEmoji(test.code, test.dialect)
+		if s != test.s || ok != test.ok {
+			t.Errorf("ShortcodeEmoji(%q, %s) returned %q %v not %q %v", test.code, test.dialect, s, ok, test.s, test.ok)
+		}
+	}
+}
+
+func Test_FromShortcodes(t *testing.T) {
+	tests := []struct {
+		s        string
+		dialect  Dialect
+		expected string
+	}{
+		{"good :+1:", GitHub, "good 👍"},
+		{":+1::+1:", GitHub, "👍👍"},
+		{"time: 10:30 :tada:", GitHub, "time: 10:30 🎉"},
+		{"::tada:", GitHub, ":🎉"},
+		{":tada", GitHub, ":tada"},
+		{":+1::skin-tone-2:", GitHub, "👍:skin-tone-2:"},
+		{":thumbs_up_light_skin_tone:", CLDR, "👍🏻"},
+		{":thumbs_up: :unknown:", CLDR, "👍 :unknown:"},
+	}
+	for _, test := range tests {
+		if s := FromShortcodes(test.s, test.dialect); s != test.expected {
+			t.Errorf("FromShortcodes(%q, %s) returned %q not %q", test.s, test.dialect, s, test.expected)
+		}
+	}
+}
+
+func Test_ShortcodesRoundTrip(t *testing.T) {
+	for _, d := range allDialects {
+		for _, e := range metadata {
+			if e.status != FullyQualified {
+				continue
+			}
+			code := ToShortcodes(e.sequence, d)
+			// only the CLDR dialect names every emoji
+			if code == e.sequence && d == CLDR {
+				t.Errorf("ToShortcodes(%q, %s) has no shortcode", e.sequence, d)
+			}
+			if s := FromShortcodes(code, d); s != e.sequence {
+				t.Errorf("FromShortcodes(%q, %s) returned %q not %q", code, d, s, e.sequence)
+			}
+		}
+	}
+}
+
+func Test_ParseDialect(t *testing.T) {
+	for _, d := range allDialects {
+		if p, err := ParseDialect(d.String()); p != d || err != nil {
+			t.Errorf("ParseDialect(%q) returned %s %v", d.String(), p, err)
+		}
+	}
+	if _, err := ParseDialect("irc"); err != ErrUnknownDialect {
+		t.Errorf("ParseDialect(irc) returned %v", err)
+	}
+}
+
+func Test_RegisterDialect(t *testing.T) {
+	d, err := ParseDialect("test")
+	if err != nil {
+		d = RegisterDialect("test", map[string]string{"thumbsup": "👍", "+1": "👍", "smile": "☺"})
+	}
+	if p, err := ParseDialect("TEST"); p != d || err != nil || d.String() != "test" {
+		t.Errorf("ParseDialect(TEST) returned %s %v not %s", p, err, d)
+	}
+	if code, ok := Shortcode("👍", d); code != ":+1:" || !ok {
+		t.Errorf("Shortcode(👍, %s) returned %q %v", d, code, ok)
+	}
+	if s, ok := ShortcodeEmoji("smile", d); s != "☺️" || !ok {
+		t.Errorf("ShortcodeEmoji(smile, %s) returned %q %v", d, s, ok)
+	}
+	if s := FromShortcodes(":thumbsup: :smile: :cat:", d); s != "👍 ☺️ :cat:" {
+		t.Errorf("FromShortcodes returned %q", s)
+	}
+	if _, ok := Shortcode("👍", GitHub); !ok {
+		t.Errorf("registering %s changed the GitHub dialect", d)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RegisterDialect(GitHub) did not panic")
+		}
+	}()
+	RegisterDialect("GitHub", nil)
+}
@@ -0,0 +1,5150 @@
+// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+var shortcodes = []shortcode{
+	{"#️⃣", "keycap_hash", CLDR},
+	{"#️⃣", "hash", GitHub},
+	{"*️⃣", "keycap_asterisk", CLDR},
+	{"*️⃣", "asterisk", GitHub},
+	{"0️⃣", "keycap_0", CLDR},
+	{"0️⃣", "zero", GitHub},
+	{"1️⃣", "keycap_1", CLDR},
+	{"1️⃣", "one", GitHub},
+	{"2️⃣", "keycap_2", CLDR},
+	{"2️⃣", "two", GitHub},
+	{"3️⃣", "keycap_3", CLDR},
+	{"3️⃣", "three", GitHub},
+	{"4️⃣", "keycap_4", CLDR},
+	{"4️⃣", "four", GitHub},
+	{"5️⃣", "keycap_5", CLDR},
+	{"5️⃣", "five", GitHub},
+	{"6️⃣", "keycap_6", CLDR},
+	{"6️⃣", "six", GitHub},
+	{"7️⃣", "keycap_7", CLDR},
+	{"7️⃣", "seven", GitHub},
+	{"8️⃣", "keycap_8", CLDR},
+	{"8️⃣", "eight", GitHub},
+	{"9️⃣", "keycap_9", CLDR},
+	{"9️⃣", "nine", GitHub},
+	{"©️", "copyright", CLDR},
+	{"©️", "copyright", GitHub},
+	{"®️", "registered", CLDR},
+	{"®️", "registered", GitHub},
+	{"‼️", "double_exclamation_mark", CLDR},
+	{"‼️", "bangbang", GitHub},
+	{"⁉️", "exclamation_question_mark", CLDR},
+	{"⁉️", "interrobang", GitHub},
+	{"™️", "trade_mark", CLDR},
+	{"™️", "tm", GitHub},
+	{"ℹ️", "information", CLDR},
+	{"ℹ️", "information_source", GitHub},
+	{"↔️", "left_right_arrow", CLDR},
+	{"↔️", "left_right_arrow", GitHub},
+	{"↕️", "up_down_arrow", CLDR},
+	{"↕️", "arrow_up_down", GitHub},
+	{"↖️", "up_left_arrow", CLDR},
+	{"↖️", "arrow_upper_left", GitHub},
+	{"↗️", "up_right_arrow", CLDR},
+	{"↗️", "arrow_upper_right", GitHub},
+	{"↘️", "down_right_arrow", CLDR},
+	{"↘️", "arrow_lower_right", GitHub},
+	{"↙️", "down_left_arrow", CLDR},
+	{"↙️", "arrow_lower_left", GitHub},
+	{"↩️", "right_arrow_curving_left", CLDR},
+	{"↩️", "leftwards_arrow_with_hook", GitHub},
+	{"↪️", "left_arrow_curving_right", CLDR},
+	{"↪️", "arrow_right_hook", GitHub},
+	{"⌚", "watch", CLDR},
+	{"⌚", "watch", GitHub},
+	{"⌛", "hourglass_done", CLDR},
+	{"⌛", "hourglass", GitHub},
+	{"⌨️", "keyboard", CLDR},
+	{"⌨️", "keyboard", GitHub},
+	{"⏏️", "eject_button", CLDR},
+	{"⏏️", "eject_button", GitHub},
+	{"⏩", "fast_forward_button", CLDR},
+	{"⏩", "fast_forward", GitHub},
+	{"⏪", "fast_reverse_button", CLDR},
+	{"⏪", "rewind", GitHub},
+	{"⏫", "fast_up_button", CLDR},
+	{"⏫", "arrow_double_up", GitHub},
+	{"⏬", "fast_down_button", CLDR},
+	{"⏬", "arrow_double_down", GitHub},
+	{"⏭️", "next_track_button", CLDR},
+	{"⏭️", "next_track_button", GitHub},
+	{"⏮️", "last_track_button", CLDR},
+	{"⏮️", "previous_track_button", GitHub},
+	{"⏯️", "play_or_pause_button", CLDR},
+	{"⏯️", "play_or_pause_button", GitHub},
+	{"⏰", "alarm_clock", CLDR},
+	{"⏰", "alarm_clock", GitHub},
+	{"⏱️", "stopwatch", CLDR},
+	{"⏱️", "stopwatch", GitHub},
+	{"⏲️", "timer_clock", CLDR},
+	{"⏲️", "timer_clock", GitHub},
+	{"⏳", "hourglass_not_done", CLDR},
+	{"⏳", "hourglass_flowing_sand", GitHub},
+	{"⏸️", "pause_button", CLDR},
+	{"⏸️", "pause_button", GitHub},
+	{"⏹️", "stop_button", CLDR},
+	{"⏹️", "stop_button", GitHub},
+	{"⏺️", "record_button", CLDR},
+	{"⏺️", "record_button", GitHub},
+	{"Ⓜ️", "circled_m", CLDR},
+	{"Ⓜ️", "m", GitHub},
+	{"▪️", "black_small_square", CLDR},
+	{"▪️", "black_small_square", GitHub},
+	{"▫️", "white_small_square", CLDR},
+	{"▫️", "white_small_square", GitHub},
+	{"▶️", "play_button", CLDR},
+	{"▶️", "arrow_forward", GitHub},
+	{"◀️", "reverse_button", CLDR},
+	{"◀️", "arrow_backward", GitHub},
+	{"◻️", "white_medium_square", CLDR},
+	{"◻️", "white_medium_square", GitHub},
+	{"◼️", "black_medium_square", CLDR},
+	{"◼️", "black_medium_square", GitHub},
+	{"◽", "white_medium_small_square", CLDR},
+	{"◽", "white_medium_small_square", GitHub},
+	{"◾", "black_medium_small_square", CLDR},
+	{"◾", "black_medium_small_square", GitHub},
+	{"☀️", "sun", CLDR},
+	{"☀️", "sunny", GitHub},
+	{"☁️", "cloud", CLDR},
+	{"☁️", "cloud", GitHub},
+	{"☂️", "umbrella", CLDR},
+	{"☂️", "open_umbrella", GitHub},
+	{"☃️", "snowman", CLDR},
+	{"☃️", "snowman_with_snow", GitHub},
+	{"☄️", "comet", CLDR},
+	{"☄️", "comet", GitHub},
+	{"☎️", "telephone", CLDR},
+	{"☎️", "phone", GitHub},
+	{"☎️", "telephone", GitHub},
+	{"☑️", "check_box_with_check", CLDR},
+	{"☑️", "ballot_box_with_check", GitHub},
+	{"☔", "umbrella_with_rain_drops", CLDR},
+	{"☔", "umbrella", GitHub},
+	{"☕", "hot_beverage", CLDR},
+	{"☕", "coffee", GitHub},
+	{"☘️", "shamrock", CLDR},
+	{"☘️", "shamrock", GitHub},
+	{"☝️", "index_pointing_up", CLDR},
+	{"☝️", "point_up", GitHub},
+	{"☝🏻", "index_pointing_up_light_skin_tone", CLDR},
+	{"☝🏼", "index_pointing_up_medium_light_skin_tone", CLDR},
+	{"☝🏽", "index_pointing_up_medium_skin_tone", CLDR},
+	{"☝🏾", "index_pointing_up_medium_dark_skin_tone", CLDR},
+	{"☝🏿", "index_pointing_up_dark_skin_tone", CLDR},
+	{"☠️", "skull_and_crossbones", CLDR},
+	{"☠️", "skull_and_crossbones", GitHub},
+	{"☢️", "radioactive", CLDR},
+	{"☢️", "radioactive", GitHub},
+	{"☣️", "biohazard", CLDR},
+	{"☣️", "biohazard", GitHub},
+	{"☦️", "orthodox_cross", CLDR},
+	{"☦️", "orthodox_cross", GitHub},
+	{"☪️", "star_and_crescent", CLDR},
+	{"☪️", "star_and_crescent", GitHub},
+	{"☮️", "peace_symbol", CLDR},
+	{"☮️", "peace_symbol", GitHub},
+	{"☯️", "yin_yang", CLDR},
+	{"☯️", "yin_yang", GitHub},
+	{"☸️", "wheel_of_dharma", CLDR},
+	{"☸️", "wheel_of_dharma", GitHub},
+	{"☹️", "frowning_face", CLDR},
+	{"☹️", "frowning_face", GitHub},
+	{"☺️", "smiling_face", CLDR},
+	{"☺️", "relaxed", GitHub},
+	{"♀️", "female_sign", CLDR},
+	{"♀️", "female_sign", GitHub},
+	{"♂️", "male_sign", CLDR},
+	{"♂️", "male_sign", GitHub},
+	{"♈", "aries", CLDR},
+	{"♈", "aries", GitHub},
+	{"♉", "taurus", CLDR},
+	{"♉", "taurus", GitHub},
+	{"♊", "gemini", CLDR},
+	{"♊", "gemini", GitHub},
+	{"♋", "cancer", CLDR},
+	{"♋", "cancer", GitHub},
+	{"♌", "leo", CLDR},
+	{"♌", "leo", GitHub},
+	{"♍", "virgo", CLDR},
+	{"♍", "virgo", GitHub},
+	{"♎", "libra", CLDR},
+	{"♎", "libra", GitHub},
+	{"♏", "scorpio", CLDR},
+	{"♏", "scorpius", GitHub},
+	{"♐", "sagittarius", CLDR},
+	{"♐", "sagittarius", GitHub},
+	{"♑", "capricorn", CLDR},
+	{"♑", "capricorn", GitHub},
+	{"♒", "aquarius", CLDR},
+	{"♒", "aquarius", GitHub},
+	{"♓", "pisces", CLDR},
+	{"♓", "pisces", GitHub},
+	{"♟️", "chess_pawn", CLDR},
+	{"♟️", "chess_pawn", GitHub},
+	{"♠️", "spade_suit", CLDR},
+	{"♠️", "spades", GitHub},
+	{"♣️", "club_suit", CLDR},
+	{"♣️", "clubs", GitHub},
+	{"♥️", "heart_suit", CLDR},
+	{"♥️", "hearts", GitHub},
+	{"♦️", "diamond_suit", CLDR},
+	{"♦️", "diamonds", GitHub},
+	{"♨️", "hot_springs", CLDR},
+	{"♨️", "hotsprings", GitHub},
+	{"♻️", "recycling_symbol", CLDR},
+	{"♻️", "recycle", GitHub},
+	{"♾️", "infinity", CLDR},
+	{"♾️", "infinity", GitHub},
+	{"♿", "wheelchair_symbol", CLDR},
+	{"♿", "wheelchair", GitHub},
+	{"⚒️", "hammer_and_pick", CLDR},
+	{"⚒️", "hammer_and_pick", GitHub},
+	{"⚓", "anchor", CLDR},
+	{"⚓", "anchor", GitHub},
+	{"⚔️", "crossed_swords", CLDR},
+	{"⚔️", "crossed_swords", GitHub},
+	{"⚕️", "medical_symbol", CLDR},
+	{"⚕️", "medical_symbol", GitHub},
+	{"⚖️", "balance_scale", CLDR},
+	{"⚖️", "balance_scale", GitHub},
+	{"⚗️", "alembic", CLDR},
+	{"⚗️", "alembic", GitHub},
+	{"⚙️", "gear", CLDR},
+	{"⚙️", "gear", GitHub},
+	{"⚛️", "atom_symbol", CLDR},
+	{"⚛️", "atom_symbol", GitHub},
+	{"⚜️", "fleur_de_lis", CLDR},
+	{"⚜️", "fleur_de_lis", GitHub},
+	{"⚠️", "warning", CLDR},
+	{"⚠️", "warning", GitHub},
+	{"⚡", "high_voltage", CLDR},
+	{"⚡", "zap", GitHub},
+	{"⚧️", "transgender_symbol", CLDR},
+	{"⚧️", "transgender_symbol", GitHub},
+	{"⚪", "white_circle", CLDR},
+	{"⚪", "white_circle", GitHub},
+	{"⚫", "black_circle", CLDR},
+	{"⚫", "black_circle", GitHub},
+	{"⚰️", "coffin", CLDR},
+	{"⚰️", "coffin", GitHub},
+	{"⚱️", "funeral_urn", CLDR},
+	{"⚱️", "funeral_urn", GitHub},
+	{"⚽", "soccer_ball", CLDR},
+	{"⚽", "soccer", GitHub},
+	{"⚾", "baseball", CLDR},
+	{"⚾", "baseball", GitHub},
+	{"⛄", "snowman_without_snow", CLDR},
+	{"⛄", "snowman", GitHub},
+	{"⛅", "sun_behind_cloud", CLDR},
+	{"⛅", "partly_sunny", GitHub},
+	{"⛈️", "cloud_with_lightning_and_rain", CLDR},
+	{"⛈️", "cloud_with_lightning_and_rain", GitHub},
+	{"⛎", "ophiuchus", CLDR},
+	{"⛎", "ophiuchus", GitHub},
+	{"⛏️", "pick", CLDR},
+	{"⛏️", "pick", GitHub},
+	{"⛑️", "rescue_workers_helmet", CLDR},
+	{"⛑️", "rescue_worker_helmet", GitHub},
+	{"⛓️", "chains", CLDR},
+	{"⛓️", "chains", GitHub},
+	{"⛔", "no_entry", CLDR},
+	{"⛔", "no_entry", GitHub},
+	{"⛩️", "shinto_shrine", CLDR},
+	{"⛩️", "shinto_shrine", GitHub},
+	{"⛪", "church", CLDR},
+	{"⛪", "church", GitHub},
+	{"⛰️", "mountain", CLDR},
+	{"⛰️", "mountain", GitHub},
+	{"⛱️", "umbrella_on_ground", CLDR},
+	{"⛱️", "parasol_on_ground", GitHub},
+	{"⛲", "fountain", CLDR},
+	{"⛲", "fountain", GitHub},
+	{"⛳", "flag_in_hole", CLDR},
+	{"⛳", "golf", GitHub},
+	{"⛴️", "ferry", CLDR},
+	{"⛴️", "ferry", GitHub},
+	{"⛵", "sailboat", CLDR},
+	{"⛵", "boat", GitHub},
+	{"⛵", "sailboat", GitHub},
+	{"⛷️", "skier", CLDR},
+	{"⛷️", "skier", GitHub},
+	{"⛸️", "ice_skate", CLDR},
+	{"⛸️", "ice_skate", GitHub},
+	{"⛹️", "person_bouncing_ball", CLDR},
+	{"⛹️", "bouncing_ball_person", GitHub},
+	{"⛹️\u200d♀️", "woman_bouncing_ball", CLDR},
+	{"⛹️\u200d♀️", "bouncing_ball_woman", GitHub},
+	{"⛹️\u200d♀️", "basketball_woman", GitHub},
+	{"⛹️\u200d♂️", "man_bouncing_ball", CLDR},
+	{"⛹️\u200d♂️", "bouncing_ball_man", GitHub},
+	{"⛹️\u200d♂️", "basketball_man", GitHub},
+	{"⛹🏻", "person_bouncing_ball_light_skin_tone", CLDR},
+	{"⛹🏻\u200d♀️", "woman_bouncing_ball_light_skin_tone", CLDR},
+	{"⛹🏻\u200d♂️", "man_bouncing_ball_light_skin_tone", CLDR},
+	{"⛹🏼", "person_bouncing_ball_medium_light_skin_tone", CLDR},
+	{"⛹🏼\u200d♀️", "woman_bouncing_ball_medium_light_skin_tone", CLDR},
+	{"⛹🏼\u200d♂️", "man_bouncing_ball_medium_light_skin_tone", CLDR},
+	{"⛹🏽", "person_bouncing_ball_medium_skin_tone", CLDR},
+	{"⛹🏽\u200d♀️", "woman_bouncing_ball_medium_skin_tone", CLDR},
+	{"⛹🏽\u200d♂️", "man_bouncing_ball_medium_skin_tone", CLDR},
+	{"⛹🏾", "person_bouncing_ball_medium_dark_skin_tone", CLDR},
+	{"⛹🏾\u200d♀️", "woman_bouncing_ball_medium_dark_skin_tone", CLDR},
+	{"⛹🏾\u200d♂️", "man_bouncing_ball_medium_dark_skin_tone", CLDR},
+	{"⛹🏿", "person_bouncing_ball_dark_skin_tone", CLDR},
+	{"⛹🏿\u200d♀️", "woman_bouncing_ball_dark_skin_tone", CLDR},
+	{"⛹🏿\u200d♂️", "man_bouncing_ball_dark_skin_tone", CLDR},
+	{"⛺", "tent", CLDR},
+	{"⛺", "tent", GitHub},
+	{"⛽", "fuel_pump", CLDR},
+	{"⛽", "fuelpump", GitHub},
+	{"✂️", "scissors", CLDR},
+	{"✂️", "scissors", GitHub},
+	{"✅", "check_mark_button", CLDR},
+	{"✅", "white_check_mark", GitHub},
+	{"✈️", "airplane", CLDR},
+	{"✈️", "airplane", GitHub},
+	{"✉️", "envelope", CLDR},
+	{"✉️", "envelope", GitHub},
+	{"✊", "raised_fist", CLDR},
+	{"✊", "fist_raised", GitHub},
+	{"✊", "fist", GitHub},
+	{"✊🏻", "raised_fist_light_skin_tone", CLDR},
+	{"✊🏼", "raised_fist_medium_light_skin_tone", CLDR},
+	{"✊🏽", "raised_fist_medium_skin_tone", CLDR},
+	{"✊🏾", "raised_fist_medium_dark_skin_tone", CLDR},
+	{"✊🏿", "raised_fist_dark_skin_tone", CLDR},
+	{"✋", "raised_hand", CLDR},
+	{"✋", "hand", GitHub},
+	{"✋", "raised_hand", GitHub},
+	{"✋🏻", "raised_hand_light_skin_tone", CLDR},
+	{"✋🏼", "raised_hand_medium_light_skin_tone", CLDR},
+	{"✋🏽", "raised_hand_medium_skin_tone", CLDR},
+	{"✋🏾", "raised_hand_medium_dark_skin_tone", CLDR},
+	{"✋🏿", "raised_hand_dark_skin_tone", CLDR},
+	{"✌️", "victory_hand", CLDR},
+	{"✌️", "v", GitHub},
+	{"✌🏻", "victory_hand_light_skin_tone", CLDR},
+	{"✌🏼", "victory_hand_medium_light_skin_tone", CLDR},
+	{"✌🏽", "victory_hand_medium_skin_tone", CLDR},
+	{"✌🏾", "victory_hand_medium_dark_skin_tone", CLDR},
+	{"✌🏿", "victory_hand_dark_skin_tone", CLDR},
+	{"✍️", "writing_hand", CLDR},
+	{"✍️", "writing_hand", GitHub},
+	{"✍🏻", "writing_hand_light_skin_tone", CLDR},
+	{"✍🏼", "writing_hand_medium_light_skin_tone", CLDR},
+	{"✍🏽", "writing_hand_medium_skin_tone", CLDR},
+	{"✍🏾", "writing_hand_medium_dark_skin_tone", CLDR},
+	{"✍🏿", "writing_hand_dark_skin_tone", CLDR},
+	{"✏️", "pencil", CLDR},
+	{"✏️", "pencil2", GitHub},
+	{"✒️", "black_nib", CLDR},
+	{"✒️", "black_nib", GitHub},
+	{"✔️", "check_mark", CLDR},
+	{"✔️", "heavy_check_mark", GitHub},
+	{"✖️", "multiply", CLDR},
+	{"✖️", "heavy_multiplication_x", GitHub},
+	{"✝️", "latin_cross", CLDR},
+	{"✝️", "latin_cross", GitHub},
+	{"✡️", "star_of_david", CLDR},
+	{"✡️", "star_of_david", GitHub},
+	{"✨", "sparkles", CLDR},
+	{"✨", "sparkles", GitHub},
+	{"✳️", "eight_spoked_asterisk", CLDR},
+	{"✳️", "eight_spoked_asterisk", GitHub},
+	{"✴️", "eight_pointed_star", CLDR},
+	{"✴️", "eight_pointed_black_star", GitHub},
+	{"❄️", "snowflake", CLDR},
+	{"❄️", "snowflake", GitHub},
+	{"❇️", "sparkle", CLDR},
+	{"❇️", "sparkle", GitHub},
+	{"❌", "cross_mark", CLDR},
+	{"❌", "x", GitHub},
+	{"❎", "cross_mark_button", CLDR},
+	{"❎", "negative_squared_cross_mark", GitHub},
+	{"❓", "red_question_mark", CLDR},
+	{"❓", "question", GitHub},
+	{"❔", "white_question_mark", CLDR},
+	{"❔", "grey_question", GitHub},
+	{"❕", "white_exclamation_mark", CLDR},
+	{"❕", "grey_exclamation", GitHub},
+	{"❗", "red_exclamation_mark", CLDR},
+	{"❗", "exclamation", GitHub},
+	{"❗", "heavy_exclamation_mark", GitHub},
+	{"❣️", "heart_exclamation", CLDR},
+	{"❣️", "heavy_heart_exclamation", GitHub},
+	{"❤️", "red_heart", CLDR},
+	{"❤️", "heart", GitHub},
+	{"➕", "plus", CLDR},
+	{"➕", "heavy_plus_sign", GitHub},
+	{"➖", "minus", CLDR},
+	{"➖", "heavy_minus_sign", GitHub},
+	{"➗", "divide", CLDR},
+	{"➗", "heavy_division_sign", GitHub},
+	{"➡️", "right_arrow", CLDR},
+	{"➡️", "arrow_right", GitHub},
+	{"➰", "curly_loop", CLDR},
+	{"➰", "curly_loop", GitHub},
+	{"➿", "double_curly_loop", CLDR},
+	{"➿", "loop", GitHub},
+	{"⤴️", "right_arrow_curving_up", CLDR},
+	{"⤴️", "arrow_heading_up", GitHub},
+	{"⤵️", "right_arrow_curving_down", CLDR},
+	{"⤵️", "arrow_heading_down", GitHub},
+	{"⬅️", "left_arrow", CLDR},
+	{"⬅️", "arrow_left", GitHub},
+	{"⬆️", "up_arrow", CLDR},
+	{"⬆️", "arrow_up", GitHub},
+	{"⬇️", "down_arrow", CLDR},
+	{"⬇️", "arrow_down", GitHub},
+	{"⬛", "black_large_square", CLDR},
+	{"⬛", "black_large_square", GitHub},
+	{"⬜", "white_large_square", CLDR},
+	{"⬜", "white_large_square", GitHub},
+	{"⭐", "star", CLDR},
+	{"⭐", "star", GitHub},
+	{"⭕", "hollow_red_circle", CLDR},
+	{"⭕", "o", GitHub},
+	{"〰️", "wavy_dash", CLDR},
+	{"〰️", "wavy_dash", GitHub},
+	{"〽️", "part_alternation_mark", CLDR},
+	{"〽️", "part_alternation_mark", GitHub},
+	{"㊗️", "japanese_congratulations_button", CLDR},
+	{"㊗️", "congratulations", GitHub},
+	{"㊙️", "japanese_secret_button", CLDR},
+	{"㊙️", "secret", GitHub},
+	{"🀄", "mahjong_red_dragon", CLDR},
+	{"🀄", "mahjong", GitHub},
+	{"🃏", "joker", CLDR},
+	{"🃏", "black_joker", GitHub},
+	{"🅰️", "a_button_blood_type", CLDR},
+	{"🅰️", "a", GitHub},
+	{"🅱️", "b_button_blood_type", CLDR},
+	{"🅱️", "b", GitHub},
+	{"🅾️", "o_button_blood_type", CLDR},
+	{"🅾️", "o2", GitHub},
+	{"🅿️", "p_button", CLDR},
+	{"🅿️", "parking", GitHub},
+	{"🆎", "ab_button_blood_type", CLDR},
+	{"🆎", "ab", GitHub},
+	{"🆑", "cl_button", CLDR},
+	{"🆑", "cl", GitHub},
+	{"🆒", "cool_button", CLDR},
+	{"🆒", "cool", GitHub},
+	{"🆓", "free_button", CLDR},
+	{"🆓", "free", GitHub},
+	{"🆔", "id_button", CLDR},
+	{"🆔", "id", GitHub},
+	{"🆕", "new_button", CLDR},
+	{"🆕", "new", GitHub},
+	{"🆖", "ng_button", CLDR},
+	{"🆖", "ng", GitHub},
+	{"🆗", "ok_button", CLDR},
+	{"🆗", "ok", GitHub},
+	{"🆘", "sos_button", CLDR},
+	{"🆘", "sos", GitHub},
+	{"🆙", "up_button", CLDR},
+	{"🆙", "up", GitHub},
+	{"🆚", "vs_button", CLDR},
+	{"🆚", "vs", GitHub},
+	{"🇦🇨", "flag_ascension_island", CLDR},
+	{"🇦🇨", "ascension_island", GitHub},
+	{"🇦🇩", "flag_andorra", CLDR},
+	{"🇦🇩", "andorra", GitHub},
+	{"🇦🇪", "flag_united_arab_emirates", CLDR},
+	{"🇦🇪", "united_arab_emirates", GitHub},
+	{"🇦🇫", "flag_afghanistan", CLDR},
+	{"🇦🇫", "afghanistan", GitHub},
+	{"🇦🇬", "flag_antigua_and_barbuda", CLDR},
+	{"🇦🇬", "antigua_barbuda", GitHub},
+	{"🇦🇮", "flag_anguilla", CLDR},
+	{"🇦🇮", "anguilla", GitHub},
+	{"🇦🇱", "flag_albania", CLDR},
+	{"🇦🇱", "albania", GitHub},
+	{"🇦🇲", "flag_armenia", CLDR},
+	{"🇦🇲", "armenia", GitHub},
+	{"🇦🇴", "flag_angola", CLDR},
+	{"🇦🇴", "angola", GitHub},
+	{"🇦🇶", "flag_antarctica", CLDR},
+	{"🇦🇶", "antarctica", GitHub},
+	{"🇦🇷", "flag_argentina", CLDR},
+	{"🇦🇷", "argentina", GitHub},
+	{"🇦🇸", "flag_american_samoa", CLDR},
+	{"🇦🇸", "american_samoa", GitHub},
+	{"🇦🇹", "flag_austria", CLDR},
+	{"🇦🇹", "austria", GitHub},
+	{"🇦🇺", "flag_australia", CLDR},
+	{"🇦🇺", "australia", GitHub},
+	{"🇦🇼", "flag_aruba", CLDR},
+	{"🇦🇼", "aruba", GitHub},
+	{"🇦🇽", "flag_aland_islands", CLDR},
+	{"🇦🇽", "aland_islands", GitHub},
+	{"🇦🇿", "flag_azerbaijan", CLDR},
+	{"🇦🇿", "azerbaijan", GitHub},
+	{"🇧🇦", "flag_bosnia_and_herzegovina", CLDR},
+	{"🇧🇦", "bosnia_herzegovina", GitHub},
+	{"🇧🇧", "flag_barbados", CLDR},
+	{"🇧🇧", "barbados", GitHub},
+	{"🇧🇩", "flag_bangladesh", CLDR},
+	{"🇧🇩", "bangladesh", GitHub},
+	{"🇧🇪", "flag_belgium", CLDR},
+	{"🇧🇪", "belgium", GitHub},
+	{"🇧🇫", "flag_burkina_faso", CLDR},
+	{"🇧🇫", "burkina_faso", GitHub},
+	{"🇧🇬", "flag_bulgaria", CLDR},
+	{"🇧🇬", "bulgaria", GitHub},
+	{"🇧🇭", "flag_bahrain", CLDR},
+	{"🇧🇭", "bahrain", GitHub},
+	{"🇧🇮", "flag_burundi", CLDR},
+	{"🇧🇮", "burundi", GitHub},
+	{"🇧🇯", "flag_benin", CLDR},
+	{"🇧🇯", "benin", GitHub},
+	{"🇧🇱", "flag_st_barthelemy", CLDR},
+	{"🇧🇱", "st_barthelemy", GitHub},
+	{"🇧🇲", "flag_bermuda", CLDR},
+	{"🇧🇲", "bermuda", GitHub},
+	{"🇧🇳", "flag_brunei", CLDR},
+	{"🇧🇳", "brunei", GitHub},
+	{"🇧🇴", "flag_bolivia", CLDR},
+	{"🇧🇴", "bolivia", GitHub},
+	{"🇧🇶", "flag_caribbean_netherlands", CLDR},
+	{"🇧🇶", "caribbean_netherlands", GitHub},
+	{"🇧🇷", "flag_brazil", CLDR},
+	{"🇧🇷", "brazil", GitHub},
+	{"🇧🇸", "flag_bahamas", CLDR},
+	{"🇧🇸", "bahamas", GitHub},
+	{"🇧🇹", "flag_bhutan", CLDR},
+	{"🇧🇹", "bhutan", GitHub},
+	{"🇧🇻", "flag_bouvet_island", CLDR},
+	{"🇧🇻", "bouvet_island", GitHub},
+	{"🇧🇼", "flag_botswana", CLDR},
+	{"🇧🇼", "botswana", GitHub},
+	{"🇧🇾", "flag_belarus", CLDR},
+	{"🇧🇾", "belarus", GitHub},
+	{"🇧🇿", "flag_belize", CLDR},
+	{"🇧🇿", "belize", GitHub},
+	{"🇨🇦", "flag_canada", CLDR},
+	{"🇨🇦", "canada", GitHub},
+	{"🇨🇨", "flag_cocos_keeling_islands", CLDR},
+	{"🇨🇨", "cocos_islands", GitHub},
+	{"🇨🇩", "flag_congo_kinshasa", CLDR},
+	{"🇨🇩", "congo_kinshasa", GitHub},
+	{"🇨🇫", "flag_central_african_republic", CLDR},
+	{"🇨🇫", "central_african_republic", GitHub},
+	{"🇨🇬", "flag_congo_brazzaville", CLDR},
+	{"🇨🇬", "congo_brazzaville", GitHub},
+	{"🇨🇭", "flag_switzerland", CLDR},
+	{"🇨🇭", "switzerland", GitHub},
+	{"🇨🇮", "flag_cote_divoire", CLDR},
+	{"🇨🇮", "cote_divoire", GitHub},
+	{"🇨🇰", "flag_cook_islands", CLDR},
+	{"🇨🇰", "cook_islands", GitHub},
+	{"🇨🇱", "flag_chile", CLDR},
+	{"🇨🇱", "chile", GitHub},
+	{"🇨🇲", "flag_cameroon", CLDR},
+	{"🇨🇲", "cameroon", GitHub},
+	{"🇨🇳", "flag_china", CLDR},
+	{"🇨🇳", "cn", GitHub},
+	{"🇨🇴", "flag_colombia", CLDR},
+	{"🇨🇴", "colombia", GitHub},
+	{"🇨🇵", "flag_clipperton_island", CLDR},
+	{"🇨🇵", "clipperton_island", GitHub},
+	{"🇨🇷", "flag_costa_rica", CLDR},
+	{"🇨🇷", "costa_rica", GitHub},
+	{"🇨🇺", "flag_cuba", CLDR},
+	{"🇨🇺", "cuba", GitHub},
+	{"🇨🇻", "flag_cape_verde", CLDR},
+	{"🇨🇻", "cape_verde", GitHub},
+	{"🇨🇼", "flag_curacao", CLDR},
+	{"🇨🇼", "curacao", GitHub},
+	{"🇨🇽", "flag_christmas_island", CLDR},
+	{"🇨🇽", "christmas_island", GitHub},
+	{"🇨🇾", "flag_cyprus", CLDR},
+	{"🇨🇾", "cyprus", GitHub},
+	{"🇨🇿", "flag_czechia", CLDR},
+	{"🇨🇿", "czech_republic", GitHub},
+	{"🇩🇪", "flag_germany", CLDR},
+	{"🇩🇪", "de", GitHub},
+	{"🇩🇬", "flag_diego_garcia", CLDR},
+	{"🇩🇬", "diego_garcia", GitHub},
+	{"🇩🇯", "flag_djibouti", CLDR},
+	{"🇩🇯", "djibouti", GitHub},
+	{"🇩🇰", "flag_denmark", CLDR},
+	{"🇩🇰", "denmark", GitHub},
+	{"🇩🇲", "flag_dominica", CLDR},
+	{"🇩🇲", "dominica", GitHub},
+	{"🇩🇴", "flag_dominican_republic", CLDR},
+	{"🇩🇴", "dominican_republic", GitHub},
+	{"🇩🇿", "flag_algeria", CLDR},
+	{"🇩🇿", "algeria", GitHub},
+	{"🇪🇦", "flag_ceuta_and_melilla", CLDR},
+	{"🇪🇦", "ceuta_melilla", GitHub},
+	{"🇪🇨", "flag_ecuador", CLDR},
+	{"🇪🇨", "ecuador", GitHub},
+	{"🇪🇪", "flag_estonia", CLDR},
+	{"🇪🇪", "estonia", GitHub},
+	{"🇪🇬", "flag_egypt", CLDR},
+	{"🇪🇬", "egypt", GitHub},
+	{"🇪🇭", "flag_western_sahara", CLDR},
+	{"🇪🇭", "western_sahara", GitHub},
+	{"🇪🇷", "flag_eritrea", CLDR},
+	{"🇪🇷", "eritrea", GitHub},
+	{"🇪🇸", "flag_spain", CLDR},
+	{"🇪🇸", "es", GitHub},
+	{"🇪🇹", "flag_ethiopia", CLDR},
+	{"🇪🇹", "ethiopia", GitHub},
+	{"🇪🇺", "flag_european_union", CLDR},
+	{"🇪🇺", "eu", GitHub},
+	{"🇪🇺", "european_union", GitHub},
+	{"🇫🇮", "flag_finland", CLDR},
+	{"🇫🇮", "finland", GitHub},
+	{"🇫🇯", "flag_fiji", CLDR},
+	{"🇫🇯", "fiji", GitHub},
+	{"🇫🇰", "flag_falkland_islands", CLDR},
+	{"🇫🇰", "falkland_islands", GitHub},
+	{"🇫🇲", "flag_micronesia", CLDR},
+	{"🇫🇲", "micronesia", GitHub},
+	{"🇫🇴", "flag_faroe_islands", CLDR},
+	{"🇫🇴", "faroe_islands", GitHub},
+	{"🇫🇷", "flag_france", CLDR},
+	{"🇫🇷", "fr", GitHub},
+	{"🇬🇦", "flag_gabon", CLDR},
+	{"🇬🇦", "gabon", GitHub},
+	{"🇬🇧", "flag_united_kingdom", CLDR},
+	{"🇬🇧", "gb", GitHub},
+	{"🇬🇧", "uk", GitHub},
+	{"🇬🇩", "flag_grenada", CLDR},
+	{"🇬🇩", "grenada", GitHub},
+	{"🇬🇪", "flag_georgia", CLDR},
+	{"🇬🇪", "georgia", GitHub},
+	{"🇬🇫", "flag_french_guiana", CLDR},
+	{"🇬🇫", "french_guiana", GitHub},
+	{"🇬🇬", "flag_guernsey", CLDR},
+	{"🇬🇬", "guernsey", GitHub},
+	{"🇬🇭", "flag_ghana", CLDR},
+	{"🇬🇭", "ghana", GitHub},
+	{"🇬🇮", "flag_gibraltar", CLDR},
+	{"🇬🇮", "gibraltar", GitHub},
+	{"🇬🇱", "flag_greenland", CLDR},
+	{"🇬🇱", "greenland", GitHub},
+	{"🇬🇲", "flag_gambia", CLDR},
+	{"🇬🇲", "gambia", GitHub},
+	{"🇬🇳", "flag_guinea", CLDR},
+	{"🇬🇳", "guinea", GitHub},
+	{"🇬🇵", "flag_guadeloupe", CLDR},
+	{"🇬🇵", "guadeloupe", GitHub},
+	{"🇬🇶", "flag_equatorial_guinea", CLDR},
+	{"🇬🇶", "equatorial_guinea", GitHub},
+	{"🇬🇷", "flag_greece", CLDR},
+	{"🇬🇷", "greece", GitHub},
+	{"🇬🇸", "flag_south_georgia_and_south_sandwich_islands", CLDR},
+	{"🇬🇸", "south_georgia_south_sandwich_islands", GitHub},
+	{"🇬🇹", "flag_guatemala", CLDR},
+	{"🇬🇹", "guatemala", GitHub},
+	{"🇬🇺", "flag_guam", CLDR},
+	{"🇬🇺", "guam", GitHub},
+	{"🇬🇼", "flag_guinea_bissau", CLDR},
+	{"🇬🇼", "guinea_bissau", GitHub},
+	{"🇬🇾", "flag_guyana", CLDR},
+	{"🇬🇾", "guyana", GitHub},
+	{"🇭🇰", "flag_hong_kong_sar_china", CLDR},
+	{"🇭🇰", "hong_kong", GitHub},
+	{"🇭🇲", "flag_heard_and_mcdonald_islands", CLDR},
+	{"🇭🇲", "heard_mcdonald_islands", GitHub},
+	{"🇭🇳", "flag_honduras", CLDR},
+	{"🇭🇳", "honduras", GitHub},
+	{"🇭🇷", "flag_croatia", CLDR},
+	{"🇭🇷", "croatia", GitHub},
+	{"🇭🇹", "flag_haiti", CLDR},
+	{"🇭🇹", "haiti", GitHub},
+	{"🇭🇺", "flag_hungary", CLDR},
+	{"🇭🇺", "hungary", GitHub},
+	{"🇮🇨", "flag_canary_islands", CLDR},
+	{"🇮🇨", "canary_islands", GitHub},
+	{"🇮🇩", "flag_indonesia", CLDR},
+	{"🇮🇩", "indonesia", GitHub},
+	{"🇮🇪", "flag_ireland", CLDR},
+	{"🇮🇪", "ireland", GitHub},
+	{"🇮🇱", "flag_israel", CLDR},
+	{"🇮🇱", "israel", GitHub},
+	{"🇮🇲", "flag_isle_of_man", CLDR},
+	{"🇮🇲", "isle_of_man", GitHub},
+	{"🇮🇳", "flag_india", CLDR},
+	{"🇮🇳", "india", GitHub},
+	{"🇮🇴", "flag_british_indian_ocean_territory", CLDR},
+	{"🇮🇴", "british_indian_ocean_territory", GitHub},
+	{"🇮🇶", "flag_iraq", CLDR},
+	{"🇮🇶", "iraq", GitHub},
+	{"🇮🇷", "flag_iran", CLDR},
+	{"🇮🇷", "iran", GitHub},
+	{"🇮🇸", "flag_iceland", CLDR},
+	{"🇮🇸", "iceland", GitHub},
+	{"🇮🇹", "flag_italy", CLDR},
+	{"🇮🇹", "it", GitHub},
+	{"🇯🇪", "flag_jersey", CLDR},
+	{"🇯🇪", "jersey", GitHub},
+	{"🇯🇲", "flag_jamaica", CLDR},
+	{"🇯🇲", "jamaica", GitHub},
+	{"🇯🇴", "flag_jordan", CLDR},
+	{"🇯🇴", "jordan", GitHub},
+	{"🇯🇵", "flag_japan", CLDR},
+	{"🇯🇵", "jp", GitHub},
+	{"🇰🇪", "flag_kenya", CLDR},
+	{"🇰🇪", "kenya", GitHub},
+	{"🇰🇬", "flag_kyrgyzstan", CLDR},
+	{"🇰🇬", "kyrgyzstan", GitHub},
+	{"🇰🇭", "flag_cambodia", CLDR},
+	{"🇰🇭", "cambodia", GitHub},
+	{"🇰🇮", "flag_kiribati", CLDR},
+	{"🇰🇮", "kiribati", GitHub},
+	{"🇰🇲", "flag_comoros", CLDR},
+	{"🇰🇲", "comoros", GitHub},
+	{"🇰🇳", "flag_st_kitts_and_nevis", CLDR},
+	{"🇰🇳", "st_kitts_nevis", GitHub},
+	{"🇰🇵", "flag_north_korea", CLDR},
+	{"🇰🇵", "north_korea", GitHub},
+	{"🇰🇷", "flag_south_korea", CLDR},
+	{"🇰🇷", "kr", GitHub},
+	{"🇰🇼", "flag_kuwait", CLDR},
+	{"🇰🇼", "kuwait", GitHub},
+	{"🇰🇾", "flag_cayman_islands", CLDR},
+	{"🇰🇾", "cayman_islands", GitHub},
+	{"🇰🇿", "flag_kazakhstan", CLDR},
+	{"🇰🇿", "kazakhstan", GitHub},
+	{"🇱🇦", "flag_laos", CLDR},
+	{"🇱🇦", "laos", GitHub},
+	{"🇱🇧", "flag_lebanon", CLDR},
+	{"🇱🇧", "lebanon", GitHub},
+	{"🇱🇨", "flag_st_lucia", CLDR},
+	{"🇱🇨", "st_lucia", GitHub},
+	{"🇱🇮", "flag_liechtenstein", CLDR},
+	{"🇱🇮", "liechtenstein", GitHub},
+	{"🇱🇰", "flag_sri_lanka", CLDR},
+	{"🇱🇰", "sri_lanka", GitHub},
+	{"🇱🇷", "flag_liberia", CLDR},
+	{"🇱🇷", "liberia", GitHub},
+	{"🇱🇸", "flag_lesotho", CLDR},
+	{"🇱🇸", "lesotho", GitHub},
+	{"🇱🇹", "flag_lithuania", CLDR},
+	{"🇱🇹", "lithuania", GitHub},
+	{"🇱🇺", "flag_luxembourg", CLDR},
+	{"🇱🇺", "luxembourg", GitHub},
+	{"🇱🇻", "flag_latvia", CLDR},
+	{"🇱🇻", "latvia", GitHub},
+	{"🇱🇾", "flag_libya", CLDR},
+	{"🇱🇾", "libya", GitHub},
+	{"🇲🇦", "flag_morocco", CLDR},
+	{"🇲🇦", "morocco", GitHub},
+	{"🇲🇨", "flag_monaco", CLDR},
+	{"🇲🇨", "monaco", GitHub},
+	{"🇲🇩", "flag_moldova", CLDR},
+	{"🇲🇩", "moldova", GitHub},
+	{"🇲🇪", "flag_montenegro", CLDR},
+	{"🇲🇪", "montenegro", GitHub},
+	{"🇲🇫", "flag_st_martin", CLDR},
+	{"🇲🇫", "st_martin", GitHub},
+	{"🇲🇬", "flag_madagascar", CLDR},
+	{"🇲🇬", "madagascar", GitHub},
+	{"🇲🇭", "flag_marshall_islands", CLDR},
+	{"🇲🇭", "marshall_islands", GitHub},
+	{"🇲🇰", "flag_north_macedonia", CLDR},
+	{"🇲🇰", "macedonia", GitHub},
+	{"🇲🇱", "flag_mali", CLDR},
+	{"🇲🇱", "mali", GitHub},
+	{"🇲🇲", "flag_myanmar_burma", CLDR},
+	{"🇲🇲", "myanmar", GitHub},
+	{"🇲🇳", "flag_mongolia", CLDR},
+	{"🇲🇳", "mongolia", GitHub},
+	{"🇲🇴", "flag_macao_sar_china", CLDR},
+	{"🇲🇴", "macau", GitHub},
+	{"🇲🇵", "flag_northern_mariana_islands", CLDR},
+	{"🇲🇵", "northern_mariana_islands", GitHub},
+	{"🇲🇶", "flag_martinique", CLDR},
+	{"🇲🇶", "martinique", GitHub},
+	{"🇲🇷", "flag_mauritania", CLDR},
+	{"🇲🇷", "mauritania", GitHub},
+	{"🇲🇸", "flag_montserrat", CLDR},
+	{"🇲🇸", "montserrat", GitHub},
+	{"🇲🇹", "flag_malta", CLDR},
+	{"🇲🇹", "malta", GitHub},
+	{"🇲🇺", "flag_mauritius", CLDR},
+	{"🇲🇺", "mauritius", GitHub},
+	{"🇲🇻", "flag_maldives", CLDR},
+	{"🇲🇻", "maldives", GitHub},
+	{"🇲🇼", "flag_malawi", CLDR},
+	{"🇲🇼", "malawi", GitHub},
+	{"🇲🇽", "flag_mexico", CLDR},
+	{"🇲🇽", "mexico", GitHub},
+	{"🇲🇾", "flag_malaysia", CLDR},
+	{"🇲🇾", "malaysia", GitHub},
+	{"🇲🇿", "flag_mozambique", CLDR},
+	{"🇲🇿", "mozambique", GitHub},
+	{"🇳🇦", "flag_namibia", CLDR},
+	{"🇳🇦", "namibia", GitHub},
+	{"🇳🇨", "flag_new_caledonia", CLDR},
+	{"🇳🇨", "new_caledonia", GitHub},
+	{"🇳🇪", "flag_niger", CLDR},
+	{"🇳🇪", "niger", GitHub},
+	{"🇳🇫", "flag_norfolk_island", CLDR},
+	{"🇳🇫", "norfolk_island", GitHub},
+	{"🇳🇬", "flag_nigeria", CLDR},
+	{"🇳🇬", "nigeria", GitHub},
+	{"🇳🇮", "flag_nicaragua", CLDR},
+	{"🇳🇮", "nicaragua", GitHub},
+	{"🇳🇱", "flag_netherlands", CLDR},
+	{"🇳🇱", "netherlands", GitHub},
+	{"🇳🇴", "flag_norway", CLDR},
+	{"🇳🇴", "norway", GitHub},
+	{"🇳🇵", "flag_nepal", CLDR},
+	{"🇳🇵", "nepal", GitHub},
+	{"🇳🇷", "flag_nauru", CLDR},
+	{"🇳🇷", "nauru", GitHub},
+	{"🇳🇺", "flag_niue", CLDR},
+	{"🇳🇺", "niue", GitHub},
+	{"🇳🇿", "flag_new_zealand", CLDR},
+	{"🇳🇿", "new_zealand", GitHub},
+	{"🇴🇲", "flag_oman", CLDR},
+	{"🇴🇲", "oman", GitHub},
+	{"🇵🇦", "flag_panama", CLDR},
+	{"🇵🇦", "panama", GitHub},
+	{"🇵🇪", "flag_peru", CLDR},
+	{"🇵🇪", "peru", GitHub},
+	{"🇵🇫", "flag_french_polynesia", CLDR},
+	{"🇵🇫", "french_polynesia", GitHub},
+	{"🇵🇬", "flag_papua_new_guinea", CLDR},
+	{"🇵🇬", "papua_new_guinea", GitHub},
+	{"🇵🇭", "flag_philippines", CLDR},
+	{"🇵🇭", "philippines", GitHub},
+	{"🇵🇰", "flag_pakistan", CLDR},
+	{"🇵🇰", "pakistan", GitHub},
+	{"🇵🇱", "flag_poland", CLDR},
+	{"🇵🇱", "poland", GitHub},
+	{"🇵🇲", "flag_st_pierre_and_miquelon", CLDR},
+	{"🇵🇲", "st_pierre_miquelon", GitHub},
+	{"🇵🇳", "flag_pitcairn_islands", CLDR},
+	{"🇵🇳", "pitcairn_islands", GitHub},
+	{"🇵🇷", "flag_puerto_rico", CLDR},
+	{"🇵🇷", "puerto_rico", GitHub},
+	{"🇵🇸", "flag_palestinian_territories", CLDR},
+	{"🇵🇸", "palestinian_territories", GitHub},
+	{"🇵🇹", "flag_portugal", CLDR},
+	{"🇵🇹", "portugal", GitHub},
+	{"🇵🇼", "flag_palau", CLDR},
+	{"🇵🇼", "palau", GitHub},
+	{"🇵🇾", "flag_paraguay", CLDR},
+	{"🇵🇾", "paraguay", GitHub},
+	{"🇶🇦", "flag_qatar", CLDR},
+	{"🇶🇦", "qatar", GitHub},
+	{"🇷🇪", "flag_reunion", CLDR},
+	{"🇷🇪", "reunion", GitHub},
+	{"🇷🇴", "flag_romania", CLDR},
+	{"🇷🇴", "romania", GitHub},
+	{"🇷🇸", "flag_serbia", CLDR},
+	{"🇷🇸", "serbia", GitHub},
+	{"🇷🇺", "flag_russia", CLDR},
+	{"🇷🇺", "ru", GitHub},
+	{"🇷🇼", "flag_rwanda", CLDR},
+	{"🇷🇼", "rwanda", GitHub},
+	{"🇸🇦", "flag_saudi_arabia", CLDR},
+	{"🇸🇦", "saudi_arabia", GitHub},
+	{"🇸🇧", "flag_solomon_islands", CLDR},
+	{"🇸🇧", "solomon_islands", GitHub},
+	{"🇸🇨", "flag_seychelles", CLDR},
+	{"🇸🇨", "seychelles", GitHub},
+	{"🇸🇩", "flag_sudan", CLDR},
+	{"🇸🇩", "sudan", GitHub},
+	{"🇸🇪", "flag_sweden", CLDR},
+	{"🇸🇪", "sweden", GitHub},
+	{"🇸🇬", "flag_singapore", CLDR},
+	{"🇸🇬", "singapore", GitHub},
+	{"🇸🇭", "flag_st_helena", CLDR},
+	{"🇸🇭", "st_helena", GitHub},
+	{"🇸🇮", "flag_slovenia", CLDR},
+	{"🇸🇮", "slovenia", GitHub},
+	{"🇸🇯", "flag_svalbard_and_jan_mayen", CLDR},
+	{"🇸🇯", "svalbard_jan_mayen", GitHub},
+	{"🇸🇰", "flag_slovakia", CLDR},
+	{"🇸🇰", "slovakia", GitHub},
+	{"🇸🇱", "flag_sierra_leone", CLDR},
+	{"🇸🇱", "sierra_leone", GitHub},
+	{"🇸🇲", "flag_san_marino", CLDR},
+	{"🇸🇲", "san_marino", GitHub},
+	{"🇸🇳", "flag_senegal", CLDR},
+	{"🇸🇳", "senegal", GitHub},
+	{"🇸🇴", "flag_somalia", CLDR},
+	{"🇸🇴", "somalia", GitHub},
+	{"🇸🇷", "flag_suriname", CLDR},
+	{"🇸🇷", "suriname", GitHub},
+	{"🇸🇸", "flag_south_sudan", CLDR},
+	{"🇸🇸", "south_sudan", GitHub},
+	{"🇸🇹", "flag_sao_tome_and_principe", CLDR},
+	{"🇸🇹", "sao_tome_principe", GitHub},
+	{"🇸🇻", "flag_el_salvador", CLDR},
+	{"🇸🇻", "el_salvador", GitHub},
+	{"🇸🇽", "flag_sint_maarten", CLDR},
+	{"🇸🇽", "sint_maarten", GitHub},
+	{"🇸🇾", "flag_syria", CLDR},
+	{"🇸🇾", "syria", GitHub},
+	{"🇸🇿", "flag_eswatini", CLDR},
+	{"🇸🇿", "swaziland", GitHub},
+	{"🇹🇦", "flag_tristan_da_cunha", CLDR},
+	{"🇹🇦", "tristan_da_cunha", GitHub},
+	{"🇹🇨", "flag_turks_and_caicos_islands", CLDR},
+	{"🇹🇨", "turks_caicos_islands", GitHub},
+	{"🇹🇩", "flag_chad", CLDR},
+	{"🇹🇩", "chad", GitHub},
+	{"🇹🇫", "flag_french_southern_territories", CLDR},
+	{"🇹🇫", "french_southern_territories", GitHub},
+	{"🇹🇬", "flag_togo", CLDR},
+	{"🇹🇬", "togo", GitHub},
+	{"🇹🇭", "flag_thailand", CLDR},
+	{"🇹🇭", "thailand", GitHub},
+	{"🇹🇯", "flag_tajikistan", CLDR},
+	{"🇹🇯", "tajikistan", GitHub},
+	{"🇹🇰", "flag_tokelau", CLDR},
+	{"🇹🇰", "tokelau", GitHub},
+	{"🇹🇱", "flag_timor_leste", CLDR},
+	{"🇹🇱", "timor_leste", GitHub},
+	{"🇹🇲", "flag_turkmenistan", CLDR},
+	{"🇹🇲", "turkmenistan", GitHub},
+	{"🇹🇳", "flag_tunisia", CLDR},
+	{"🇹🇳", "tunisia", GitHub},
+	{"🇹🇴", "flag_tonga", CLDR},
+	{"🇹🇴", "tonga", GitHub},
+	{"🇹🇷", "flag_turkiye", CLDR},
+	{"🇹🇷", "tr", GitHub},
+	{"🇹🇹", "flag_trinidad_and_tobago", CLDR},
+	{"🇹🇹", "trinidad_tobago", GitHub},
+	{"🇹🇻", "flag_tuvalu", CLDR},
+	{"🇹🇻", "tuvalu", GitHub},
+	{"🇹🇼", "flag_taiwan", CLDR},
+	{"🇹🇼", "taiwan", GitHub},
+	{"🇹🇿", "flag_tanzania", CLDR},
+	{"🇹🇿", "tanzania", GitHub},
+	{"🇺🇦", "flag_ukraine", CLDR},
+	{"🇺🇦", "ukraine", GitHub},
+	{"🇺🇬", "flag_uganda", CLDR},
+	{"🇺🇬", "uganda", GitHub},
+	{"🇺🇲", "flag_us_outlying_islands", CLDR},
+	{"🇺🇲", "us_outlying_islands", GitHub},
+	{"🇺🇳", "flag_united_nations", CLDR},
+	{"🇺🇳", "united_nations", GitHub},
+	{"🇺🇸", "flag_united_states", CLDR},
+	{"🇺🇸", "us", GitHub},
+	{"🇺🇾", "flag_uruguay", CLDR},
+	{"🇺🇾", "uruguay", GitHub},
+	{"🇺🇿", "flag_uzbekistan", CLDR},
+	{"🇺🇿", "uzbekistan", GitHub},
+	{"🇻🇦", "flag_vatican_city", CLDR},
+	{"🇻🇦", "vatican_city", GitHub},
+	{"🇻🇨", "flag_st_vincent_and_grenadines", CLDR},
+	{"🇻🇨", "st_vincent_grenadines", GitHub},
+	{"🇻🇪", "flag_venezuela", CLDR},
+	{"🇻🇪", "venezuela", GitHub},
+	{"🇻🇬", "flag_british_virgin_islands", CLDR},
+	{"🇻🇬", "british_virgin_islands", GitHub},
+	{"🇻🇮", "flag_us_virgin_islands", CLDR},
+	{"🇻🇮", "us_virgin_islands", GitHub},
+	{"🇻🇳", "flag_vietnam", CLDR},
+	{"🇻🇳", "vietnam", GitHub},
+	{"🇻🇺", "flag_vanuatu", CLDR},
+	{"🇻🇺", "vanuatu", GitHub},
+	{"🇼🇫", "flag_wallis_and_futuna", CLDR},
+	{"🇼🇫", "wallis_futuna", GitHub},
+	{"🇼🇸", "flag_samoa", CLDR},
+	{"🇼🇸", "samoa", GitHub},
+	{"🇽🇰", "flag_kosovo", CLDR},
+	{"🇽🇰", "kosovo", GitHub},
+	{"🇾🇪", "flag_yemen", CLDR},
+	{"🇾🇪", "yemen", GitHub},
+	{"🇾🇹", "flag_mayotte", CLDR},
+	{"🇾🇹", "mayotte", GitHub},
+	{"🇿🇦", "flag_south_africa", CLDR},
+	{"🇿🇦", "south_africa", GitHub},
+	{"🇿🇲", "flag_zambia", CLDR},
+	{"🇿🇲", "zambia", GitHub},
+	{"🇿🇼", "flag_zimbabwe", CLDR},
+	{"🇿🇼", "zimbabwe", GitHub},
+	{"🈁", "japanese_here_button", CLDR},
+	{"🈁", "koko", GitHub},
+	{"🈂️", "japanese_service_charge_button", CLDR},
+	{"🈂️", "sa", GitHub},
+	{"🈚", "japanese_free_of_charge_button", CLDR},
+	{"🈚", "u7121", GitHub},
+	{"🈯", "japanese_reserved_button", CLDR},
+	{"🈯", "u6307", GitHub},
+	{"🈲", "japanese_prohibited_button", CLDR},
+	{"🈲", "u7981", GitHub},
+	{"🈳", "japanese_vacancy_button", CLDR},
+	{"🈳", "u7a7a", GitHub},
+	{"🈴", "japanese_passing_grade_button", CLDR},
+	{"🈴", "u5408", GitHub},
+	{"🈵", "japanese_no_vacancy_button", CLDR},
+	{"🈵", "u6e80", GitHub},
+	{"🈶", "japanese_not_free_of_charge_button", CLDR},
+	{"🈶", "u6709", GitHub},
+	{"🈷️", "japanese_monthly_amount_button", CLDR},
+	{"🈷️", "u6708", GitHub},
+	{"🈸", "japanese_application_button", CLDR},
+	{"🈸", "u7533", GitHub},
+	{"🈹", "japanese_discount_button", CLDR},
+	{"🈹", "u5272", GitHub},
+	{"🈺", "japanese_open_for_business_button", CLDR},
+	{"🈺", "u55b6", GitHub},
+	{"🉐", "japanese_bargain_button", CLDR},
+	{"🉐", "ideograph_advantage", GitHub},
+	{"🉑", "japanese_acceptable_button", CLDR},
+	{"🉑", "accept", GitHub},
+	{"🌀", "cyclone", CLDR},
+	{"🌀", "cyclone", GitHub},
+	{"🌁", "foggy", CLDR},
+	{"🌁", "foggy", GitHub},
+	{"🌂", "closed_umbrella", CLDR},
+	{"🌂", "closed_umbrella", GitHub},
+	{"🌃", "night_with_stars", CLDR},
+	{"🌃", "night_with_stars", GitHub},
+	{"🌄", "sunrise_over_mountains", CLDR},
+	{"🌄", "sunrise_over_mountains", GitHub},
+	{"🌅", "sunrise", CLDR},
+	{"🌅", "sunrise", GitHub},
+	{"🌆", "cityscape_at_dusk", CLDR},
+	{"🌆", "city_sunset", GitHub},
+	{"🌇", "sunset", CLDR},
+	{"🌇", "city_sunrise", GitHub},
+	{"🌈", "rainbow", CLDR},
+	{"🌈", "rainbow", GitHub},
+	{"🌉", "bridge_at_night", CLDR},
+	{"🌉", "bridge_at_night", GitHub},
+	{"🌊", "water_wave", CLDR},
+	{"🌊", "ocean", GitHub},
+	{"🌋", "volcano", CLDR},
+	{"🌋", "volcano", GitHub},
+	{"🌌", "milky_way", CLDR},
+	{"🌌", "milky_way", GitHub},
+	{"🌍", "globe_showing_europe_africa", CLDR},
+	{"🌍", "earth_africa", GitHub},
+	{"🌎", "globe_showing_americas", CLDR},
+	{"🌎", "earth_americas", GitHub},
+	{"🌏", "globe_showing_asia_australia", CLDR},
+	{"🌏", "earth_asia", GitHub},
+	{"🌐", "globe_with_meridians", CLDR},
+	{"🌐", "globe_with_meridians", GitHub},
+	{"🌑", "new_moon", CLDR},
+	{"🌑", "new_moon", GitHub},
+	{"🌒", "waxing_crescent_moon", CLDR},
+	{"🌒", "waxing_crescent_moon", GitHub},
+	{"🌓", "first_quarter_moon", CLDR},
+	{"🌓", "first_quarter_moon", GitHub},
+	{"🌔", "waxing_gibbous_moon", CLDR},
+	{"🌔", "moon", GitHub},
+	{"🌔", "waxing_gibbous_moon", GitHub},
+	{"🌕", "full_moon", CLDR},
+	{"🌕", "full_moon", GitHub},
+	{"🌖", "waning_gibbous_moon", CLDR},
+	{"🌖", "waning_gibbous_moon", GitHub},
+	{"🌗", "last_quarter_moon", CLDR},
+	{"🌗", "last_quarter_moon", GitHub},
+	{"🌘", "waning_crescent_moon", CLDR},
+	{"🌘", "waning_crescent_moon", GitHub},
+	{"🌙", "crescent_moon", CLDR},
+	{"🌙", "crescent_moon", GitHub},
+	{"🌚", "new_moon_face", CLDR},
+	{"🌚", "new_moon_with_face", GitHub},
+	{"🌛", "first_quarter_moon_face", CLDR},
+	{"🌛", "first_quarter_moon_with_face", GitHub},
+	{"🌜", "last_quarter_moon_face", CLDR},
+	{"🌜", "last_quarter_moon_with_face", GitHub},
+	{"🌝", "full_moon_face", CLDR},
+	{"🌝", "full_moon_with_face", GitHub},
+	{"🌞", "sun_with_face", CLDR},
+	{"🌞", "sun_with_face", GitHub},
+	{"🌟", "glowing_star", CLDR},
+	{"🌟", "star2", GitHub},
+	{"🌠", "shooting_star", CLDR},
+	{"🌠", "stars", GitHub},
+	{"🌡️", "thermometer", CLDR},
+	{"🌡️", "thermometer", GitHub},
+	{"🌤️", "sun_behind_small_cloud", CLDR},
+	{"🌤️", "sun_behind_small_cloud", GitHub},
+	{"🌥️", "sun_behind_large_cloud", CLDR},
+	{"🌥️", "sun_behind_large_cloud", GitHub},
+	{"🌦️", "sun_behind_rain_cloud", CLDR},
+	{"🌦️", "sun_behind_rain_cloud", GitHub},
+	{"🌧️", "cloud_with_rain", CLDR},
+	{"🌧️", "cloud_with_rain", GitHub},
+	{"🌨️", "cloud_with_snow", CLDR},
+	{"🌨️", "cloud_with_snow", GitHub},
+	{"🌩️", "cloud_with_lightning", CLDR},
+	{"🌩️", "cloud_with_lightning", GitHub},
+	{"🌪️", "tornado", CLDR},
+	{"🌪️", "tornado", GitHub},
+	{"🌫️", "fog", CLDR},
+	{"🌫️", "fog", GitHub},
+	{"🌬️", "wind_face", CLDR},
+	{"🌬️", "wind_face", GitHub},
+	{"🌭", "hot_dog", CLDR},
+	{"🌭", "hotdog", GitHub},
+	{"🌮", "taco", CLDR},
+	{"🌮", "taco", GitHub},
+	{"🌯", "burrito", CLDR},
+	{"🌯", "burrito", GitHub},
+	{"🌰", "chestnut", CLDR},
+	{"🌰", "chestnut", GitHub},
+	{"🌱", "seedling", CLDR},
+	{"🌱", "seedling", GitHub},
+	{"🌲", "evergreen_tree", CLDR},
+	{"🌲", "evergreen_tree", GitHub},
+	{"🌳", "deciduous_tree", CLDR},
+	{"🌳", "deciduous_tree", GitHub},
+	{"🌴", "palm_tree", CLDR},
+	{"🌴", "palm_tree", GitHub},
+	{"🌵", "cactus", CLDR},
+	{"🌵", "cactus", GitHub},
+	{"🌶️", "hot_pepper", CLDR},
+	{"🌶️", "hot_pepper", GitHub},
+	{"🌷", "tulip", CLDR},
+	{"🌷", "tulip", GitHub},
+	{"🌸", "cherry_blossom", CLDR},
+	{"🌸", "cherry_blossom", GitHub},
+	{"🌹", "rose", CLDR},
+	{"🌹", "rose", GitHub},
+	{"🌺", "hibiscus", CLDR},
+	{"🌺", "hibiscus", GitHub},
+	{"🌻", "sunflower", CLDR},
+	{"🌻", "sunflower", GitHub},
+	{"🌼", "blossom", CLDR},
+	{"🌼", "blossom", GitHub},
+	{"🌽", "ear_of_corn", CLDR},
+	{"🌽", "corn", GitHub},
+	{"🌾", "sheaf_of_rice", CLDR},
+	{"🌾", "ear_of_rice", GitHub},
+	{"🌿", "herb", CLDR},
+	{"🌿", "herb", GitHub},
+	{"🍀", "four_leaf_clover", CLDR},
+	{"🍀", "four_leaf_clover", GitHub},
+	{"🍁", "maple_leaf", CLDR},
+	{"🍁", "maple_leaf", GitHub},
+	{"🍂", "fallen_leaf", CLDR},
+	{"🍂", "fallen_leaf", GitHub},
+	{"🍃", "leaf_fluttering_in_wind", CLDR},
+	{"🍃", "leaves", GitHub},
+	{"🍄", "mushroom", CLDR},
+	{"🍄", "mushroom", GitHub},
+	{"🍅", "tomato", CLDR},
+	{"🍅", "tomato", GitHub},
+	{"🍆", "eggplant", CLDR},
+	{"🍆", "eggplant", GitHub},
+	{"🍇", "grapes", CLDR},
+	{"🍇", "grapes", GitHub},
+	{"🍈", "melon", CLDR},
+	{"🍈", "melon", GitHub},
+	{"🍉", "watermelon", CLDR},
+	{"🍉", "watermelon", GitHub},
+	{"🍊", "tangerine", CLDR},
+	{"🍊", "tangerine", GitHub},
+	{"🍊", "orange", GitHub},
+	{"🍊", "mandarin", GitHub},
+	{"🍋", "lemon", CLDR},
+	{"🍋", "lemon", GitHub},
+	{"🍌", "banana", CLDR},
+	{"🍌", "banana", GitHub},
+	{"🍍", "pineapple", CLDR},
+	{"🍍", "pineapple", GitHub},
+	{"🍎", "red_apple", CLDR},
+	{"🍎", "apple", GitHub},
+	{"🍏", "green_apple", CLDR},
+	{"🍏", "green_apple", GitHub},
+	{"🍐", "pear", CLDR},
+	{"🍐", "pear", GitHub},
+	{"🍑", "peach", CLDR},
+	{"🍑", "peach", GitHub},
+	{"🍒", "cherries", CLDR},
+	{"🍒", "cherries", GitHub},
+	{"🍓", "strawberry", CLDR},
+	{"🍓", "strawberry", GitHub},
+	{"🍔", "hamburger", CLDR},
+	{"🍔", "hamburger", GitHub},
+	{"🍕", "pizza", CLDR},
+	{"🍕", "pizza", GitHub},
+	{"🍖", "meat_on_bone", CLDR},
+	{"🍖", "meat_on_bone", GitHub},
+	{"🍗", "poultry_leg", CLDR},
+	{"🍗", "poultry_leg", GitHub},
+	{"🍘", "rice_cracker", CLDR},
+	{"🍘", "rice_cracker", GitHub},
+	{"🍙", "rice_ball", CLDR},
+	{"🍙", "rice_ball", GitHub},
+	{"🍚", "cooked_rice", CLDR},
+	{"🍚", "rice", GitHub},
+	{"🍛", "curry_rice", CLDR},
+	{"🍛", "curry", GitHub},
+	{"🍜", "steaming_bowl", CLDR},
+	{"🍜", "ramen", GitHub},
+	{"🍝", "spaghetti", CLDR},
+	{"🍝", "spaghetti", GitHub},
+	{"🍞", "bread", CLDR},
+	{"🍞", "bread", GitHub},
+	{"🍟", "french_fries", CLDR},
+	{"🍟", "fries", GitHub},
+	{"🍠", "roasted_sweet_potato", CLDR},
+	{"🍠", "sweet_potato", GitHub},
+	{"🍡", "dango", CLDR},
+	{"🍡", "dango", GitHub},
+	{"🍢", "oden", CLDR},
+	{"🍢", "oden", GitHub},
+	{"🍣", "sushi", CLDR},
+	{"🍣", "sushi", GitHub},
+	{"🍤", "fried_shrimp", CLDR},
+	{"🍤", "fried_shrimp", GitHub},
+	{"🍥", "fish_cake_with_swirl", CLDR},
+	{"🍥", "fish_cake", GitHub},
+	{"🍦", "soft_ice_cream", CLDR},
+	{"🍦", "icecream", GitHub},
+	{"🍧", "shaved_ice", CLDR},
+	{"🍧", "shaved_ice", GitHub},
+	{"🍨", "ice_cream", CLDR},
+	{"🍨", "ice_cream", GitHub},
+	{"🍩", "doughnut", CLDR},
+	{"🍩", "doughnut", GitHub},
+	{"🍪", "cookie", CLDR},
+	{"🍪", "cookie", GitHub},
+	{"🍫", "chocolate_bar", CLDR},
+	{"🍫", "chocolate_bar", GitHub},
+	{"🍬", "candy", CLDR},
+	{"🍬", "candy", GitHub},
+	{"🍭", "lollipop", CLDR},
+	{"🍭", "lollipop", GitHub},
+	{"🍮", "custard", CLDR},
+	{"🍮", "custard", GitHub},
+	{"🍯", "honey_pot", CLDR},
+	{"🍯", "honey_pot", GitHub},
+	{"🍰", "shortcake", CLDR},
+	{"🍰", "cake", GitHub},
+	{"🍱", "bento_box", CLDR},
+	{"🍱", "bento", GitHub},
+	{"🍲", "pot_of_food", CLDR},
+	{"🍲", "stew", GitHub},
+	{"🍳", "cooking", CLDR},
+	{"🍳", "fried_egg", GitHub},
+	{"🍴", "fork_and_knife", CLDR},
+	{"🍴", "fork_and_knife", GitHub},
+	{"🍵", "teacup_without_handle", CLDR},
+	{"🍵", "tea", GitHub},
+	{"🍶", "sake", CLDR},
+	{"🍶", "sake", GitHub},
+	{"🍷", "wine_glass", CLDR},
+	{"🍷", "wine_glass", GitHub},
+	{"🍸", "cocktail_glass", CLDR},
+	{"🍸", "cocktail", GitHub},
+	{"🍹", "tropical_drink", CLDR},
+	{"🍹", "tropical_drink", GitHub},
+	{"🍺", "beer_mug", CLDR},
+	{"🍺", "beer", GitHub},
+	{"🍻", "clinking_beer_mugs", CLDR},
+	{"🍻", "beers", GitHub},
+	{"🍼", "baby_bottle", CLDR},
+	{"🍼", "baby_bottle", GitHub},
+	{"🍽️", "fork_and_knife_with_plate", CLDR},
+	{"🍽️", "plate_with_cutlery", GitHub},
+	{"🍾", "bottle_with_popping_cork", CLDR},
+	{"🍾", "champagne", GitHub},
+	{"🍿", "popcorn", CLDR},
+	{"🍿", "popcorn", GitHub},
+	{"🎀", "ribbon", CLDR},
+	{"🎀", "ribbon", GitHub},
+	{"🎁", "wrapped_gift", CLDR},
+	{"🎁", "gift", GitHub},
+	{"🎂", "birthday_cake", CLDR},
+	{"🎂", "birthday", GitHub},
+	{"🎃", "jack_o_lantern", CLDR},
+	{"🎃", "jack_o_lantern", GitHub},
+	{"🎄", "christmas_tree", CLDR},
+	{"🎄", "christmas_tree", GitHub},
+	{"🎅", "santa_claus", CLDR},
+	{"🎅", "santa", GitHub},
+	{"🎅🏻", "santa_claus_light_skin_tone", CLDR},
+	{"🎅🏼", "santa_claus_medium_light_skin_tone", CLDR},
+	{"🎅🏽", "santa_claus_medium_skin_tone", CLDR},
+	{"🎅🏾", "santa_claus_medium_dark_skin_tone", CLDR},
+	{"🎅🏿", "santa_claus_dark_skin_tone", CLDR},
+	{"🎆", "fireworks", CLDR},
+	{"🎆", "fireworks", GitHub},
+	{"🎇", "sparkler", CLDR},
+	{"🎇", "sparkler", GitHub},
+	{"🎈", "balloon", CLDR},
+	{"🎈", "balloon", GitHub},
+	{"🎉", "party_popper", CLDR},
+	{"🎉", "tada", GitHub},
+	{"🎊", "confetti_ball", CLDR},
+	{"🎊", "confetti_ball", GitHub},
+	{"🎋", "tanabata_tree", CLDR},
+	{"🎋", "tanabata_tree", GitHub},
+	{"🎌", "crossed_flags", CLDR},
+	{"🎌", "crossed_flags", GitHub},
+	{"🎍", "pine_decoration", CLDR},
+	{"🎍", "bamboo", GitHub},
+	{"🎎", "japanese_dolls", CLDR},
+	{"🎎", "dolls", GitHub},
+	{"🎏", "carp_streamer", CLDR},
+	{"🎏", "flags", GitHub},
+	{"🎐", "wind_chime", CLDR},
+	{"🎐", "wind_chime", GitHub},
+	{"🎑", "moon_viewing_ceremony", CLDR},
+	{"🎑", "rice_scene", GitHub},
+	{"🎒", "backpack", CLDR},
+	{"🎒", "school_satchel", GitHub},
+	{"🎓", "graduation_cap", CLDR},
+	{"🎓", "mortar_board", GitHub},
+	{"🎖️", "military_medal", CLDR},
+	{"🎖️", "medal_military", GitHub},
+	{"🎗️", "reminder_ribbon", CLDR},
+	{"🎗️", "reminder_ribbon", GitHub},
+	{"🎙️", "studio_microphone", CLDR},
+	{"🎙️", "studio_microphone", GitHub},
+	{"🎚️", "level_slider", CLDR},
+	{"🎚️", "level_slider", GitHub},
+	{"🎛️", "control_knobs", CLDR},
+	{"🎛️", "control_knobs", GitHub},
+	{"🎞️", "film_frames", CLDR},
+	{"🎞️", "film_strip", GitHub},
+	{"🎟️", "admission_tickets", CLDR},
+	{"🎟️", "tickets", GitHub},
+	{"🎠", "carousel_horse", CLDR},
+	{"🎠", "carousel_horse", GitHub},
+	{"🎡", "ferris_wheel", CLDR},
+	{"🎡", "ferris_wheel", GitHub},
+	{"🎢", "roller_coaster", CLDR},
+	{"🎢", "roller_coaster", GitHub},
+	{"🎣", "fishing_pole", CLDR},
+	{"🎣", "fishing_pole_and_fish", GitHub},
+	{"🎤", "microphone", CLDR},
+	{"🎤", "microphone", GitHub},
+	{"🎥", "movie_camera", CLDR},
+	{"🎥", "movie_camera", GitHub},
+	{"🎦", "cinema", CLDR},
+	{"🎦", "cinema", GitHub},
+	{"🎧", "headphone", CLDR},
+	{"🎧", "headphones", GitHub},
+	{"🎨", "artist_palette", CLDR},
+	{"🎨", "art", GitHub},
+	{"🎩", "top_hat", CLDR},
+	{"🎩", "tophat", GitHub},
+	{"🎪", "circus_tent", CLDR},
+	{"🎪", "circus_tent", GitHub},
+	{"🎫", "ticket", CLDR},
+	{"🎫", "ticket", GitHub},
+	{"🎬", "clapper_board", CLDR},
+	{"🎬", "clapper", GitHub},
+	{"🎭", "performing_arts", CLDR},
+	{"🎭", "performing_arts", GitHub},
+	{"🎮", "video_game", CLDR},
+	{"🎮", "video_game", GitHub},
+	{"🎯", "bullseye", CLDR},
+	{"🎯", "dart", GitHub},
+	{"🎰", "slot_machine", CLDR},
+	{"🎰", "slot_machine", GitHub},
+	{"🎱", "pool_8_ball", CLDR},
+	{"🎱", "8ball", GitHub},
+	{"🎲", "game_die", CLDR},
+	{"🎲", "game_die", GitHub},
+	{"🎳", "bowling", CLDR},
+	{"🎳", "bowling", GitHub},
+	{"🎴", "flower_playing_cards", CLDR},
+	{"🎴", "flower_playing_cards", GitHub},
+	{"🎵", "musical_note", CLDR},
+	{"🎵", "musical_note", GitHub},
+	{"🎶", "musical_notes", CLDR},
+	{"🎶", "notes", GitHub},
+	{"🎷", "saxophone", CLDR},
+	{"🎷", "saxophone", GitHub},
+	{"🎸", "guitar", CLDR},
+	{"🎸", "guitar", GitHub},
+	{"🎹", "musical_keyboard", CLDR},
+	{"🎹", "musical_keyboard", GitHub},
+	{"🎺", "trumpet", CLDR},
+	{"🎺", "trumpet", GitHub},
+	{"🎻", "violin", CLDR},
+	{"🎻", "violin", GitHub},
+	{"🎼", "musical_score", CLDR},
+	{"🎼", "musical_score", GitHub},
+	{"🎽", "running_shirt", CLDR},
+	{"🎽", "running_shirt_with_sash", GitHub},
+	{"🎾", "tennis", CLDR},
+	{"🎾", "tennis", GitHub},
+	{"🎿", "skis", CLDR},
+	{"🎿", "ski", GitHub},
+	{"🏀", "basketball", CLDR},
+	{"🏀", "basketball", GitHub},
+	{"🏁", "chequered_flag", CLDR},
+	{"🏁", "checkered_flag", GitHub},
+	{"🏂", "snowboarder", CLDR},
+	{"🏂", "snowboarder", GitHub},
+	{"🏂🏻", "snowboarder_light_skin_tone", CLDR},
+	{"🏂🏼", "snowboarder_medium_light_skin_tone", CLDR},
+	{"🏂🏽", "snowboarder_medium_skin_tone", CLDR},
+	{"🏂🏾", "snowboarder_medium_dark_skin_tone", CLDR},
+	{"🏂🏿", "snowboarder_dark_skin_tone", CLDR},
+	{"🏃", "person_running", CLDR},
+	{"🏃", "runner", GitHub},
+	{"🏃", "running", GitHub},
+	{"🏃\u200d♀️", "woman_running", CLDR},
+	{"🏃\u200d♀️", "running_woman", GitHub},
+	{"🏃\u200d♂️", "man_running", CLDR},
+	{"🏃\u200d♂️", "running_man", GitHub},
+	{"🏃🏻", "person_running_light_skin_tone", CLDR},
+	{"🏃🏻\u200d♀️", "woman_running_light_skin_tone", CLDR},
+	{"🏃🏻\u200d♂️", "man_running_light_skin_tone", CLDR},
+	{"🏃🏼", "person_running_medium_light_skin_tone", CLDR},
+	{"🏃🏼\u200d♀️", "woman_running_medium_light_skin_tone", CLDR},
+	{"🏃🏼\u200d♂️", "man_running_medium_light_skin_tone", CLDR},
+	{"🏃🏽", "person_running_medium_skin_tone", CLDR},
+	{"🏃🏽\u200d♀️", "woman_running_medium_skin_tone", CLDR},
+	{"🏃🏽\u200d♂️", "man_running_medium_skin_tone", CLDR},
+	{"🏃🏾", "person_running_medium_dark_skin_tone", CLDR},
+	{"🏃🏾\u200d♀️", "woman_running_medium_dark_skin_tone", CLDR},
+	{"🏃🏾\u200d♂️", "man_running_medium_dark_skin_tone", CLDR},
+	{"🏃🏿", "person_running_dark_skin_tone", CLDR},
+	{"🏃🏿\u200d♀️", "woman_running_dark_skin_tone", CLDR},
+	{"🏃🏿\u200d♂️", "man_running_dark_skin_tone", CLDR},
+	{"🏄", "person_surfing", CLDR},
+	{"🏄", "surfer", GitHub},
+	{"🏄\u200d♀️", "woman_surfing", CLDR},
+	{"🏄\u200d♀️", "surfing_woman", GitHub},
+	{"🏄\u200d♂️", "man_surfing", CLDR},
+	{"🏄\u200d♂️", "surfing_man", GitHub},
+	{"🏄🏻", "person_surfing_light_skin_tone", CLDR},
+	{"🏄🏻\u200d♀️", "woman_surfing_light_skin_tone", CLDR},
+	{"🏄🏻\u200d♂️", "man_surfing_light_skin_tone", CLDR},
+	{"🏄🏼", "person_surfing_medium_light_skin_tone", CLDR},
+	{"🏄🏼\u200d♀️", "woman_surfing_medium_light_skin_tone", CLDR},
+	{"🏄🏼\u200d♂️", "man_surfing_medium_light_skin_tone", CLDR},
+	{"🏄🏽", "person_surfing_medium_skin_tone", CLDR},
+	{"🏄🏽\u200d♀️", "woman_surfing_medium_skin_tone", CLDR},
+	{"🏄🏽\u200d♂️", "man_surfing_medium_skin_tone", CLDR},
+	{"🏄🏾", "person_surfing_medium_dark_skin_tone", CLDR},
+	{"🏄🏾\u200d♀️", "woman_surfing_medium_dark_skin_tone", CLDR},
+	{"🏄🏾\u200d♂️", "man_surfing_medium_dark_skin_tone", CLDR},
+	{"🏄🏿", "person_surfing_dark_skin_tone", CLDR},
+	{"🏄🏿\u200d♀️", "woman_surfing_dark_skin_tone", CLDR},
+	{"🏄🏿\u200d♂️", "man_surfing_dark_skin_tone", CLDR},
+	{"🏅", "sports_medal", CLDR},
+	{"🏅", "medal_sports", GitHub},
+	{"🏆", "trophy", CLDR},
+	{"🏆", "trophy", GitHub},
+	{"🏇", "horse_racing", CLDR},
+	{"🏇", "horse_racing", GitHub},
+	{"🏇🏻", "horse_racing_light_skin_tone", CLDR},
+	{"🏇🏼", "horse_racing_medium_light_skin_tone", CLDR},
+	{"🏇🏽", "horse_racing_medium_skin_tone", CLDR},
+	{"🏇🏾", "horse_racing_medium_dark_skin_tone", CLDR},
+	{"🏇🏿", "horse_racing_dark_skin_tone", CLDR},
+	{"🏈", "american_football", CLDR},
+	{"🏈", "football", GitHub},
+	{"🏉", "rugby_football", CLDR},
+	{"🏉", "rugby_football", GitHub},
+	{"🏊", "person_swimming", CLDR},
+	{"🏊", "swimmer", GitHub},
+	{"🏊\u200d♀️", "woman_swimming", CLDR},
+	{"🏊\u200d♀️", "swimming_woman", GitHub},
+	{"🏊\u200d♂️", "man_swimming", CLDR},
+	{"🏊\u200d♂️", "swimming_man", GitHub},
+	{"🏊🏻", "person_swimming_light_skin_tone", CLDR},
+	{"🏊🏻\u200d♀️", "woman_swimming_light_skin_tone", CLDR},
+	{"🏊🏻\u200d♂️", "man_swimming_light_skin_tone", CLDR},
+	{"🏊🏼", "person_swimming_medium_light_skin_tone", CLDR},
+	{"🏊🏼\u200d♀️", "woman_swimming_medium_light_skin_tone", CLDR},
+	{"🏊🏼\u200d♂️", "man_swimming_medium_light_skin_tone", CLDR},
+	{"🏊🏽", "person_swimming_medium_skin_tone", CLDR},
+	{"🏊🏽\u200d♀️", "woman_swimming_medium_skin_tone", CLDR},
+	{"🏊🏽\u200d♂️", "man_swimming_medium_skin_tone", CLDR},
+	{"🏊🏾", "person_swimming_medium_dark_skin_tone", CLDR},
+	{"🏊🏾\u200d♀️", "woman_swimming_medium_dark_skin_tone", CLDR},
+	{"🏊🏾\u200d♂️", "man_swimming_medium_dark_skin_tone", CLDR},
+	{"🏊🏿", "person_swimming_dark_skin_tone", CLDR},
+	{"🏊🏿\u200d♀️", "woman_swimming_dark_skin_tone", CLDR},
+	{"🏊🏿\u200d♂️", "man_swimming_dark_skin_tone", CLDR},
+	{"🏋️", "person_lifting_weights", CLDR},
+	{"🏋️", "weight_lifting", GitHub},
+	{"🏋️\u200d♀️", "woman_lifting_weights", CLDR},
+	{"🏋️\u200d♀️", "weight_lifting_woman", GitHub},
+	{"🏋️\u200d♂️", "man_lifting_weights", CLDR},
+	{"🏋️\u200d♂️", "weight_lifting_man", GitHub},
+	{"🏋🏻", "person_lifting_weights_light_skin_tone", CLDR},
+	{"🏋🏻\u200d♀️", "woman_lifting_weights_light_skin_tone", CLDR},
+	{"🏋🏻\u200d♂️", "man_lifting_weights_light_skin_tone", CLDR},
+	{"🏋🏼", "person_lifting_weights_medium_light_skin_tone", CLDR},
+	{"🏋🏼\u200d♀️", "woman_lifting_weights_medium_light_skin_tone", CLDR},
+	{"🏋🏼\u200d♂️", "man_lifting_weights_medium_light_skin_tone", CLDR},
+	{"🏋🏽", "person_lifting_weights_medium_skin_tone", CLDR},
+	{"🏋🏽\u200d♀️", "woman_lifting_weights_medium_skin_tone", CLDR},
+	{"🏋🏽\u200d♂️", "man_lifting_weights_medium_skin_tone", CLDR},
+	{"🏋🏾", "person_lifting_weights_medium_dark_skin_tone", CLDR},
+	{"🏋🏾\u200d♀️", "woman_lifting_weights_medium_dark_skin_tone", CLDR},
+	{"🏋🏾\u200d♂️", "man_lifting_weights_medium_dark_skin_tone", CLDR},
+	{"🏋🏿", "person_lifting_weights_dark_skin_tone", CLDR},
+	{"🏋🏿\u200d♀️", "woman_lifting_weights_dark_skin_tone", CLDR},
+	{"🏋🏿\u200d♂️", "man_lifting_weights_dark_skin_tone", CLDR},
+	{"🏌️", "person_golfing", CLDR},
+	{"🏌️", "golfing", GitHub},
+	{"🏌️\u200d♀️", "woman_golfing", CLDR},
+	{"🏌️\u200d♀️", "golfing_woman", GitHub},
+	{"🏌️\u200d♂️", "man_golfing", CLDR},
+	{"🏌️\u200d♂️", "golfing_man", GitHub},
+	{"🏌🏻", "person_golfing_light_skin_tone", CLDR},
+	{"🏌🏻\u200d♀️", "woman_golfing_light_skin_tone", CLDR},
+	{"🏌🏻\u200d♂️", "man_golfing_light_skin_tone", CLDR},
+	{"🏌🏼", "person_golfing_medium_light_skin_tone", CLDR},
+	{"🏌🏼\u200d♀️", "woman_golfing_medium_light_skin_tone", CLDR},
+	{"🏌🏼\u200d♂️", "man_golfing_medium_light_skin_tone", CLDR},
+	{"🏌🏽", "person_golfing_medium_skin_tone", CLDR},
+	{"🏌🏽\u200d♀️", "woman_golfing_medium_skin_tone", CLDR},
+	{"🏌🏽\u200d♂️", "man_golfing_medium_skin_tone", CLDR},
+	{"🏌🏾", "person_golfing_medium_dark_skin_tone", CLDR},
+	{"🏌🏾\u200d♀️", "woman_golfing_medium_dark_skin_tone", CLDR},
+	{"🏌🏾\u200d♂️", "man_golfing_medium_dark_skin_tone", CLDR},
+	{"🏌🏿", "person_golfing_dark_skin_tone", CLDR},
+	{"🏌🏿\u200d♀️", "woman_golfing_dark_skin_tone", CLDR},
+	{"🏌🏿\u200d♂️", "man_golfing_dark_skin_tone", CLDR},
+	{"🏍️", "motorcycle", CLDR},
+	{"🏍️", "motorcycle", GitHub},
+	{"🏎️", "racing_car", CLDR},
+	{"🏎️", "racing_car", GitHub},
+	{"🏏", "cricket_game", CLDR},
+	{"🏏", "cricket_game", GitHub},
+	{"🏐", "volleyball", CLDR},
+	{"🏐", "volleyball", GitHub},
+	{"🏑", "field_hockey", CLDR},
+	{"🏑", "field_hockey", GitHub},
+	{"🏒", "ice_hockey", CLDR},
+	{"🏒", "ice_hockey", GitHub},
+	{"🏓", "ping_pong", CLDR},
+	{"🏓", "ping_pong", GitHub},
+	{"🏔️", "snow_capped_mountain", CLDR},
+	{"🏔️", "mountain_snow", GitHub},
+	{"🏕️", "camping", CLDR},
+	{"🏕️", "camping", GitHub},
+	{"🏖️", "beach_with_umbrella", CLDR},
+	{"🏖️", "beach_umbrella", GitHub},
+	{"🏗️", "building_construction", CLDR},
+	{"🏗️", "building_construction", GitHub},
+	{"🏘️", "houses", CLDR},
+	{"🏘️", "houses", GitHub},
+	{"🏙️", "cityscape", CLDR},
+	{"🏙️", "cityscape", GitHub},
+	{"🏚️", "derelict_house", CLDR},
+	{"🏚️", "derelict_house", GitHub},
+	{"🏛️", "classical_building", CLDR},
+	{"🏛️", "classical_building", GitHub},
+	{"🏜️", "desert", CLDR},
+	{"🏜️", "desert", GitHub},
+	{"🏝️", "desert_island", CLDR},
+	{"🏝️", "desert_island", GitHub},
+	{"🏞️", "national_park", CLDR},
+	{"🏞️", "national_park", GitHub},
+	{"🏟️", "stadium", CLDR},
+	{"🏟️", "stadium", GitHub},
+	{"🏠", "house", CLDR},
+	{"🏠", "house", GitHub},
+	{"🏡", "house_with_garden", CLDR},
+	{"🏡", "house_with_garden", GitHub},
+	{"🏢", "office_building", CLDR},
+	{"🏢", "office", GitHub},
+	{"🏣", "japanese_post_office", CLDR},
+	{"🏣", "post_office", GitHub},
+	{"🏤", "post_office", CLDR},
+	{"🏤", "european_post_office", GitHub},
+	{"🏥", "hospital", CLDR},
+	{"🏥", "hospital", GitHub},
+	{"🏦", "bank", CLDR},
+	{"🏦", "bank", GitHub},
+	{"🏧", "atm_sign", CLDR},
+	{"🏧", "atm", GitHub},
+	{"🏨", "hotel", CLDR},
+	{"🏨", "hotel", GitHub},
+	{"🏩", "love_hotel", CLDR},
+	{"🏩", "love_hotel", GitHub},
+	{"🏪", "convenience_store", CLDR},
+	{"🏪", "convenience_store", GitHub},
+	{"🏫", "school", CLDR},
+	{"🏫", "school", GitHub},
+	{"🏬", "department_store", CLDR},
+	{"🏬", "department_store", GitHub},
+	{"🏭", "factory", CLDR},
+	{"🏭", "factory", GitHub},
+	{"🏮", "red_paper_lantern", CLDR},
+	{"🏮", "izakaya_lantern", GitHub},
+	{"🏮", "lantern", GitHub},
+	{"🏯", "japanese_castle", CLDR},
+	{"🏯", "japanese_castle", GitHub},
+	{"🏰", "castle", CLDR},
+	{"🏰", "european_castle", GitHub},
+	{"🏳️", "white_flag", CLDR},
+	{"🏳️", "white_flag", GitHub},
+	{"🏳️\u200d⚧️", "transgender_flag", CLDR},
+	{"🏳️\u200d⚧️", "transgender_flag", GitHub},
+	{"🏳️\u200d🌈", "rainbow_flag", CLDR},
+	{"🏳️\u200d🌈", "rainbow_flag", GitHub},
+	{"🏴", "black_flag", CLDR},
+	{"🏴", "black_flag", GitHub},
+	{"🏴\u200d☠️", "pirate_flag", CLDR},
+	{"🏴\u200d☠️", "pirate_flag", GitHub},
+	{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "flag_england", CLDR},
+	{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "england", GitHub},
+	{"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "flag_scotland", CLDR},
+	{"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "scotland", GitHub},
+	{"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", "flag_wales", CLDR},
+	{"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", "wales", GitHub},
+	{"🏵️", "rosette", CLDR},
+	{"🏵️", "rosette", GitHub},
+	{"🏷️", "label", CLDR},
+	{"🏷️", "label", GitHub},
+	{"🏸", "badminton", CLDR},
+	{"🏸", "badminton", GitHub},
+	{"🏹", "bow_and_arrow", CLDR},
+	{"🏹", "bow_and_arrow", GitHub},
+	{"🏺", "amphora", CLDR},
+	{"🏺", "amphora", GitHub},
+	{"🐀", "rat", CLDR},
+	{"🐀", "rat", GitHub},
+	{"🐁", "mouse", CLDR},
+	{"🐁", "mouse2", GitHub},
+	{"🐂", "ox", CLDR},
+	{"🐂", "ox", GitHub},
+	{"🐃", "water_buffalo", CLDR},
+	{"🐃", "water_buffalo", GitHub},
+	{"🐄", "cow", CLDR},
+	{"🐄", "cow2", GitHub},
+	{"🐅", "tiger", CLDR},
+	{"🐅", "tiger2", GitHub},
+	{"🐆", "leopard", CLDR},
+	{"🐆", "leopard", GitHub},
+	{"🐇", "rabbit", CLDR},
+	{"🐇", "rabbit2", GitHub},
+	{"🐈", "cat", CLDR},
+	{"🐈", "cat2", GitHub},
+	{"🐈\u200d⬛", "black_cat", CLDR},
+	{"🐈\u200d⬛", "black_cat", GitHub},
+	{"🐉", "dragon", CLDR},
+	{"🐉", "dragon", GitHub},
+	{"🐊", "crocodile", CLDR},
+	{"🐊", "crocodile", GitHub},
+	{"🐋", "whale", CLDR},
+	{"🐋", "whale2", GitHub},
+	{"🐌", "snail", CLDR},
+	{"🐌", "snail", GitHub},
+	{"🐍", "snake", CLDR},
+	{"🐍", "snake", GitHub},
+	{"🐎", "horse", CLDR},
+	{"🐎", "racehorse", GitHub},
+	{"🐏", "ram", CLDR},
+	{"🐏", "ram", GitHub},
+	{"🐐", "goat", CLDR},
+	{"🐐", "goat", GitHub},
+	{"🐑", "ewe", CLDR},
+	{"🐑", "sheep", GitHub},
+	{"🐒", "monkey", CLDR},
+	{"🐒", "monkey", GitHub},
+	{"🐓", "rooster", CLDR},
+	{"🐓", "rooster", GitHub},
+	{"🐔", "chicken", CLDR},
+	{"🐔", "chicken", GitHub},
+	{"🐕", "dog", CLDR},
+	{"🐕", "dog2", GitHub},
+	{"🐕\u200d🦺", "service_dog", CLDR},
+	{"🐕\u200d🦺", "service_dog", GitHub},
+	{"🐖", "pig", CLDR},
+	{"🐖", "pig2", GitHub},
+	{"🐗", "boar", CLDR},
+	{"🐗", "boar", GitHub},
+	{"🐘", "elephant", CLDR},
+	{"🐘", "elephant", GitHub},
+	{"🐙", "octopus", CLDR},
+	{"🐙", "octopus", GitHub},
+	{"🐚", "spiral_shell", CLDR},
+	{"🐚", "shell", GitHub},
+	{"🐛", "bug", CLDR},
+	{"🐛", "bug", GitHub},
+	{"🐜", "ant", CLDR},
+	{"🐜", "ant", GitHub},
+	{"🐝", "honeybee", CLDR},
+	{"🐝", "bee", GitHub},
+	{"🐝", "honeybee", GitHub},
+	{"🐞", "lady_beetle", CLDR},
+	{"🐞", "lady_beetle", GitHub},
+	{"🐟", "fish", CLDR},
+	{"🐟", "fish", GitHub},
+	{"🐠", "tropical_fish", CLDR},
+	{"🐠", "tropical_fish", GitHub},
+	{"🐡", "blowfish", CLDR},
+	{"🐡", "blowfish", GitHub},
+	{"🐢", "turtle", CLDR},
+	{"🐢", "turtle", GitHub},
+	{"🐣", "hatching_chick", CLDR},
+	{"🐣", "hatching_chick", GitHub},
+	{"🐤", "baby_chick", CLDR},
+	{"🐤", "baby_chick", GitHub},
+	{"🐥", "front_facing_baby_chick", CLDR},
+	{"🐥", "hatched_chick", GitHub},
+	{"🐦", "bird", CLDR},
+	{"🐦", "bird", GitHub},
+	{"🐧", "penguin", CLDR},
+	{"🐧", "penguin", GitHub},
+	{"🐨", "koala", CLDR},
+	{"🐨", "koala", GitHub},
+	{"🐩", "poodle", CLDR},
+	{"🐩", "poodle", GitHub},
+	{"🐪", "camel", CLDR},
+	{"🐪", "dromedary_camel", GitHub},
+	{"🐫", "two_hump_camel", CLDR},
+	{"🐫", "camel", GitHub},
+	{"🐬", "dolphin", CLDR},
+	{"🐬", "dolphin", GitHub},
+	{"🐬", "flipper", GitHub},
+	{"🐭", "mouse_face", CLDR},
+	{"🐭", "mouse", GitHub},
+	{"🐮", "cow_face", CLDR},
+	{"🐮", "cow", GitHub},
+	{"🐯", "tiger_face", CLDR},
+	{"🐯", "tiger", GitHub},
+	{"🐰", "rabbit_face", CLDR},
+	{"🐰", "rabbit", GitHub},
+	{"🐱", "cat_face", CLDR},
+	{"🐱", "cat", GitHub},
+	{"🐲", "dragon_face", CLDR},
+	{"🐲", "dragon_face", GitHub},
+	{"🐳", "spouting_whale", CLDR},
+	{"🐳", "whale", GitHub},
+	{"🐴", "horse_face", CLDR},
+	{"🐴", "horse", GitHub},
+	{"🐵", "monkey_face", CLDR},
+	{"🐵", "monkey_face", GitHub},
+	{"🐶", "dog_face", CLDR},
+	{"🐶", "dog", GitHub},
+	{"🐷", "pig_face", CLDR},
+	{"🐷", "pig", GitHub},
+	{"🐸", "frog", CLDR},
+	{"🐸", "frog", GitHub},
+	{"🐹", "hamster", CLDR},
+	{"🐹", "hamster", GitHub},
+	{"🐺", "wolf", CLDR},
+	{"🐺", "wolf", GitHub},
+	{"🐻", "bear", CLDR},
+	{"🐻", "bear", GitHub},
+	{"🐻\u200d❄️", "polar_bear", CLDR},
+	{"🐻\u200d❄️", "polar_bear", GitHub},
+	{"🐼", "panda", CLDR},
+	{"🐼", "panda_face", GitHub},
+	{"🐽", "pig_nose", CLDR},
+	{"🐽", "pig_nose", GitHub},
+	{"🐾", "paw_prints", CLDR},
+	{"🐾", "feet", GitHub},
+	{"🐾", "paw_prints", GitHub},
+	{"🐿️", "chipmunk", CLDR},
+	{"🐿️", "chipmunk", GitHub},
+	{"👀", "eyes", CLDR},
+	{"👀", "eyes", GitHub},
+	{"👁️", "eye", CLDR},
+	{"👁️", "eye", GitHub},
+	{"👁️\u200d🗨️", "eye_in_speech_bubble", CLDR},
+	{"👁️\u200d🗨️", "eye_speech_bubble", GitHub},
+	{"👂", "ear", CLDR},
+	{"👂", "ear", GitHub},
+	{"👂🏻", "ear_light_skin_tone", CLDR},
+	{"👂🏼", "ear_medium_light_skin_tone", CLDR},
+	{"👂🏽", "ear_medium_skin_tone", CLDR},
+	{"👂🏾", "ear_medium_dark_skin_tone", CLDR},
+	{"👂🏿", "ear_dark_skin_tone", CLDR},
+	{"👃", "nose", CLDR},
+	{"👃", "nose", GitHub},
+	{"👃🏻", "nose_light_skin_tone", CLDR},
+	{"👃🏼", "nose_medium_light_skin_tone", CLDR},
+	{"👃🏽", "nose_medium_skin_tone", CLDR},
+	{"👃🏾", "nose_medium_dark_skin_tone", CLDR},
+	{"👃🏿", "nose_dark_skin_tone", CLDR},
+	{"👄", "mouth", CLDR},
+	{"👄", "lips", GitHub},
+	{"👅", "tongue", CLDR},
+	{"👅", "tongue", GitHub},
+	{"👆", "backhand_index_pointing_up", CLDR},
+	{"👆", "point_up_2", GitHub},
+	{"👆🏻", "backhand_index_pointing_up_light_skin_tone", CLDR},
+	{"👆🏼", "backhand_index_pointing_up_medium_light_skin_tone", CLDR},
+	{"👆🏽", "backhand_index_pointing_up_medium_skin_tone", CLDR},
+	{"👆🏾", "backhand_index_pointing_up_medium_dark_skin_tone", CLDR},
+	{"👆🏿", "backhand_index_pointing_up_dark_skin_tone", CLDR},
+	{"👇", "backhand_index_pointing_down", CLDR},
+	{"👇", "point_down", GitHub},
+	{"👇🏻", "backhand_index_pointing_down_light_skin_tone", CLDR},
+	{"👇🏼", "backhand_index_pointing_down_medium_light_skin_tone", CLDR},
+	{"👇🏽", "backhand_index_pointing_down_medium_skin_tone", CLDR},
+	{"👇🏾", "backhand_index_pointing_down_medium_dark_skin_tone", CLDR},
+	{"👇🏿", "backhand_index_pointing_down_dark_skin_tone", CLDR},
+	{"👈", "backhand_index_pointing_left", CLDR},
+	{"👈", "point_left", GitHub},
+	{"👈🏻", "backhand_index_pointing_left_light_skin_tone", CLDR},
+	{"👈🏼", "backhand_index_pointing_left_medium_light_skin_tone", CLDR},
+	{"👈🏽", "backhand_index_pointing_left_medium_skin_tone", CLDR},
+	{"👈🏾", "backhand_index_pointing_left_medium_dark_skin_tone", CLDR},
+	{"👈🏿", "backhand_index_pointing_left_dark_skin_tone", CLDR},
+	{"👉", "backhand_index_pointing_right", CLDR},
+	{"👉", "point_right", GitHub},
+	{"👉🏻", "backhand_index_pointing_right_light_skin_tone", CLDR},
+	{"👉🏼", "backhand_index_pointing_right_medium_light_skin_tone", CLDR},
+	{"👉🏽", "backhand_index_pointing_right_medium_skin_tone", CLDR},
+	{"👉🏾", "backhand_index_pointing_right_medium_dark_skin_tone", CLDR},
+	{"👉🏿", "backhand_index_pointing_right_dark_skin_tone", CLDR},
+	{"👊", "oncoming_fist", CLDR},
+	{"👊", "fist_oncoming", GitHub},
+	{"👊", "facepunch", GitHub},
+	{"👊", "punch", GitHub},
+	{"👊🏻", "oncoming_fist_light_skin_tone", CLDR},
+	{"👊🏼", "oncoming_fist_medium_light_skin_tone", CLDR},
+	{"👊🏽", "oncoming_fist_medium_skin_tone", CLDR},
+	{"👊🏾", "oncoming_fist_medium_dark_skin_tone", CLDR},
+	{"👊🏿", "oncoming_fist_dark_skin_tone", CLDR},
+	{"👋", "waving_hand", CLDR},
+	{"👋", "wave", GitHub},
+	{"👋🏻", "waving_hand_light_skin_tone", CLDR},
+	{"👋🏼", "waving_hand_medium_light_skin_tone", CLDR},
+	{"👋🏽", "waving_hand_medium_skin_tone", CLDR},
+	{"👋🏾", "waving_hand_medium_dark_skin_tone", CLDR},
+	{"👋🏿", "waving_hand_dark_skin_tone", CLDR},
+	{"👌", "ok_hand", CLDR},
+	{"👌", "ok_hand", GitHub},
+	{"👌🏻", "ok_hand_light_skin_tone", CLDR},
+	{"👌🏼", "ok_hand_medium_light_skin_tone", CLDR},
+	{"👌🏽", "ok_hand_medium_skin_tone", CLDR},
+	{"👌🏾", "ok_hand_medium_dark_skin_tone", CLDR},
+	{"👌🏿", "ok_hand_dark_skin_tone", CLDR},
+	{"👍", "thumbs_up", CLDR},
+	{"👍", "+1", GitHub},
+	{"👍", "thumbsup", GitHub},
+	{"👍🏻", "thumbs_up_light_skin_tone", CLDR},
+	{"👍🏼", "thumbs_up_medium_light_skin_tone", CLDR},
+	{"👍🏽", "thumbs_up_medium_skin_tone", CLDR},
+	{"👍🏾", "thumbs_up_medium_dark_skin_tone", CLDR},
+	{"👍🏿", "thumbs_up_dark_skin_tone", CLDR},
+	{"👎", "thumbs_down", CLDR},
+	{"👎", "-1", GitHub},
+	{"👎", "thumbsdown", GitHub},
+	{"👎🏻", "thumbs_down_light_skin_tone", CLDR},
+	{"👎🏼", "thumbs_down_medium_light_skin_tone", CLDR},
+	{"👎🏽", "thumbs_down_medium_skin_tone", CLDR},
+	{"👎🏾", "thumbs_down_medium_dark_skin_tone", CLDR},
+	{"👎🏿", "thumbs_down_dark_skin_tone", CLDR},
+	{"👏", "clapping_hands", CLDR},
+	{"👏", "clap", GitHub},
+	{"👏🏻", "clapping_hands_light_skin_tone", CLDR},
+	{"👏🏼", "clapping_hands_medium_light_skin_tone", CLDR},
+	{"👏🏽", "clapping_hands_medium_skin_tone", CLDR},
+	{"👏🏾", "clapping_hands_medium_dark_skin_tone", CLDR},
+	{"👏🏿", "clapping_hands_dark_skin_tone", CLDR},
+	{"👐", "open_hands", CLDR},
+	{"👐", "open_hands", GitHub},
+	{"👐🏻", "open_hands_light_skin_tone", CLDR},
+	{"👐🏼", "open_hands_medium_light_skin_tone", CLDR},
+	{"👐🏽", "open_hands_medium_skin_tone", CLDR},
+	{"👐🏾", "open_hands_medium_dark_skin_tone", CLDR},
+	{"👐🏿", "open_hands_dark_skin_tone", CLDR},
+	{"👑", "crown", CLDR},
+	{"👑", "crown", GitHub},
+	{"👒", "womans_hat", CLDR},
+	{"👒", "womans_hat", GitHub},
+	{"👓", "glasses", CLDR},
+	{"👓", "eyeglasses", GitHub},
+	{"👔", "necktie", CLDR},
+	{"👔", "necktie", GitHub},
+	{"👕", "t_shirt", CLDR},
+	{"👕", "shirt", GitHub},
+	{"👕", "tshirt", GitHub},
+	{"👖", "jeans", CLDR},
+	{"👖", "jeans", GitHub},
+	{"👗", "dress", CLDR},
+	{"👗", "dress", GitHub},
+	{"👘", "kimono", CLDR},
+	{"👘", "kimono", GitHub},
+	{"👙", "bikini", CLDR},
+	{"👙", "bikini", GitHub},
+	{"👚", "womans_clothes", CLDR},
+	{"👚", "womans_clothes", GitHub},
+	{"👛", "purse", CLDR},
+	{"👛", "purse", GitHub},
+	{"👜", "handbag", CLDR},
+	{"👜", "handbag", GitHub},
+	{"👝", "clutch_bag", CLDR},
+	{"👝", "pouch", GitHub},
+	{"👞", "mans_shoe", CLDR},
+	{"👞", "mans_shoe", GitHub},
+	{"👞", "shoe", GitHub},
+	{"👟", "running_shoe", CLDR},
+	{"👟", "athletic_shoe", GitHub},
+	{"👠", "high_heeled_shoe", CLDR},
+	{"👠", "high_heel", GitHub},
+	{"👡", "womans_sandal", CLDR},
+	{"👡", "sandal", GitHub},
+	{"👢", "womans_boot", CLDR},
+	{"👢", "boot", GitHub},
+	{"👣", "footprints", CLDR},
+	{"👣", "footprints", GitHub},
+	{"👤", "bust_in_silhouette", CLDR},
+	{"👤", "bust_in_silhouette", GitHub},
+	{"👥", "busts_in_silhouette", CLDR},
+	{"👥", "busts_in_silhouette", GitHub},
+	{"👦", "boy", CLDR},
+	{"👦", "boy", GitHub},
+	{"👦🏻", "boy_light_skin_tone", CLDR},
+	{"👦🏼", "boy_medium_light_skin_tone", CLDR},
+	{"👦🏽", "boy_medium_skin_tone", CLDR},
+	{"👦🏾", "boy_medium_dark_skin_tone", CLDR},
+	{"👦🏿", "boy_dark_skin_tone", CLDR},
+	{"👧", "girl", CLDR},
+	{"👧", "girl", GitHub},
+	{"👧🏻", "girl_light_skin_tone", CLDR},
+	{"👧🏼", "girl_medium_light_skin_tone", CLDR},
+	{"👧🏽", "girl_medium_skin_tone", CLDR},
+	{"👧🏾", "girl_medium_dark_skin_tone", CLDR},
+	{"👧🏿", "girl_dark_skin_tone", CLDR},
+	{"👨", "man", CLDR},
+	{"👨", "man", GitHub},
+	{"👨\u200d⚕️", "man_health_worker", CLDR},
+	{"👨\u200d⚕️", "man_health_worker", GitHub},
+	{"👨\u200d⚖️", "man_judge", CLDR},
+	{"👨\u200d⚖️", "man_judge", GitHub},
+	{"👨\u200d✈️", "man_pilot", CLDR},
+	{"👨\u200d✈️", "man_pilot", GitHub},
+	{"👨\u200d❤️\u200d👨", "couple_with_heart_man_man", CLDR},
+	{"👨\u200d❤️\u200d👨", "couple_with_heart_man_man", GitHub},
+	{"👨\u200d❤️\u200d💋\u200d👨", "kiss_man_man", CLDR},
+	{"👨\u200d❤️\u200d💋\u200d👨", "couplekiss_man_man", GitHub},
+	{"👨\u200d🌾", "man_farmer", CLDR},
+	{"👨\u200d🌾", "man_farmer", GitHub},
+	{"👨\u200d🍳", "man_cook", CLDR},
+	{"👨\u200d🍳", "man_cook", GitHub},
+	{"👨\u200d🍼", "man_feeding_baby", CLDR},
+	{"👨\u200d🍼", "man_feeding_baby", GitHub},
+	{"👨\u200d🎓", "man_student", CLDR},
+	{"👨\u200d🎓", "man_student", GitHub},
+	{"👨\u200d🎤", "man_singer", CLDR},
+	{"👨\u200d🎤", "man_singer", GitHub},
+	{"👨\u200d🎨", "man_artist", CLDR},
+	{"👨\u200d🎨", "man_artist", GitHub},
+	{"👨\u200d🏫", "man_teacher", CLDR},
+	{"👨\u200d🏫", "man_teacher", GitHub},
+	{"👨\u200d🏭", "man_factory_worker", CLDR},
+	{"👨\u200d🏭", "man_factory_worker", GitHub},
+	{"👨\u200d👦", "family_man_boy", CLDR},
+	{"👨\u200d👦", "family_man_boy", GitHub},
+	{"👨\u200d👦\u200d👦", "family_man_boy_boy", CLDR},
+	{"👨\u200d👦\u200d👦", "family_man_boy_boy", GitHub},
+	{"👨\u200d👧", "family_man_girl", CLDR},
+	{"👨\u200d👧", "family_man_girl", GitHub},
+	{"👨\u200d👧\u200d👦", "family_man_girl_boy", CLDR},
+	{"👨\u200d👧\u200d👦", "family_man_girl_boy", GitHub},
+	{"👨\u200d👧\u200d👧", "family_man_girl_girl", CLDR},
+	{"👨\u200d👧\u200d👧", "family_man_girl_girl", GitHub},
+	{"👨\u200d👨\u200d👦", "family_man_man_boy", CLDR},
+	{"👨\u200d👨\u200d👦", "family_man_man_boy", GitHub},
+	{"👨\u200d👨\u200d👦\u200d👦", "family_man_man_boy_boy", CLDR},
+	{"👨\u200d👨\u200d👦\u200d👦", "family_man_man_boy_boy", GitHub},
+	{"👨\u200d👨\u200d👧", "family_man_man_girl", CLDR},
+	{"👨\u200d👨\u200d👧", "family_man_man_girl", GitHub},
+	{"👨\u200d👨\u200d👧\u200d👦", "family_man_man_girl_boy", CLDR},
+	{"👨\u200d👨\u200d👧\u200d👦", "family_man_man_girl_boy", GitHub},
+	{"👨\u200d👨\u200d👧\u200d👧", "family_man_man_girl_girl", CLDR},
+	{"👨\u200d👨\u200d👧\u200d👧", "family_man_man_girl_girl", GitHub},
+	{"👨\u200d👩\u200d👦", "family_man_woman_boy", CLDR},
+	{"👨\u200d👩\u200d👦", "family_man_woman_boy", GitHub},
+	{"👨\u200d👩\u200d👦\u200d👦", "family_man_woman_boy_boy", CLDR},
+	{"👨\u200d👩\u200d👦\u200d👦", "family_man_woman_boy_boy", GitHub},
+	{"👨\u200d👩\u200d👧", "family_man_woman_girl", CLDR},
+	{"👨\u200d👩\u200d👧", "family_man_woman_girl", GitHub},
+	{"👨\u200d👩\u200d👧\u200d👦", "family_man_woman_girl_boy", CLDR},
+	{"👨\u200d👩\u200d👧\u200d👦", "family_man_woman_girl_boy", GitHub},
+	{"👨\u200d👩\u200d👧\u200d👧", "family_man_woman_girl_girl", CLDR},
+	{"👨\u200d👩\u200d👧\u200d👧", "family_man_woman_girl_girl", GitHub},
+	{"👨\u200d💻", "man_technologist", CLDR},
+	{"👨\u200d💻", "man_technologist", GitHub},
+	{"👨\u200d💼", "man_office_worker", CLDR},
+	{"👨\u200d💼", "man_office_worker", GitHub},
+	{"👨\u200d🔧", "man_mechanic", CLDR},
+	{"👨\u200d🔧", "man_mechanic", GitHub},
+	{"👨\u200d🔬", "man_scientist", CLDR},
+	{"👨\u200d🔬", "man_scientist", GitHub},
+	{"👨\u200d🚀", "man_astronaut", CLDR},
+	{"👨\u200d🚀", "man_astronaut", GitHub},
+	{"👨\u200d🚒", "man_firefighter", CLDR},
+	{"👨\u200d🚒", "man_firefighter", GitHub},
+	{"👨\u200d🦯", "man_with_white_cane", CLDR},
+	{"👨\u200d🦯", "man_with_probing_cane", GitHub},
+	{"👨\u200d🦰", "man_red_hair", CLDR},
+	{"👨\u200d🦰", "red_haired_man", GitHub},
+	{"👨\u200d🦱", "man_curly_hair", CLDR},
+	{"👨\u200d🦱", "curly_haired_man", GitHub},
+	{"👨\u200d🦲", "man_bald", CLDR},
+	{"👨\u200d🦲", "bald_man", GitHub},
+	{"👨\u200d🦳", "man_white_hair", CLDR},
+	{"👨\u200d🦳", "white_haired_man", GitHub},
+	{"👨\u200d🦼", "man_in_motorized_wheelchair", CLDR},
+	{"👨\u200d🦼", "man_in_motorized_wheelchair", GitHub},
+	{"👨\u200d🦽", "man_in_manual_wheelchair", CLDR},
+	{"👨\u200d🦽", "man_in_manual_wheelchair", GitHub},
+	{"👨🏻", "man_light_skin_tone", CLDR},
+	{"👨🏻\u200d⚕️", "man_health_worker_light_skin_tone", CLDR},
+	{"👨🏻\u200d⚖️", "man_judge_light_skin_tone", CLDR},
+	{"👨🏻\u200d✈️", "man_pilot_light_skin_tone", CLDR},
+	{"👨🏻\u200d🌾", "man_farmer_light_skin_tone", CLDR},
+	{"👨🏻\u200d🍳", "man_cook_light_skin_tone", CLDR},
+	{"👨🏻\u200d🍼", "man_feeding_baby_light_skin_tone", CLDR},
+	{"👨🏻\u200d🎓", "man_student_light_skin_tone", CLDR},
+	{"👨🏻\u200d🎤", "man_singer_light_skin_tone", CLDR},
+	{"👨🏻\u200d🎨", "man_artist_light_skin_tone", CLDR},
+	{"👨🏻\u200d🏫", "man_teacher_light_skin_tone", CLDR},
+	{"👨🏻\u200d🏭", "man_factory_worker_light_skin_tone", CLDR},
+	{"👨🏻\u200d💻", "man_technologist_light_skin_tone", CLDR},
+	{"👨🏻\u200d💼", "man_office_worker_light_skin_tone", CLDR},
+	{"👨🏻\u200d🔧", "man_mechanic_light_skin_tone", CLDR},
+	{"👨🏻\u200d🔬", "man_scientist_light_skin_tone", CLDR},
+	{"👨🏻\u200d🚀", "man_astronaut_light_skin_tone", CLDR},
+	{"👨🏻\u200d🚒", "man_firefighter_light_skin_tone", CLDR},
+	{"👨🏻\u200d🤝\u200d👨🏼", "men_holding_hands_light_skin_tone_medium_light_skin_tone", CLDR},
+	{"👨🏻\u200d🤝\u200d👨🏽", "men_holding_hands_light_skin_tone_medium_skin_tone", CLDR},
+	{"👨🏻\u200d🤝\u200d👨🏾", "men_holding_hands_light_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👨🏻\u200d🤝\u200d👨🏿", "men_holding_hands_light_skin_tone_dark_skin_tone", CLDR},
+	{"👨🏻\u200d🦯", "man_with_white_cane_light_skin_tone", CLDR},
+	{"👨🏻\u200d🦰", "man_light_skin_tone_red_hair", CLDR},
+	{"👨🏻\u200d🦱", "man_light_skin_tone_curly_hair", CLDR},
+	{"👨🏻\u200d🦲", "man_light_skin_tone_bald", CLDR},
+	{"👨🏻\u200d🦳", "man_light_skin_tone_white_hair", CLDR},
+	{"👨🏻\u200d🦼", "man_in_motorized_wheelchair_light_skin_tone", CLDR},
+	{"👨🏻\u200d🦽", "man_in_manual_wheelchair_light_skin_tone", CLDR},
+	{"👨🏼", "man_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d⚕️", "man_health_worker_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d⚖️", "man_judge_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d✈️", "man_pilot_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🌾", "man_farmer_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🍳", "man_cook_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🍼", "man_feeding_baby_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🎓", "man_student_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🎤", "man_singer_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🎨", "man_artist_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🏫", "man_teacher_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🏭", "man_factory_worker_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d💻", "man_technologist_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d💼", "man_office_worker_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🔧", "man_mechanic_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🔬", "man_scientist_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🚀", "man_astronaut_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🚒", "man_firefighter_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🤝\u200d👨🏻", "men_holding_hands_medium_light_skin_tone_light_skin_tone", CLDR},
+	{"👨🏼\u200d🤝\u200d👨🏽", "men_holding_hands_medium_light_skin_tone_medium_skin_tone", CLDR},
+	{"👨🏼\u200d🤝\u200d👨🏾", "men_holding_hands_medium_light_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👨🏼\u200d🤝\u200d👨🏿", "men_holding_hands_medium_light_skin_tone_dark_skin_tone", CLDR},
+	{"👨🏼\u200d🦯", "man_with_white_cane_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🦰", "man_medium_light_skin_tone_red_hair", CLDR},
+	{"👨🏼\u200d🦱", "man_medium_light_skin_tone_curly_hair", CLDR},
+	{"👨🏼\u200d🦲", "man_medium_light_skin_tone_bald", CLDR},
+	{"👨🏼\u200d🦳", "man_medium_light_skin_tone_white_hair", CLDR},
+	{"👨🏼\u200d🦼", "man_in_motorized_wheelchair_medium_light_skin_tone", CLDR},
+	{"👨🏼\u200d🦽", "man_in_manual_wheelchair_medium_light_skin_tone", CLDR},
+	{"👨🏽", "man_medium_skin_tone", CLDR},
+	{"👨🏽\u200d⚕️", "man_health_worker_medium_skin_tone", CLDR},
+	{"👨🏽\u200d⚖️", "man_judge_medium_skin_tone", CLDR},
+	{"👨🏽\u200d✈️", "man_pilot_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🌾", "man_farmer_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🍳", "man_cook_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🍼", "man_feeding_baby_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🎓", "man_student_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🎤", "man_singer_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🎨", "man_artist_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🏫", "man_teacher_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🏭", "man_factory_worker_medium_skin_tone", CLDR},
+	{"👨🏽\u200d💻", "man_technologist_medium_skin_tone", CLDR},
+	{"👨🏽\u200d💼", "man_office_worker_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🔧", "man_mechanic_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🔬", "man_scientist_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🚀", "man_astronaut_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🚒", "man_firefighter_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🤝\u200d👨🏻", "men_holding_hands_medium_skin_tone_light_skin_tone", CLDR},
+	{"👨🏽\u200d🤝\u200d👨🏼", "men_holding_hands_medium_skin_tone_medium_light_skin_tone", CLDR},
+	{"👨🏽\u200d🤝\u200d👨🏾", "men_holding_hands_medium_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👨🏽\u200d🤝\u200d👨🏿", "men_holding_hands_medium_skin_tone_dark_skin_tone", CLDR},
+	{"👨🏽\u200d🦯", "man_with_white_cane_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🦰", "man_medium_skin_tone_red_hair", CLDR},
+	{"👨🏽\u200d🦱", "man_medium_skin_tone_curly_hair", CLDR},
+	{"👨🏽\u200d🦲", "man_medium_skin_tone_bald", CLDR},
+	{"👨🏽\u200d🦳", "man_medium_skin_tone_white_hair", CLDR},
+	{"👨🏽\u200d🦼", "man_in_motorized_wheelchair_medium_skin_tone", CLDR},
+	{"👨🏽\u200d🦽", "man_in_manual_wheelchair_medium_skin_tone", CLDR},
+	{"👨🏾", "man_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d⚕️", "man_health_worker_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d⚖️", "man_judge_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d✈️", "man_pilot_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🌾", "man_farmer_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🍳", "man_cook_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🍼", "man_feeding_baby_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🎓", "man_student_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🎤", "man_singer_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🎨", "man_artist_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🏫", "man_teacher_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🏭", "man_factory_worker_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d💻", "man_technologist_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d💼", "man_office_worker_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🔧", "man_mechanic_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🔬", "man_scientist_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🚀", "man_astronaut_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🚒", "man_firefighter_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🤝\u200d👨🏻", "men_holding_hands_medium_dark_skin_tone_light_skin_tone", CLDR},
+	{"👨🏾\u200d🤝\u200d👨🏼", "men_holding_hands_medium_dark_skin_tone_medium_light_skin_tone", CLDR},
+	{"👨🏾\u200d🤝\u200d👨🏽", "men_holding_hands_medium_dark_skin_tone_medium_skin_tone", CLDR},
+	{"👨🏾\u200d🤝\u200d👨🏿", "men_holding_hands_medium_dark_skin_tone_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🦯", "man_with_white_cane_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🦰", "man_medium_dark_skin_tone_red_hair", CLDR},
+	{"👨🏾\u200d🦱", "man_medium_dark_skin_tone_curly_hair", CLDR},
+	{"👨🏾\u200d🦲", "man_medium_dark_skin_tone_bald", CLDR},
+	{"👨🏾\u200d🦳", "man_medium_dark_skin_tone_white_hair", CLDR},
+	{"👨🏾\u200d🦼", "man_in_motorized_wheelchair_medium_dark_skin_tone", CLDR},
+	{"👨🏾\u200d🦽", "man_in_manual_wheelchair_medium_dark_skin_tone", CLDR},
+	{"👨🏿", "man_dark_skin_tone", CLDR},
+	{"👨🏿\u200d⚕️", "man_health_worker_dark_skin_tone", CLDR},
+	{"👨🏿\u200d⚖️", "man_judge_dark_skin_tone", CLDR},
+	{"👨🏿\u200d✈️", "man_pilot_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🌾", "man_farmer_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🍳", "man_cook_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🍼", "man_feeding_baby_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🎓", "man_student_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🎤", "man_singer_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🎨", "man_artist_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🏫", "man_teacher_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🏭", "man_factory_worker_dark_skin_tone", CLDR},
+	{"👨🏿\u200d💻", "man_technologist_dark_skin_tone", CLDR},
+	{"👨🏿\u200d💼", "man_office_worker_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🔧", "man_mechanic_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🔬", "man_scientist_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🚀", "man_astronaut_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🚒", "man_firefighter_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🤝\u200d👨🏻", "men_holding_hands_dark_skin_tone_light_skin_tone", CLDR},
+	{"👨🏿\u200d🤝\u200d👨🏼", "men_holding_hands_dark_skin_tone_medium_light_skin_tone", CLDR},
+	{"👨🏿\u200d🤝\u200d👨🏽", "men_holding_hands_dark_skin_tone_medium_skin_tone", CLDR},
+	{"👨🏿\u200d🤝\u200d👨🏾", "men_holding_hands_dark_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🦯", "man_with_white_cane_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🦰", "man_dark_skin_tone_red_hair", CLDR},
+	{"👨🏿\u200d🦱", "man_dark_skin_tone_curly_hair", CLDR},
+	{"👨🏿\u200d🦲", "man_dark_skin_tone_bald", CLDR},
+	{"👨🏿\u200d🦳", "man_dark_skin_tone_white_hair", CLDR},
+	{"👨🏿\u200d🦼", "man_in_motorized_wheelchair_dark_skin_tone", CLDR},
+	{"👨🏿\u200d🦽", "man_in_manual_wheelchair_dark_skin_tone", CLDR},
+	{"👩", "woman", CLDR},
+	{"👩", "woman", GitHub},
+	{"👩\u200d⚕️", "woman_health_worker", CLDR},
+	{"👩\u200d⚕️", "woman_health_worker", GitHub},
+	{"👩\u200d⚖️", "woman_judge", CLDR},
+	{"👩\u200d⚖️", "woman_judge", GitHub},
+	{"👩\u200d✈️", "woman_pilot", CLDR},
+	{"👩\u200d✈️", "woman_pilot", GitHub},
+	{"👩\u200d❤️\u200d👨", "couple_with_heart_woman_man", CLDR},
+	{"👩\u200d❤️\u200d👨", "couple_with_heart_woman_man", GitHub},
+	{"👩\u200d❤️\u200d👩", "couple_with_heart_woman_woman", CLDR},
+	{"👩\u200d❤️\u200d👩", "couple_with_heart_woman_woman", GitHub},
+	{"👩\u200d❤️\u200d💋\u200d👨", "kiss_woman_man", CLDR},
+	{"👩\u200d❤️\u200d💋\u200d👨", "couplekiss_man_woman", GitHub},
+	{"👩\u200d❤️\u200d💋\u200d👩", "kiss_woman_woman", CLDR},
+	{"👩\u200d❤️\u200d💋\u200d👩", "couplekiss_woman_woman", GitHub},
+	{"👩\u200d🌾", "woman_farmer", CLDR},
+	{"👩\u200d🌾", "woman_farmer", GitHub},
+	{"👩\u200d🍳", "woman_cook", CLDR},
+	{"👩\u200d🍳", "woman_cook", GitHub},
+	{"👩\u200d🍼", "woman_feeding_baby", CLDR},
+	{"👩\u200d🍼", "woman_feeding_baby", GitHub},
+	{"👩\u200d🎓", "woman_student", CLDR},
+	{"👩\u200d🎓", "woman_student", GitHub},
+	{"👩\u200d🎤", "woman_singer", CLDR},
+	{"👩\u200d🎤", "woman_singer", GitHub},
+	{"👩\u200d🎨", "woman_artist", CLDR},
+	{"👩\u200d🎨", "woman_artist", GitHub},
+	{"👩\u200d🏫", "woman_teacher", CLDR},
+	{"👩\u200d🏫", "woman_teacher", GitHub},
+	{"👩\u200d🏭", "woman_factory_worker", CLDR},
+	{"👩\u200d🏭", "woman_factory_worker", GitHub},
+	{"👩\u200d👦", "family_woman_boy", CLDR},
+	{"👩\u200d👦", "family_woman_boy", GitHub},
+	{"👩\u200d👦\u200d👦", "family_woman_boy_boy", CLDR},
+	{"👩\u200d👦\u200d👦", "family_woman_boy_boy", GitHub},
+	{"👩\u200d👧", "family_woman_girl", CLDR},
+	{"👩\u200d👧", "family_woman_girl", GitHub},
+	{"👩\u200d👧\u200d👦", "family_woman_girl_boy", CLDR},
+	{"👩\u200d👧\u200d👦", "family_woman_girl_boy", GitHub},
+	{"👩\u200d👧\u200d👧", "family_woman_girl_girl", CLDR},
+	{"👩\u200d👧\u200d👧", "family_woman_girl_girl", GitHub},
+	{"👩\u200d👩\u200d👦", "family_woman_woman_boy", CLDR},
+	{"👩\u200d👩\u200d👦", "family_woman_woman_boy", GitHub},
+	{"👩\u200d👩\u200d👦\u200d👦", "family_woman_woman_boy_boy", CLDR},
+	{"👩\u200d👩\u200d👦\u200d👦", "family_woman_woman_boy_boy", GitHub},
+	{"👩\u200d👩\u200d👧", "family_woman_woman_girl", CLDR},
+	{"👩\u200d👩\u200d👧", "family_woman_woman_girl", GitHub},
+	{"👩\u200d👩\u200d👧\u200d👦", "family_woman_woman_girl_boy", CLDR},
+	{"👩\u200d👩\u200d👧\u200d👦", "family_woman_woman_girl_boy", GitHub},
+	{"👩\u200d👩\u200d👧\u200d👧", "family_woman_woman_girl_girl", CLDR},
+	{"👩\u200d👩\u200d👧\u200d👧", "family_woman_woman_girl_girl", GitHub},
+	{"👩\u200d💻", "woman_technologist", CLDR},
+	{"👩\u200d💻", "woman_technologist", GitHub},
+	{"👩\u200d💼", "woman_office_worker", CLDR},
+	{"👩\u200d💼", "woman_office_worker", GitHub},
+	{"👩\u200d🔧", "woman_mechanic", CLDR},
+	{"👩\u200d🔧", "woman_mechanic", GitHub},
+	{"👩\u200d🔬", "woman_scientist", CLDR},
+	{"👩\u200d🔬", "woman_scientist", GitHub},
+	{"👩\u200d🚀", "woman_astronaut", CLDR},
+	{"👩\u200d🚀", "woman_astronaut", GitHub},
+	{"👩\u200d🚒", "woman_firefighter", CLDR},
+	{"👩\u200d🚒", "woman_firefighter", GitHub},
+	{"👩\u200d🦯", "woman_with_white_cane", CLDR},
+	{"👩\u200d🦯", "woman_with_probing_cane", GitHub},
+	{"👩\u200d🦰", "woman_red_hair", CLDR},
+	{"👩\u200d🦰", "red_haired_woman", GitHub},
+	{"👩\u200d🦱", "woman_curly_hair", CLDR},
+	{"👩\u200d🦱", "curly_haired_woman", GitHub},
+	{"👩\u200d🦲", "woman_bald", CLDR},
+	{"👩\u200d🦲", "bald_woman", GitHub},
+	{"👩\u200d🦳", "woman_white_hair", CLDR},
+	{"👩\u200d🦳", "white_haired_woman", GitHub},
+	{"👩\u200d🦼", "woman_in_motorized_wheelchair", CLDR},
+	{"👩\u200d🦼", "woman_in_motorized_wheelchair", GitHub},
+	{"👩\u200d🦽", "woman_in_manual_wheelchair", CLDR},
+	{"👩\u200d🦽", "woman_in_manual_wheelchair", GitHub},
+	{"👩🏻", "woman_light_skin_tone", CLDR},
+	{"👩🏻\u200d⚕️", "woman_health_worker_light_skin_tone", CLDR},
+	{"👩🏻\u200d⚖️", "woman_judge_light_skin_tone", CLDR},
+	{"👩🏻\u200d✈️", "woman_pilot_light_skin_tone", CLDR},
+	{"👩🏻\u200d🌾", "woman_farmer_light_skin_tone", CLDR},
+	{"👩🏻\u200d🍳", "woman_cook_light_skin_tone", CLDR},
+	{"👩🏻\u200d🍼", "woman_feeding_baby_light_skin_tone", CLDR},
+	{"👩🏻\u200d🎓", "woman_student_light_skin_tone", CLDR},
+	{"👩🏻\u200d🎤", "woman_singer_light_skin_tone", CLDR},
+	{"👩🏻\u200d🎨", "woman_artist_light_skin_tone", CLDR},
+	{"👩🏻\u200d🏫", "woman_teacher_light_skin_tone", CLDR},
+	{"👩🏻\u200d🏭", "woman_factory_worker_light_skin_tone", CLDR},
+	{"👩🏻\u200d💻", "woman_technologist_light_skin_tone", CLDR},
+	{"👩🏻\u200d💼", "woman_office_worker_light_skin_tone", CLDR},
+	{"👩🏻\u200d🔧", "woman_mechanic_light_skin_tone", CLDR},
+	{"👩🏻\u200d🔬", "woman_scientist_light_skin_tone", CLDR},
+	{"👩🏻\u200d🚀", "woman_astronaut_light_skin_tone", CLDR},
+	{"👩🏻\u200d🚒", "woman_firefighter_light_skin_tone", CLDR},
+	{"👩🏻\u200d🤝\u200d👨🏼", "woman_and_man_holding_hands_light_skin_tone_medium_light_skin_tone", CLDR},
+	{"👩🏻\u200d🤝\u200d👨🏽", "woman_and_man_holding_hands_light_skin_tone_medium_skin_tone", CLDR},
+	{"👩🏻\u200d🤝\u200d👨🏾", "woman_and_man_holding_hands_light_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👩🏻\u200d🤝\u200d👨🏿", "woman_and_man_holding_hands_light_skin_tone_dark_skin_tone", CLDR},
+	{"👩🏻\u200d🤝\u200d👩🏼", "women_holding_hands_light_skin_tone_medium_light_skin_tone", CLDR},
+	{"👩🏻\u200d🤝\u200d👩🏽", "women_holding_hands_light_skin_tone_medium_skin_tone", CLDR},
+	{"👩🏻\u200d🤝\u200d👩🏾", "women_holding_hands_light_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👩🏻\u200d🤝\u200d👩🏿", "women_holding_hands_light_skin_tone_dark_skin_tone", CLDR},
+	{"👩🏻\u200d🦯", "woman_with_white_cane_light_skin_tone", CLDR},
+	{"👩🏻\u200d🦰", "woman_light_skin_tone_red_hair", CLDR},
+	{"👩🏻\u200d🦱", "woman_light_skin_tone_curly_hair", CLDR},
+	{"👩🏻\u200d🦲", "woman_light_skin_tone_bald", CLDR},
+	{"👩🏻\u200d🦳", "woman_light_skin_tone_white_hair", CLDR},
+	{"👩🏻\u200d🦼", "woman_in_motorized_wheelchair_light_skin_tone", CLDR},
+	{"👩🏻\u200d🦽", "woman_in_manual_wheelchair_light_skin_tone", CLDR},
+	{"👩🏼", "woman_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d⚕️", "woman_health_worker_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d⚖️", "woman_judge_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d✈️", "woman_pilot_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🌾", "woman_farmer_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🍳", "woman_cook_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🍼", "woman_feeding_baby_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🎓", "woman_student_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🎤", "woman_singer_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🎨", "woman_artist_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🏫", "woman_teacher_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🏭", "woman_factory_worker_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d💻", "woman_technologist_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d💼", "woman_office_worker_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🔧", "woman_mechanic_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🔬", "woman_scientist_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🚀", "woman_astronaut_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🚒", "woman_firefighter_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🤝\u200d👨🏻", "woman_and_man_holding_hands_medium_light_skin_tone_light_skin_tone", CLDR},
+	{"👩🏼\u200d🤝\u200d👨🏽", "woman_and_man_holding_hands_medium_light_skin_tone_medium_skin_tone", CLDR},
+	{"👩🏼\u200d🤝\u200d👨🏾", "woman_and_man_holding_hands_medium_light_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👩🏼\u200d🤝\u200d👨🏿", "woman_and_man_holding_hands_medium_light_skin_tone_dark_skin_tone", CLDR},
+	{"👩🏼\u200d🤝\u200d👩🏻", "women_holding_hands_medium_light_skin_tone_light_skin_tone", CLDR},
+	{"👩🏼\u200d🤝\u200d👩🏽", "women_holding_hands_medium_light_skin_tone_medium_skin_tone", CLDR},
+	{"👩🏼\u200d🤝\u200d👩🏾", "women_holding_hands_medium_light_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👩🏼\u200d🤝\u200d👩🏿", "women_holding_hands_medium_light_skin_tone_dark_skin_tone", CLDR},
+	{"👩🏼\u200d🦯", "woman_with_white_cane_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🦰", "woman_medium_light_skin_tone_red_hair", CLDR},
+	{"👩🏼\u200d🦱", "woman_medium_light_skin_tone_curly_hair", CLDR},
+	{"👩🏼\u200d🦲", "woman_medium_light_skin_tone_bald", CLDR},
+	{"👩🏼\u200d🦳", "woman_medium_light_skin_tone_white_hair", CLDR},
+	{"👩🏼\u200d🦼", "woman_in_motorized_wheelchair_medium_light_skin_tone", CLDR},
+	{"👩🏼\u200d🦽", "woman_in_manual_wheelchair_medium_light_skin_tone", CLDR},
+	{"👩🏽", "woman_medium_skin_tone", CLDR},
+	{"👩🏽\u200d⚕️", "woman_health_worker_medium_skin_tone", CLDR},
+	{"👩🏽\u200d⚖️", "woman_judge_medium_skin_tone", CLDR},
+	{"👩🏽\u200d✈️", "woman_pilot_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🌾", "woman_farmer_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🍳", "woman_cook_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🍼", "woman_feeding_baby_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🎓", "woman_student_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🎤", "woman_singer_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🎨", "woman_artist_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🏫", "woman_teacher_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🏭", "woman_factory_worker_medium_skin_tone", CLDR},
+	{"👩🏽\u200d💻", "woman_technologist_medium_skin_tone", CLDR},
+	{"👩🏽\u200d💼", "woman_office_worker_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🔧", "woman_mechanic_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🔬", "woman_scientist_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🚀", "woman_astronaut_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🚒", "woman_firefighter_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🤝\u200d👨🏻", "woman_and_man_holding_hands_medium_skin_tone_light_skin_tone", CLDR},
+	{"👩🏽\u200d🤝\u200d👨🏼", "woman_and_man_holding_hands_medium_skin_tone_medium_light_skin_tone", CLDR},
+	{"👩🏽\u200d🤝\u200d👨🏾", "woman_and_man_holding_hands_medium_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👩🏽\u200d🤝\u200d👨🏿", "woman_and_man_holding_hands_medium_skin_tone_dark_skin_tone", CLDR},
+	{"👩🏽\u200d🤝\u200d👩🏻", "women_holding_hands_medium_skin_tone_light_skin_tone", CLDR},
+	{"👩🏽\u200d🤝\u200d👩🏼", "women_holding_hands_medium_skin_tone_medium_light_skin_tone", CLDR},
+	{"👩🏽\u200d🤝\u200d👩🏾", "women_holding_hands_medium_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👩🏽\u200d🤝\u200d👩🏿", "women_holding_hands_medium_skin_tone_dark_skin_tone", CLDR},
+	{"👩🏽\u200d🦯", "woman_with_white_cane_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🦰", "woman_medium_skin_tone_red_hair", CLDR},
+	{"👩🏽\u200d🦱", "woman_medium_skin_tone_curly_hair", CLDR},
+	{"👩🏽\u200d🦲", "woman_medium_skin_tone_bald", CLDR},
+	{"👩🏽\u200d🦳", "woman_medium_skin_tone_white_hair", CLDR},
+	{"👩🏽\u200d🦼", "woman_in_motorized_wheelchair_medium_skin_tone", CLDR},
+	{"👩🏽\u200d🦽", "woman_in_manual_wheelchair_medium_skin_tone", CLDR},
+	{"👩🏾", "woman_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d⚕️", "woman_health_worker_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d⚖️", "woman_judge_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d✈️", "woman_pilot_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🌾", "woman_farmer_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🍳", "woman_cook_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🍼", "woman_feeding_baby_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🎓", "woman_student_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🎤", "woman_singer_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🎨", "woman_artist_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🏫", "woman_teacher_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🏭", "woman_factory_worker_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d💻", "woman_technologist_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d💼", "woman_office_worker_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🔧", "woman_mechanic_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🔬", "woman_scientist_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🚀", "woman_astronaut_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🚒", "woman_firefighter_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🤝\u200d👨🏻", "woman_and_man_holding_hands_medium_dark_skin_tone_light_skin_tone", CLDR},
+	{"👩🏾\u200d🤝\u200d👨🏼", "woman_and_man_holding_hands_medium_dark_skin_tone_medium_light_skin_tone", CLDR},
+	{"👩🏾\u200d🤝\u200d👨🏽", "woman_and_man_holding_hands_medium_dark_skin_tone_medium_skin_tone", CLDR},
+	{"👩🏾\u200d🤝\u200d👨🏿", "woman_and_man_holding_hands_medium_dark_skin_tone_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🤝\u200d👩🏻", "women_holding_hands_medium_dark_skin_tone_light_skin_tone", CLDR},
+	{"👩🏾\u200d🤝\u200d👩🏼", "women_holding_hands_medium_dark_skin_tone_medium_light_skin_tone", CLDR},
+	{"👩🏾\u200d🤝\u200d👩🏽", "women_holding_hands_medium_dark_skin_tone_medium_skin_tone", CLDR},
+	{"👩🏾\u200d🤝\u200d👩🏿", "women_holding_hands_medium_dark_skin_tone_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🦯", "woman_with_white_cane_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🦰", "woman_medium_dark_skin_tone_red_hair", CLDR},
+	{"👩🏾\u200d🦱", "woman_medium_dark_skin_tone_curly_hair", CLDR},
+	{"👩🏾\u200d🦲", "woman_medium_dark_skin_tone_bald", CLDR},
+	{"👩🏾\u200d🦳", "woman_medium_dark_skin_tone_white_hair", CLDR},
+	{"👩🏾\u200d🦼", "woman_in_motorized_wheelchair_medium_dark_skin_tone", CLDR},
+	{"👩🏾\u200d🦽", "woman_in_manual_wheelchair_medium_dark_skin_tone", CLDR},
+	{"👩🏿", "woman_dark_skin_tone", CLDR},
+	{"👩🏿\u200d⚕️", "woman_health_worker_dark_skin_tone", CLDR},
+	{"👩🏿\u200d⚖️", "woman_judge_dark_skin_tone", CLDR},
+	{"👩🏿\u200d✈️", "woman_pilot_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🌾", "woman_farmer_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🍳", "woman_cook_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🍼", "woman_feeding_baby_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🎓", "woman_student_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🎤", "woman_singer_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🎨", "woman_artist_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🏫", "woman_teacher_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🏭", "woman_factory_worker_dark_skin_tone", CLDR},
+	{"👩🏿\u200d💻", "woman_technologist_dark_skin_tone", CLDR},
+	{"👩🏿\u200d💼", "woman_office_worker_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🔧", "woman_mechanic_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🔬", "woman_scientist_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🚀", "woman_astronaut_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🚒", "woman_firefighter_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🤝\u200d👨🏻", "woman_and_man_holding_hands_dark_skin_tone_light_skin_tone", CLDR},
+	{"👩🏿\u200d🤝\u200d👨🏼", "woman_and_man_holding_hands_dark_skin_tone_medium_light_skin_tone", CLDR},
+	{"👩🏿\u200d🤝\u200d👨🏽", "woman_and_man_holding_hands_dark_skin_tone_medium_skin_tone", CLDR},
+	{"👩🏿\u200d🤝\u200d👨🏾", "woman_and_man_holding_hands_dark_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🤝\u200d👩🏻", "women_holding_hands_dark_skin_tone_light_skin_tone", CLDR},
+	{"👩🏿\u200d🤝\u200d👩🏼", "women_holding_hands_dark_skin_tone_medium_light_skin_tone", CLDR},
+	{"👩🏿\u200d🤝\u200d👩🏽", "women_holding_hands_dark_skin_tone_medium_skin_tone", CLDR},
+	{"👩🏿\u200d🤝\u200d👩🏾", "women_holding_hands_dark_skin_tone_medium_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🦯", "woman_with_white_cane_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🦰", "woman_dark_skin_tone_red_hair", CLDR},
+	{"👩🏿\u200d🦱", "woman_dark_skin_tone_curly_hair", CLDR},
+	{"👩🏿\u200d🦲", "woman_dark_skin_tone_bald", CLDR},
+	{"👩🏿\u200d🦳", "woman_dark_skin_tone_white_hair", CLDR},
+	{"👩🏿\u200d🦼", "woman_in_motorized_wheelchair_dark_skin_tone", CLDR},
+	{"👩🏿\u200d🦽", "woman_in_manual_wheelchair_dark_skin_tone", CLDR},
+	{"👪", "family", CLDR},
+	{"👪", "family", GitHub},
+	{"👫", "woman_and_man_holding_hands", CLDR},
+	{"👫", "couple", GitHub},
+	{"👫🏻", "woman_and_man_holding_hands_light_skin_tone", CLDR},
+	{"👫🏼", "woman_and_man_holding_hands_medium_light_skin_tone", CLDR},
+	{"👫🏽", "woman_and_man_holding_hands_medium_skin_tone", CLDR},
+	{"👫🏾", "woman_and_man_holding_hands_medium_dark_skin_tone", CLDR},
+	{"👫🏿", "woman_and_man_holding_hands_dark_skin_tone", CLDR},
+	{"👬", "men_holding_hands", CLDR},
+	{"👬", "two_men_holding_hands", GitHub},
+	{"👬🏻", "men_holding_hands_light_skin_tone", CLDR},
+	{"👬🏼", "men_holding_hands_medium_light_skin_tone", CLDR},
+	{"👬🏽", "men_holding_hands_medium_skin_tone", CLDR},
+	{"👬🏾", "men_holding_hands_medium_dark_skin_tone", CLDR},
+	{"👬🏿", "men_holding_hands_dark_skin_tone", CLDR},
+	{"👭", "women_holding_hands", CLDR},
+	{"👭", "two_women_holding_hands", GitHub},
+	{"👭🏻", "women_holding_hands_light_skin_tone", CLDR},
+	{"👭🏼", "women_holding_hands_medium_light_skin_tone", CLDR},
+	{"👭🏽", "women_holding_hands_medium_skin_tone", CLDR},
+	{"👭🏾", "women_holding_hands_medium_dark_skin_tone", CLDR},
+	{"👭🏿", "women_holding_hands_dark_skin_tone", CLDR},
+	{"👮", "police_officer", CLDR},
+	{"👮", "police_officer", GitHub},
+	{"👮", "cop", GitHub},
+	{"👮\u200d♀️", "woman_police_officer", CLDR},
+	{"👮\u200d♀️", "policewoman", GitHub},
+	{"👮\u200d♂️", "man_police_officer", CLDR},
+	{"👮\u200d♂️", "policeman", GitHub},
+	{"👮🏻", "police_officer_light_skin_tone", CLDR},
+	{"👮🏻\u200d♀️", "woman_police_officer_light_skin_tone", CLDR},
+	{"👮🏻\u200d♂️", "man_police_officer_light_skin_tone", CLDR},
+	{"👮🏼", "police_officer_medium_light_skin_tone", CLDR},
+	{"👮🏼\u200d♀️", "woman_police_officer_medium_light_skin_tone", CLDR},
+	{"👮🏼\u200d♂️", "man_police_officer_medium_light_skin_tone", CLDR},
+	{"👮🏽", "police_officer_medium_skin_tone", CLDR},
+	{"👮🏽\u200d♀️", "woman_police_officer_medium_skin_tone", CLDR},
+	{"👮🏽\u200d♂️", "man_police_officer_medium_skin_tone", CLDR},
+	{"👮🏾", "police_officer_medium_dark_skin_tone", CLDR},
+	{"👮🏾\u200d♀️", "woman_police_officer_medium_dark_skin_tone", CLDR},
+	{"👮🏾\u200d♂️", "man_police_officer_medium_dark_skin_tone", CLDR},
+	{"👮🏿", "police_officer_dark_skin_tone", CLDR},
+	{"👮🏿\u200d♀️", "woman_police_officer_dark_skin_tone", CLDR},
+	{"👮🏿\u200d♂️", "man_police_officer_dark_skin_tone", CLDR},
+	{"👯", "people_with_bunny_ears", CLDR},
+	{"👯", "dancers", GitHub},
+	{"👯\u200d♀️", "women_with_bunny_ears", CLDR},
+	{"👯\u200d♀️", "dancing_women", GitHub},
+	{"👯\u200d♂️", "men_with_bunny_ears", CLDR},
+	{"👯\u200d♂️", "dancing_men", GitHub},
+	{"👰", "person_with_veil", CLDR},
+	{"👰", "person_with_veil", GitHub},
+	{"👰\u200d♀️", "woman_with_veil", CLDR},
+	{"👰\u200d♀️", "woman_with_veil", GitHub},
+	{"👰\u200d♀️", "bride_with_veil", GitHub},
+	{"👰\u200d♂️", "man_with_veil", CLDR},
+	{"👰\u200d♂️", "man_with_veil", GitHub},
+	{"👰🏻", "person_with_veil_light_skin_tone", CLDR},
+	{"👰🏻\u200d♀️", "woman_with_veil_light_skin_tone", CLDR},
+	{"👰🏻\u200d♂️", "man_with_veil_light_skin_tone", CLDR},
+	{"👰🏼", "person_with_veil_medium_light_skin_tone", CLDR},
+	{"👰🏼\u200d♀️", "woman_with_veil_medium_light_skin_tone", CLDR},
+	{"👰🏼\u200d♂️", "man_with_veil_medium_light_skin_tone", CLDR},
+	{"👰🏽", "person_with_veil_medium_skin_tone", CLDR},
+	{"👰🏽\u200d♀️", "woman_with_veil_medium_skin_tone", CLDR},
+	{"👰🏽\u200d♂️", "man_with_veil_medium_skin_tone", CLDR},
+	{"👰🏾", "person_with_veil_medium_dark_skin_tone", CLDR},
+	{"👰🏾\u200d♀️", "woman_with_veil_medium_dark_skin_tone", CLDR},
+	{"👰🏾\u200d♂️", "man_with_veil_medium_dark_skin_tone", CLDR},
+	{"👰🏿", "person_with_veil_dark_skin_tone", CLDR},
+	{"👰🏿\u200d♀️", "woman_with_veil_dark_skin_tone", CLDR},
+	{"👰🏿\u200d♂️", "man_with_veil_dark_skin_tone", CLDR},
+	{"👱", "person_blond_hair", CLDR},
+	{"👱", "blond_haired_person", GitHub},
+	{"👱\u200d♀️", "woman_blond_hair", CLDR},
+	{"👱\u200d♀️", "blond_haired_woman", GitHub},
+	{"👱\u200d♀️", "blonde_woman", GitHub},
+	{"👱\u200d♂️", "man_blond_hair", CLDR},
+	{"👱\u200d♂️", "blond_haired_man", GitHub},
+	{"👱🏻", "person_light_skin_tone_blond_hair", CLDR},
+	{"👱🏻\u200d♀️", "woman_light_skin_tone_blond_hair", CLDR},
+	{"👱🏻\u200d♂️", "man_light_skin_tone_blond_hair", CLDR},
+	{"👱🏼", "person_medium_light_skin_tone_blond_hair", CLDR},
+	{"👱🏼\u200d♀️", "woman_medium_light_skin_tone_blond_hair", CLDR},
+	{"👱🏼\u200d♂️", "man_medium_light_skin_tone_blond_hair", CLDR},
+	{"👱🏽", "person_medium_skin_tone_blond_hair", CLDR},
+	{"👱🏽\u200d♀️", "woman_medium_skin_tone_blond_hair", CLDR},
+	{"👱🏽\u200d♂️", "man_medium_skin_tone_blond_hair", CLDR},
+	{"👱🏾", "person_medium_dark_skin_tone_blond_hair", CLDR},
+	{"👱🏾\u200d♀️", "woman_medium_dark_skin_tone_blond_hair", CLDR},
+	{"👱🏾\u200d♂️", "man_medium_dark_skin_tone_blond_hair", CLDR},
+	{"👱🏿", "person_dark_skin_tone_blond_hair", CLDR},
+	{"👱🏿\u200d♀️", "woman_dark_skin_tone_blond_hair", CLDR},
+	{"👱🏿\u200d♂️", "man_dark_skin_tone_blond_hair", CLDR},
+	{"👲", "person_with_skullcap", CLDR},
+	{"👲", "man_with_gua_pi_mao", GitHub},
+	{"👲🏻", "person_with_skullcap_light_skin_tone", CLDR},
+	{"👲🏼", "person_with_skullcap_medium_light_skin_tone", CLDR},
+	{"👲🏽", "person_with_skullcap_medium_skin_tone", CLDR},
+	{"👲🏾", "person_with_skullcap_medium_dark_skin_tone", CLDR},
+	{"👲🏿", "person_with_skullcap_dark_skin_tone", CLDR},
+	{"👳", "person_wearing_turban", CLDR},
+	{"👳", "person_with_turban", GitHub},
+	{"👳\u200d♀️", "woman_wearing_turban", CLDR},
+	{"👳\u200d♀️", "woman_with_turban", GitHub},
+	{"👳\u200d♂️", "man_wearing_turban", CLDR},
+	{"👳\u200d♂️", "man_with_turban", GitHub},
+	{"👳🏻", "person_wearing_turban_light_skin_tone", CLDR},
+	{"👳🏻\u200d♀️", "woman_wearing_turban_light_skin_tone", CLDR},
+	{"👳🏻\u200d♂️", "man_wearing_turban_light_skin_tone", CLDR},
+	{"👳🏼", "person_wearing_turban_medium_light_skin_tone", CLDR},
+	{"👳🏼\u200d♀️", "woman_wearing_turban_medium_light_skin_tone", CLDR},
+	{"👳🏼\u200d♂️", "man_wearing_turban_medium_light_skin_tone", CLDR},
+	{"👳🏽", "person_wearing_turban_medium_skin_tone", CLDR},
+	{"👳🏽\u200d♀️", "woman_wearing_turban_medium_skin_tone", CLDR},
+	{"👳🏽\u200d♂️", "man_wearing_turban_medium_skin_tone", CLDR},
+	{"👳🏾", "person_wearing_turban_medium_dark_skin_tone", CLDR},
+	{"👳🏾\u200d♀️", "woman_wearing_turban_medium_dark_skin_tone", CLDR},
+	{"👳🏾\u200d♂️", "man_wearing_turban_medium_dark_skin_tone", CLDR},
+	{"👳🏿", "person_wearing_turban_dark_skin_tone", CLDR},
+	{"👳🏿\u200d♀️", "woman_wearing_turban_dark_skin_tone", CLDR},
+	{"👳🏿\u200d♂️", "man_wearing_turban_dark_skin_tone", CLDR},
+	{"👴", "old_man", CLDR},
+	{"👴", "older_man", GitHub},
+	{"👴🏻", "old_man_light_skin_tone", CLDR},
+	{"👴🏼", "old_man_medium_light_skin_tone", CLDR},
+	{"👴🏽", "old_man_medium_skin_tone", CLDR},
+	{"👴🏾", "old_man_medium_dark_skin_tone", CLDR},
+	{"👴🏿", "old_man_dark_skin_tone", CLDR},
+	{"👵", "old_woman", CLDR},
+	{"👵", "older_woman", GitHub},
+	{"👵🏻", "old_woman_light_skin_tone", CLDR},
+	{"👵🏼", "old_woman_medium_light_skin_tone", CLDR},
+	{"👵🏽", "old_woman_medium_skin_tone", CLDR},
+	{"👵🏾", "old_woman_medium_dark_skin_tone", CLDR},
+	{"👵🏿", "old_woman_dark_skin_tone", CLDR},
+	{"👶", "baby", CLDR},
+	{"👶", "baby", GitHub},
+	{"👶🏻", "baby_light_skin_tone", CLDR},
+	{"👶🏼", "baby_medium_light_skin_tone", CLDR},
+	{"👶🏽", "baby_medium_skin_tone", CLDR},
+	{"👶🏾", "baby_medium_dark_skin_tone", CLDR},
+	{"👶🏿", "baby_dark_skin_tone", CLDR},
+	{"👷", "construction_worker", CLDR},
+	{"👷", "construction_worker", GitHub},
+	{"👷\u200d♀️", "woman_construction_worker", CLDR},
+	{"👷\u200d♀️", "construction_worker_woman", GitHub},
+	{"👷\u200d♂️", "man_construction_worker", CLDR},
+	{"👷\u200d♂️", "construction_worker_man", GitHub},
+	{"👷🏻", "construction_worker_light_skin_tone", CLDR},
+	{"👷🏻\u200d♀️", "woman_construction_worker_light_skin_tone", CLDR},
+	{"👷🏻\u200d♂️", "man_construction_worker_light_skin_tone", CLDR},
+	{"👷🏼", "construction_worker_medium_light_skin_tone", CLDR},
+	{"👷🏼\u200d♀️", "woman_construction_worker_medium_light_skin_tone", CLDR},
+	{"👷🏼\u200d♂️", "man_construction_worker_medium_light_skin_tone", CLDR},
+	{"👷🏽", "construction_worker_medium_skin_tone", CLDR},
+	{"👷🏽\u200d♀️", "woman_construction_worker_medium_skin_tone", CLDR},
+	{"👷🏽\u200d♂️", "man_construction_worker_medium_skin_tone", CLDR},
+	{"👷🏾", "construction_worker_medium_dark_skin_tone", CLDR},
+	{"👷🏾\u200d♀️", "woman_construction_worker_medium_dark_skin_tone", CLDR},
+	{"👷🏾\u200d♂️", "man_construction_worker_medium_dark_skin_tone", CLDR},
+	{"👷🏿", "construction_worker_dark_skin_tone", CLDR},
+	{"👷🏿\u200d♀️", "woman_construction_worker_dark_skin_tone", CLDR},
+	{"👷🏿\u200d♂️", "man_construction_worker_dark_skin_tone", CLDR},
+	{"👸", "princess", CLDR},
+	{"👸", "princess", GitHub},
+	{"👸🏻", "princess_light_skin_tone", CLDR},
+	{"👸🏼", "princess_medium_light_skin_tone", CLDR},
+	{"👸🏽", "princess_medium_skin_tone", CLDR},
+	{"👸🏾", "princess_medium_dark_skin_tone", CLDR},
+	{"👸🏿", "princess_dark_skin_tone", CLDR},
+	{"👹", "ogre", CLDR},
+	{"👹", "japanese_ogre", GitHub},
+	{"👺", "goblin", CLDR},
+	{"👺", "japanese_goblin", GitHub},
+	{"👻", "ghost", CLDR},
+	{"👻", "ghost", GitHub},
+	{"👼", "baby_angel", CLDR},
+	{"👼", "angel", GitHub},
+	{"👼🏻", "baby_angel_light_skin_tone", CLDR},
+	{"👼🏼", "baby_angel_medium_light_skin_tone", CLDR},
+	{"👼🏽", "baby_angel_medium_skin_tone", CLDR},
+	{"👼🏾", "baby_angel_medium_dark_skin_tone", CLDR},
+	{"👼🏿", "baby_angel_dark_skin_tone", CLDR},
+	{"👽", "alien", CLDR},
+	{"👽", "alien", GitHub},
+	{"👾", "alien_monster", CLDR},
+	{"👾", "space_invader", GitHub},
+	{"👿", "angry_face_with_horns", CLDR},
+	{"👿", "imp", GitHub},
+	{"💀", "skull", CLDR},
+	{"💀", "skull", GitHub},
+	{"💁", "person_tipping_hand", CLDR},
+	{"💁", "tipping_hand_person", GitHub},
+	{"💁", "information_desk_person", GitHub},
+	{"💁\u200d♀️", "woman_tipping_hand", CLDR},
+	{"💁\u200d♀️", "tipping_hand_woman", GitHub},
+	{"💁\u200d♀️", "sassy_woman", GitHub},
+	{"💁\u200d♂️", "man_tipping_hand", CLDR},
+	{"💁\u200d♂️", "tipping_hand_man", GitHub},
+	{"💁\u200d♂️", "sassy_man", GitHub},
+	{"💁🏻", "person_tipping_hand_light_skin_tone", CLDR},
+	{"💁🏻\u200d♀️", "woman_tipping_hand_light_skin_tone", CLDR},
+	{"💁🏻\u200d♂️", "man_tipping_hand_light_skin_tone", CLDR},
+	{"💁🏼", "person_tipping_hand_medium_light_skin_tone", CLDR},
+	{"💁🏼\u200d♀️", "woman_tipping_hand_medium_light_skin_tone", CLDR},
+	{"💁🏼\u200d♂️", "man_tipping_hand_medium_light_skin_tone", CLDR},
+	{"💁🏽", "person_tipping_hand_medium_skin_tone", CLDR},
+	{"💁🏽\u200d♀️", "woman_tipping_hand_medium_skin_tone", CLDR},
+	{"💁🏽\u200d♂️", "man_tipping_hand_medium_skin_tone", CLDR},
+	{"💁🏾", "person_tipping_hand_medium_dark_skin_tone", CLDR},
+	{"💁🏾\u200d♀️", "woman_tipping_hand_medium_dark_skin_tone", CLDR},
+	{"💁🏾\u200d♂️", "man_tipping_hand_medium_dark_skin_tone", CLDR},
+	{"💁🏿", "person_tipping_hand_dark_skin_tone", CLDR},
+	{"💁🏿\u200d♀️", "woman_tipping_hand_dark_skin_tone", CLDR},
+	{"💁🏿\u200d♂️", "man_tipping_hand_dark_skin_tone", CLDR},
+	{"💂", "guard", CLDR},
+	{"💂", "guard", GitHub},
+	{"💂\u200d♀️", "woman_guard", CLDR},
+	{"💂\u200d♀️", "guardswoman", GitHub},
+	{"💂\u200d♂️", "man_guard", CLDR},
+	{"💂\u200d♂️", "guardsman", GitHub},
+	{"💂🏻", "guard_light_skin_tone", CLDR},
+	{"💂🏻\u200d♀️", "woman_guard_light_skin_tone", CLDR},
+	{"💂🏻\u200d♂️", "man_guard_light_skin_tone", CLDR},
+	{"💂🏼", "guard_medium_light_skin_tone", CLDR},
+	{"💂🏼\u200d♀️", "woman_guard_medium_light_skin_tone", CLDR},
+	{"💂🏼\u200d♂️", "man_guard_medium_light_skin_tone", CLDR},
+	{"💂🏽", "guard_medium_skin_tone", CLDR},
+	{"💂🏽\u200d♀️", "woman_guard_medium_skin_tone", CLDR},
+	{"💂🏽\u200d♂️", "man_guard_medium_skin_tone", CLDR},
+	{"💂🏾", "guard_medium_dark_skin_tone", CLDR},
+	{"💂🏾\u200d♀️", "woman_guard_medium_dark_skin_tone", CLDR},
+	{"💂🏾\u200d♂️", "man_guard_medium_dark_skin_tone", CLDR},
+	{"💂🏿", "guard_dark_skin_tone", CLDR},
+	{"💂🏿\u200d♀️", "woman_guard_dark_skin_tone", CLDR},
+	{"💂🏿\u200d♂️", "man_guard_dark_skin_tone", CLDR},
+	{"💃", "woman_dancing", CLDR},
+	{"💃", "woman_dancing", GitHub},
+	{"💃", "dancer", GitHub},
+	{"💃🏻", "woman_dancing_light_skin_tone", CLDR},
+	{"💃🏼", "woman_dancing_medium_light_skin_tone", CLDR},
+	{"💃🏽", "woman_dancing_medium_skin_tone", CLDR},
+	{"💃🏾", "woman_dancing_medium_dark_skin_tone", CLDR},
+	{"💃🏿", "woman_dancing_dark_skin_tone", CLDR},
+	{"💄", "lipstick", CLDR},
+	{"💄", "lipstick", GitHub},
+	{"💅", "nail_polish", CLDR},
+	{"💅", "nail_care", GitHub},
+	{"💅🏻", "nail_polish_light_skin_tone", CLDR},
+	{"💅🏼", "nail_polish_medium_light_skin_tone", CLDR},
+	{"💅🏽", "nail_polish_medium_skin_tone", CLDR},
+	{"💅🏾", "nail_polish_medium_dark_skin_tone", CLDR},
+	{"💅🏿", "nail_polish_dark_skin_tone", CLDR},
+	{"💆", "person_getting_massage", CLDR},
+	{"💆", "massage", GitHub},
+	{"💆\u200d♀️", "woman_getting_massage", CLDR},
+	{"💆\u200d♀️", "massage_woman", GitHub},
+	{"💆\u200d♂️", "man_getting_massage", CLDR},
+	{"💆\u200d♂️", "massage_man", GitHub},
+	{"💆🏻", "person_getting_massage_light_skin_tone", CLDR},
+	{"💆🏻\u200d♀️", "woman_getting_massage_light_skin_tone", CLDR},
+	{"💆🏻\u200d♂️", "man_getting_massage_light_skin_tone", CLDR},
+	{"💆🏼", "person_getting_massage_medium_light_skin_tone", CLDR},
+	{"💆🏼\u200d♀️", "woman_getting_massage_medium_light_skin_tone", CLDR},
+	{"💆🏼\u200d♂️", "man_getting_massage_medium_light_skin_tone", CLDR},
+	{"💆🏽", "person_getting_massage_medium_skin_tone", CLDR},
+	{"💆🏽\u200d♀️", "woman_getting_massage_medium_skin_tone", CLDR},
+	{"💆🏽\u200d♂️", "man_getting_massage_medium_skin_tone", CLDR},
+	{"💆🏾", "person_getting_massage_medium_dark_skin_tone", CLDR},
+	{"💆🏾\u200d♀️", "woman_getting_massage_medium_dark_skin_tone", CLDR},
+	{"💆🏾\u200d♂️", "man_getting_massage_medium_dark_skin_tone", CLDR},
+	{"💆🏿", "person_getting_massage_dark_skin_tone", CLDR},
+	{"💆🏿\u200d♀️", "woman_getting_massage_dark_skin_tone", CLDR},
+	{"💆🏿\u200d♂️", "man_getting_massage_dark_skin_tone", CLDR},
+	{"💇", "person_getting_haircut", CLDR},
+	{"💇", "haircut", GitHub},
+	{"💇\u200d♀️", "woman_getting_haircut", CLDR},
+	{"💇\u200d♀️", "haircut_woman", GitHub},
+	{"💇\u200d♂️", "man_getting_haircut", CLDR},
+	{"💇\u200d♂️", "haircut_man", GitHub},
+	{"💇🏻", "person_getting_haircut_light_skin_tone", CLDR},
+	{"💇🏻\u200d♀️", "woman_getting_haircut_light_skin_tone", CLDR},
+	{"💇🏻\u200d♂️", "man_getting_haircut_light_skin_tone", CLDR},
+	{"💇🏼", "person_getting_haircut_medium_light_skin_tone", CLDR},
+	{"💇🏼\u200d♀️", "woman_getting_haircut_medium_light_skin_tone", CLDR},
+	{"💇🏼\u200d♂️", "man_getting_haircut_medium_light_skin_tone", CLDR},
+	{"💇🏽", "person_getting_haircut_medium_skin_tone", CLDR},
+	{"💇🏽\u200d♀️", "woman_getting_haircut_medium_skin_tone", CLDR},
+	{"💇🏽\u200d♂️", "man_getting_haircut_medium_skin_tone", CLDR},
+	{"💇🏾", "person_getting_haircut_medium_dark_skin_tone", CLDR},
+	{"💇🏾\u200d♀️", "woman_getting_haircut_medium_dark_skin_tone", CLDR},
+	{"💇🏾\u200d♂️", "man_getting_haircut_medium_dark_skin_tone", CLDR},
+	{"💇🏿", "person_getting_haircut_dark_skin_tone", CLDR},
+	{"💇🏿\u200d♀️", "woman_getting_haircut_dark_skin_tone", CLDR},
+	{"💇🏿\u200d♂️", "man_getting_haircut_dark_skin_tone", CLDR},
+	{"💈", "barber_pole", CLDR},
+	{"💈", "barber", GitHub},
+	{"💉", "syringe", CLDR},
+	{"💉", "syringe", GitHub},
+	{"💊", "pill", CLDR},
+	{"💊", "pill", GitHub},
+	{"💋", "kiss_mark", CLDR},
+	{"💋", "kiss", GitHub},
+	{"💌", "love_letter", CLDR},
+	{"💌", "love_letter", GitHub},
+	{"💍", "ring", CLDR},
+	{"💍", "ring", GitHub},
+	{"💎", "gem_stone", CLDR},
+	{"💎", "gem", GitHub},
+	{"💏", "kiss", CLDR},
+	{"💏", "couplekiss", GitHub},
+	{"💐", "bouquet", CLDR},
+	{"💐", "bouquet", GitHub},
+	{"💑", "couple_with_heart", CLDR},
+	{"💑", "couple_with_heart", GitHub},
+	{"💒", "wedding", CLDR},
+	{"💒", "wedding", GitHub},
+	{"💓", "beating_heart", CLDR},
+	{"💓", "heartbeat", GitHub},
+	{"💔", "broken_heart", CLDR},
+	{"💔", "broken_heart", GitHub},
+	{"💕", "two_hearts", CLDR},
+	{"💕", "two_hearts", GitHub},
+	{"💖", "sparkling_heart", CLDR},
+	{"💖", "sparkling_heart", GitHub},
+	{"💗", "growing_heart", CLDR},
+	{"💗", "heartpulse", GitHub},
+	{"💘", "heart_with_arrow", CLDR},
+	{"💘", "cupid", GitHub},
+	{"💙", "blue_heart", CLDR},
+	{"💙", "blue_heart", GitHub},
+	{"💚", "green_heart", CLDR},
+	{"💚", "green_heart", GitHub},
+	{"💛", "yellow_heart", CLDR},
+	{"💛", "yellow_heart", GitHub},
+	{"💜", "purple_heart", CLDR},
+	{"💜", "purple_heart", GitHub},
+	{"💝", "heart_with_ribbon", CLDR},
+	{"💝", "gift_heart", GitHub},
+	{"💞", "revolving_hearts", CLDR},
+	{"💞", "revolving_hearts", GitHub},
+	{"💟", "heart_decoration", CLDR},
+	{"💟", "heart_decoration", GitHub},
+	{"💠", "diamond_with_a_dot", CLDR},
+	{"💠", "diamond_shape_with_a_dot_inside", GitHub},
+	{"💡", "light_bulb", CLDR},
+	{"💡", "bulb", GitHub},
+	{"💢", "anger_symbol", CLDR},
+	{"💢", "anger", GitHub},
+	{"💣", "bomb", CLDR},
+	{"💣", "bomb", GitHub},
+	{"💤", "zzz", CLDR},
+	{"💤", "zzz", GitHub},
+	{"💥", "collision", CLDR},
+	{"💥", "boom", GitHub},
+	{"💥", "collision", GitHub},
+	{"💦", "sweat_droplets", CLDR},
+	{"💦", "sweat_drops", GitHub},
+	{"💧", "droplet", CLDR},
+	{"💧", "droplet", GitHub},
+	{"💨", "dashing_away", CLDR},
+	{"💨", "dash", GitHub},
+	{"💩", "pile_of_poo", CLDR},
+	{"💩", "hankey", GitHub},
+	{"💩", "poop", GitHub},
+	{"💩", "shit", GitHub},
+	{"💪", "flexed_biceps", CLDR},
+	{"💪", "muscle", GitHub},
+	{"💪🏻", "flexed_biceps_light_skin_tone", CLDR},
+	{"💪🏼", "flexed_biceps_medium_light_skin_tone", CLDR},
+	{"💪🏽", "flexed_biceps_medium_skin_tone", CLDR},
+	{"💪🏾", "flexed_biceps_medium_dark_skin_tone", CLDR},
+	{"💪🏿", "flexed_biceps_dark_skin_tone", CLDR},
+	{"💫", "dizzy", CLDR},
+	{"💫", "dizzy", GitHub},
+	{"💬", "speech_balloon", CLDR},
+	{"💬", "speech_balloon", GitHub},
+	{"💭", "thought_balloon", CLDR},
+	{"💭", "thought_balloon", GitHub},
+	{"💮", "white_flower", CLDR},
+	{"💮", "white_flower", GitHub},
+	{"💯", "hundred_points", CLDR},
+	{"💯", "100", GitHub},
+	{"💰", "money_bag", CLDR},
+	{"💰", "moneybag", GitHub},
+	{"💱", "currency_exchange", CLDR},
+	{"💱", "currency_exchange", GitHub},
+	{"💲", "heavy_dollar_sign", CLDR},
+	{"💲", "heavy_dollar_sign", GitHub},
+	{"💳", "credit_card", CLDR},
+	{"💳", "credit_card", GitHub},
+	{"💴", "yen_banknote", CLDR},
+	{"💴", "yen", GitHub},
+	{"💵", "dollar_banknote", CLDR},
+	{"💵", "dollar", GitHub},
+	{"💶", "euro_banknote", CLDR},
+	{"💶", "euro", GitHub},
+	{"💷", "pound_banknote", CLDR},
+	{"💷", "pound", GitHub},
+	{"💸", "money_with_wings", CLDR},
+	{"💸", "money_with_wings", GitHub},
+	{"💹", "chart_increasing_with_yen", CLDR},
+	{"💹", "chart", GitHub},
+	{"💺", "seat", CLDR},
+	{"💺", "seat", GitHub},
+	{"💻", "laptop", CLDR},
+	{"💻", "computer", GitHub},
+	{"💼", "briefcase", CLDR},
+	{"💼", "briefcase", GitHub},
+	{"💽", "computer_disk", CLDR},
+	{"💽", "minidisc", GitHub},
+	{"💾", "floppy_disk", CLDR},
+	{"💾", "floppy_disk", GitHub},
+	{"💿", "optical_disk", CLDR},
+	{"💿", "cd", GitHub},
+	{"📀", "dvd", CLDR},
+	{"📀", "dvd", GitHub},
+	{"📁", "file_folder", CLDR},
+	{"📁", "file_folder", GitHub},
+	{"📂", "open_file_folder", CLDR},
+	{"📂", "open_file_folder", GitHub},
+	{"📃", "page_with_curl", CLDR},
+	{"📃", "page_with_curl", GitHub},
+	{"📄", "page_facing_up", CLDR},
+	{"📄", "page_facing_up", GitHub},
+	{"📅", "calendar", CLDR},
+	{"📅", "date", GitHub},
+	{"📆", "tear_off_calendar", CLDR},
+	{"📆", "calendar", GitHub},
+	{"📇", "card_index", CLDR},
+	{"📇", "card_index", GitHub},
+	{"📈", "chart_increasing", CLDR},
+	{"📈", "chart_with_upwards_trend", GitHub},
+	{"📉", "chart_decreasing", CLDR},
+	{"📉", "chart_with_downwards_trend", GitHub},
+	{"📊", "bar_chart", CLDR},
+	{"📊", "bar_chart", GitHub},
+	{"📋", "clipboard", CLDR},
+	{"📋", "clipboard", GitHub},
+	{"📌", "pushpin", CLDR},
+	{"📌", "pushpin", GitHub},
+	{"📍", "round_pushpin", CLDR},
+	{"📍", "round_pushpin", GitHub},
+	{"📎", "paperclip", CLDR},
+	{"📎", "paperclip", GitHub},
+	{"📏", "straight_ruler", CLDR},
+	{"📏", "straight_ruler", GitHub},
+	{"📐", "triangular_ruler", CLDR},
+	{"📐", "triangular_ruler", GitHub},
+	{"📑", "bookmark_tabs", CLDR},
+	{"📑", "bookmark_tabs", GitHub},
+	{"📒", "ledger", CLDR},
+	{"📒", "ledger", GitHub},
+	{"📓", "notebook", CLDR},
+	{"📓", "notebook", GitHub},
+	{"📔", "notebook_with_decorative_cover", CLDR},
+	{"📔", "notebook_with_decorative_cover", GitHub},
+	{"📕", "closed_book", CLDR},
+	{"📕", "closed_book", GitHub},
+	{"📖", "open_book", CLDR},
+	{"📖", "book", GitHub},
+	{"📖", "open_book", GitHub},
+	{"📗", "green_book", CLDR},
+	{"📗", "green_book", GitHub},
+	{"📘", "blue_book", CLDR},
+	{"📘", "blue_book", GitHub},
+	{"📙", "orange_book", CLDR},
+	{"📙", "orange_book", GitHub},
+	{"📚", "books", CLDR},
+	{"📚", "books", GitHub},
+	{"📛", "name_badge", CLDR},
+	{"📛", "name_badge", GitHub},
+	{"📜", "scroll", CLDR},
+	{"📜", "scroll", GitHub},
+	{"📝", "memo", CLDR},
+	{"📝", "memo", GitHub},
+	{"📝", "pencil", GitHub},
+	{"📞", "telephone_receiver", CLDR},
+	{"📞", "telephone_receiver", GitHub},
+	{"📟", "pager", CLDR},
+	{"📟", "pager", GitHub},
+	{"📠", "fax_machine", CLDR},
+	{"📠", "fax", GitHub},
+	{"📡", "satellite_antenna", CLDR},
+	{"📡", "satellite", GitHub},
+	{"📢", "loudspeaker", CLDR},
+	{"📢", "loudspeaker", GitHub},
+	{"📣", "megaphone", CLDR},
+	{"📣", "mega", GitHub},
+	{"📤", "outbox_tray", CLDR},
+	{"📤", "outbox_tray", GitHub},
+	{"📥", "inbox_tray", CLDR},
+	{"📥", "inbox_tray", GitHub},
+	{"📦", "package", CLDR},
+	{"📦", "package", GitHub},
+	{"📧", "e_mail", CLDR},
+	{"📧", "email", GitHub},
+	{"📧", "e-mail", GitHub},
+	{"📨", "incoming_envelope", CLDR},
+	{"📨", "incoming_envelope", GitHub},
+	{"📩", "envelope_with_arrow", CLDR},
+	{"📩", "envelope_with_arrow", GitHub},
+	{"📪", "closed_mailbox_with_lowered_flag", CLDR},
+	{"📪", "mailbox_closed", GitHub},
+	{"📫", "closed_mailbox_with_raised_flag", CLDR},
+	{"📫", "mailbox", GitHub},
+	{"📬", "open_mailbox_with_raised_flag", CLDR},
+	{"📬", "mailbox_with_mail", GitHub},
+	{"📭", "open_mailbox_with_lowered_flag", CLDR},
+	{"📭", "mailbox_with_no_mail", GitHub},
+	{"📮", "postbox", CLDR},
+	{"📮", "postbox", GitHub},
+	{"📯", "postal_horn", CLDR},
+	{"📯", "postal_horn", GitHub},
+	{"📰", "newspaper", CLDR},
+	{"📰", "newspaper", GitHub},
+	{"📱", "mobile_phone", CLDR},
+	{"📱", "iphone", GitHub},
+	{"📲", "mobile_phone_with_arrow", CLDR},
+	{"📲", "calling", GitHub},
+	{"📳", "vibration_mode", CLDR},
+	{"📳", "vibration_mode", GitHub},
+	{"📴", "mobile_phone_off", CLDR},
+	{"📴", "mobile_phone_off", GitHub},
+	{"📵", "no_mobile_phones", CLDR},
+	{"📵", "no_mobile_phones", GitHub},
+	{"📶", "antenna_bars", CLDR},
+	{"📶", "signal_strength", GitHub},
+	{"📷", "camera", CLDR},
+	{"📷", "camera", GitHub},
+	{"📸", "camera_with_flash", CLDR},
+	{"📸", "camera_flash", GitHub},
+	{"📹", "video_camera", CLDR},
+	{"📹", "video_camera", GitHub},
+	{"📺", "television", CLDR},
+	{"📺", "tv", GitHub},
+	{"📻", "radio", CLDR},
+	{"📻", "radio", GitHub},
+	{"📼", "videocassette", CLDR},
+	{"📼", "vhs", GitHub},
+	{"📽️", "film_projector", CLDR},
+	{"📽️", "film_projector", GitHub},
+	{"📿", "prayer_beads", CLDR},
+	{"📿", "prayer_beads", GitHub},
+	{"🔀", "shuffle_tracks_button", CLDR},
+	{"🔀", "twisted_rightwards_arrows", GitHub},
+	{"🔁", "repeat_button", CLDR},
+	{"🔁", "repeat", GitHub},
+	{"🔂", "repeat_single_button", CLDR},
+	{"🔂", "repeat_one", GitHub},
+	{"🔃", "clockwise_vertical_arrows", CLDR},
+	{"🔃", "arrows_clockwise", GitHub},
+	{"🔄", "counterclockwise_arrows_button", CLDR},
+	{"🔄", "arrows_counterclockwise", GitHub},
+	{"🔅", "dim_button", CLDR},
+	{"🔅", "low_brightness", GitHub},
+	{"🔆", "bright_button", CLDR},
+	{"🔆", "high_brightness", GitHub},
+	{"🔇", "muted_speaker", CLDR},
+	{"🔇", "mute", GitHub},
+	{"🔈", "speaker_low_volume", CLDR},
+	{"🔈", "speaker", GitHub},
+	{"🔉", "speaker_medium_volume", CLDR},
+	{"🔉", "sound", GitHub},
+	{"🔊", "speaker_high_volume", CLDR},
+	{"🔊", "loud_sound", GitHub},
+	{"🔋", "battery", CLDR},
+	{"🔋", "battery", GitHub},
+	{"🔌", "electric_plug", CLDR},
+	{"🔌", "electric_plug", GitHub},
+	{"🔍", "magnifying_glass_tilted_left", CLDR},
+	{"🔍", "mag", GitHub},
+	{"🔎", "magnifying_glass_tilted_right", CLDR},
+	{"🔎", "mag_right", GitHub},
+	{"🔏", "locked_with_pen", CLDR},
+	{"🔏", "lock_with_ink_pen", GitHub},
+	{"🔐", "locked_with_key", CLDR},
+	{"🔐", "closed_lock_with_key", GitHub},
+	{"🔑", "key", CLDR},
+	{"🔑", "key", GitHub},
+	{"🔒", "locked", CLDR},
+	{"🔒", "lock", GitHub},
+	{"🔓", "unlocked", CLDR},
+	{"🔓", "unlock", GitHub},
+	{"🔔", "bell", CLDR},
+	{"🔔", "bell", GitHub},
+	{"🔕", "bell_with_slash", CLDR},
+	{"🔕", "no_bell", GitHub},
+	{"🔖", "bookmark", CLDR},
+	{"🔖", "bookmark", GitHub},
+	{"🔗", "link", CLDR},
+	{"🔗", "link", GitHub},
+	{"🔘", "radio_button", CLDR},
+	{"🔘", "radio_button", GitHub},
+	{"🔙", "back_arrow", CLDR},
+	{"🔙", "back", GitHub},
+	{"🔚", "end_arrow", CLDR},
+	{"🔚", "end", GitHub},
+	{"🔛", "on_arrow", CLDR},
+	{"🔛", "on", GitHub},
+	{"🔜", "soon_arrow", CLDR},
+	{"🔜", "soon", GitHub},
+	{"🔝", "top_arrow", CLDR},
+	{"🔝", "top", GitHub},
+	{"🔞", "no_one_under_eighteen", CLDR},
+	{"🔞", "underage", GitHub},
+	{"🔟", "keycap_10", CLDR},
+	{"🔟", "keycap_ten", GitHub},
+	{"🔠", "input_latin_uppercase", CLDR},
+	{"🔠", "capital_abcd", GitHub},
+	{"🔡", "input_latin_lowercase", CLDR},
+	{"🔡", "abcd", GitHub},
+	{"🔢", "input_numbers", CLDR},
+	{"🔢", "1234", GitHub},
+	{"🔣", "input_symbols", CLDR},
+	{"🔣", "symbols", GitHub},
+	{"🔤", "input_latin_letters", CLDR},
+	{"🔤", "abc", GitHub},
+	{"🔥", "fire", CLDR},
+	{"🔥", "fire", GitHub},
+	{"🔦", "flashlight", CLDR},
+	{"🔦", "flashlight", GitHub},
+	{"🔧", "wrench", CLDR},
+	{"🔧", "wrench", GitHub},
+	{"🔨", "hammer", CLDR},
+	{"🔨", "hammer", GitHub},
+	{"🔩", "nut_and_bolt", CLDR},
+	{"🔩", "nut_and_bolt", GitHub},
+	{"🔪", "kitchen_knife", CLDR},
+	{"🔪", "hocho", GitHub},
+	{"🔪", "knife", GitHub},
+	{"🔫", "water_pistol", CLDR},
+	{"🔫", "gun", GitHub},
+	{"🔬", "microscope", CLDR},
+	{"🔬", "microscope", GitHub},
+	{"🔭", "telescope", CLDR},
+	{"🔭", "telescope", GitHub},
+	{"🔮", "crystal_ball", CLDR},
+	{"🔮", "crystal_ball", GitHub},
+	{"🔯", "dotted_six_pointed_star", CLDR},
+	{"🔯", "six_pointed_star", GitHub},
+	{"🔰", "japanese_symbol_for_beginner", CLDR},
+	{"🔰", "beginner", GitHub},
+	{"🔱", "trident_emblem", CLDR},
+	{"🔱", "trident", GitHub},
+	{"🔲", "black_square_button", CLDR},
+	{"🔲", "black_square_button", GitHub},
+	{"🔳", "white_square_button", CLDR},
+	{"🔳", "white_square_button", GitHub},
+	{"🔴", "red_circle", CLDR},
+	{"🔴", "red_circle", GitHub},
+	{"🔵", "blue_circle", CLDR},
+	{"🔵", "large_blue_circle", GitHub},
+	{"🔶", "large_orange_diamond", CLDR},
+	{"🔶", "large_orange_diamond", GitHub},
+	{"🔷", "large_blue_diamond", CLDR},
+	{"🔷", "large_blue_diamond", GitHub},
+	{"🔸", "small_orange_diamond", CLDR},
+	{"🔸", "small_orange_diamond", GitHub},
+	{"🔹", "small_blue_diamond", CLDR},
+	{"🔹", "small_blue_diamond", GitHub},
+	{"🔺", "red_triangle_pointed_up", CLDR},
+	{"🔺", "small_red_triangle", GitHub},
+	{"🔻", "red_triangle_pointed_down", CLDR},
+	{"🔻", "small_red_triangle_down", GitHub},
+	{"🔼", "upwards_button", CLDR},
+	{"🔼", "arrow_up_small", GitHub},
+	{"🔽", "downwards_button", CLDR},
+	{"🔽", "arrow_down_small", GitHub},
+	{"🕉️", "om", CLDR},
+	{"🕉️", "om", GitHub},
+	{"🕊️", "dove", CLDR},
+	{"🕊️", "dove", GitHub},
+	{"🕋", "kaaba", CLDR},
+	{"🕋", "kaaba", GitHub},
+	{"🕌", "mosque", CLDR},
+	{"🕌", "mosque", GitHub},
+	{"🕍", "synagogue", CLDR},
+	{"🕍", "synagogue", GitHub},
+	{"🕎", "menorah", CLDR},
+	{"🕎", "menorah", GitHub},
+	{"🕐", "one_oclock", CLDR},
+	{"🕐", "clock1", GitHub},
+	{"🕑", "two_oclock", CLDR},
+	{"🕑", "clock2", GitHub},
+	{"🕒", "three_oclock", CLDR},
+	{"🕒", "clock3", GitHub},
+	{"🕓", "four_oclock", CLDR},
+	{"🕓", "clock4", GitHub},
+	{"🕔", "five_oclock", CLDR},
+	{"🕔", "clock5", GitHub},
+	{"🕕", "six_oclock", CLDR},
+	{"🕕", "clock6", GitHub},
+	{"🕖", "seven_oclock", CLDR},
+	{"🕖", "clock7", GitHub},
+	{"🕗", "eight_oclock", CLDR},
+	{"🕗", "clock8", GitHub},
+	{"🕘", "nine_oclock", CLDR},
+	{"🕘", "clock9", GitHub},
+	{"🕙", "ten_oclock", CLDR},
+	{"🕙", "clock10", GitHub},
+	{"🕚", "eleven_oclock", CLDR},
+	{"🕚", "clock11", GitHub},
+	{"🕛", "twelve_oclock", CLDR},
+	{"🕛", "clock12", GitHub},
+	{"🕜", "one_thirty", CLDR},
+	{"🕜", "clock130", GitHub},
+	{"🕝", "two_thirty", CLDR},
+	{"🕝", "clock230", GitHub},
+	{"🕞", "three_thirty", CLDR},
+	{"🕞", "clock330", GitHub},
+	{"🕟", "four_thirty", CLDR},
+	{"🕟", "clock430", GitHub},
+	{"🕠", "five_thirty", CLDR},
+	{"🕠", "clock530", GitHub},
+	{"🕡", "six_thirty", CLDR},
+	{"🕡", "clock630", GitHub},
+	{"🕢", "seven_thirty", CLDR},
+	{"🕢", "clock730", GitHub},
+	{"🕣", "eight_thirty", CLDR},
+	{"🕣", "clock830", GitHub},
+	{"🕤", "nine_thirty", CLDR},
+	{"🕤", "clock930", GitHub},
+	{"🕥", "ten_thirty", CLDR},
+	{"🕥", "clock1030", GitHub},
+	{"🕦", "eleven_thirty", CLDR},
+	{"🕦", "clock1130", GitHub},
+	{"🕧", "twelve_thirty", CLDR},
+	{"🕧", "clock1230", GitHub},
+	{"🕯️", "candle", CLDR},
+	{"🕯️", "candle", GitHub},
+	{"🕰️", "mantelpiece_clock", CLDR},
+	{"🕰️", "mantelpiece_clock", GitHub},
+	{"🕳️", "hole", CLDR},
+	{"🕳️", "hole", GitHub},
+	{"🕴️", "person_in_suit_levitating", CLDR},
+	{"🕴️", "business_suit_levitating", GitHub},
+	{"🕴🏻", "person_in_suit_levitating_light_skin_tone", CLDR},
+	{"🕴🏼", "person_in_suit_levitating_medium_light_skin_tone", CLDR},
+	{"🕴🏽", "person_in_suit_levitating_medium_skin_tone", CLDR},
+	{"🕴🏾", "person_in_suit_levitating_medium_dark_skin_tone", CLDR},
+	{"🕴🏿", "person_in_suit_levitating_dark_skin_tone", CLDR},
+	{"🕵️", "detective", CLDR},
+	{"🕵️", "detective", GitHub},
+	{"🕵️\u200d♀️", "woman_detective", CLDR},
+	{"🕵️\u200d♀️", "female_detective", GitHub},
+	{"🕵️\u200d♂️", "man_detective", CLDR},
+	{"🕵️\u200d♂️", "male_detective", GitHub},
+	{"🕵🏻", "detective_light_skin_tone", CLDR},
+	{"🕵🏻\u200d♀️", "woman_detective_light_skin_tone", CLDR},
+	{"🕵🏻\u200d♂️", "man_detective_light_skin_tone", CLDR},
+	{"🕵🏼", "detective_medium_light_skin_tone", CLDR},
+	{"🕵🏼\u200d♀️", "woman_detective_medium_light_skin_tone", CLDR},
+	{"🕵🏼\u200d♂️", "man_detective_medium_light_skin_tone", CLDR},
+	{"🕵🏽", "detective_medium_skin_tone", CLDR},
+	{"🕵🏽\u200d♀️", "woman_detective_medium_skin_tone", CLDR},
+	{"🕵🏽\u200d♂️", "man_detective_medium_skin_tone", CLDR},
+	{"🕵🏾", "detective_medium_dark_skin_tone", CLDR},
+	{"🕵🏾\u200d♀️", "woman_detective_medium_dark_skin_tone", CLDR},
+	{"🕵🏾\u200d♂️", "man_detective_medium_dark_skin_tone", CLDR},
+	{"🕵🏿", "detective_dark_skin_tone", CLDR},
+	{"🕵🏿\u200d♀️", "woman_detective_dark_skin_tone", CLDR},
+	{"🕵🏿\u200d♂️", "man_detective_dark_skin_tone", CLDR},
+	{"🕶️", "sunglasses", CLDR},
+	{"🕶️", "dark_sunglasses", GitHub},
+	{"🕷️", "spider", CLDR},
+	{"🕷️", "spider", GitHub},
+	{"🕸️", "spider_web", CLDR},
+	{"🕸️", "spider_web", GitHub},
+	{"🕹️", "joystick", CLDR},
+	{"🕹️", "joystick", GitHub},
+	{"🕺", "man_dancing", CLDR},
+	{"🕺", "man_dancing", GitHub},
+	{"🕺🏻", "man_dancing_light_skin_tone", CLDR},
+	{"🕺🏼", "man_dancing_medium_light_skin_tone", CLDR},
+	{"🕺🏽", "man_dancing_medium_skin_tone", CLDR},
+	{"🕺🏾", "man_dancing_medium_dark_skin_tone", CLDR},
+	{"🕺🏿", "man_dancing_dark_skin_tone", CLDR},
+	{"🖇️", "linked_paperclips", CLDR},
+	{"🖇️", "paperclips", GitHub},
+	{"🖊️", "pen", CLDR},
+	{"🖊️", "pen", GitHub},
+	{"🖋️", "fountain_pen", CLDR},
+	{"🖋️", "fountain_pen", GitHub},
+	{"🖌️", "paintbrush", CLDR},
+	{"🖌️", "paintbrush", GitHub},
+	{"🖍️", "crayon", CLDR},
+	{"🖍️", "crayon", GitHub},
+	{"🖐️", "hand_with_fingers_splayed", CLDR},
+	{"🖐️", "raised_hand_with_fingers_splayed", GitHub},
+	{"🖐🏻", "hand_with_fingers_splayed_light_skin_tone", CLDR},
+	{"🖐🏼", "hand_with_fingers_splayed_medium_light_skin_tone", CLDR},
+	{"🖐🏽", "hand_with_fingers_splayed_medium_skin_tone", CLDR},
+	{"🖐🏾", "hand_with_fingers_splayed_medium_dark_skin_tone", CLDR},
+	{"🖐🏿", "hand_with_fingers_splayed_dark_skin_tone", CLDR},
+	{"🖕", "middle_finger", CLDR},
+	{"🖕", "middle_finger", GitHub},
+	{"🖕", "fu", GitHub},
+	{"🖕🏻", "middle_finger_light_skin_tone", CLDR},
+	{"🖕🏼", "middle_finger_medium_light_skin_tone", CLDR},
+	{"🖕🏽", "middle_finger_medium_skin_tone", CLDR},
+	{"🖕🏾", "middle_finger_medium_dark_skin_tone", CLDR},
+	{"🖕🏿", "middle_finger_dark_skin_tone", CLDR},
+	{"🖖", "vulcan_salute", CLDR},
+	{"🖖", "vulcan_salute", GitHub},
+	{"🖖🏻", "vulcan_salute_light_skin_tone", CLDR},
+	{"🖖🏼", "vulcan_salute_medium_light_skin_tone", CLDR},
+	{"🖖🏽", "vulcan_salute_medium_skin_tone", CLDR},
+	{"🖖🏾", "vulcan_salute_medium_dark_skin_tone", CLDR},
+	{"🖖🏿", "vulcan_salute_dark_skin_tone", CLDR},
+	{"🖤", "black_heart", CLDR},
+	{"🖤", "black_heart", GitHub},
+	{"🖥️", "desktop_computer", CLDR},
+	{"🖥️", "desktop_computer", GitHub},
+	{"🖨️", "printer", CLDR},
+	{"🖨️", "printer", GitHub},
+	{"🖱️", "computer_mouse", CLDR},
+	{"🖱️", "computer_mouse", GitHub},
+	{"🖲️", "trackball", CLDR},
+	{"🖲️", "trackball", GitHub},
+	{"🖼️", "framed_picture", CLDR},
+	{"🖼️", "framed_picture", GitHub},
+	{"🗂️", "card_index_dividers", CLDR},
+	{"🗂️", "card_index_dividers", GitHub},
+	{"🗃️", "card_file_box", CLDR},
+	{"🗃️", "card_file_box", GitHub},
+	{"🗄️", "file_cabinet", CLDR},
+	{"🗄️", "file_cabinet", GitHub},
+	{"🗑️", "wastebasket", CLDR},
+	{"🗑️", "wastebasket", GitHub},
+	{"🗒️", "spiral_notepad", CLDR},
+	{"🗒️", "spiral_notepad", GitHub},
+	{"🗓️", "spiral_calendar", CLDR},
+	{"🗓️", "spiral_calendar", GitHub},
+	{"🗜️", "clamp", CLDR},
+	{"🗜️", "clamp", GitHub},
+	{"🗝️", "old_key", CLDR},
+	{"🗝️", "old_key", GitHub},
+	{"🗞️", "rolled_up_newspaper", CLDR},
+	{"🗞️", "newspaper_roll", GitHub},
+	{"🗡️", "dagger", CLDR},
+	{"🗡️", "dagger", GitHub},
+	{"🗣️", "speaking_head", CLDR},
+	{"🗣️", "speaking_head", GitHub},
+	{"🗨️", "left_speech_bubble", CLDR},
+	{"🗨️", "left_speech_bubble", GitHub},
+	{"🗯️", "right_anger_bubble", CLDR},
+	{"🗯️", "right_anger_bubble", GitHub},
+	{"🗳️", "ballot_box_with_ballot", CLDR},
+	{"🗳️", "ballot_box", GitHub},
+	{"🗺️", "world_map", CLDR},
+	{"🗺️", "world_map", GitHub},
+	{"🗻", "mount_fuji", CLDR},
+	{"🗻", "mount_fuji", GitHub},
+	{"🗼", "tokyo_tower", CLDR},
+	{"🗼", "tokyo_tower", GitHub},
+	{"🗽", "statue_of_liberty", CLDR},
+	{"🗽", "statue_of_liberty", GitHub},
+	{"🗾", "map_of_japan", CLDR},
+	{"🗾", "japan", GitHub},
+	{"🗿", "moai", CLDR},
+	{"🗿", "moyai", GitHub},
+	{"😀", "grinning_face", CLDR},
+	{"😀", "grinning", GitHub},
+	{"😁", "beaming_face_with_smiling_eyes", CLDR},
+	{"😁", "grin", GitHub},
+	{"😂", "face_with_tears_of_joy", CLDR},
+	{"😂", "joy", GitHub},
+	{"😃", "grinning_face_with_big_eyes", CLDR},
+	{"😃", "smiley", GitHub},
+	{"😄", "grinning_face_with_smiling_eyes", CLDR},
+	{"😄", "smile", GitHub},
+	{"😅", "grinning_face_with_sweat", CLDR},
+	{"😅", "sweat_smile", GitHub},
+	{"😆", "grinning_squinting_face", CLDR},
+	{"😆", "laughing", GitHub},
+	{"😆", "satisfied", GitHub},
+	{"😇", "smiling_face_with_halo", CLDR},
+	{"😇", "innocent", GitHub},
+	{"😈", "smiling_face_with_horns", CLDR},
+	{"😈", "smiling_imp", GitHub},
+	{"😉", "winking_face", CLDR},
+	{"😉", "wink", GitHub},
+	{"😊", "smiling_face_with_smiling_eyes", CLDR},
+	{"😊", "blush", GitHub},
+	{"😋", "face_savoring_food", CLDR},
+	{"😋", "yum", GitHub},
+	{"😌", "relieved_face", CLDR},
+	{"😌", "relieved", GitHub},
+	{"😍", "smiling_face_with_heart_eyes", CLDR},
+	{"😍", "heart_eyes", GitHub},
+	{"😎", "smiling_face_with_sunglasses", CLDR},
+	{"😎", "sunglasses", GitHub},
+	{"😏", "smirking_face", CLDR},
+	{"😏", "smirk", GitHub},
+	{"😐", "neutral_face", CLDR},
+	{"😐", "neutral_face", GitHub},
+	{"😑", "expressionless_face", CLDR},
+	{"😑", "expressionless", GitHub},
+	{"😒", "unamused_face", CLDR},
+	{"😒", "unamused", GitHub},
+	{"😓", "downcast_face_with_sweat", CLDR},
+	{"😓", "sweat", GitHub},
+	{"😔", "pensive_face", CLDR},
+	{"😔", "pensive", GitHub},
+	{"😕", "confused_face", CLDR},
+	{"😕", "confused", GitHub},
+	{"😖", "confounded_face", CLDR},
+	{"😖", "confounded", GitHub},
+	{"😗", "kissing_face", CLDR},
+	{"😗", "kissing", GitHub},
+	{"😘", "face_blowing_a_kiss", CLDR},
+	{"😘", "kissing_heart", GitHub},
+	{"😙", "kissing_face_with_smiling_eyes", CLDR},
+	{"😙", "kissing_smiling_eyes", GitHub},
+	{"😚", "kissing_face_with_closed_eyes", CLDR},
+	{"😚", "kissing_closed_eyes", GitHub},
+	{"😛", "face_with_tongue", CLDR},
+	{"😛", "stuck_out_tongue", GitHub},
+	{"😜", "winking_face_with_tongue", CLDR},
+	{"😜", "stuck_out_tongue_winking_eye", GitHub},
+	{"😝", "squinting_face_with_tongue", CLDR},
+	{"😝", "stuck_out_tongue_closed_eyes", GitHub},
+	{"😞", "disappointed_face", CLDR},
+	{"😞", "disappointed", GitHub},
+	{"😟", "worried_face", CLDR},
+	{"😟", "worried", GitHub},
+	{"😠", "angry_face", CLDR},
+	{"😠", "angry", GitHub},
+	{"😡", "enraged_face", CLDR},
+	{"😡", "rage", GitHub},
+	{"😡", "pout", GitHub},
+	{"😢", "crying_face", CLDR},
+	{"😢", "cry", GitHub},
+	{"😣", "persevering_face", CLDR},
+	{"😣", "persevere", GitHub},
+	{"😤", "face_with_steam_from_nose", CLDR},
+	{"😤", "triumph", GitHub},
+	{"😥", "sad_but_relieved_face", CLDR},
+	{"😥", "disappointed_relieved", GitHub},
+	{"😦", "frowning_face_with_open_mouth", CLDR},
+	{"😦", "frowning", GitHub},
+	{"😧", "anguished_face", CLDR},
+	{"😧", "anguished", GitHub},
+	{"😨", "fearful_face", CLDR},
+	{"😨", "fearful", GitHub},
+	{"😩", "weary_face", CLDR},
+	{"😩", "weary", GitHub},
+	{"😪", "sleepy_face", CLDR},
+	{"😪", "sleepy", GitHub},
+	{"😫", "tired_face", CLDR},
+	{"😫", "tired_face", GitHub},
+	{"😬", "grimacing_face", CLDR},
+	{"😬", "grimacing", GitHub},
+	{"😭", "loudly_crying_face", CLDR},
+	{"😭", "sob", GitHub},
+	{"😮", "face_with_open_mouth", CLDR},
+	{"😮", "open_mouth", GitHub},
+	{"😯", "hushed_face", CLDR},
+	{"😯", "hushed", GitHub},
+	{"😰", "anxious_face_with_sweat", CLDR},
+	{"😰", "cold_sweat", GitHub},
+	{"😱", "face_screaming_in_fear", CLDR},
+	{"😱", "scream", GitHub},
+	{"😲", "astonished_face", CLDR},
+	{"😲", "astonished", GitHub},
+	{"😳", "flushed_face", CLDR},
+	{"😳", "flushed", GitHub},
+	{"😴", "sleeping_face", CLDR},
+	{"😴", "sleeping", GitHub},
+	{"😵", "face_with_crossed_out_eyes", CLDR},
+	{"😵", "dizzy_face", GitHub},
+	{"😶", "face_without_mouth", CLDR},
+	{"😶", "no_mouth", GitHub},
+	{"😷", "face_with_medical_mask", CLDR},
+	{"😷", "mask", GitHub},
+	{"😸", "grinning_cat_with_smiling_eyes", CLDR},
+	{"😸", "smile_cat", GitHub},
+	{"😹", "cat_with_tears_of_joy", CLDR},
+	{"😹", "joy_cat", GitHub},
+	{"😺", "grinning_cat", CLDR},
+	{"😺", "smiley_cat", GitHub},
+	{"😻", "smiling_cat_with_heart_eyes", CLDR},
+	{"😻", "heart_eyes_cat", GitHub},
+	{"😼", "cat_with_wry_smile", CLDR},
+	{"😼", "smirk_cat", GitHub},
+	{"😽", "kissing_cat", CLDR},
+	{"😽", "kissing_cat", GitHub},
+	{"😾", "pouting_cat", CLDR},
+	{"😾", "pouting_cat", GitHub},
+	{"😿", "crying_cat", CLDR},
+	{"😿", "crying_cat_face", GitHub},
+	{"🙀", "weary_cat", CLDR},
+	{"🙀", "scream_cat", GitHub},
+	{"🙁", "slightly_frowning_face", CLDR},
+	{"🙁", "slightly_frowning_face", GitHub},
+	{"🙂", "slightly_smiling_face", CLDR},
+	{"🙂", "slightly_smiling_face", GitHub},
+	{"🙃", "upside_down_face", CLDR},
+	{"🙃", "upside_down_face", GitHub},
+	{"🙄", "face_with_rolling_eyes", CLDR},
+	{"🙄", "roll_eyes", GitHub},
+	{"🙅", "person_gesturing_no", CLDR},
+	{"🙅", "no_good", GitHub},
+	{"🙅\u200d♀️", "woman_gesturing_no", CLDR},
+	{"🙅\u200d♀️", "no_good_woman", GitHub},
+	{"🙅\u200d♀️", "ng_woman", GitHub},
+	{"🙅\u200d♂️", "man_gesturing_no", CLDR},
+	{"🙅\u200d♂️", "no_good_man", GitHub},
+	{"🙅\u200d♂️", "ng_man", GitHub},
+	{"🙅🏻", "person_gesturing_no_light_skin_tone", CLDR},
+	{"🙅🏻\u200d♀️", "woman_gesturing_no_light_skin_tone", CLDR},
+	{"🙅🏻\u200d♂️", "man_gesturing_no_light_skin_tone", CLDR},
+	{"🙅🏼", "person_gesturing_no_medium_light_skin_tone", CLDR},
+	{"🙅🏼\u200d♀️", "woman_gesturing_no_medium_light_skin_tone", CLDR},
+	{"🙅🏼\u200d♂️", "man_gesturing_no_medium_light_skin_tone", CLDR},
+	{"🙅🏽", "person_gesturing_no_medium_skin_tone", CLDR},
+	{"🙅🏽\u200d♀️", "woman_gesturing_no_medium_skin_tone", CLDR},
+	{"🙅🏽\u200d♂️", "man_gesturing_no_medium_skin_tone", CLDR},
+	{"🙅🏾", "person_gesturing_no_medium_dark_skin_tone", CLDR},
+	{"🙅🏾\u200d♀️", "woman_gesturing_no_medium_dark_skin_tone", CLDR},
+	{"🙅🏾\u200d♂️", "man_gesturing_no_medium_dark_skin_tone", CLDR},
+	{"🙅🏿", "person_gesturing_no_dark_skin_tone", CLDR},
+	{"🙅🏿\u200d♀️", "woman_gesturing_no_dark_skin_tone", CLDR},
+	{"🙅🏿\u200d♂️", "man_gesturing_no_dark_skin_tone", CLDR},
+	{"🙆", "person_gesturing_ok", CLDR},
+	{"🙆", "ok_person", GitHub},
+	{"🙆\u200d♀️", "woman_gesturing_ok", CLDR},
+	{"🙆\u200d♀️", "ok_woman", GitHub},
+	{"🙆\u200d♂️", "man_gesturing_ok", CLDR},
+	{"🙆\u200d♂️", "ok_man", GitHub},
+	{"🙆🏻", "person_gesturing_ok_light_skin_tone", CLDR},
+	{"🙆🏻\u200d♀️", "woman_gesturing_ok_light_skin_tone", CLDR},
+	{"🙆🏻\u200d♂️", "man_gesturing_ok_light_skin_tone", CLDR},
+	{"🙆🏼", "person_gesturing_ok_medium_light_skin_tone", CLDR},
+	{"🙆🏼\u200d♀️", "woman_gesturing_ok_medium_light_skin_tone", CLDR},
+	{"🙆🏼\u200d♂️", "man_gesturing_ok_medium_light_skin_tone", CLDR},
+	{"🙆🏽", "person_gesturing_ok_medium_skin_tone", CLDR},
+	{"🙆🏽\u200d♀️", "woman_gesturing_ok_medium_skin_tone", CLDR},
+	{"🙆🏽\u200d♂️", "man_gesturing_ok_medium_skin_tone", CLDR},
+	{"🙆🏾", "person_gesturing_ok_medium_dark_skin_tone", CLDR},
+	{"🙆🏾\u200d♀️", "woman_gesturing_ok_medium_dark_skin_tone", CLDR},
+	{"🙆🏾\u200d♂️", "man_gesturing_ok_medium_dark_skin_tone", CLDR},
+	{"🙆🏿", "person_gesturing_ok_dark_skin_tone", CLDR},
+	{"🙆🏿\u200d♀️", "woman_gesturing_ok_dark_skin_tone", CLDR},
+	{"🙆🏿\u200d♂️", "man_gesturing_ok_dark_skin_tone", CLDR},
+	{"🙇", "person_bowing", CLDR},
+	{"🙇", "bow", GitHub},
+	{"🙇\u200d♀️", "woman_bowing", CLDR},
+	{"🙇\u200d♀️", "bowing_woman", GitHub},
+	{"🙇\u200d♂️", "man_bowing", CLDR},
+	{"🙇\u200d♂️", "bowing_man", GitHub},
+	{"🙇🏻", "person_bowing_light_skin_tone", CLDR},
+	{"🙇🏻\u200d♀️", "woman_bowing_light_skin_tone", CLDR},
+	{"🙇🏻\u200d♂️", "man_bowing_light_skin_tone", CLDR},
+	{"🙇🏼", "person_bowing_medium_light_skin_tone", CLDR},
+	{"🙇🏼\u200d♀️", "woman_bowing_medium_light_skin_tone", CLDR},
+	{"🙇🏼\u200d♂️", "man_bowing_medium_light_skin_tone", CLDR},
+	{"🙇🏽", "person_bowing_medium_skin_tone", CLDR},
+	{"🙇🏽\u200d♀️", "woman_bowing_medium_skin_tone", CLDR},
+	{"🙇🏽\u200d♂️", "man_bowing_medium_skin_tone", CLDR},
+	{"🙇🏾", "person_bowing_medium_dark_skin_tone", CLDR},
+	{"🙇🏾\u200d♀️", "woman_bowing_medium_dark_skin_tone", CLDR},
+	{"🙇🏾\u200d♂️", "man_bowing_medium_dark_skin_tone", CLDR},
+	{"🙇🏿", "person_bowing_dark_skin_tone", CLDR},
+	{"🙇🏿\u200d♀️", "woman_bowing_dark_skin_tone", CLDR},
+	{"🙇🏿\u200d♂️", "man_bowing_dark_skin_tone", CLDR},
+	{"🙈", "see_no_evil_monkey", CLDR},
+	{"🙈", "see_no_evil", GitHub},
+	{"🙉", "hear_no_evil_monkey", CLDR},
+	{"🙉", "hear_no_evil", GitHub},
+	{"🙊", "speak_no_evil_monkey", CLDR},
+	{"🙊", "speak_no_evil", GitHub},
+	{"🙋", "person_raising_hand", CLDR},
+	{"🙋", "raising_hand", GitHub},
+	{"🙋\u200d♀️", "woman_raising_hand", CLDR},
+	{"🙋\u200d♀️", "raising_hand_woman", GitHub},
+	{"🙋\u200d♂️", "man_raising_hand", CLDR},
+	{"🙋\u200d♂️", "raising_hand_man", GitHub},
+	{"🙋🏻", "person_raising_hand_light_skin_tone", CLDR},
+	{"🙋🏻\u200d♀️", "woman_raising_hand_light_skin_tone", CLDR},
+	{"🙋🏻\u200d♂️", "man_raising_hand_light_skin_tone", CLDR},
+	{"🙋🏼", "person_raising_hand_medium_light_skin_tone", CLDR},
+	{"🙋🏼\u200d♀️", "woman_raising_hand_medium_light_skin_tone", CLDR},
+	{"🙋🏼\u200d♂️", "man_raising_hand_medium_light_skin_tone", CLDR},
+	{"🙋🏽", "person_raising_hand_medium_skin_tone", CLDR},
+	{"🙋🏽\u200d♀️", "woman_raising_hand_medium_skin_tone", CLDR},
+	{"🙋🏽\u200d♂️", "man_raising_hand_medium_skin_tone", CLDR},
+	{"🙋🏾", "person_raising_hand_medium_dark_skin_tone", CLDR},
+	{"🙋🏾\u200d♀️", "woman_raising_hand_medium_dark_skin_tone", CLDR},
+	{"🙋🏾\u200d♂️", "man_raising_hand_medium_dark_skin_tone", CLDR},
+	{"🙋🏿", "person_raising_hand_dark_skin_tone", CLDR},
+	{"🙋🏿\u200d♀️", "woman_raising_hand_dark_skin_tone", CLDR},
+	{"🙋🏿\u200d♂️", "man_raising_hand_dark_skin_tone", CLDR},
+	{"🙌", "raising_hands", CLDR},
+	{"🙌", "raised_hands", GitHub},
+	{"🙌🏻", "raising_hands_light_skin_tone", CLDR},
+	{"🙌🏼", "raising_hands_medium_light_skin_tone", CLDR},
+	{"🙌🏽", "raising_hands_medium_skin_tone", CLDR},
+	{"🙌🏾", "raising_hands_medium_dark_skin_tone", CLDR},
+	{"🙌🏿", "raising_hands_dark_skin_tone", CLDR},
+	{"🙍", "person_frowning", CLDR},
+	{"🙍", "frowning_person", GitHub},
+	{"🙍\u200d♀️", "woman_frowning", CLDR},
+	{"🙍\u200d♀️", "frowning_woman", GitHub},
+	{"🙍\u200d♂️", "man_frowning", CLDR},
+	{"🙍\u200d♂️", "frowning_man", GitHub},
+	{"🙍🏻", "person_frowning_light_skin_tone", CLDR},
+	{"🙍🏻\u200d♀️", "woman_frowning_light_skin_tone", CLDR},
+	{"🙍🏻\u200d♂️", "man_frowning_light_skin_tone", CLDR},
+	{"🙍🏼", "person_frowning_medium_light_skin_tone", CLDR},
+	{"🙍🏼\u200d♀️", "woman_frowning_medium_light_skin_tone", CLDR},
+	{"🙍🏼\u200d♂️", "man_frowning_medium_light_skin_tone", CLDR},
+	{"🙍🏽", "person_frowning_medium_skin_tone", CLDR},
+	{"🙍🏽\u200d♀️", "woman_frowning_medium_skin_tone", CLDR},
+	{"🙍🏽\u200d♂️", "man_frowning_medium_skin_tone", CLDR},
+	{"🙍🏾", "person_frowning_medium_dark_skin_tone", CLDR},
+	{"🙍🏾\u200d♀️", "woman_frowning_medium_dark_skin_tone", CLDR},
+	{"🙍🏾\u200d♂️", "man_frowning_medium_dark_skin_tone", CLDR},
+	{"🙍🏿", "person_frowning_dark_skin_tone", CLDR},
+	{"🙍🏿\u200d♀️", "woman_frowning_dark_skin_tone", CLDR},
+	{"🙍🏿\u200d♂️", "man_frowning_dark_skin_tone", CLDR},
+	{"🙎", "person_pouting", CLDR},
+	{"🙎", "pouting_face", GitHub},
+	{"🙎\u200d♀️", "woman_pouting", CLDR},
+	{"🙎\u200d♀️", "pouting_woman", GitHub},
+	{"🙎\u200d♂️", "man_pouting", CLDR},
+	{"🙎\u200d♂️", "pouting_man", GitHub},
+	{"🙎🏻", "person_pouting_light_skin_tone", CLDR},
+	{"🙎🏻\u200d♀️", "woman_pouting_light_skin_tone", CLDR},
+	{"🙎🏻\u200d♂️", "man_pouting_light_skin_tone", CLDR},
+	{"🙎🏼", "person_pouting_medium_light_skin_tone", CLDR},
+	{"🙎🏼\u200d♀️", "woman_pouting_medium_light_skin_tone", CLDR},
+	{"🙎🏼\u200d♂️", "man_pouting_medium_light_skin_tone", CLDR},
+	{"🙎🏽", "person_pouting_medium_skin_tone", CLDR},
+	{"🙎🏽\u200d♀️", "woman_pouting_medium_skin_tone", CLDR},
+	{"🙎🏽\u200d♂️", "man_pouting_medium_skin_tone", CLDR},
+	{"🙎🏾", "person_pouting_medium_dark_skin_tone", CLDR},
+	{"🙎🏾\u200d♀️", "woman_pouting_medium_dark_skin_tone", CLDR},
+	{"🙎🏾\u200d♂️", "man_pouting_medium_dark_skin_tone", CLDR},
+	{"🙎🏿", "person_pouting_dark_skin_tone", CLDR},
+	{"🙎🏿\u200d♀️", "woman_pouting_dark_skin_tone", CLDR},
+	{"🙎🏿\u200d♂️", "man_pouting_dark_skin_tone", CLDR},
+	{"🙏", "folded_hands", CLDR},
+	{"🙏", "pray", GitHub},
+	{"🙏🏻", "folded_hands_light_skin_tone", CLDR},
+	{"🙏🏼", "folded_hands_medium_light_skin_tone", CLDR},
+	{"🙏🏽", "folded_hands_medium_skin_tone", CLDR},
+	{"🙏🏾", "folded_hands_medium_dark_skin_tone", CLDR},
+	{"🙏🏿", "folded_hands_dark_skin_tone", CLDR},
+	{"🚀", "rocket", CLDR},
+	{"🚀", "rocket", GitHub},
+	{"🚁", "helicopter", CLDR},
+	{"🚁", "helicopter", GitHub},
+	{"🚂", "locomotive", CLDR},
+	{"🚂", "steam_locomotive", GitHub},
+	{"🚃", "railway_car", CLDR},
+	{"🚃", "railway_car", GitHub},
+	{"🚄", "high_speed_train", CLDR},
+	{"🚄", "bullettrain_side", GitHub},
+	{"🚅", "bullet_train", CLDR},
+	{"🚅", "bullettrain_front", GitHub},
+	{"🚆", "train", CLDR},
+	{"🚆", "train2", GitHub},
+	{"🚇", "metro", CLDR},
+	{"🚇", "metro", GitHub},
+	{"🚈", "light_rail", CLDR},
+	{"🚈", "light_rail", GitHub},
+	{"🚉", "station", CLDR},
+	{"🚉", "station", GitHub},
+	{"🚊", "tram", CLDR},
+	{"🚊", "tram", GitHub},
+	{"🚋", "tram_car", CLDR},
+	{"🚋", "train", GitHub},
+	{"🚌", "bus", CLDR},
+	{"🚌", "bus", GitHub},
+	{"🚍", "oncoming_bus", CLDR},
+	{"🚍", "oncoming_bus", GitHub},
+	{"🚎", "trolleybus", CLDR},
+	{"🚎", "trolleybus", GitHub},
+	{"🚏", "bus_stop", CLDR},
+	{"🚏", "busstop", GitHub},
+	{"🚐", "minibus", CLDR},
+	{"🚐", "minibus", GitHub},
+	{"🚑", "ambulance", CLDR},
+	{"🚑", "ambulance", GitHub},
+	{"🚒", "fire_engine", CLDR},
+	{"🚒", "fire_engine", GitHub},
+	{"🚓", "police_car", CLDR},
+	{"🚓", "police_car", GitHub},
+	{"🚔", "oncoming_police_car", CLDR},
+	{"🚔", "oncoming_police_car", GitHub},
+	{"🚕", "taxi", CLDR},
+	{"🚕", "taxi", GitHub},
+	{"🚖", "oncoming_taxi", CLDR},
+	{"🚖", "oncoming_taxi", GitHub},
+	{"🚗", "automobile", CLDR},
+	{"🚗", "car", GitHub},
+	{"🚗", "red_car", GitHub},
+	{"🚘", "oncoming_automobile", CLDR},
+	{"🚘", "oncoming_automobile", GitHub},
+	{"🚙", "sport_utility_vehicle", CLDR},
+	{"🚙", "blue_car", GitHub},
+	{"🚚", "delivery_truck", CLDR},
+	{"🚚", "truck", GitHub},
+	{"🚛", "articulated_lorry", CLDR},
+	{"🚛", "articulated_lorry", GitHub},
+	{"🚜", "tractor", CLDR},
+	{"🚜", "tractor", GitHub},
+	{"🚝", "monorail", CLDR},
+	{"🚝", "monorail", GitHub},
+	{"🚞", "mountain_railway", CLDR},
+	{"🚞", "mountain_railway", GitHub},
+	{"🚟", "suspension_railway", CLDR},
+	{"🚟", "suspension_railway", GitHub},
+	{"🚠", "mountain_cableway", CLDR},
+	{"🚠", "mountain_cableway", GitHub},
+	{"🚡", "aerial_tramway", CLDR},
+	{"🚡", "aerial_tramway", GitHub},
+	{"🚢", "ship", CLDR},
+	{"🚢", "ship", GitHub},
+	{"🚣", "person_rowing_boat", CLDR},
+	{"🚣", "rowboat", GitHub},
+	{"🚣\u200d♀️", "woman_rowing_boat", CLDR},
+	{"🚣\u200d♀️", "rowing_woman", GitHub},
+	{"🚣\u200d♂️", "man_rowing_boat", CLDR},
+	{"🚣\u200d♂️", "rowing_man", GitHub},
+	{"🚣🏻", "person_rowing_boat_light_skin_tone", CLDR},
+	{"🚣🏻\u200d♀️", "woman_rowing_boat_light_skin_tone", CLDR},
+	{"🚣🏻\u200d♂️", "man_rowing_boat_light_skin_tone", CLDR},
+	{"🚣🏼", "person_rowing_boat_medium_light_skin_tone", CLDR},
+	{"🚣🏼\u200d♀️", "woman_rowing_boat_medium_light_skin_tone", CLDR},
+	{"🚣🏼\u200d♂️", "man_rowing_boat_medium_light_skin_tone", CLDR},
+	{"🚣🏽", "person_rowing_boat_medium_skin_tone", CLDR},
+	{"🚣🏽\u200d♀️", "woman_rowing_boat_medium_skin_tone", CLDR},
+	{"🚣🏽\u200d♂️", "man_rowing_boat_medium_skin_tone", CLDR},
+	{"🚣🏾", "person_rowing_boat_medium_dark_skin_tone", CLDR},
+	{"🚣🏾\u200d♀️", "woman_rowing_boat_medium_dark_skin_tone", CLDR},
+	{"🚣🏾\u200d♂️", "man_rowing_boat_medium_dark_skin_tone", CLDR},
+	{"🚣🏿", "person_rowing_boat_dark_skin_tone", CLDR},
+	{"🚣🏿\u200d♀️", "woman_rowing_boat_dark_skin_tone", CLDR},
+	{"🚣🏿\u200d♂️", "man_rowing_boat_dark_skin_tone", CLDR},
+	{"🚤", "speedboat", CLDR},
+	{"🚤", "speedboat", GitHub},
+	{"🚥", "horizontal_traffic_light", CLDR},
+	{"🚥", "traffic_light", GitHub},
+	{"🚦", "vertical_traffic_light", CLDR},
+	{"🚦", "vertical_traffic_light", GitHub},
+	{"🚧", "construction", CLDR},
+	{"🚧", "construction", GitHub},
+	{"🚨", "police_car_light", CLDR},
+	{"🚨", "rotating_light", GitHub},
+	{"🚩", "triangular_flag", CLDR},
+	{"🚩", "triangular_flag_on_post", GitHub},
+	{"🚪", "door", CLDR},
+	{"🚪", "door", GitHub},
+	{"🚫", "prohibited", CLDR},
+	{"🚫", "no_entry_sign", GitHub},
+	{"🚬", "cigarette", CLDR},
+	{"🚬", "smoking", GitHub},
+	{"🚭", "no_smoking", CLDR},
+	{"🚭", "no_smoking", GitHub},
+	{"🚮", "litter_in_bin_sign", CLDR},
+	{"🚮", "put_litter_in_its_place", GitHub},
+	{"🚯", "no_littering", CLDR},
+	{"🚯", "do_not_litter", GitHub},
+	{"🚰", "potable_water", CLDR},
+	{"🚰", "potable_water", GitHub},
+	{"🚱", "non_potable_water", CLDR},
+	{"🚱", "non-potable_water", GitHub},
+	{"🚲", "bicycle", CLDR},
+	{"🚲", "bike", GitHub},
+	{"🚳", "no_bicycles", CLDR},
+	{"🚳", "no_bicycles", GitHub},
+	{"🚴", "person_biking", CLDR},
+	{"🚴", "bicyclist", GitHub},
+	{"🚴\u200d♀️", "woman_biking", CLDR},
+	{"🚴\u200d♀️", "biking_woman", GitHub},
+	{"🚴\u200d♂️", "man_biking", CLDR},
+	{"🚴\u200d♂️", "biking_man", GitHub},
+	{"🚴🏻", "person_biking_light_skin_tone", CLDR},
+	{"🚴🏻\u200d♀️", "woman_biking_light_skin_tone", CLDR},
+	{"🚴🏻\u200d♂️", "man_biking_light_skin_tone", CLDR},
+	{"🚴🏼", "person_biking_medium_light_skin_tone", CLDR},
+	{"🚴🏼\u200d♀️", "woman_biking_medium_light_skin_tone", CLDR},
+	{"🚴🏼\u200d♂️", "man_biking_medium_light_skin_tone", CLDR},
+	{"🚴🏽", "person_biking_medium_skin_tone", CLDR},
+	{"🚴🏽\u200d♀️", "woman_biking_medium_skin_tone", CLDR},
+	{"🚴🏽\u200d♂️", "man_biking_medium_skin_tone", CLDR},
+	{"🚴🏾", "person_biking_medium_dark_skin_tone", CLDR},
+	{"🚴🏾\u200d♀️", "woman_biking_medium_dark_skin_tone", CLDR},
+	{"🚴🏾\u200d♂️", "man_biking_medium_dark_skin_tone", CLDR},
+	{"🚴🏿", "person_biking_dark_skin_tone", CLDR},
+	{"🚴🏿\u200d♀️", "woman_biking_dark_skin_tone", CLDR},
+	{"🚴🏿\u200d♂️", "man_biking_dark_skin_tone", CLDR},
+	{"🚵", "person_mountain_biking", CLDR},
+	{"🚵", "mountain_bicyclist", GitHub},
+	{"🚵\u200d♀️", "woman_mountain_biking", CLDR},
+	{"🚵\u200d♀️", "mountain_biking_woman", GitHub},
+	{"🚵\u200d♂️", "man_mountain_biking", CLDR},
+	{"🚵\u200d♂️", "mountain_biking_man", GitHub},
+	{"🚵🏻", "person_mountain_biking_light_skin_tone", CLDR},
+	{"🚵🏻\u200d♀️", "woman_mountain_biking_light_skin_tone", CLDR},
+	{"🚵🏻\u200d♂️", "man_mountain_biking_light_skin_tone", CLDR},
+	{"🚵🏼", "person_mountain_biking_medium_light_skin_tone", CLDR},
+	{"🚵🏼\u200d♀️", "woman_mountain_biking_medium_light_skin_tone", CLDR},
+	{"🚵🏼\u200d♂️", "man_mountain_biking_medium_light_skin_tone", CLDR},
+	{"🚵🏽", "person_mountain_biking_medium_skin_tone", CLDR},
+	{"🚵🏽\u200d♀️", "woman_mountain_biking_medium_skin_tone", CLDR},
+	{"🚵🏽\u200d♂️", "man_mountain_biking_medium_skin_tone", CLDR},
+	{"🚵🏾", "person_mountain_biking_medium_dark_skin_tone", CLDR},
+	{"🚵🏾\u200d♀️", "woman_mountain_biking_medium_dark_skin_tone", CLDR},
+	{"🚵🏾\u200d♂️", "man_mountain_biking_medium_dark_skin_tone", CLDR},
+	{"🚵🏿", "person_mountain_biking_dark_skin_tone", CLDR},
+	{"🚵🏿\u200d♀️", "woman_mountain_biking_dark_skin_tone", CLDR},
+	{"🚵🏿\u200d♂️", "man_mountain_biking_dark_skin_tone", CLDR},
+	{"🚶", "person_walking", CLDR},
+	{"🚶", "walking", GitHub},
+	{"🚶\u200d♀️", "woman_walking", CLDR},
+	{"🚶\u200d♀️", "walking_woman", GitHub},
+	{"🚶\u200d♂️", "man_walking", CLDR},
+	{"🚶\u200d♂️", "walking_man", GitHub},
+	{"🚶🏻", "person_walking_light_skin_tone", CLDR},
+	{"🚶🏻\u200d♀️", "woman_walking_light_skin_tone", CLDR},
+	{"🚶🏻\u200d♂️", "man_walking_light_skin_tone", CLDR},
+	{"🚶🏼", "person_walking_medium_light_skin_tone", CLDR},
+	{"🚶🏼\u200d♀️", "woman_walking_medium_light_skin_tone", CLDR},
+	{"🚶🏼\u200d♂️", "man_walking_medium_light_skin_tone", CLDR},
+	{"🚶🏽", "person_walking_medium_skin_tone", CLDR},
+	{"🚶🏽\u200d♀️", "woman_walking_medium_skin_tone", CLDR},
+	{"🚶🏽\u200d♂️", "man_walking_medium_skin_tone", CLDR},
+	{"🚶🏾", "person_walking_medium_dark_skin_tone", CLDR},
+	{"🚶🏾\u200d♀️", "woman_walking_medium_dark_skin_tone", CLDR},
+	{"🚶🏾\u200d♂️", "man_walking_medium_dark_skin_tone", CLDR},
+	{"🚶🏿", "person_walking_dark_skin_tone", CLDR},
+	{"🚶🏿\u200d♀️", "woman_walking_dark_skin_tone", CLDR},
+	{"🚶🏿\u200d♂️", "man_walking_dark_skin_tone", CLDR},
+	{"🚷", "no_pedestrians", CLDR},
+	{"🚷", "no_pedestrians", GitHub},
+	{"🚸", "children_crossing", CLDR},
+	{"🚸", "children_crossing", GitHub},
+	{"🚹", "mens_room", CLDR},
+	{"🚹", "mens", GitHub},
+	{"🚺", "womens_room", CLDR},
+	{"🚺", "womens", GitHub},
+	{"🚻", "restroom", CLDR},
+	{"🚻", "restroom", GitHub},
+	{"🚼", "baby_symbol", CLDR},
+	{"🚼", "baby_symbol", GitHub},
+	{"🚽", "toilet", CLDR},
+	{"🚽", "toilet", GitHub},
+	{"🚾", "water_closet", CLDR},
+	{"🚾", "wc", GitHub},
+	{"🚿", "shower", CLDR},
+	{"🚿", "shower", GitHub},
+	{"🛀", "person_taking_bath", CLDR},
+	{"🛀", "bath", GitHub},
+	{"🛀🏻", "person_taking_bath_light_skin_tone", CLDR},
+	{"🛀🏼", "person_taking_bath_medium_light_skin_tone", CLDR},
+	{"🛀🏽", "person_taking_bath_medium_skin_tone", CLDR},
+	{"🛀🏾", "person_taking_bath_medium_dark_skin_tone", CLDR},
+	{"🛀🏿", "person_taking_bath_dark_skin_tone", CLDR},
+	{"🛁", "bathtub", CLDR},
+	{"🛁", "bathtub", GitHub},
+	{"🛂", "passport_control", CLDR},
+	{"🛂", "passport_control", GitHub},
+	{"🛃", "customs", CLDR},
+	{"🛃", "customs", GitHub},
+	{"🛄", "baggage_claim", CLDR},
+	{"🛄", "baggage_claim", GitHub},
+	{"🛅", "left_luggage", CLDR},
+	{"🛅", "left_luggage", GitHub},
+	{"🛋️", "couch_and_lamp", CLDR},
+	{"🛋️", "couch_and_lamp", GitHub},
+	{"🛌", "person_in_bed", CLDR},
+	{"🛌", "sleeping_bed", GitHub},
+	{"🛌🏻", "person_in_bed_light_skin_tone", CLDR},
+	{"🛌🏼", "person_in_bed_medium_light_skin_tone", CLDR},
+	{"🛌🏽", "person_in_bed_medium_skin_tone", CLDR},
+	{"🛌🏾", "person_in_bed_medium_dark_skin_tone", CLDR},
+	{"🛌🏿", "person_in_bed_dark_skin_tone", CLDR},
+	{"🛍️", "shopping_bags", CLDR},
+	{"🛍️", "shopping", GitHub},
+	{"🛎️", "bellhop_bell", CLDR},
+	{"🛎️", "bellhop_bell", GitHub},
+	{"🛏️", "bed", CLDR},
+	{"🛏️", "bed", GitHub},
+	{"🛐", "place_of_worship", CLDR},
+	{"🛐", "place_of_worship", GitHub},
+	{"🛑", "stop_sign", CLDR},
+	{"🛑", "stop_sign", GitHub},
+	{"🛒", "shopping_cart", CLDR},
+	{"🛒", "shopping_cart", GitHub},
+	{"🛕", "hindu_temple", CLDR},
+	{"🛕", "hindu_temple", GitHub},
+	{"🛖", "hut", CLDR},
+	{"🛖", "hut", GitHub},
+	{"🛗", "elevator", CLDR},
+	{"🛗", "elevator", GitHub},
+	{"🛠️", "hammer_and_wrench", CLDR},
+	{"🛠️", "hammer_and_wrench", GitHub},
+	{"🛡️", "shield", CLDR},
+	{"🛡️", "shield", GitHub},
+	{"🛢️", "oil_drum", CLDR},
+	{"🛢️", "oil_drum", GitHub},
+	{"🛣️", "motorway", CLDR},
+	{"🛣️", "motorway", GitHub},
+	{"🛤️", "railway_track", CLDR},
+	{"🛤️", "railway_track", GitHub},
+	{"🛥️", "motor_boat", CLDR},
+	{"🛥️", "motor_boat", GitHub},
+	{"🛩️", "small_airplane", CLDR},
+	{"🛩️", "small_airplane", GitHub},
+	{"🛫", "airplane_departure", CLDR},
+	{"🛫", "flight_departure", GitHub},
+	{"🛬", "airplane_arrival", CLDR},
+	{"🛬", "flight_arrival", GitHub},
+	{"🛰️", "satellite", CLDR},
+	{"🛰️", "artificial_satellite", GitHub},
+	{"🛳️", "passenger_ship", CLDR},
+	{"🛳️", "passenger_ship", GitHub},
+	{"🛴", "kick_scooter", CLDR},
+	{"🛴", "kick_scooter", GitHub},
+	{"🛵", "motor_scooter", CLDR},
+	{"🛵", "motor_scooter", GitHub},
+	{"🛶", "canoe", CLDR},
+	{"🛶", "canoe", GitHub},
+	{"🛷", "sled", CLDR},
+	{"🛷", "sled", GitHub},
+	{"🛸", "flying_saucer", CLDR},
+	{"🛸", "flying_saucer", GitHub},
+	{"🛹", "skateboard", CLDR},
+	{"🛹", "skateboard", GitHub},
+	{"🛺", "auto_rickshaw", CLDR},
+	{"🛺", "auto_rickshaw", GitHub},
+	{"🛻", "pickup_truck", CLDR},
+	{"🛻", "pickup_truck", GitHub},
+	{"🛼", "roller_skate", CLDR},
+	{"🛼", "roller_skate", GitHub},
+	{"🟠", "orange_circle", CLDR},
+	{"🟠", "orange_circle", GitHub},
+	{"🟡", "yellow_circle", CLDR},
+	{"🟡", "yellow_circle", GitHub},
+	{"🟢", "green_circle", CLDR},
+	{"🟢", "green_circle", GitHub},
+	{"🟣", "purple_circle", CLDR},
+	{"🟣", "purple_circle", GitHub},
+	{"🟤", "brown_circle", CLDR},
+	{"🟤", "brown_circle", GitHub},
+	{"🟥", "red_square", CLDR},
+	{"🟥", "red_square", GitHub},
+	{"🟦", "blue_square", CLDR},
+	{"🟦", "blue_square", GitHub},
+	{"🟧", "orange_square", CLDR},
+	{"🟧", "orange_square", GitHub},
+	{"🟨", "yellow_square", CLDR},
+	{"🟨", "yellow_square", GitHub},
+	{"🟩", "green_square", CLDR},
+	{"🟩", "green_square", GitHub},
+	{"🟪", "purple_square", CLDR},
+	{"🟪", "purple_square", GitHub},
+	{"🟫", "brown_square", CLDR},
+	{"🟫", "brown_square", GitHub},
+	{"🤌", "pinched_fingers", CLDR},
+	{"🤌", "pinched_fingers", GitHub},
+	{"🤌🏻", "pinched_fingers_light_skin_tone", CLDR},
+	{"🤌🏼", "pinched_fingers_medium_light_skin_tone", CLDR},
+	{"🤌🏽", "pinched_fingers_medium_skin_tone", CLDR},
+	{"🤌🏾", "pinched_fingers_medium_dark_skin_tone", CLDR},
+	{"🤌🏿", "pinched_fingers_dark_skin_tone", CLDR},
+	{"🤍", "white_heart", CLDR},
+	{"🤍", "white_heart", GitHub},
+	{"🤎", "brown_heart", CLDR},
+	{"🤎", "brown_heart", GitHub},
+	{"🤏", "pinching_hand", CLDR},
+	{"🤏", "pinching_hand", GitHub},
+	{"🤏🏻", "pinching_hand_light_skin_tone", CLDR},
+	{"🤏🏼", "pinching_hand_medium_light_skin_tone", CLDR},
+	{"🤏🏽", "pinching_hand_medium_skin_tone", CLDR},
+	{"🤏🏾", "pinching_hand_medium_dark_skin_tone", CLDR},
+	{"🤏🏿", "pinching_hand_dark_skin_tone", CLDR},
+	{"🤐", "zipper_mouth_face", CLDR},
+	{"🤐", "zipper_mouth_face", GitHub},
+	{"🤑", "money_mouth_face", CLDR},
+	{"🤑", "money_mouth_face", GitHub},
+	{"🤒", "face_with_thermometer", CLDR},
+	{"🤒", "face_with_thermometer", GitHub},
+	{"🤓", "nerd_face", CLDR},
+	{"🤓", "nerd_face", GitHub},
+	{"🤔", "thinking_face", CLDR},
+	{"🤔", "thinking", GitHub},
+	{"🤕", "face_with_head_bandage", CLDR},
+	{"🤕", "face_with_head_bandage", GitHub},
+	{"🤖", "robot", CLDR},
+	{"🤖", "robot", GitHub},
+	{"🤗", "smiling_face_with_open_hands", CLDR},
+	{"🤗", "hugs", GitHub},
+	{"🤘", "sign_of_the_horns", CLDR},
+	{"🤘", "metal", GitHub},
+	{"🤘🏻", "sign_of_the_horns_light_skin_tone", CLDR},
+	{"🤘🏼", "sign_of_the_horns_medium_light_skin_tone", CLDR},
+	{"🤘🏽", "sign_of_the_horns_medium_skin_tone", CLDR},
+	{"🤘🏾", "sign_of_the_horns_medium_dark_skin_tone", CLDR},
+	{"🤘🏿", "sign_of_the_horns_dark_skin_tone", CLDR},
+	{"🤙", "call_me_hand", CLDR},
+	{"🤙", "call_me_hand", GitHub},
+	{"🤙🏻", "call_me_hand_light_skin_tone", CLDR},
+	{"🤙🏼", "call_me_hand_medium_light_skin_tone", CLDR},
+	{"🤙🏽", "call_me_hand_medium_skin_tone", CLDR},
+	{"🤙🏾", "call_me_hand_medium_dark_skin_tone", CLDR},
+	{"🤙🏿", "call_me_hand_dark_skin_tone", CLDR},
+	{"🤚", "raised_back_of_hand", CLDR},
+	{"🤚", "raised_back_of_hand", GitHub},
+	{"🤚🏻", "raised_back_of_hand_light_skin_tone", CLDR},
+	{"🤚🏼", "raised_back_of_hand_medium_light_skin_tone", CLDR},
+	{"🤚🏽", "raised_back_of_hand_medium_skin_tone", CLDR},
+	{"🤚🏾", "raised_back_of_hand_medium_dark_skin_tone", CLDR},
+	{"🤚🏿", "raised_back_of_hand_dark_skin_tone", CLDR},
+	{"🤛", "left_facing_fist", CLDR},
+	{"🤛", "fist_left", GitHub},
+	{"🤛🏻", "left_facing_fist_light_skin_tone", CLDR},
+	{"🤛🏼", "left_facing_fist_medium_light_skin_tone", CLDR},
+	{"🤛🏽", "left_facing_fist_medium_skin_tone", CLDR},
+	{"🤛🏾", "left_facing_fist_medium_dark_skin_tone", CLDR},
+	{"🤛🏿", "left_facing_fist_dark_skin_tone", CLDR},
+	{"🤜", "right_facing_fist", CLDR},
+	{"🤜", "fist_right", GitHub},
+	{"🤜🏻", "right_facing_fist_light_skin_tone", CLDR},
+	{"🤜🏼", "right_facing_fist_medium_light_skin_tone", CLDR},
+	{"🤜🏽", "right_facing_fist_medium_skin_tone", CLDR},
+	{"🤜🏾", "right_facing_fist_medium_dark_skin_tone", CLDR},
+	{"🤜🏿", "right_facing_fist_dark_skin_tone", CLDR},
+	{"🤝", "handshake", CLDR},
+	{"🤝", "handshake", GitHub},
+	{"🤞", "crossed_fingers", CLDR},
+	{"🤞", "crossed_fingers", GitHub},
+	{"🤞🏻", "crossed_fingers_light_skin_tone", CLDR},
+	{"🤞🏼", "crossed_fingers_medium_light_skin_tone", CLDR},
+	{"🤞🏽", "crossed_fingers_medium_skin_tone", CLDR},
+	{"🤞🏾", "crossed_fingers_medium_dark_skin_tone", CLDR},
+	{"🤞🏿", "crossed_fingers_dark_skin_tone", CLDR},
+	{"🤟", "love_you_gesture", CLDR},
+	{"🤟", "love_you_gesture", GitHub},
+	{"🤟🏻", "love_you_gesture_light_skin_tone", CLDR},
+	{"🤟🏼", "love_you_gesture_medium_light_skin_tone", CLDR},
+	{"🤟🏽", "love_you_gesture_medium_skin_tone", CLDR},
+	{"🤟🏾", "love_you_gesture_medium_dark_skin_tone", CLDR},
+	{"🤟🏿", "love_you_gesture_dark_skin_tone", CLDR},
+	{"🤠", "cowboy_hat_face", CLDR},
+	{"🤠", "cowboy_hat_face", GitHub},
+	{"🤡", "clown_face", CLDR},
+	{"🤡", "clown_face", GitHub},
+	{"🤢", "nauseated_face", CLDR},
+	{"🤢", "nauseated_face", GitHub},
+	{"🤣", "rolling_on_the_floor_laughing", CLDR},
+	{"🤣", "rofl", GitHub},
+	{"🤤", "drooling_face", CLDR},
+	{"🤤", "drooling_face", GitHub},
+	{"🤥", "lying_face", CLDR},
+	{"🤥", "lying_face", GitHub},
+	{"🤦", "person_facepalming", CLDR},
+	{"🤦", "facepalm", GitHub},
+	{"🤦\u200d♀️", "woman_facepalming", CLDR},
+	{"🤦\u200d♀️", "woman_facepalming", GitHub},
+	{"🤦\u200d♂️", "man_facepalming", CLDR},
+	{"🤦\u200d♂️", "man_facepalming", GitHub},
+	{"🤦🏻", "person_facepalming_light_skin_tone", CLDR},
+	{"🤦🏻\u200d♀️", "woman_facepalming_light_skin_tone", CLDR},
+	{"🤦🏻\u200d♂️", "man_facepalming_light_skin_tone", CLDR},
+	{"🤦🏼", "person_facepalming_medium_light_skin_tone", CLDR},
+	{"🤦🏼\u200d♀️", "woman_facepalming_medium_light_skin_tone", CLDR},
+	{"🤦🏼\u200d♂️", "man_facepalming_medium_light_skin_tone", CLDR},
+	{"🤦🏽", "person_facepalming_medium_skin_tone", CLDR},
+	{"🤦🏽\u200d♀️", "woman_facepalming_medium_skin_tone", CLDR},
+	{"🤦🏽\u200d♂️", "man_facepalming_medium_skin_tone", CLDR},
+	{"🤦🏾", "person_facepalming_medium_dark_skin_tone", CLDR},
+	{"🤦🏾\u200d♀️", "woman_facepalming_medium_dark_skin_tone", CLDR},
+	{"🤦🏾\u200d♂️", "man_facepalming_medium_dark_skin_tone", CLDR},
+	{"🤦🏿", "person_facepalming_dark_skin_tone", CLDR},
+	{"🤦🏿\u200d♀️", "woman_facepalming_dark_skin_tone", CLDR},
+	{"🤦🏿\u200d♂️", "man_facepalming_dark_skin_tone", CLDR},
+	{"🤧", "sneezing_face", CLDR},
+	{"🤧", "sneezing_face", GitHub},
+	{"🤨", "face_with_raised_eyebrow", CLDR},
+	{"🤨", "raised_eyebrow", GitHub},
+	{"🤩", "star_struck", CLDR},
+	{"🤩", "star_struck", GitHub},
+	{"🤪", "zany_face", CLDR},
+	{"🤪", "zany_face", GitHub},
+	{"🤫", "shushing_face", CLDR},
+	{"🤫", "shushing_face", GitHub},
+	{"🤬", "face_with_symbols_on_mouth", CLDR},
+	{"🤬", "cursing_face", GitHub},
+	{"🤭", "face_with_hand_over_mouth", CLDR},
+	{"🤭", "hand_over_mouth", GitHub},
+	{"🤮", "face_vomiting", CLDR},
+	{"🤮", "vomiting_face", GitHub},
+	{"🤯", "exploding_head", CLDR},
+	{"🤯", "exploding_head", GitHub},
+	{"🤰", "pregnant_woman", CLDR},
+	{"🤰", "pregnant_woman", GitHub},
+	{"🤰🏻", "pregnant_woman_light_skin_tone", CLDR},
+	{"🤰🏼", "pregnant_woman_medium_light_skin_tone", CLDR},
+	{"🤰🏽", "pregnant_woman_medium_skin_tone", CLDR},
+	{"🤰🏾", "pregnant_woman_medium_dark_skin_tone", CLDR},
+	{"🤰🏿", "pregnant_woman_dark_skin_tone", CLDR},
+	{"🤱", "breast_feeding", CLDR},
+	{"🤱", "breast_feeding", GitHub},
+	{"🤱🏻", "breast_feeding_light_skin_tone", CLDR},
+	{"🤱🏼", "breast_feeding_medium_light_skin_tone", CLDR},
+	{"🤱🏽", "breast_feeding_medium_skin_tone", CLDR},
+	{"🤱🏾", "breast_feeding_medium_dark_skin_tone", CLDR},
+	{"🤱🏿", "breast_feeding_dark_skin_tone", CLDR},
+	{"🤲", "palms_up_together", CLDR},
+	{"🤲", "palms_up_together", GitHub},
+	{"🤲🏻", "palms_up_together_light_skin_tone", CLDR},
+	{"🤲🏼", "palms_up_together_medium_light_skin_tone", CLDR},
+	{"🤲🏽", "palms_up_together_medium_skin_tone", CLDR},
+	{"🤲🏾", "palms_up_together_medium_dark_skin_tone", CLDR},
+	{"🤲🏿", "palms_up_together_dark_skin_tone", CLDR},
+	{"🤳", "selfie", CLDR},
+	{"🤳", "selfie", GitHub},
+	{"🤳🏻", "selfie_light_skin_tone", CLDR},
+	{"🤳🏼", "selfie_medium_light_skin_tone", CLDR},
+	{"🤳🏽", "selfie_medium_skin_tone", CLDR},
+	{"🤳🏾", "selfie_medium_dark_skin_tone", CLDR},
+	{"🤳🏿", "selfie_dark_skin_tone", CLDR},
+	{"🤴", "prince", CLDR},
+	{"🤴", "prince", GitHub},
+	{"🤴🏻", "prince_light_skin_tone", CLDR},
+	{"🤴🏼", "prince_medium_light_skin_tone", CLDR},
+	{"🤴🏽", "prince_medium_skin_tone", CLDR},
+	{"🤴🏾", "prince_medium_dark_skin_tone", CLDR},
+	{"🤴🏿", "prince_dark_skin_tone", CLDR},
+	{"🤵", "person_in_tuxedo", CLDR},
+	{"🤵", "person_in_tuxedo", GitHub},
+	{"🤵\u200d♀️", "woman_in_tuxedo", CLDR},
+	{"🤵\u200d♀️", "woman_in_tuxedo", GitHub},
+	{"🤵\u200d♂️", "man_in_tuxedo", CLDR},
+	{"🤵\u200d♂️", "man_in_tuxedo", GitHub},
+	{"🤵🏻", "person_in_tuxedo_light_skin_tone", CLDR},
+	{"🤵🏻\u200d♀️", "woman_in_tuxedo_light_skin_tone", CLDR},
+	{"🤵🏻\u200d♂️", "man_in_tuxedo_light_skin_tone", CLDR},
+	{"🤵🏼", "person_in_tuxedo_medium_light_skin_tone", CLDR},
+	{"🤵🏼\u200d♀️", "woman_in_tuxedo_medium_light_skin_tone", CLDR},
+	{"🤵🏼\u200d♂️", "man_in_tuxedo_medium_light_skin_tone", CLDR},
+	{"🤵🏽", "person_in_tuxedo_medium_skin_tone", CLDR},
+	{"🤵🏽\u200d♀️", "woman_in_tuxedo_medium_skin_tone", CLDR},
+	{"🤵🏽\u200d♂️", "man_in_tuxedo_medium_skin_tone", CLDR},
+	{"🤵🏾", "person_in_tuxedo_medium_dark_skin_tone", CLDR},
+	{"🤵🏾\u200d♀️", "woman_in_tuxedo_medium_dark_skin_tone", CLDR},
+	{"🤵🏾\u200d♂️", "man_in_tuxedo_medium_dark_skin_tone", CLDR},
+	{"🤵🏿", "person_in_tuxedo_dark_skin_tone", CLDR},
+	{"🤵🏿\u200d♀️", "woman_in_tuxedo_dark_skin_tone", CLDR},
+	{"🤵🏿\u200d♂️", "man_in_tuxedo_dark_skin_tone", CLDR},
+	{"🤶", "mrs_claus", CLDR},
+	{"🤶", "mrs_claus", GitHub},
+	{"🤶🏻", "mrs_claus_light_skin_tone", CLDR},
+	{"🤶🏼", "mrs_claus_medium_light_skin_tone", CLDR},
+	{"🤶🏽", "mrs_claus_medium_skin_tone", CLDR},
+	{"🤶🏾", "mrs_claus_medium_dark_skin_tone", CLDR},
+	{"🤶🏿", "mrs_claus_dark_skin_tone", CLDR},
+	{"🤷", "person_shrugging", CLDR},
+	{"🤷", "shrug", GitHub},
+	{"🤷\u200d♀️", "woman_shrugging", CLDR},
+	{"🤷\u200d♀️", "woman_shrugging", GitHub},
+	{"🤷\u200d♂️", "man_shrugging", CLDR},
+	{"🤷\u200d♂️", "man_shrugging", GitHub},
+	{"🤷🏻", "person_shrugging_light_skin_tone", CLDR},
+	{"🤷🏻\u200d♀️", "woman_shrugging_light_skin_tone", CLDR},
+	{"🤷🏻\u200d♂️", "man_shrugging_light_skin_tone", CLDR},
+	{"🤷🏼", "person_shrugging_medium_light_skin_tone", CLDR},
+	{"🤷🏼\u200d♀️", "woman_shrugging_medium_light_skin_tone", CLDR},
+	{"🤷🏼\u200d♂️", "man_shrugging_medium_light_skin_tone", CLDR},
+	{"🤷🏽", "person_shrugging_medium_skin_tone", CLDR},
+	{"🤷🏽\u200d♀️", "woman_shrugging_medium_skin_tone", CLDR},
+	{"🤷🏽\u200d♂️", "man_shrugging_medium_skin_tone", CLDR},
+	{"🤷🏾", "person_shrugging_medium_dark_skin_tone", CLDR},
+	{"🤷🏾\u200d♀️", "woman_shrugging_medium_dark_skin_tone", CLDR},
+	{"🤷🏾\u200d♂️", "man_shrugging_medium_dark_skin_tone", CLDR},
+	{"🤷🏿", "person_shrugging_dark_skin_tone", CLDR},
+	{"🤷🏿\u200d♀️", "woman_shrugging_dark_skin_tone", CLDR},
+	{"🤷🏿\u200d♂️", "man_shrugging_dark_skin_tone", CLDR},
+	{"🤸", "person_cartwheeling", CLDR},
+	{"🤸", "cartwheeling", GitHub},
+	{"🤸\u200d♀️", "woman_cartwheeling", CLDR},
+	{"🤸\u200d♀️", "woman_cartwheeling", GitHub},
+	{"🤸\u200d♂️", "man_cartwheeling", CLDR},
+	{"🤸\u200d♂️", "man_cartwheeling", GitHub},
+	{"🤸🏻", "person_cartwheeling_light_skin_tone", CLDR},
+	{"🤸🏻\u200d♀️", "woman_cartwheeling_light_skin_tone", CLDR},
+	{"🤸🏻\u200d♂️", "man_cartwheeling_light_skin_tone", CLDR},
+	{"🤸🏼", "person_cartwheeling_medium_light_skin_tone", CLDR},
+	{"🤸🏼\u200d♀️", "woman_cartwheeling_medium_light_skin_tone", CLDR},
+	{"🤸🏼\u200d♂️", "man_cartwheeling_medium_light_skin_tone", CLDR},
+	{"🤸🏽", "person_cartwheeling_medium_skin_tone", CLDR},
+	{"🤸🏽\u200d♀️", "woman_cartwheeling_medium_skin_tone", CLDR},
+	{"🤸🏽\u200d♂️", "man_cartwheeling_medium_skin_tone", CLDR},
+	{"🤸🏾", "person_cartwheeling_medium_dark_skin_tone", CLDR},
+	{"🤸🏾\u200d♀️", "woman_cartwheeling_medium_dark_skin_tone", CLDR},
+	{"🤸🏾\u200d♂️", "man_cartwheeling_medium_dark_skin_tone", CLDR},
+	{"🤸🏿", "person_cartwheeling_dark_skin_tone", CLDR},
+	{"🤸🏿\u200d♀️", "woman_cartwheeling_dark_skin_tone", CLDR},
+	{"🤸🏿\u200d♂️", "man_cartwheeling_dark_skin_tone", CLDR},
+	{"🤹", "person_juggling", CLDR},
+	{"🤹", "juggling_person", GitHub},
+	{"🤹\u200d♀️", "woman_juggling", CLDR},
+	{"🤹\u200d♀️", "woman_juggling", GitHub},
+	{"🤹\u200d♂️", "man_juggling", CLDR},
+	{"🤹\u200d♂️", "man_juggling", GitHub},
+	{"🤹🏻", "person_juggling_light_skin_tone", CLDR},
+	{"🤹🏻\u200d♀️", "woman_juggling_light_skin_tone", CLDR},
+	{"🤹🏻\u200d♂️", "man_juggling_light_skin_tone", CLDR},
+	{"🤹🏼", "person_juggling_medium_light_skin_tone", CLDR},
+	{"🤹🏼\u200d♀️", "woman_juggling_medium_light_skin_tone", CLDR},
+	{"🤹🏼\u200d♂️", "man_juggling_medium_light_skin_tone", CLDR},
+	{"🤹🏽", "person_juggling_medium_skin_tone", CLDR},
+	{"🤹🏽\u200d♀️", "woman_juggling_medium_skin_tone", CLDR},
+	{"🤹🏽\u200d♂️", "man_juggling_medium_skin_tone", CLDR},
+	{"🤹🏾", "person_juggling_medium_dark_skin_tone", CLDR},
+	{"🤹🏾\u200d♀️", "woman_juggling_medium_dark_skin_tone", CLDR},
+	{"🤹🏾\u200d♂️", "man_juggling_medium_dark_skin_tone", CLDR},
+	{"🤹🏿", "person_juggling_dark_skin_tone", CLDR},
+	{"🤹🏿\u200d♀️", "woman_juggling_dark_skin_tone", CLDR},
+	{"🤹🏿\u200d♂️", "man_juggling_dark_skin_tone", CLDR},
+	{"🤺", "person_fencing", CLDR},
+	{"🤺", "person_fencing", GitHub},
+	{"🤼", "people_wrestling", CLDR},
+	{"🤼", "wrestling", GitHub},
+	{"🤼\u200d♀️", "women_wrestling", CLDR},
+	{"🤼\u200d♀️", "women_wrestling", GitHub},
+	{"🤼\u200d♂️", "men_wrestling", CLDR},
+	{"🤼\u200d♂️", "men_wrestling", GitHub},
+	{"🤽", "person_playing_water_polo", CLDR},
+	{"🤽", "water_polo", GitHub},
+	{"🤽\u200d♀️", "woman_playing_water_polo", CLDR},
+	{"🤽\u200d♀️", "woman_playing_water_polo", GitHub},
+	{"🤽\u200d♂️", "man_playing_water_polo", CLDR},
+	{"🤽\u200d♂️", "man_playing_water_polo", GitHub},
+	{"🤽🏻", "person_playing_water_polo_light_skin_tone", CLDR},
+	{"🤽🏻\u200d♀️", "woman_playing_water_polo_light_skin_tone", CLDR},
+	{"🤽🏻\u200d♂️", "man_playing_water_polo_light_skin_tone", CLDR},
+	{"🤽🏼", "person_playing_water_polo_medium_light_skin_tone", CLDR},
+	{"🤽🏼\u200d♀️", "woman_playing_water_polo_medium_light_skin_tone", CLDR},
+	{"🤽🏼\u200d♂️", "man_playing_water_polo_medium_light_skin_tone", CLDR},
+	{"🤽🏽", "person_playing_water_polo_medium_skin_tone", CLDR},
+	{"🤽🏽\u200d♀️", "woman_playing_water_polo_medium_skin_tone", CLDR},
+	{"🤽🏽\u200d♂️", "man_playing_water_polo_medium_skin_tone", CLDR},
+	{"🤽🏾", "person_playing_water_polo_medium_dark_skin_tone", CLDR},
+	{"🤽🏾\u200d♀️", "woman_playing_water_polo_medium_dark_skin_tone", CLDR},
+	{"🤽🏾\u200d♂️", "man_playing_water_polo_medium_dark_skin_tone", CLDR},
+	{"🤽🏿", "person_playing_water_polo_dark_skin_tone", CLDR},
+	{"🤽🏿\u200d♀️", "woman_playing_water_polo_dark_skin_tone", CLDR},
+	{"🤽🏿\u200d♂️", "man_playing_water_polo_dark_skin_tone", CLDR},
+	{"🤾", "person_playing_handball", CLDR},
+	{"🤾", "handball_person", GitHub},
+	{"🤾\u200d♀️", "woman_playing_handball", CLDR},
+	{"🤾\u200d♀️", "woman_playing_handball", GitHub},
+	{"🤾\u200d♂️", "man_playing_handball", CLDR},
+	{"🤾\u200d♂️", "man_playing_handball", GitHub},
+	{"🤾🏻", "person_playing_handball_light_skin_tone", CLDR},
+	{"🤾🏻\u200d♀️", "woman_playing_handball_light_skin_tone", CLDR},
+	{"🤾🏻\u200d♂️", "man_playing_handball_light_skin_tone", CLDR},
+	{"🤾🏼", "person_playing_handball_medium_light_skin_tone", CLDR},
+	{"🤾🏼\u200d♀️", "woman_playing_handball_medium_light_skin_tone", CLDR},
+	{"🤾🏼\u200d♂️", "man_playing_handball_medium_light_skin_tone", CLDR},
+	{"🤾🏽", "person_playing_handball_medium_skin_tone", CLDR},
+	{"🤾🏽\u200d♀️", "woman_playing_handball_medium_skin_tone", CLDR},
+	{"🤾🏽\u200d♂️", "man_playing_handball_medium_skin_tone", CLDR},
+	{"🤾🏾", "person_playing_handball_medium_dark_skin_tone", CLDR},
+	{"🤾🏾\u200d♀️", "woman_playing_handball_medium_dark_skin_tone", CLDR},
+	{"🤾🏾\u200d♂️", "man_playing_handball_medium_dark_skin_tone", CLDR},
+	{"🤾🏿", "person_playing_handball_dark_skin_tone", CLDR},
+	{"🤾🏿\u200d♀️", "woman_playing_handball_dark_skin_tone", CLDR},
+	{"🤾🏿\u200d♂️", "man_playing_handball_dark_skin_tone", CLDR},
+	{"🤿", "diving_mask", CLDR},
+	{"🤿", "diving_mask", GitHub},
+	{"🥀", "wilted_flower", CLDR},
+	{"🥀", "wilted_flower", GitHub},
+	{"🥁", "drum", CLDR},
+	{"🥁", "drum", GitHub},
+	{"🥂", "clinking_glasses", CLDR},
+	{"🥂", "clinking_glasses", GitHub},
+	{"🥃", "tumbler_glass", CLDR},
+	{"🥃", "tumbler_glass", GitHub},
+	{"🥄", "spoon", CLDR},
+	{"🥄", "spoon", GitHub},
+	{"🥅", "goal_net", CLDR},
+	{"🥅", "goal_net", GitHub},
+	{"🥇", "1st_place_medal", CLDR},
+	{"🥇", "1st_place_medal", GitHub},
+	{"🥈", "2nd_place_medal", CLDR},
+	{"🥈", "2nd_place_medal", GitHub},
+	{"🥉", "3rd_place_medal", CLDR},
+	{"🥉", "3rd_place_medal", GitHub},
+	{"🥊", "boxing_glove", CLDR},
+	{"🥊", "boxing_glove", GitHub},
+	{"🥋", "martial_arts_uniform", CLDR},
+	{"🥋", "martial_arts_uniform", GitHub},
+	{"🥌", "curling_stone", CLDR},
+	{"🥌", "curling_stone", GitHub},
+	{"🥍", "lacrosse", CLDR},
+	{"🥍", "lacrosse", GitHub},
+	{"🥎", "softball", CLDR},
+	{"🥎", "softball", GitHub},
+	{"🥏", "flying_disc", CLDR},
+	{"🥏", "flying_disc", GitHub},
+	{"🥐", "croissant", CLDR},
+	{"🥐", "croissant", GitHub},
+	{"🥑", "avocado", CLDR},
+	{"🥑", "avocado", GitHub},
+	{"🥒", "cucumber", CLDR},
+	{"🥒", "cucumber", GitHub},
+	{"🥓", "bacon", CLDR},
+	{"🥓", "bacon", GitHub},
+	{"🥔", "potato", CLDR},
+	{"🥔", "potato", GitHub},
+	{"🥕", "carrot", CLDR},
+	{"🥕", "carrot", GitHub},
+	{"🥖", "baguette_bread", CLDR},
+	{"🥖", "baguette_bread", GitHub},
+	{"🥗", "green_salad", CLDR},
+	{"🥗", "green_salad", GitHub},
+	{"🥘", "shallow_pan_of_food", CLDR},
+	{"🥘", "shallow_pan_of_food", GitHub},
+	{"🥙", "stuffed_flatbread", CLDR},
+	{"🥙", "stuffed_flatbread", GitHub},
+	{"🥚", "egg", CLDR},
+	{"🥚", "egg", GitHub},
+	{"🥛", "glass_of_milk", CLDR},
+	{"🥛", "milk_glass", GitHub},
+	{"🥜", "peanuts", CLDR},
+	{"🥜", "peanuts", GitHub},
+	{"🥝", "kiwi_fruit", CLDR},
+	{"🥝", "kiwi_fruit", GitHub},
+	{"🥞", "pancakes", CLDR},
+	{"🥞", "pancakes", GitHub},
+	{"🥟", "dumpling", CLDR},
+	{"🥟", "dumpling", GitHub},
+	{"🥠", "fortune_cookie", CLDR},
+	{"🥠", "fortune_cookie", GitHub},
+	{"🥡", "takeout_box", CLDR},
+	{"🥡", "takeout_box", GitHub},
+	{"🥢", "chopsticks", CLDR},
+	{"🥢", "chopsticks", GitHub},
+	{"🥣", "bowl_with_spoon", CLDR},
+	{"🥣", "bowl_with_spoon", GitHub},
+	{"🥤", "cup_with_straw", CLDR},
+	{"🥤", "cup_with_straw", GitHub},
+	{"🥥", "coconut", CLDR},
+	{"🥥", "coconut", GitHub},
+	{"🥦", "broccoli", CLDR},
+	{"🥦", "broccoli", GitHub},
+	{"🥧", "pie", CLDR},
+	{"🥧", "pie", GitHub},
+	{"🥨", "pretzel", CLDR},
+	{"🥨", "pretzel", GitHub},
+	{"🥩", "cut_of_meat", CLDR},
+	{"🥩", "cut_of_meat", GitHub},
+	{"🥪", "sandwich", CLDR},
+	{"🥪", "sandwich", GitHub},
+	{"🥫", "canned_food", CLDR},
+	{"🥫", "canned_food", GitHub},
+	{"🥬", "leafy_green", CLDR},
+	{"🥬", "leafy_green", GitHub},
+	{"🥭", "mango", CLDR},
+	{"🥭", "mango", GitHub},
+	{"🥮", "moon_cake", CLDR},
+	{"🥮", "moon_cake", GitHub},
+	{"🥯", "bagel", CLDR},
+	{"🥯", "bagel", GitHub},
+	{"🥰", "smiling_face_with_hearts", CLDR},
+	{"🥰", "smiling_face_with_three_hearts", GitHub},
+	{"🥱", "yawning_face", CLDR},
+	{"🥱", "yawning_face", GitHub},
+	{"🥲", "smiling_face_with_tear", CLDR},
+	{"🥲", "smiling_face_with_tear", GitHub},
+	{"🥳", "partying_face", CLDR},
+	{"🥳", "partying_face", GitHub},
+	{"🥴", "woozy_face", CLDR},
+	{"🥴", "woozy_face", GitHub},
+	{"🥵", "hot_face", CLDR},
+	{"🥵", "hot_face", GitHub},
+	{"🥶", "cold_face", CLDR},
+	{"🥶", "cold_face", GitHub},
+	{"🥷", "ninja", CLDR},
+	{"🥷", "ninja", GitHub},
+	{"🥷🏻", "ninja_light_skin_tone", CLDR},
+	{"🥷🏼", "ninja_medium_light_skin_tone", CLDR},
+	{"🥷🏽", "ninja_medium_skin_tone", CLDR},
+	{"🥷🏾", "ninja_medium_dark_skin_tone", CLDR},
+	{"🥷🏿", "ninja_dark_skin_tone", CLDR},
+	{"🥸", "disguised_face", CLDR},
+	{"🥸", "disguised_face", GitHub},
+	{"🥺", "pleading_face", CLDR},
+	{"🥺", "pleading_face", GitHub},
+	{"🥻", "sari", CLDR},
+	{"🥻", "sari", GitHub},
+	{"🥼", "lab_coat", CLDR},
+	{"🥼", "lab_coat", GitHub},
+	{"🥽", "goggles", CLDR},
+	{"🥽", "goggles", GitHub},
+	{"🥾", "hiking_boot", CLDR},
+	{"🥾", "hiking_boot", GitHub},
+	{"🥿", "flat_shoe", CLDR},
+	{"🥿", "flat_shoe", GitHub},
+	{"🦀", "crab", CLDR},
+	{"🦀", "crab", GitHub},
+	{"🦁", "lion", CLDR},
+	{"🦁", "lion", GitHub},
+	{"🦂", "scorpion", CLDR},
+	{"🦂", "scorpion", GitHub},
+	{"🦃", "turkey", CLDR},
+	{"🦃", "turkey", GitHub},
+	{"🦄", "unicorn", CLDR},
+	{"🦄", "unicorn", GitHub},
+	{"🦅", "eagle", CLDR},
+	{"🦅", "eagle", GitHub},
+	{"🦆", "duck", CLDR},
+	{"🦆", "duck", GitHub},
+	{"🦇", "bat", CLDR},
+	{"🦇", "bat", GitHub},
+	{"🦈", "shark", CLDR},
+	{"🦈", "shark", GitHub},
+	{"🦉", "owl", CLDR},
+	{"🦉", "owl", GitHub},
+	{"🦊", "fox", CLDR},
+	{"🦊", "fox_face", GitHub},
+	{"🦋", "butterfly", CLDR},
+	{"🦋", "butterfly", GitHub},
+	{"🦌", "deer", CLDR},
+	{"🦌", "deer", GitHub},
+	{"🦍", "gorilla", CLDR},
+	{"🦍", "gorilla", GitHub},
+	{"🦎", "lizard", CLDR},
+	{"🦎", "lizard", GitHub},
+	{"🦏", "rhinoceros", CLDR},
+	{"🦏", "rhinoceros", GitHub},
+	{"🦐", "shrimp", CLDR},
+	{"🦐", "shrimp", GitHub},
+	{"🦑", "squid", CLDR},
+	{"🦑", "squid", GitHub},
+	{"🦒", "giraffe", CLDR},
+	{"🦒", "giraffe", GitHub},
+	{"🦓", "zebra", CLDR},
+	{"🦓", "zebra", GitHub},
+	{"🦔", "hedgehog", CLDR},
+	{"🦔", "hedgehog", GitHub},
+	{"🦕", "sauropod", CLDR},
+	{"🦕", "sauropod", GitHub},
+	{"🦖", "t_rex", CLDR},
+	{"🦖", "t-rex", GitHub},
+	{"🦗", "cricket", CLDR},
+	{"🦗", "cricket", GitHub},
+	{"🦘", "kangaroo", CLDR},
+	{"🦘", "kangaroo", GitHub},
+	{"🦙", "llama", CLDR},
+	{"🦙", "llama", GitHub},
+	{"🦚", "peacock", CLDR},
+	{"🦚", "peacock", GitHub},
+	{"🦛", "hippopotamus", CLDR},
+	{"🦛", "hippopotamus", GitHub},
+	{"🦜", "parrot", CLDR},
+	{"🦜", "parrot", GitHub},
+	{"🦝", "raccoon", CLDR},
+	{"🦝", "raccoon", GitHub},
+	{"🦞", "lobster", CLDR},
+	{"🦞", "lobster", GitHub},
+	{"🦟", "mosquito", CLDR},
+	{"🦟", "mosquito", GitHub},
+	{"🦠", "microbe", CLDR},
+	{"🦠", "microbe", GitHub},
+	{"🦡", "badger", CLDR},
+	{"🦡", "badger", GitHub},
+	{"🦢", "swan", CLDR},
+	{"🦢", "swan", GitHub},
+	{"🦣", "mammoth", CLDR},
+	{"🦣", "mammoth", GitHub},
+	{"🦤", "dodo", CLDR},
+	{"🦤", "dodo", GitHub},
+	{"🦥", "sloth", CLDR},
+	{"🦥", "sloth", GitHub},
+	{"🦦", "otter", CLDR},
+	{"🦦", "otter", GitHub},
+	{"🦧", "orangutan", CLDR},
+	{"🦧", "orangutan", GitHub},
+	{"🦨", "skunk", CLDR},
+	{"🦨", "skunk", GitHub},
+	{"🦩", "flamingo", CLDR},
+	{"🦩", "flamingo", GitHub},
+	{"🦪", "oyster", CLDR},
+	{"🦪", "oyster", GitHub},
+	{"🦫", "beaver", CLDR},
+	{"🦫", "beaver", GitHub},
+	{"🦬", "bison", CLDR},
+	{"🦬", "bison", GitHub},
+	{"🦭", "seal", CLDR},
+	{"🦭", "seal", GitHub},
+	{"🦮", "guide_dog", CLDR},
+	{"🦮", "guide_dog", GitHub},
+	{"🦯", "white_cane", CLDR},
+	{"🦯", "probing_cane", GitHub},
+	{"🦴", "bone", CLDR},
+	{"🦴", "bone", GitHub},
+	{"🦵", "leg", CLDR},
+	{"🦵", "leg", GitHub},
+	{"🦵🏻", "leg_light_skin_tone", CLDR},
+	{"🦵🏼", "leg_medium_light_skin_tone", CLDR},
+	{"🦵🏽", "leg_medium_skin_tone", CLDR},
+	{"🦵🏾", "leg_medium_dark_skin_tone", CLDR},
+	{"🦵🏿", "leg_dark_skin_tone", CLDR},
+	{"🦶", "foot", CLDR},
+	{"🦶", "foot", GitHub},
+	{"🦶🏻", "foot_light_skin_tone", CLDR},
+	{"🦶🏼", "foot_medium_light_skin_tone", CLDR},
+	{"🦶🏽", "foot_medium_skin_tone", CLDR},
+	{"🦶🏾", "foot_medium_dark_skin_tone", CLDR},
+	{"🦶🏿", "foot_dark_skin_tone", CLDR},
+	{"🦷", "tooth", CLDR},
+	{"🦷", "tooth", GitHub},
+	{"🦸", "superhero", CLDR},
+	{"🦸", "superhero", GitHub},
+	{"🦸\u200d♀️", "woman_superhero", CLDR},
+	{"🦸\u200d♀️", "superhero_woman", GitHub},
+	{"🦸\u200d♂️", "man_superhero", CLDR},
+	{"🦸\u200d♂️", "superhero_man", GitHub},
+	{"🦸🏻", "superhero_light_skin_tone", CLDR},
+	{"🦸🏻\u200d♀️", "woman_superhero_light_skin_tone", CLDR},
+	{"🦸🏻\u200d♂️", "man_superhero_light_skin_tone", CLDR},
+	{"🦸🏼", "superhero_medium_light_skin_tone", CLDR},
+	{"🦸🏼\u200d♀️", "woman_superhero_medium_light_skin_tone", CLDR},
+	{"🦸🏼\u200d♂️", "man_superhero_medium_light_skin_tone", CLDR},
+	{"🦸🏽", "superhero_medium_skin_tone", CLDR},
+	{"🦸🏽\u200d♀️", "woman_superhero_medium_skin_tone", CLDR},
+	{"🦸🏽\u200d♂️", "man_superhero_medium_skin_tone", CLDR},
+	{"🦸🏾", "superhero_medium_dark_skin_tone", CLDR},
+	{"🦸🏾\u200d♀️", "woman_superhero_medium_dark_skin_tone", CLDR},
+	{"🦸🏾\u200d♂️", "man_superhero_medium_dark_skin_tone", CLDR},
+	{"🦸🏿", "superhero_dark_skin_tone", CLDR},
+	{"🦸🏿\u200d♀️", "woman_superhero_dark_skin_tone", CLDR},
+	{"🦸🏿\u200d♂️", "man_superhero_dark_skin_tone", CLDR},
+	{"🦹", "supervillain", CLDR},
+	{"🦹", "supervillain", GitHub},
+	{"🦹\u200d♀️", "woman_supervillain", CLDR},
+	{"🦹\u200d♀️", "supervillain_woman", GitHub},
+	{"🦹\u200d♂️", "man_supervillain", CLDR},
+	{"🦹\u200d♂️", "supervillain_man", GitHub},
+	{"🦹🏻", "supervillain_light_skin_tone", CLDR},
+	{"🦹🏻\u200d♀️", "woman_supervillain_light_skin_tone", CLDR},
+	{"🦹🏻\u200d♂️", "man_supervillain_light_skin_tone", CLDR},
+	{"🦹🏼", "supervillain_medium_light_skin_tone", CLDR},
+	{"🦹🏼\u200d♀️", "woman_supervillain_medium_light_skin_tone", CLDR},
+	{"🦹🏼\u200d♂️", "man_supervillain_medium_light_skin_tone", CLDR},
+	{"🦹🏽", "supervillain_medium_skin_tone", CLDR},
+	{"🦹🏽\u200d♀️", "woman_supervillain_medium_skin_tone", CLDR},
+	{"🦹🏽\u200d♂️", "man_supervillain_medium_skin_tone", CLDR},
+	{"🦹🏾", "supervillain_medium_dark_skin_tone", CLDR},
+	{"🦹🏾\u200d♀️", "woman_supervillain_medium_dark_skin_tone", CLDR},
+	{"🦹🏾\u200d♂️", "man_supervillain_medium_dark_skin_tone", CLDR},
+	{"🦹🏿", "supervillain_dark_skin_tone", CLDR},
+	{"🦹🏿\u200d♀️", "woman_supervillain_dark_skin_tone", CLDR},
+	{"🦹🏿\u200d♂️", "man_supervillain_dark_skin_tone", CLDR},
+	{"🦺", "safety_vest", CLDR},
+	{"🦺", "safety_vest", GitHub},
+	{"🦻", "ear_with_hearing_aid", CLDR},
+	{"🦻", "ear_with_hearing_aid", GitHub},
+	{"🦻🏻", "ear_with_hearing_aid_light_skin_tone", CLDR},
+	{"🦻🏼", "ear_with_hearing_aid_medium_light_skin_tone", CLDR},
+	{"🦻🏽", "ear_with_hearing_aid_medium_skin_tone", CLDR},
+	{"🦻🏾", "ear_with_hearing_aid_medium_dark_skin_tone", CLDR},
+	{"🦻🏿", "ear_with_hearing_aid_dark_skin_tone", CLDR},
+	{"🦼", "motorized_wheelchair", CLDR},
+	{"🦼", "motorized_wheelchair", GitHub},
+	{"🦽", "manual_wheelchair", CLDR},
+	{"🦽", "manual_wheelchair", GitHub},
+	{"🦾", "mechanical_arm", CLDR},
+	{"🦾", "mechanical_arm", GitHub},
+	{"🦿", "mechanical_leg", CLDR},
+	{"🦿", "mechanical_leg", GitHub},
+	{"🧀", "cheese_wedge", CLDR},
+	{"🧀", "cheese", GitHub},
+	{"🧁", "cupcake", CLDR},
+	{"🧁", "cupcake", GitHub},
+	{"🧂", "salt", CLDR},
+	{"🧂", "salt", GitHub},
+	{"🧃", "beverage_box", CLDR},
+	{"🧃", "beverage_box", GitHub},
+	{"🧄", "garlic", CLDR},
+	{"🧄", "garlic", GitHub},
+	{"🧅", "onion", CLDR},
+	{"🧅", "onion", GitHub},
+	{"🧆", "falafel", CLDR},
+	{"🧆", "falafel", GitHub},
+	{"🧇", "waffle", CLDR},
+	{"🧇", "waffle", GitHub},
+	{"🧈", "butter", CLDR},
+	{"🧈", "butter", GitHub},
+	{"🧉", "mate", CLDR},
+	{"🧉", "mate", GitHub},
+	{"🧊", "ice", CLDR},
+	{"🧊", "ice_cube", GitHub},
+	{"🧋", "bubble_tea", CLDR},
+	{"🧋", "bubble_tea", GitHub},
+	{"🧍", "person_standing", CLDR},
+	{"🧍", "standing_person", GitHub},
+	{"🧍\u200d♀️", "woman_standing", CLDR},
+	{"🧍\u200d♀️", "standing_woman", GitHub},
+	{"🧍\u200d♂️", "man_standing", CLDR},
+	{"🧍\u200d♂️", "standing_man", GitHub},
+	{"🧍🏻", "person_standing_light_skin_tone", CLDR},
+	{"🧍🏻\u200d♀️", "woman_standing_light_skin_tone", CLDR},
+	{"🧍🏻\u200d♂️", "man_standing_light_skin_tone", CLDR},
+	{"🧍🏼", "person_standing_medium_light_skin_tone", CLDR},
+	{"🧍🏼\u200d♀️", "woman_standing_medium_light_skin_tone", CLDR},
+	{"🧍🏼\u200d♂️", "man_standing_medium_light_skin_tone", CLDR},
+	{"🧍🏽", "person_standing_medium_skin_tone", CLDR},
+	{"🧍🏽\u200d♀️", "woman_standing_medium_skin_tone", CLDR},
+	{"🧍🏽\u200d♂️", "man_standing_medium_skin_tone", CLDR},
+	{"🧍🏾", "person_standing_medium_dark_skin_tone", CLDR},
+	{"🧍🏾\u200d♀️", "woman_standing_medium_dark_skin_tone", CLDR},
+	{"🧍🏾\u200d♂️", "man_standing_medium_dark_skin_tone", CLDR},
+	{"🧍🏿", "person_standing_dark_skin_tone", CLDR},
+	{"🧍🏿\u200d♀️", "woman_standing_dark_skin_tone", CLDR},
+	{"🧍🏿\u200d♂️", "man_standing_dark_skin_tone", CLDR},
+	{"🧎", "person_kneeling", CLDR},
+	{"🧎", "kneeling_person", GitHub},
+	{"🧎\u200d♀️", "woman_kneeling", CLDR},
+	{"🧎\u200d♀️", "kneeling_woman", GitHub},
+	{"🧎\u200d♂️", "man_kneeling", CLDR},
+	{"🧎\u200d♂️", "kneeling_man", GitHub},
+	{"🧎🏻", "person_kneeling_light_skin_tone", CLDR},
+	{"🧎🏻\u200d♀️", "woman_kneeling_light_skin_tone", CLDR},
+	{"🧎🏻\u200d♂️", "man_kneeling_light_skin_tone", CLDR},
+	{"🧎🏼", "person_kneeling_medium_light_skin_tone", CLDR},
+	{"🧎🏼\u200d♀️", "woman_kneeling_medium_light_skin_tone", CLDR},
+	{"🧎🏼\u200d♂️", "man_kneeling_medium_light_skin_tone", CLDR},
+	{"🧎🏽", "person_kneeling_medium_skin_tone", CLDR},
+	{"🧎🏽\u200d♀️", "woman_kneeling_medium_skin_tone", CLDR},
+	{"🧎🏽\u200d♂️", "man_kneeling_medium_skin_tone", CLDR},
+	{"🧎🏾", "person_kneeling_medium_dark_skin_tone", CLDR},
+	{"🧎🏾\u200d♀️", "woman_kneeling_medium_dark_skin_tone", CLDR},
+	{"🧎🏾\u200d♂️", "man_kneeling_medium_dark_skin_tone", CLDR},
+	{"🧎🏿", "person_kneeling_dark_skin_tone", CLDR},
+	{"🧎🏿\u200d♀️", "woman_kneeling_dark_skin_tone", CLDR},
+	{"🧎🏿\u200d♂️", "man_kneeling_dark_skin_tone", CLDR},
+	{"🧏", "deaf_person", CLDR},
+	{"🧏", "deaf_person", GitHub},
+	{"🧏\u200d♀️", "deaf_woman", CLDR},
+	{"🧏\u200d♀️", "deaf_woman", GitHub},
+	{"🧏\u200d♂️", "deaf_man", CLDR},
+	{"🧏\u200d♂️", "deaf_man", GitHub},
+	{"🧏🏻", "deaf_person_light_skin_tone", CLDR},
+	{"🧏🏻\u200d♀️", "deaf_woman_light_skin_tone", CLDR},
+	{"🧏🏻\u200d♂️", "deaf_man_light_skin_tone", CLDR},
+	{"🧏🏼", "deaf_person_medium_light_skin_tone", CLDR},
+	{"🧏🏼\u200d♀️", "deaf_woman_medium_light_skin_tone", CLDR},
+	{"🧏🏼\u200d♂️", "deaf_man_medium_light_skin_tone", CLDR},
+	{"🧏🏽", "deaf_person_medium_skin_tone", CLDR},
+	{"🧏🏽\u200d♀️", "deaf_woman_medium_skin_tone", CLDR},
+	{"🧏🏽\u200d♂️", "deaf_man_medium_skin_tone", CLDR},
+	{"🧏🏾", "deaf_person_medium_dark_skin_tone", CLDR},
+	{"🧏🏾\u200d♀️", "deaf_woman_medium_dark_skin_tone", CLDR},
+	{"🧏🏾\u200d♂️", "deaf_man_medium_dark_skin_tone", CLDR},
+	{"🧏🏿", "deaf_person_dark_skin_tone", CLDR},
+	{"🧏🏿\u200d♀️", "deaf_woman_dark_skin_tone", CLDR},
+	{"🧏🏿\u200d♂️", "deaf_man_dark_skin_tone", CLDR},
+	{"🧐", "face_with_monocle", CLDR},
+	{"🧐", "monocle_face", GitHub},
+	{"🧑", "person", CLDR},
+	{"🧑", "adult", GitHub},
+	{"🧑\u200d⚕️", "health_worker", CLDR},
+	{"🧑\u200d⚕️", "health_worker", GitHub},
+	{"🧑\u200d⚖️", "judge", CLDR},
+	{"🧑\u200d⚖️", "judge", GitHub},
+	{"🧑\u200d✈️", "pilot", CLDR},
+	{"🧑\u200d✈️", "pilot", GitHub},
+	{"🧑\u200d🌾", "farmer", CLDR},
+	{"🧑\u200d🌾", "farmer", GitHub},
+	{"🧑\u200d🍳", "cook", CLDR},
+	{"🧑\u200d🍳", "cook", GitHub},
+	{"🧑\u200d🍼", "person_feeding_baby", CLDR},
+	{"🧑\u200d🍼", "person_feeding_baby", GitHub},
+	{"🧑\u200d🎄", "mx_claus", CLDR},
+	{"🧑\u200d🎄", "mx_claus", GitHub},
+	{"🧑\u200d🎓", "student", CLDR},
+	{"🧑\u200d🎓", "student", GitHub},
+	{"🧑\u200d🎤", "singer", CLDR},
+	{"🧑\u200d🎤", "singer", GitHub},
+	{"🧑\u200d🎨", "artist", CLDR},
+	{"🧑\u200d🎨", "artist", GitHub},
+	{"🧑\u200d🏫", "teacher", CLDR},
+	{"🧑\u200d🏫", "teacher", GitHub},
+	{"🧑\u200d🏭", "factory_worker", CLDR},
+	{"🧑\u200d🏭", "factory_worker", GitHub},
+	{"🧑\u200d💻", "technologist", CLDR},
+	{"🧑\u200d💻", "technologist", GitHub},
+	{"🧑\u200d💼", "office_worker", CLDR},
+	{"🧑\u200d💼", "office_worker", GitHub},
+	{"🧑\u200d🔧", "mechanic", CLDR},
+	{"🧑\u200d🔧", "mechanic", GitHub},
+	{"🧑\u200d🔬", "scientist", CLDR},
+	{"🧑\u200d🔬", "scientist", GitHub},
+	{"🧑\u200d🚀", "astronaut", CLDR},
+	{"🧑\u200d🚀", "astronaut", GitHub},
+	{"🧑\u200d🚒", "firefighter", CLDR},
+	{"🧑\u200d🚒", "firefighter", GitHub},
+	{"🧑\u200d🤝\u200d🧑", "people_holding_hands", CLDR},
+	{"🧑\u200d🤝\u200d🧑", "people_holding_hands", GitHub},
+	{"🧑\u200d🦯", "person_with_white_cane", CLDR},
+	{"🧑\u200d🦯", "person_with_probing_cane", GitHub},
+	{"🧑\u200d🦰", "person_red_hair", CLDR},
+	{"🧑\u200d🦰", "person_red_hair", GitHub},
+	{"🧑\u200d🦱", "person_curly_hair", CLDR},
+	{"🧑\u200d🦱", "person_curly_hair", GitHub},
+	{"🧑\u200d🦲", "person_bald", CLDR},
+	{"🧑\u200d🦲", "person_bald", GitHub},
+	{"🧑\u200d🦳", "person_white_hair", CLDR},
+	{"🧑\u200d🦳", "person_white_hair", GitHub},
+	{"🧑\u200d🦼", "person_in_motorized_wheelchair", CLDR},
+	{"🧑\u200d🦼", "person_in_motorized_wheelchair", GitHub},
+	{"🧑\u200d🦽", "person_in_manual_wheelchair", CLDR},
+	{"🧑\u200d🦽", "person_in_manual_wheelchair", GitHub},
+	{"🧑🏻", "person_light_skin_tone", CLDR},
+	{"🧑🏻\u200d⚕️", "health_worker_light_skin_tone", CLDR},
+	{"🧑🏻\u200d⚖️", "judge_light_skin_tone", CLDR},
+	{"🧑🏻\u200d✈️", "pilot_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🌾", "farmer_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🍳", "cook_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🍼", "person_feeding_baby_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🎄", "mx_claus_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🎓", "student_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🎤", "singer_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🎨", "artist_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🏫", "teacher_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🏭", "factory_worker_light_skin_tone", CLDR},
+	{"🧑🏻\u200d💻", "technologist_light_skin_tone", CLDR},
+	{"🧑🏻\u200d💼", "office_worker_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🔧", "mechanic_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🔬", "scientist_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🚀", "astronaut_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🚒", "firefighter_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🤝\u200d🧑🏻", "people_holding_hands_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🤝\u200d🧑🏼", "people_holding_hands_light_skin_tone_medium_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🤝\u200d🧑🏽", "people_holding_hands_light_skin_tone_medium_skin_tone", CLDR},
+	{"🧑🏻\u200d🤝\u200d🧑🏾", "people_holding_hands_light_skin_tone_medium_dark_skin_tone", CLDR},
+	{"🧑🏻\u200d🤝\u200d🧑🏿", "people_holding_hands_light_skin_tone_dark_skin_tone", CLDR},
+	{"🧑🏻\u200d🦯", "person_with_white_cane_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🦰", "person_light_skin_tone_red_hair", CLDR},
+	{"🧑🏻\u200d🦱", "person_light_skin_tone_curly_hair", CLDR},
+	{"🧑🏻\u200d🦲", "person_light_skin_tone_bald", CLDR},
+	{"🧑🏻\u200d🦳", "person_light_skin_tone_white_hair", CLDR},
+	{"🧑🏻\u200d🦼", "person_in_motorized_wheelchair_light_skin_tone", CLDR},
+	{"🧑🏻\u200d🦽", "person_in_manual_wheelchair_light_skin_tone", CLDR},
+	{"🧑🏼", "person_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d⚕️", "health_worker_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d⚖️", "judge_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d✈️", "pilot_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🌾", "farmer_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🍳", "cook_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🍼", "person_feeding_baby_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🎄", "mx_claus_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🎓", "student_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🎤", "singer_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🎨", "artist_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🏫", "teacher_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🏭", "factory_worker_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d💻", "technologist_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d💼", "office_worker_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🔧", "mechanic_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🔬", "scientist_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🚀", "astronaut_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🚒", "firefighter_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🤝\u200d🧑🏻", "people_holding_hands_medium_light_skin_tone_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🤝\u200d🧑🏼", "people_holding_hands_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🤝\u200d🧑🏽", "people_holding_hands_medium_light_skin_tone_medium_skin_tone", CLDR},
+	{"🧑🏼\u200d🤝\u200d🧑🏾", "people_holding_hands_medium_light_skin_tone_medium_dark_skin_tone", CLDR},
+	{"🧑🏼\u200d🤝\u200d🧑🏿", "people_holding_hands_medium_light_skin_tone_dark_skin_tone", CLDR},
+	{"🧑🏼\u200d🦯", "person_with_white_cane_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🦰", "person_medium_light_skin_tone_red_hair", CLDR},
+	{"🧑🏼\u200d🦱", "person_medium_light_skin_tone_curly_hair", CLDR},
+	{"🧑🏼\u200d🦲", "person_medium_light_skin_tone_bald", CLDR},
+	{"🧑🏼\u200d🦳", "person_medium_light_skin_tone_white_hair", CLDR},
+	{"🧑🏼\u200d🦼", "person_in_motorized_wheelchair_medium_light_skin_tone", CLDR},
+	{"🧑🏼\u200d🦽", "person_in_manual_wheelchair_medium_light_skin_tone", CLDR},
+	{"🧑🏽", "person_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d⚕️", "health_worker_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d⚖️", "judge_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d✈️", "pilot_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🌾", "farmer_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🍳", "cook_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🍼", "person_feeding_baby_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🎄", "mx_claus_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🎓", "student_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🎤", "singer_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🎨", "artist_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🏫", "teacher_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🏭", "factory_worker_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d💻", "technologist_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d💼", "office_worker_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🔧", "mechanic_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🔬", "scientist_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🚀", "astronaut_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🚒", "firefighter_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🤝\u200d🧑🏻", "people_holding_hands_medium_skin_tone_light_skin_tone", CLDR},
+	{"🧑🏽\u200d🤝\u200d🧑🏼", "people_holding_hands_medium_skin_tone_medium_light_skin_tone", CLDR},
+	{"🧑🏽\u200d🤝\u200d🧑🏽", "people_holding_hands_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🤝\u200d🧑🏾", "people_holding_hands_medium_skin_tone_medium_dark_skin_tone", CLDR},
+	{"🧑🏽\u200d🤝\u200d🧑🏿", "people_holding_hands_medium_skin_tone_dark_skin_tone", CLDR},
+	{"🧑🏽\u200d🦯", "person_with_white_cane_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🦰", "person_medium_skin_tone_red_hair", CLDR},
+	{"🧑🏽\u200d🦱", "person_medium_skin_tone_curly_hair", CLDR},
+	{"🧑🏽\u200d🦲", "person_medium_skin_tone_bald", CLDR},
+	{"🧑🏽\u200d🦳", "person_medium_skin_tone_white_hair", CLDR},
+	{"🧑🏽\u200d🦼", "person_in_motorized_wheelchair_medium_skin_tone", CLDR},
+	{"🧑🏽\u200d🦽", "person_in_manual_wheelchair_medium_skin_tone", CLDR},
+	{"🧑🏾", "person_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d⚕️", "health_worker_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d⚖️", "judge_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d✈️", "pilot_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🌾", "farmer_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🍳", "cook_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🍼", "person_feeding_baby_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🎄", "mx_claus_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🎓", "student_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🎤", "singer_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🎨", "artist_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🏫", "teacher_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🏭", "factory_worker_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d💻", "technologist_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d💼", "office_worker_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🔧", "mechanic_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🔬", "scientist_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🚀", "astronaut_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🚒", "firefighter_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🤝\u200d🧑🏻", "people_holding_hands_medium_dark_skin_tone_light_skin_tone", CLDR},
+	{"🧑🏾\u200d🤝\u200d🧑🏼", "people_holding_hands_medium_dark_skin_tone_medium_light_skin_tone", CLDR},
+	{"🧑🏾\u200d🤝\u200d🧑🏽", "people_holding_hands_medium_dark_skin_tone_medium_skin_tone", CLDR},
+	{"🧑🏾\u200d🤝\u200d🧑🏾", "people_holding_hands_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🤝\u200d🧑🏿", "people_holding_hands_medium_dark_skin_tone_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🦯", "person_with_white_cane_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🦰", "person_medium_dark_skin_tone_red_hair", CLDR},
+	{"🧑🏾\u200d🦱", "person_medium_dark_skin_tone_curly_hair", CLDR},
+	{"🧑🏾\u200d🦲", "person_medium_dark_skin_tone_bald", CLDR},
+	{"🧑🏾\u200d🦳", "person_medium_dark_skin_tone_white_hair", CLDR},
+	{"🧑🏾\u200d🦼", "person_in_motorized_wheelchair_medium_dark_skin_tone", CLDR},
+	{"🧑🏾\u200d🦽", "person_in_manual_wheelchair_medium_dark_skin_tone", CLDR},
+	{"🧑🏿", "person_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d⚕️", "health_worker_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d⚖️", "judge_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d✈️", "pilot_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🌾", "farmer_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🍳", "cook_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🍼", "person_feeding_baby_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🎄", "mx_claus_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🎓", "student_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🎤", "singer_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🎨", "artist_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🏫", "teacher_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🏭", "factory_worker_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d💻", "technologist_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d💼", "office_worker_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🔧", "mechanic_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🔬", "scientist_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🚀", "astronaut_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🚒", "firefighter_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🤝\u200d🧑🏻", "people_holding_hands_dark_skin_tone_light_skin_tone", CLDR},
+	{"🧑🏿\u200d🤝\u200d🧑🏼", "people_holding_hands_dark_skin_tone_medium_light_skin_tone", CLDR},
+	{"🧑🏿\u200d🤝\u200d🧑🏽", "people_holding_hands_dark_skin_tone_medium_skin_tone", CLDR},
+	{"🧑🏿\u200d🤝\u200d🧑🏾", "people_holding_hands_dark_skin_tone_medium_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🤝\u200d🧑🏿", "people_holding_hands_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🦯", "person_with_white_cane_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🦰", "person_dark_skin_tone_red_hair", CLDR},
+	{"🧑🏿\u200d🦱", "person_dark_skin_tone_curly_hair", CLDR},
+	{"🧑🏿\u200d🦲", "person_dark_skin_tone_bald", CLDR},
+	{"🧑🏿\u200d🦳", "person_dark_skin_tone_white_hair", CLDR},
+	{"🧑🏿\u200d🦼", "person_in_motorized_wheelchair_dark_skin_tone", CLDR},
+	{"🧑🏿\u200d🦽", "person_in_manual_wheelchair_dark_skin_tone", CLDR},
+	{"🧒", "child", CLDR},
+	{"🧒", "child", GitHub},
+	{"🧒🏻", "child_light_skin_tone", CLDR},
+	{"🧒🏼", "child_medium_light_skin_tone", CLDR},
+	{"🧒🏽", "child_medium_skin_tone", CLDR},
+	{"🧒🏾", "child_medium_dark_skin_tone", CLDR},
+	{"🧒🏿", "child_dark_skin_tone", CLDR},
+	{"🧓", "older_person", CLDR},
+	{"🧓", "older_adult", GitHub},
+	{"🧓🏻", "older_person_light_skin_tone", CLDR},
+	{"🧓🏼", "older_person_medium_light_skin_tone", CLDR},
+	{"🧓🏽", "older_person_medium_skin_tone", CLDR},
+	{"🧓🏾", "older_person_medium_dark_skin_tone", CLDR},
+	{"🧓🏿", "older_person_dark_skin_tone", CLDR},
+	{"🧔", "person_beard", CLDR},
+	{"🧔", "bearded_person", GitHub},
+	{"🧔🏻", "person_light_skin_tone_beard", CLDR},
+	{"🧔🏼", "person_medium_light_skin_tone_beard", CLDR},
+	{"🧔🏽", "person_medium_skin_tone_beard", CLDR},
+	{"🧔🏾", "person_medium_dark_skin_tone_beard", CLDR},
+	{"🧔🏿", "person_dark_skin_tone_beard", CLDR},
+	{"🧕", "woman_with_headscarf", CLDR},
+	{"🧕", "woman_with_headscarf", GitHub},
+	{"🧕🏻", "woman_with_headscarf_light_skin_tone", CLDR},
+	{"🧕🏼", "woman_with_headscarf_medium_light_skin_tone", CLDR},
+	{"🧕🏽", "woman_with_headscarf_medium_skin_tone", CLDR},
+	{"🧕🏾", "woman_with_headscarf_medium_dark_skin_tone", CLDR},
+	{"🧕🏿", "woman_with_headscarf_dark_skin_tone", CLDR},
+	{"🧖", "person_in_steamy_room", CLDR},
+	{"🧖", "sauna_person", GitHub},
+	{"🧖\u200d♀️", "woman_in_steamy_room", CLDR},
+	{"🧖\u200d♀️", "sauna_woman", GitHub},
+	{"🧖\u200d♂️", "man_in_steamy_room", CLDR},
+	{"🧖\u200d♂️", "sauna_man", GitHub},
+	{"🧖🏻", "person_in_steamy_room_light_skin_tone", CLDR},
+	{"🧖🏻\u200d♀️", "woman_in_steamy_room_light_skin_tone", CLDR},
+	{"🧖🏻\u200d♂️", "man_in_steamy_room_light_skin_tone", CLDR},
+	{"🧖🏼", "person_in_steamy_room_medium_light_skin_tone", CLDR},
+	{"🧖🏼\u200d♀️", "woman_in_steamy_room_medium_light_skin_tone", CLDR},
+	{"🧖🏼\u200d♂️", "man_in_steamy_room_medium_light_skin_tone", CLDR},
+	{"🧖🏽", "person_in_steamy_room_medium_skin_tone", CLDR},
+	{"🧖🏽\u200d♀️", "woman_in_steamy_room_medium_skin_tone", CLDR},
+	{"🧖🏽\u200d♂️", "man_in_steamy_room_medium_skin_tone", CLDR},
+	{"🧖🏾", "person_in_steamy_room_medium_dark_skin_tone", CLDR},
+	{"🧖🏾\u200d♀️", "woman_in_steamy_room_medium_dark_skin_tone", CLDR},
+	{"🧖🏾\u200d♂️", "man_in_steamy_room_medium_dark_skin_tone", CLDR},
+	{"🧖🏿", "person_in_steamy_room_dark_skin_tone", CLDR},
+	{"🧖🏿\u200d♀️", "woman_in_steamy_room_dark_skin_tone", CLDR},
+	{"🧖🏿\u200d♂️", "man_in_steamy_room_dark_skin_tone", CLDR},
+	{"🧗", "person_climbing", CLDR},
+	{"🧗", "climbing", GitHub},
+	{"🧗\u200d♀️", "woman_climbing", CLDR},
+	{"🧗\u200d♀️", "climbing_woman", GitHub},
+	{"🧗\u200d♂️", "man_climbing", CLDR},
+	{"🧗\u200d♂️", "climbing_man", GitHub},
+	{"🧗🏻", "person_climbing_light_skin_tone", CLDR},
+	{"🧗🏻\u200d♀️", "woman_climbing_light_skin_tone", CLDR},
+	{"🧗🏻\u200d♂️", "man_climbing_light_skin_tone", CLDR},
+	{"🧗🏼", "person_climbing_medium_light_skin_tone", CLDR},
+	{"🧗🏼\u200d♀️", "woman_climbing_medium_light_skin_tone", CLDR},
+	{"🧗🏼\u200d♂️", "man_climbing_medium_light_skin_tone", CLDR},
+	{"🧗🏽", "person_climbing_medium_skin_tone", CLDR},
+	{"🧗🏽\u200d♀️", "woman_climbing_medium_skin_tone", CLDR},
+	{"🧗🏽\u200d♂️", "man_climbing_medium_skin_tone", CLDR},
+	{"🧗🏾", "person_climbing_medium_dark_skin_tone", CLDR},
+	{"🧗🏾\u200d♀️", "woman_climbing_medium_dark_skin_tone", CLDR},
+	{"🧗🏾\u200d♂️", "man_climbing_medium_dark_skin_tone", CLDR},
+	{"🧗🏿", "person_climbing_dark_skin_tone", CLDR},
+	{"🧗🏿\u200d♀️", "woman_climbing_dark_skin_tone", CLDR},
+	{"🧗🏿\u200d♂️", "man_climbing_dark_skin_tone", CLDR},
+	{"🧘", "person_in_lotus_position", CLDR},
+	{"🧘", "lotus_position", GitHub},
+	{"🧘\u200d♀️", "woman_in_lotus_position", CLDR},
+	{"🧘\u200d♀️", "lotus_position_woman", GitHub},
+	{"🧘\u200d♂️", "man_in_lotus_position", CLDR},
+	{"🧘\u200d♂️", "lotus_position_man", GitHub},
+	{"🧘🏻", "person_in_lotus_position_light_skin_tone", CLDR},
+	{"🧘🏻\u200d♀️", "woman_in_lotus_position_light_skin_tone", CLDR},
+	{"🧘🏻\u200d♂️", "man_in_lotus_position_light_skin_tone", CLDR},
+	{"🧘🏼", "person_in_lotus_position_medium_light_skin_tone", CLDR},
+	{"🧘🏼\u200d♀️", "woman_in_lotus_position_medium_light_skin_tone", CLDR},
+	{"🧘🏼\u200d♂️", "man_in_lotus_position_medium_light_skin_tone", CLDR},
+	{"🧘🏽", "person_in_lotus_position_medium_skin_tone", CLDR},
+	{"🧘🏽\u200d♀️", "woman_in_lotus_position_medium_skin_tone", CLDR},
+	{"🧘🏽\u200d♂️", "man_in_lotus_position_medium_skin_tone", CLDR},
+	{"🧘🏾", "person_in_lotus_position_medium_dark_skin_tone", CLDR},
+	{"🧘🏾\u200d♀️", "woman_in_lotus_position_medium_dark_skin_tone", CLDR},
+	{"🧘🏾\u200d♂️", "man_in_lotus_position_medium_dark_skin_tone", CLDR},
+	{"🧘🏿", "person_in_lotus_position_dark_skin_tone", CLDR},
+	{"🧘🏿\u200d♀️", "woman_in_lotus_position_dark_skin_tone", CLDR},
+	{"🧘🏿\u200d♂️", "man_in_lotus_position_dark_skin_tone", CLDR},
+	{"🧙", "mage", CLDR},
+	{"🧙", "mage", GitHub},
+	{"🧙\u200d♀️", "woman_mage", CLDR},
+	{"🧙\u200d♀️", "mage_woman", GitHub},
+	{"🧙\u200d♂️", "man_mage", CLDR},
+	{"🧙\u200d♂️", "mage_man", GitHub},
+	{"🧙🏻", "mage_light_skin_tone", CLDR},
+	{"🧙🏻\u200d♀️", "woman_mage_light_skin_tone", CLDR},
+	{"🧙🏻\u200d♂️", "man_mage_light_skin_tone", CLDR},
+	{"🧙🏼", "mage_medium_light_skin_tone", CLDR},
+	{"🧙🏼\u200d♀️", "woman_mage_medium_light_skin_tone", CLDR},
+	{"🧙🏼\u200d♂️", "man_mage_medium_light_skin_tone", CLDR},
+	{"🧙🏽", "mage_medium_skin_tone", CLDR},
+	{"🧙🏽\u200d♀️", "woman_mage_medium_skin_tone", CLDR},
+	{"🧙🏽\u200d♂️", "man_mage_medium_skin_tone", CLDR},
+	{"🧙🏾", "mage_medium_dark_skin_tone", CLDR},
+	{"🧙🏾\u200d♀️", "woman_mage_medium_dark_skin_tone", CLDR},
+	{"🧙🏾\u200d♂️", "man_mage_medium_dark_skin_tone", CLDR},
+	{"🧙🏿", "mage_dark_skin_tone", CLDR},
+	{"🧙🏿\u200d♀️", "woman_mage_dark_skin_tone", CLDR},
+	{"🧙🏿\u200d♂️", "man_mage_dark_skin_tone", CLDR},
+	{"🧚", "fairy", CLDR},
+	{"🧚", "fairy", GitHub},
+	{"🧚\u200d♀️", "woman_fairy", CLDR},
+	{"🧚\u200d♀️", "fairy_woman", GitHub},
+	{"🧚\u200d♂️", "man_fairy", CLDR},
+	{"🧚\u200d♂️", "fairy_man", GitHub},
+	{"🧚🏻", "fairy_light_skin_tone", CLDR},
+	{"🧚🏻\u200d♀️", "woman_fairy_light_skin_tone", CLDR},
+	{"🧚🏻\u200d♂️", "man_fairy_light_skin_tone", CLDR},
+	{"🧚🏼", "fairy_medium_light_skin_tone", CLDR},
+	{"🧚🏼\u200d♀️", "woman_fairy_medium_light_skin_tone", CLDR},
+	{"🧚🏼\u200d♂️", "man_fairy_medium_light_skin_tone", CLDR},
+	{"🧚🏽", "fairy_medium_skin_tone", CLDR},
+	{"🧚🏽\u200d♀️", "woman_fairy_medium_skin_tone", CLDR},
+	{"🧚🏽\u200d♂️", "man_fairy_medium_skin_tone", CLDR},
+	{"🧚🏾", "fairy_medium_dark_skin_tone", CLDR},
+	{"🧚🏾\u200d♀️", "woman_fairy_medium_dark_skin_tone", CLDR},
+	{"🧚🏾\u200d♂️", "man_fairy_medium_dark_skin_tone", CLDR},
+	{"🧚🏿", "fairy_dark_skin_tone", CLDR},
+	{"🧚🏿\u200d♀️", "woman_fairy_dark_skin_tone", CLDR},
+	{"🧚🏿\u200d♂️", "man_fairy_dark_skin_tone", CLDR},
+	{"🧛", "vampire", CLDR},
+	{"🧛", "vampire", GitHub},
+	{"🧛\u200d♀️", "woman_vampire", CLDR},
+	{"🧛\u200d♀️", "vampire_woman", GitHub},
+	{"🧛\u200d♂️", "man_vampire", CLDR},
+	{"🧛\u200d♂️", "vampire_man", GitHub},
+	{"🧛🏻", "vampire_light_skin_tone", CLDR},
+	{"🧛🏻\u200d♀️", "woman_vampire_light_skin_tone", CLDR},
+	{"🧛🏻\u200d♂️", "man_vampire_light_skin_tone", CLDR},
+	{"🧛🏼", "vampire_medium_light_skin_tone", CLDR},
+	{"🧛🏼\u200d♀️", "woman_vampire_medium_light_skin_tone", CLDR},
+	{"🧛🏼\u200d♂️", "man_vampire_medium_light_skin_tone", CLDR},
+	{"🧛🏽", "vampire_medium_skin_tone", CLDR},
+	{"🧛🏽\u200d♀️", "woman_vampire_medium_skin_tone", CLDR},
+	{"🧛🏽\u200d♂️", "man_vampire_medium_skin_tone", CLDR},
+	{"🧛🏾", "vampire_medium_dark_skin_tone", CLDR},
+	{"🧛🏾\u200d♀️", "woman_vampire_medium_dark_skin_tone", CLDR},
+	{"🧛🏾\u200d♂️", "man_vampire_medium_dark_skin_tone", CLDR},
+	{"🧛🏿", "vampire_dark_skin_tone", CLDR},
+	{"🧛🏿\u200d♀️", "woman_vampire_dark_skin_tone", CLDR},
+	{"🧛🏿\u200d♂️", "man_vampire_dark_skin_tone", CLDR},
+	{"🧜", "merperson", CLDR},
+	{"🧜", "merperson", GitHub},
+	{"🧜\u200d♀️", "mermaid", CLDR},
+	{"🧜\u200d♀️", "mermaid", GitHub},
+	{"🧜\u200d♂️", "merman", CLDR},
+	{"🧜\u200d♂️", "merman", GitHub},
+	{"🧜🏻", "merperson_light_skin_tone", CLDR},
+	{"🧜🏻\u200d♀️", "mermaid_light_skin_tone", CLDR},
+	{"🧜🏻\u200d♂️", "merman_light_skin_tone", CLDR},
+	{"🧜🏼", "merperson_medium_light_skin_tone", CLDR},
+	{"🧜🏼\u200d♀️", "mermaid_medium_light_skin_tone", CLDR},
+	{"🧜🏼\u200d♂️", "merman_medium_light_skin_tone", CLDR},
+	{"🧜🏽", "merperson_medium_skin_tone", CLDR},
+	{"🧜🏽\u200d♀️", "mermaid_medium_skin_tone", CLDR},
+	{"🧜🏽\u200d♂️", "merman_medium_skin_tone", CLDR},
+	{"🧜🏾", "merperson_medium_dark_skin_tone", CLDR},
+	{"🧜🏾\u200d♀️", "mermaid_medium_dark_skin_tone", CLDR},
+	{"🧜🏾\u200d♂️", "merman_medium_dark_skin_tone", CLDR},
+	{"🧜🏿", "merperson_dark_skin_tone", CLDR},
+	{"🧜🏿\u200d♀️", "mermaid_dark_skin_tone", CLDR},
+	{"🧜🏿\u200d♂️", "merman_dark_skin_tone", CLDR},
+	{"🧝", "elf", CLDR},
+	{"🧝", "elf", GitHub},
+	{"🧝\u200d♀️", "woman_elf", CLDR},
+	{"🧝\u200d♀️", "elf_woman", GitHub},
+	{"🧝\u200d♂️", "man_elf", CLDR},
+	{"🧝\u200d♂️", "elf_man", GitHub},
+	{"🧝🏻", "elf_light_skin_tone", CLDR},
+	{"🧝🏻\u200d♀️", "woman_elf_light_skin_tone", CLDR},
+	{"🧝🏻\u200d♂️", "man_elf_light_skin_tone", CLDR},
+	{"🧝🏼", "elf_medium_light_skin_tone", CLDR},
+	{"🧝🏼\u200d♀️", "woman_elf_medium_light_skin_tone", CLDR},
+	{"🧝🏼\u200d♂️", "man_elf_medium_light_skin_tone", CLDR},
+	{"🧝🏽", "elf_medium_skin_tone", CLDR},
+	{"🧝🏽\u200d♀️", "woman_elf_medium_skin_tone", CLDR},
+	{"🧝🏽\u200d♂️", "man_elf_medium_skin_tone", CLDR},
+	{"🧝🏾", "elf_medium_dark_skin_tone", CLDR},
+	{"🧝🏾\u200d♀️", "woman_elf_medium_dark_skin_tone", CLDR},
+	{"🧝🏾\u200d♂️", "man_elf_medium_dark_skin_tone", CLDR},
+	{"🧝🏿", "elf_dark_skin_tone", CLDR},
+	{"🧝🏿\u200d♀️", "woman_elf_dark_skin_tone", CLDR},
+	{"🧝🏿\u200d♂️", "man_elf_dark_skin_tone", CLDR},
+	{"🧞", "genie", CLDR},
+	{"🧞", "genie", GitHub},
+	{"🧞\u200d♀️", "woman_genie", CLDR},
+	{"🧞\u200d♀️", "genie_woman", GitHub},
+	{"🧞\u200d♂️", "man_genie", CLDR},
+	{"🧞\u200d♂️", "genie_man", GitHub},
+	{"🧟", "zombie", CLDR},
+	{"🧟", "zombie", GitHub},
+	{"🧟\u200d♀️", "woman_zombie", CLDR},
+	{"🧟\u200d♀️", "zombie_woman", GitHub},
+	{"🧟\u200d♂️", "man_zombie", CLDR},
+	{"🧟\u200d♂️", "zombie_man", GitHub},
+	{"🧠", "brain", CLDR},
+	{"🧠", "brain", GitHub},
+	{"🧡", "orange_heart", CLDR},
+	{"🧡", "orange_heart", GitHub},
+	{"🧢", "billed_cap", CLDR},
+	{"🧢", "billed_cap", GitHub},
+	{"🧣", "scarf", CLDR},
+	{"🧣", "scarf", GitHub},
+	{"🧤", "gloves", CLDR},
+	{"🧤", "gloves", GitHub},
+	{"🧥", "coat", CLDR},
+	{"🧥", "coat", GitHub},
+	{"🧦", "socks", CLDR},
+	{"🧦", "socks", GitHub},
+	{"🧧", "red_envelope", CLDR},
+	{"🧧", "red_envelope", GitHub},
+	{"🧨", "firecracker", CLDR},
+	{"🧨", "firecracker", GitHub},
+	{"🧩", "puzzle_piece", CLDR},
+	{"🧩", "jigsaw", GitHub},
+	{"🧪", "test_tube", CLDR},
+	{"🧪", "test_tube", GitHub},
+	{"🧫", "petri_dish", CLDR},
+	{"🧫", "petri_dish", GitHub},
+	{"🧬", "dna", CLDR},
+	{"🧬", "dna", GitHub},
+	{"🧭", "compass", CLDR},
+	{"🧭", "compass", GitHub},
+	{"🧮", "abacus", CLDR},
+	{"🧮", "abacus", GitHub},
+	{"🧯", "fire_extinguisher", CLDR},
+	{"🧯", "fire_extinguisher", GitHub},
+	{"🧰", "toolbox", CLDR},
+	{"🧰", "toolbox", GitHub},
+	{"🧱", "brick", CLDR},
+	{"🧱", "bricks", GitHub},
+	{"🧲", "magnet", CLDR},
+	{"🧲", "magnet", GitHub},
+	{"🧳", "luggage", CLDR},
+	{"🧳", "luggage", GitHub},
+	{"🧴", "lotion_bottle", CLDR},
+	{"🧴", "lotion_bottle", GitHub},
+	{"🧵", "thread", CLDR},
+	{"🧵", "thread", GitHub},
+	{"🧶", "yarn", CLDR},
+	{"🧶", "yarn", GitHub},
+	{"🧷", "safety_pin", CLDR},
+	{"🧷", "safety_pin", GitHub},
+	{"🧸", "teddy_bear", CLDR},
+	{"🧸", "teddy_bear", GitHub},
+	{"🧹", "broom", CLDR},
+	{"🧹", "broom", GitHub},
+	{"🧺", "basket", CLDR},
+	{"🧺", "basket", GitHub},
+	{"🧻", "roll_of_paper", CLDR},
+	{"🧻", "roll_of_paper", GitHub},
+	{"🧼", "soap", CLDR},
+	{"🧼", "soap", GitHub},
+	{"🧽", "sponge", CLDR},
+	{"🧽", "sponge", GitHub},
+	{"🧾", "receipt", CLDR},
+	{"🧾", "receipt", GitHub},
+	{"🧿", "nazar_amulet", CLDR},
+	{"🧿", "nazar_amulet", GitHub},
+	{"🩰", "ballet_shoes", CLDR},
+	{"🩰", "ballet_shoes", GitHub},
+	{"🩱", "one_piece_swimsuit", CLDR},
+	{"🩱", "one_piece_swimsuit", GitHub},
+	{"🩲", "briefs", CLDR},
+	{"🩲", "swim_brief", GitHub},
+	{"🩳", "shorts", CLDR},
+	{"🩳", "shorts", GitHub},
+	{"🩴", "thong_sandal", CLDR},
+	{"🩴", "thong_sandal", GitHub},
+	{"🩸", "drop_of_blood", CLDR},
+	{"🩸", "drop_of_blood", GitHub},
+	{"🩹", "adhesive_bandage", CLDR},
+	{"🩹", "adhesive_bandage", GitHub},
+	{"🩺", "stethoscope", CLDR},
+	{"🩺", "stethoscope", GitHub},
+	{"🪀", "yo_yo", CLDR},
+	{"🪀", "yo_yo", GitHub},
+	{"🪁", "kite", CLDR},
+	{"🪁", "kite", GitHub},
+	{"🪂", "parachute", CLDR},
+	{"🪂", "parachute", GitHub},
+	{"🪃", "boomerang", CLDR},
+	{"🪃", "boomerang", GitHub},
+	{"🪄", "magic_wand", CLDR},
+	{"🪄", "magic_wand", GitHub},
+	{"🪅", "pinata", CLDR},
+	{"🪅", "pinata", GitHub},
+	{"🪆", "nesting_dolls", CLDR},
+	{"🪆", "nesting_dolls", GitHub},
+	{"🪐", "ringed_planet", CLDR},
+	{"🪐", "ringed_planet", GitHub},
+	{"🪑", "chair", CLDR},
+	{"🪑", "chair", GitHub},
+	{"🪒", "razor", CLDR},
+	{"🪒", "razor", GitHub},
+	{"🪓", "axe", CLDR},
+	{"🪓", "axe", GitHub},
+	{"🪔", "diya_lamp", CLDR},
+	{"🪔", "diya_lamp", GitHub},
+	{"🪕", "banjo", CLDR},
+	{"🪕", "banjo", GitHub},
+	{"🪖", "military_helmet", CLDR},
+	{"🪖", "military_helmet", GitHub},
+	{"🪗", "accordion", CLDR},
+	{"🪗", "accordion", GitHub},
+	{"🪘", "long_drum", CLDR},
+	{"🪘", "long_drum", GitHub},
+	{"🪙", "coin", CLDR},
+	{"🪙", "coin", GitHub},
+	{"🪚", "carpentry_saw", CLDR},
+	{"🪚", "carpentry_saw", GitHub},
+	{"🪛", "screwdriver", CLDR},
+	{"🪛", "screwdriver", GitHub},
+	{"🪜", "ladder", CLDR},
+	{"🪜", "ladder", GitHub},
+	{"🪝", "hook", CLDR},
+	{"🪝", "hook", GitHub},
+	{"🪞", "mirror", CLDR},
+	{"🪞", "mirror", GitHub},
+	{"🪟", "window", CLDR},
+	{"🪟", "window", GitHub},
+	{"🪠", "plunger", CLDR},
+	{"🪠", "plunger", GitHub},
+	{"🪡", "sewing_needle", CLDR},
+	{"🪡", "sewing_needle", GitHub},
+	{"🪢", "knot", CLDR},
+	{"🪢", "knot", GitHub},
+	{"🪣", "bucket", CLDR},
+	{"🪣", "bucket", GitHub},
+	{"🪤", "mouse_trap", CLDR},
+	{"🪤", "mouse_trap", GitHub},
+	{"🪥", "toothbrush", CLDR},
+	{"🪥", "toothbrush", GitHub},
+	{"🪦", "headstone", CLDR},
+	{"🪦", "headstone", GitHub},
+	{"🪧", "placard", CLDR},
+	{"🪧", "placard", GitHub},
+	{"🪨", "rock", CLDR},
+	{"🪨", "rock", GitHub},
+	{"🪰", "fly", CLDR},
+	{"🪰", "fly", GitHub},
+	{"🪱", "worm", CLDR},
+	{"🪱", "worm", GitHub},
+	{"🪲", "beetle", CLDR},
+	{"🪲", "beetle", GitHub},
+	{"🪳", "cockroach", CLDR},
+	{"🪳", "cockroach", GitHub},
+	{"🪴", "potted_plant", CLDR},
+	{"🪴", "potted_plant", GitHub},
+	{"🪵", "wood", CLDR},
+	{"🪵", "wood", GitHub},
+	{"🪶", "feather", CLDR},
+	{"🪶", "feather", GitHub},
+	{"🫀", "anatomical_heart", CLDR},
+	{"🫀", "anatomical_heart", GitHub},
+	{"🫁", "lungs", CLDR},
+	{"🫁", "lungs", GitHub},
+	{"🫂", "people_hugging", CLDR},
+	{"🫂", "people_hugging", GitHub},
+	{"🫐", "blueberries", CLDR},
+	{"🫐", "blueberries", GitHub},
+	{"🫑", "bell_pepper", CLDR},
+	{"🫑", "bell_pepper", GitHub},
+	{"🫒", "olive", CLDR},
+	{"🫒", "olive", GitHub},
+	{"🫓", "flatbread", CLDR},
+	{"🫓", "flatbread", GitHub},
+	{"🫔", "tamale", CLDR},
+	{"🫔", "tamale", GitHub},
+	{"🫕", "fondue", CLDR},
+	{"🫕", "fondue", GitHub},
+	{"🫖", "teapot", CLDR},
+	{"🫖", "teapot", GitHub},
+}
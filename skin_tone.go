@@ -0,0 +1,153 @@
+package emoji
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Tone is a skin tone emoji modifier
+type Tone rune
+
+// Skin tones as defined in https://www.unicode.org/reports/tr51/#Diversity
+const (
+	NoSkinTone          Tone = 0
+	LightSkinTone       Tone = 0x1F3FB
+	MediumLightSkinTone Tone = 0x1F3FC
+	MediumSkinTone      Tone = 0x1F3FD
+	MediumDarkSkinTone  Tone = 0x1F3FE
+	DarkSkinTone        Tone = 0x1F3FF
+)
+
+func (t Tone) String() string {
+	switch t {
+	case NoSkinTone:
+		return "no skin tone"
+	case LightSkinTone:
+		return "light skin tone"
+	case MediumLightSkinTone:
+		return "medium-light skin tone"
+	case MediumSkinTone:
+		return "medium skin tone"
+	case MediumDarkSkinTone:
+		return "medium-dark skin tone"
+	case DarkSkinTone:
+		return "dark skin tone"
+	}
+	return "unknown skin tone"
+}
+
+// toned maps an emoji without skin tone followed by a Tone
+// to the RGI sequence where every person has this tone
+var toned map[string]string
+var tonedOnce sync.Once
+
+func initToned() {
+	toned = make(map[string]string)
+	for _, s := range rgiSequences {
+		tones := skinTones(s, nil)
+		if len(tones) == 0 {
+			continue
+		}
+		same := true
+		for _, t := range tones {
+			same = same && t == tones[0]
+		}
+		if same {
+			toned[stripSkinTone(s)+string(tones[0])] = s
+		}
+	}
+}
+
+// SkinTones returns the skin tone of every person in the emoji of s, in order
+// a modifier only counts if it follows an EmojiModifierBase
+// so 🧑🏻‍🤝‍🧑🏿 returns [LightSkinTone DarkSkinTone]
+func SkinTones(s string) []Tone {
+	var tones []Tone
+	for _, g := range FindString(s, -1) {
+		tones = skinTones(g, tones)
+	}
+	return tones
+}
+
+// StripSkinTone removes the skin tone from every emoji in s
+// if the base character does not have emoji presentation by default
+// the modifier is replaced by \x{FE0F} so ☝🏻 becomes ☝️
+func StripSkinTone(s string) string {
+	return ReplaceString(s, -1, stripSkinTone)
+}
+
+// WithSkinTone sets the skin tone of every person in the emoji of s to t
+// emoji are only changed if the result is RGI, so 🧑🏻‍🤝‍🧑🏿 becomes 🧑🏽‍🤝‍🧑🏽 for MediumSkinTone
+// but 🐶 or the invented 👨‍🦖 are left untouched
+// NoSkinTone removes the skin tones like StripSkinTone
+func WithSkinTone(s string, t Tone) string {
+	if t == NoSkinTone {
+		return StripSkinTone(s)
+	}
+	tonedOnce.Do(initToned)
+	return ReplaceString(s, -1, func(g string) string {
+		if r, ok := toned[Qualify(stripSkinTone(g))+string(t)]; ok {
+			return r
+		}
+		return g
+	})
+}
+
+func skinTones(g string, tones []Tone) []Tone {
+	var prev rune
+	for _, r := range g {
+		if isEmod(r) && unicode.Is(EmojiModifierBase, prev) {
+			tones = append(tones, Tone(r))
+		}
+		prev = r
+	}
+	return tones
+}
+
+// stripSkinTone removes the modifiers of g
+// when that does not give an RGI sequence, such as 👩🏻‍🤝‍👩🏼 whose neutral form is 👭
+// the sequence is found by removing the skin tones from its name
+func stripSkinTone(g string) string {
+	s := removeModifiers(g)
+	if s == g || IsRGIString(s) {
+		return s
+	}
+	e, ok := lookup(g)
+	if !ok {
+		return s
+	}
+	base, attributes := splitName(e.name)
+	var kept []string
+	for _, a := range attributes {
+		if !strings.HasSuffix(a, " skin tone") {
+			kept = append(kept, a)
+		}
+	}
+	if r, ok := lookupName(joinName(base, kept)); ok {
+		return r
+	}
+	return s
+}
+
+func removeModifiers(g string) string {
+	var b strings.Builder
+	var prev rune
+	for i, r := range g {
+		if isEmod(r) && unicode.Is(EmojiModifierBase, prev) {
+			if b.Len() == 0 {
+				b.WriteString(g[:i])
+			}
+			if !unicode.Is(EmojiPresentation, prev) {
+				b.WriteRune(emojiVS)
+			}
+		} else if b.Len() > 0 {
+			b.WriteRune(r)
+		}
+		prev = r
+	}
+	if b.Len() == 0 {
+		return g
+	}
+	return b.String()
+}
@@ -0,0 +1,97 @@
+package emoji
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_SkinTones(t *testing.T) {
+	tests := []struct {
+		s     string
+		tones []Tone
+	}{
+		{"👍", nil},
+		{"👍🏽", []Tone{MediumSkinTone}},
+		{"a👍🏽b👋🏼", []Tone{MediumSkinTone, MediumLightSkinTone}},
+		{"🧑🏻‍🤝‍🧑🏿", []Tone{LightSkinTone, DarkSkinTone}},
+		{"👩🏾‍👨🏾‍👦🏾", []Tone{MediumDarkSkinTone, MediumDarkSkinTone, MediumDarkSkinTone}},
+		{"🏼", nil},
+		{"🐶🏼", nil},
+	}
+	for _, test := range tests {
+		tones := SkinTones(test.s)
+		if len(tones) != len(test.tones) {
+			t.Errorf("SkinTones(%q) returned %v not %v", test.s, tones, test.tones)
+			continue
+		}
+		for i := range tones {
+			if tones[i] != test.tones[i] {
+				t.Errorf("SkinTones(%q) returned %v not %v", test.s, tones, test.tones)
+				break
+			}
+		}
+	}
+}
+
+func Test_StripSkinTone(t *testing.T) {
+	tests := []struct {
+		s        string
+		stripped string
+	}{
+		{"👍", "👍"},
+		{"👍🏽", "👍"},
+		{"a👍🏽b👋🏼", "a👍b👋"},
+		{"☝🏻", "☝️"},
+		{"🏋🏻‍♀️", "🏋️‍♀️"},
+		{"🧑🏻‍🤝‍🧑🏿", "🧑‍🤝‍🧑"},
+		{"👩🏼‍🦰", "👩‍🦰"},
+		{"👩🏻‍🤝‍👩🏼", "👭"},
+		{"🏼", "🏼"},
+		{"test", "test"},
+	}
+	for _, test := range tests {
+		if s := StripSkinTone(test.s); s != test.stripped {
+			t.Errorf("StripSkinTone(%q) returned %q not %q", test.s, s, test.stripped)
+		}
+	}
+	for _, s := range rgiSequences {
+		if stripped := StripSkinTone(s); !IsRGIString(stripped) {
+			t.Errorf("StripSkinTone(%q) returned %q which is not rgi", s, stripped)
+		}
+	}
+}
+
+func Test_WithSkinTone(t *testing.T) {
+	tests := []struct {
+		s    string
+		tone Tone
+		with string
+	}{
+		{"👍", MediumSkinTone, "👍🏽"},
+		{"👍🏻", MediumSkinTone, "👍🏽"},
+		{"☝️", LightSkinTone, "☝🏻"},
+		{"☝", LightSkinTone, "☝🏻"},
+		{"a👍b👋🏼", DarkSkinTone, "a👍🏿b👋🏿"},
+		{"🏋️‍♀️", MediumDarkSkinTone, "🏋🏾‍♀️"},
+		{"🧑🏻‍🤝‍🧑🏿", MediumSkinTone, "🧑🏽‍🤝‍🧑🏽"},
+		{"🧑‍🤝‍🧑", LightSkinTone, "🧑🏻‍🤝‍🧑🏻"},
+		{"👩🏻‍🤝‍👩🏼", MediumSkinTone, "👭🏽"},
+		{"🧑🏻‍🤝‍🧑🏿", NoSkinTone, "🧑‍🤝‍🧑"},
+		{"🐶", LightSkinTone, "🐶"},
+		{"👨‍🦖", LightSkinTone, "👨‍🦖"},
+		{"test", LightSkinTone, "test"},
+	}
+	for _, test := range tests {
+		if s := WithSkinTone(test.s, test.tone); s != test.with {
+			t.Errorf("WithSkinTone(%q, %s) returned %q not %q", test.s, test.tone, s, test.with)
+		}
+	}
+	for _, s := range rgiGlyphs() {
+		if !strings.ContainsRune(s, rune(LightSkinTone)) {
+			continue
+		}
+		if with := WithSkinTone(StripSkinTone(s), DarkSkinTone); !IsRGIString(with) || len(SkinTones(with)) == 0 {
+			t.Errorf("WithSkinTone(%q, DarkSkinTone) returned %q", StripSkinTone(s), with)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package emoji
+
+// GlyphCount returns the number of glyphs of s
+// each emoji sequence counts as one glyph, as does every grapheme cluster of the rest of the text
+// such as GlyphCount("👩‍👩‍👧 é") == 3
+func GlyphCount(s string) int {
+	var count int
+	for len(s) > 0 {
+		s = s[nextGlyph(s):]
+		count++
+	}
+	return count
+}
+
+// TruncateGlyphs returns the n first glyphs of s as counted by GlyphCount
+// so an emoji sequence such as 👩‍👩‍👧 is never split
+func TruncateGlyphs(s string, n int) string {
+	return SubstringGlyphs(s, 0, n)
+}
+
+// SubstringGlyphs returns the glyphs of s from start included to end excluded as counted by GlyphCount
+// out of range indexes are clamped, so it returns "" if start >= end
+func SubstringGlyphs(s string, start, end int) string {
+	var from, i, count int
+	for i < len(s) && count < end {
+		if count == start {
+			from = i
+		}
+		i += nextGlyph(s[i:])
+		count++
+	}
+	if count <= start {
+		return ""
+	}
+	return s[from:i]
+}
+
+// nextGlyph returns the length in bytes of the first emoji of s
+// or of its first grapheme cluster if s does not start with an emoji
+func nextGlyph(s string) int {
+	if _, ok, n := DecodeString(s); ok {
+		return n
+	}
+	_, n := DecodeGraphemeString(s)
+	return n
+}
@@ -0,0 +1,67 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func Test_GlyphCount(t *testing.T) {
+	for _, test := range []struct {
+		s     string
+		count int
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"👩‍👩‍👧 é", 3},
+		{"é", 1},
+		{"🇯🇵🇫🇷", 2},
+		{"👍🏽👍", 2},
+		{"🏴󠁧󠁢󠁳󠁣󠁴󠁿1️⃣", 2},
+		{"👩‍a", 2},
+	} {
+		if count := GlyphCount(test.s); count != test.count {
+			t.Errorf("%q has %d glyphs instead of %d", test.s, count, test.count)
+		}
+	}
+}
+
+func Test_TruncateGlyphs(t *testing.T) {
+	for _, test := range []struct {
+		s        string
+		n        int
+		expected string
+	}{
+		{"hello", 3, "hel"},
+		{"hello", 10, "hello"},
+		{"hello", 0, ""},
+		{"hello", -1, ""},
+		{"👩‍👩‍👧👩‍👩‍👧", 1, "👩‍👩‍👧"},
+		{"hi 👍🏽 there", 4, "hi 👍🏽"},
+		{"🇯🇵🇫🇷", 1, "🇯🇵"},
+		{"cafe\u0301!", 4, "cafe\u0301"},
+	} {
+		if res := TruncateGlyphs(test.s, test.n); res != test.expected {
+			t.Errorf("%q truncated to %d glyphs is %q instead of %q", test.s, test.n, res, test.expected)
+		}
+	}
+}
+
+func Test_SubstringGlyphs(t *testing.T) {
+	for _, test := range []struct {
+		s          string
+		start, end int
+		expected   string
+	}{
+		{"hello", 1, 3, "el"},
+		{"hello", 3, 3, ""},
+		{"hello", 4, 2, ""},
+		{"hello", 3, 10, "lo"},
+		{"hello", 10, 12, ""},
+		{"hello", -2, 2, "he"},
+		{"a👩‍👩‍👧b🇯🇵c", 1, 4, "👩‍👩‍👧b🇯🇵"},
+		{"a👩‍👩‍👧b🇯🇵c", 4, 5, "c"},
+	} {
+		if res := SubstringGlyphs(test.s, test.start, test.end); res != test.expected {
+			t.Errorf("glyphs %d to %d of %q are %q instead of %q", test.start, test.end, test.s, res, test.expected)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+// Tables13_0 holds the tables of emoji version 13.0
+var Tables13_0 = &Tables{
+	Version:              Version{Major: 13, Minor: 0},
+	Emoji:                Emoji,
+	EmojiPresentation:    EmojiPresentation,
+	EmojiModifier:        EmojiModifier,
+	EmojiModifierBase:    EmojiModifierBase,
+	EmojiComponent:       EmojiComponent,
+	ExtendedPictographic: ExtendedPictographic,
+	rgi:                  rgiSequences,
+}
+
+func init() {
+	registerTables(Tables13_0)
+}
@@ -1,14 +1,75 @@
 package emoji
 
-import "unicode"
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
+// Tag is tag_spec from https://www.unicode.org/reports/tr51/#def_emoji_tag_sequence
+// the cancel tag \x{E007F} ending a tag sequence is not included
 var Tag = &unicode.RangeTable{
 	R32: []unicode.Range32{
-		{Lo: uint32(0xE0030), Hi: uint32(0xE007E), Stride: 1},
+		{Lo: uint32(0xE0020), Hi: uint32(0xE007E), Stride: 1},
 	},
 }
 
+// ErrInvalidSubdivision is returned by SubdivisionFlagFromCode for codes without an RGI flag
+var ErrInvalidSubdivision = errors.New("emoji: invalid subdivision code")
+
+const blackFlag = '🏴'
+const tagOffset = rune(0xE0000)
+
 func isTag(r rune) bool {
 	u := uint32(r)
 	return Tag.R32[0].Lo <= u && u <= Tag.R32[0].Hi
 }
+
+// SubdivisionFlag returns the lowercase ISO 3166-2 subdivision code of the flag s
+// without hyphen, such as "gbsct" for 🏴󠁧󠁢󠁳󠁣󠁴󠁿
+// s must be exactly one RGI tag sequence, so 🏴 followed by arbitrary tags is rejected
+func SubdivisionFlag(s string) (string, bool) {
+	r, n := utf8.DecodeRuneInString(s)
+	if r != blackFlag || !strings.HasSuffix(s, string(termTag)) || !IsRGIString(s) {
+		return "", false
+	}
+	var b strings.Builder
+	for _, r := range s[n : len(s)-utf8.RuneLen(termTag)] {
+		b.WriteRune(r - tagOffset)
+	}
+	return b.String(), true
+}
+
+// SubdivisionFlagFromCode returns the flag of the ISO 3166-2 subdivision code
+// such as 🏴󠁧󠁢󠁳󠁣󠁴󠁿 for "gbsct" or "GB-SCT"
+// it returns ErrInvalidSubdivision if there is no RGI flag for this code
+func SubdivisionFlagFromCode(code string) (string, error) {
+	var b strings.Builder
+	b.WriteRune(blackFlag)
+	for _, c := range strings.ToLower(code) {
+		if c == '-' {
+			continue
+		}
+		if !isTag(c + tagOffset) {
+			return "", ErrInvalidSubdivision
+		}
+		b.WriteRune(c + tagOffset)
+	}
+	b.WriteRune(termTag)
+	if !IsRGIString(b.String()) {
+		return "", ErrInvalidSubdivision
+	}
+	return b.String(), nil
+}
+
+// Subdivisions returns the subdivision code of every RGI flag, sorted
+func Subdivisions() []string {
+	var subdivisions []string
+	for _, s := range rgiSequences {
+		if code, ok := SubdivisionFlag(s); ok {
+			subdivisions = append(subdivisions, code)
+		}
+	}
+	return subdivisions
+}
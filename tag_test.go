@@ -6,7 +6,7 @@ import (
 )
 
 func Test_Tag(t *testing.T) {
-	for _, c := range "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz" {
+	for _, c := range " 0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz~" {
 		r := c + 0xE0000
 		if !unicode.Is(Tag, r) {
 			t.Errorf("%q code %q + 0xE0000 is not counted as a tag", r, c)
@@ -21,4 +21,67 @@ func Test_Tag(t *testing.T) {
 			t.Errorf("%q code %X is counted as a regional indicator", c, c)
 		}
 	}
+	if unicode.Is(Tag, termTag) {
+		t.Errorf("cancel tag is counted as a tag")
+	}
+}
+
+func Test_DecodeTagSequence(t *testing.T) {
+	tags := string(rune(0xE0067)) + string(rune(0xE0062)) + string(rune(termTag))
+	for _, s := range []string{"🏴" + tags, "😀" + tags, "☝️" + tags, "👍🏽" + tags} {
+		if !PossibleGlyphString(s) {
+			t.Errorf("%q returned negative", s)
+		}
+		if IsRGIString(s) {
+			t.Errorf("%q is rgi", s)
+		}
+	}
+	for _, s := range []string{"🏴" + tags[:8], "😀" + tags[:4] + "a"} {
+		if PossibleGlyphString(s) {
+			t.Errorf("%q returned positive", s)
+		}
+	}
+}
+
+func Test_SubdivisionFlag(t *testing.T) {
+	tests := []struct {
+		flag string
+		code string
+	}{
+		{"🏴󠁧󠁢󠁥󠁮󠁧󠁿", "gbeng"},
+		{"🏴󠁧󠁢󠁳󠁣󠁴󠁿", "gbsct"},
+		{"🏴󠁧󠁢󠁷󠁬󠁳󠁿", "gbwls"},
+	}
+	for _, test := range tests {
+		code, ok := SubdivisionFlag(test.flag)
+		if !ok || code != test.code {
+			t.Errorf("SubdivisionFlag(%q) returned %q %v not %q", test.flag, code, ok, test.code)
+		}
+		flag, err := SubdivisionFlagFromCode(test.code)
+		if err != nil || flag != test.flag {
+			t.Errorf("SubdivisionFlagFromCode(%q) returned %q %v not %q", test.code, flag, err, test.flag)
+		}
+	}
+
+	for _, s := range []string{"", "🏴", "🏴󠁵󠁳󠁴󠁸󠁿", "😀󠁧󠁢󠁳󠁣󠁴󠁿", "🏴󠁧󠁢󠁳󠁣󠁴󠁿a", "gbsct"} {
+		if code, ok := SubdivisionFlag(s); ok {
+			t.Errorf("SubdivisionFlag(%q) returned positive %q", s, code)
+		}
+	}
+
+	for _, code := range []string{"GB-SCT", "GBSCT", "gb-sct"} {
+		if flag, err := SubdivisionFlagFromCode(code); err != nil || flag != "🏴󠁧󠁢󠁳󠁣󠁴󠁿" {
+			t.Errorf("SubdivisionFlagFromCode(%q) returned %q %v", code, flag, err)
+		}
+	}
+	for _, code := range []string{"", "ustx", "gb", "gbsct!", "gbsçt"} {
+		if flag, err := SubdivisionFlagFromCode(code); err != ErrInvalidSubdivision {
+			t.Errorf("SubdivisionFlagFromCode(%q) returned %q %v", code, flag, err)
+		}
+	}
+
+	subdivisions := Subdivisions()
+	if len(subdivisions) != 3 || subdivisions[0] != "gbeng" || subdivisions[1] != "gbsct" || subdivisions[2] != "gbwls" {
+		t.Errorf("Subdivisions returned %v", subdivisions)
+	}
 }
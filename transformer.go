@@ -0,0 +1,63 @@
+package emoji
+
+import (
+	"golang.org/x/text/transform"
+)
+
+// replacer is a transform.Transformer applying f to every emoji and copying the text between them
+type replacer struct {
+	transform.NopResetter
+	f func([]byte) []byte
+}
+
+// Transform implements transform.Transformer
+// it returns transform.ErrShortSrc when the end of src could be the start of a longer emoji
+func (r replacer) Transform(dst, src []byte, atEOF bool) (int, int, error) {
+	var nDst, nSrc int
+	for nSrc < len(src) {
+		n, g, ok := scanGlyph(src[nSrc:], atEOF)
+		if n == 0 {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		if ok {
+			g = r.f(g)
+		}
+		if len(g) > len(dst)-nDst {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], g)
+		nSrc += n
+	}
+	return nDst, nSrc, nil
+}
+
+// ReplaceTransformer returns a transform.Transformer replacing every emoji g with f(g)
+// f must not keep or modify g
+func ReplaceTransformer(f func(g []byte) []byte) transform.Transformer {
+	return replacer{f: f}
+}
+
+// RemoveTransformer returns a transform.Transformer removing every emoji
+func RemoveTransformer() transform.Transformer {
+	return replacer{f: func([]byte) []byte { return nil }}
+}
+
+// StripSkinToneTransformer returns a transform.Transformer removing the skin tone of every emoji, as StripSkinTone
+func StripSkinToneTransformer() transform.Transformer {
+	return replacer{f: func(g []byte) []byte { return []byte(stripSkinTone(string(g))) }}
+}
+
+// ShortcodeTransformer returns a transform.Transformer replacing every emoji with its shortcode in the dialect d, as ToShortcodes
+func ShortcodeTransformer(d Dialect) transform.Transformer {
+	return replacer{f: func(g []byte) []byte {
+		if code, ok := Shortcode(string(g), d); ok {
+			return []byte(code)
+		}
+		return g
+	}}
+}
+
+// NormalizeTransformer returns a transform.Transformer replacing every emoji with its fully-qualified form, as Normalize
+func NormalizeTransformer() transform.Transformer {
+	return replacer{f: Normalize}
+}
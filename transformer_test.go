@@ -0,0 +1,83 @@
+package emoji
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+var transformerTests = []string{
+	"",
+	"no emoji",
+	"I ❤ 🇯🇵!",
+	"👩‍👩‍👧 family",
+	"👍🏽👍🏽 and 🧑🏻‍🤝‍🧑🏿",
+	"🏴󠁧󠁢󠁳󠁣󠁴󠁿 and 🏴",
+	"123 #️⃣ 1⃣ ☺",
+	"\xff😀\xf0\x9f",
+}
+
+func Test_Transformers(t *testing.T) {
+	transformers := []struct {
+		name        string
+		transformer transform.Transformer
+		expected    func(string) string
+	}{
+		{"Remove", RemoveTransformer(), func(s string) string {
+			return ReplaceString(s, -1, func(string) string { return "" })
+		}},
+		{"Replace", ReplaceTransformer(func(g []byte) []byte { return []byte("[" + string(g) + "]") }), func(s string) string {
+			return ReplaceString(s, -1, func(g string) string { return "[" + g + "]" })
+		}},
+		{"StripSkinTone", StripSkinToneTransformer(), StripSkinTone},
+		{"Shortcode", ShortcodeTransformer(GitHub), func(s string) string { return ToShortcodes(s, GitHub) }},
+		{"Normalize", NormalizeTransformer(), NormalizeString},
+	}
+	for _, tr := range transformers {
+		for _, test := range transformerTests {
+			expected := tr.expected(test)
+			s, _, err := transform.String(tr.transformer, test)
+			if err != nil || s != expected {
+				t.Errorf("%s transform.String(%q) returned %q %v not %q", tr.name, test, s, err, expected)
+			}
+			// one byte at a time so every sequence is split across reads
+			b, err := io.ReadAll(transform.NewReader(iotest.OneByteReader(strings.NewReader(test)), tr.transformer))
+			if err != nil || string(b) != expected {
+				t.Errorf("%s transform.NewReader(%q) returned %q %v not %q", tr.name, test, b, err, expected)
+			}
+		}
+	}
+}
+
+func Test_TransformerShortSrc(t *testing.T) {
+	dst := make([]byte, 64)
+	nDst, nSrc, err := RemoveTransformer().Transform(dst, []byte("ab👩‍"), false)
+	if nDst != 2 || nSrc != 2 || err != transform.ErrShortSrc {
+		t.Errorf("Transform returned %d %d %v", nDst, nSrc, err)
+	}
+	// a trailing zero width joiner is not an emoji
+	nDst, nSrc, err = RemoveTransformer().Transform(dst, []byte("ab👩‍"), true)
+	if nDst != 9 || nSrc != 9 || err != nil {
+		t.Errorf("Transform returned %d %d %v", nDst, nSrc, err)
+	}
+}
+
+func Test_TransformerShortDst(t *testing.T) {
+	s, _, err := transform.String(ShortcodeTransformer(CLDR), strings.Repeat("👩‍👩‍👧", 500))
+	if err != nil || s != strings.Repeat(":family_woman_woman_girl:", 500) {
+		t.Errorf("transform.String returned %v", err)
+	}
+}
+
+func Test_TransformerChain(t *testing.T) {
+	// e followed by a combining acute accent is composed by NFC
+	chain := transform.Chain(norm.NFC, ShortcodeTransformer(GitHub))
+	s, _, err := transform.String(chain, "cafe\u0301 ☕")
+	if err != nil || s != "caf\u00e9 :coffee:" {
+		t.Errorf("transform.Chain returned %q %v", s, err)
+	}
+}
@@ -0,0 +1,156 @@
+package emoji
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// errors wrapped in a SequenceError by Validate
+var (
+	ErrUnpairedRegionalIndicator = errors.New("emoji: regional indicator without its pair")
+	ErrUnterminatedTag           = errors.New("emoji: tag sequence without cancel tag")
+	ErrStrayTag                  = errors.New("emoji: tag without emoji base")
+	ErrDanglingZWJ               = errors.New("emoji: zero width joiner not followed by an emoji")
+	ErrModifierWithoutBase       = errors.New("emoji: skin tone modifier without modifier base")
+	ErrStrayVariationSelector    = errors.New("emoji: variation selector without emoji base")
+	ErrStrayKeycap               = errors.New("emoji: enclosing keycap without keycap base")
+)
+
+// SequenceError is a malformed emoji fragment
+type SequenceError struct {
+	// Offset is the position in bytes of Fragment in the validated string
+	Offset int
+	// Fragment is the faulty code points, such as the lone regional indicator or the unterminated tags
+	Fragment string
+	Err      error
+}
+
+func (e *SequenceError) Error() string {
+	return fmt.Sprintf("%v at byte %d: %+q", e.Err, e.Offset, e.Fragment)
+}
+
+func (e *SequenceError) Unwrap() error {
+	return e.Err
+}
+
+// Validate returns a *SequenceError for the first malformed emoji sequence of s
+// or nil if all emoji components of s are part of a well formed emoji
+//
+// Zero width joiners are only checked next to emoji, so they can still be used in scripts such as Devanagari
+// Validate does not check that the emoji are RGI, use IsRGIString for it
+func Validate(s string) error {
+	offset, n, err := malformed(s)
+	if err == nil {
+		return nil
+	}
+	return &SequenceError{Offset: offset, Fragment: s[offset : offset+n], Err: err}
+}
+
+// malformed returns the offset and length of the first malformed fragment of s and the reason
+func malformed(s string) (int, int, error) {
+	var i int
+	for i < len(s) {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case isRegionalIndicator(r):
+			r2, n2 := utf8.DecodeRuneInString(s[i+n:])
+			if !isRegionalIndicator(r2) {
+				return i, n, ErrUnpairedRegionalIndicator
+			}
+			n += n2
+		case isEmod(r):
+			return i, n, ErrModifierWithoutBase
+		case unicode.Is(Emoji, r):
+			end, bad, err := malformedSequence(s, i)
+			if err != nil {
+				return bad, end - bad, err
+			}
+			n = end - i
+		case r == emojiVS || r == textVS:
+			return i, n, ErrStrayVariationSelector
+		case r == enclosingKeycap:
+			return i, n, ErrStrayKeycap
+		case isTag(r) || r == termTag:
+			end := tagRun(s, i)
+			if r, n := utf8.DecodeRuneInString(s[end:]); r == termTag {
+				end += n
+			}
+			return i, end - i, ErrStrayTag
+		case r == zeroWidthJoiner:
+			// a joiner in text is fine, but not right before an emoji
+			if r2, _ := utf8.DecodeRuneInString(s[i+n:]); unicode.Is(ExtendedPictographic, r2) {
+				return i, n, ErrDanglingZWJ
+			}
+		}
+		i += n
+	}
+	return 0, 0, nil
+}
+
+// malformedSequence checks the zwj sequence starting with an emoji at i
+// it returns the end of the sequence, or the end and start of the fault with its reason
+func malformedSequence(s string, i int) (int, int, error) {
+	for {
+		var err error
+		i, err = element(s, i)
+		if err != nil {
+			end := tagRun(s, i)
+			return end, i, err
+		}
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if r != zeroWidthJoiner {
+			return i, 0, nil
+		}
+		r2, n2 := utf8.DecodeRuneInString(s[i+n:])
+		if !unicode.Is(Emoji, r2) {
+			return i + n, i, ErrDanglingZWJ
+		}
+		if isEmod(r2) {
+			return i + n + n2, i + n, ErrModifierWithoutBase
+		}
+		i += n
+	}
+}
+
+// element returns the end of the zwj element starting at i
+// or the start of its unterminated tags
+func element(s string, i int) (int, error) {
+	r1, n1 := utf8.DecodeRuneInString(s[i:])
+	i += n1
+	r2, n2 := utf8.DecodeRuneInString(s[i:])
+	switch {
+	case r2 == emojiVS:
+		i += n2
+		if r3, n3 := utf8.DecodeRuneInString(s[i:]); r3 == enclosingKeycap && isKeycapBase(r1) {
+			i += n3
+		}
+	case r2 == textVS && unicode.Is(variationBase, r1):
+		i += n2
+	case r2 == enclosingKeycap && isKeycapBase(r1):
+		i += n2
+	case isEmod(r2) && unicode.Is(EmojiModifierBase, r1):
+		i += n2
+	}
+	if r, _ := utf8.DecodeRuneInString(s[i:]); !isTag(r) {
+		return i, nil
+	}
+	end := tagRun(s, i)
+	if r, n := utf8.DecodeRuneInString(s[end:]); r == termTag {
+		return end + n, nil
+	}
+	return i, ErrUnterminatedTag
+}
+
+// tagRun returns the end of the tag characters starting at i
+func tagRun(s string, i int) int {
+	for i < len(s) {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if !isTag(r) {
+			break
+		}
+		i += n
+	}
+	return i
+}
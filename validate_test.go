@@ -0,0 +1,62 @@
+package emoji
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_Validate(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"hello",
+		"hello 👋 world",
+		"👍🏽 1️⃣ #⃣ ☺︎ 🇯🇵🇫🇷 🏴󠁧󠁢󠁳󠁣󠁴󠁿 👩‍❤️‍👨 👩🏽‍🦰",
+		"क्‍ष",
+	} {
+		if err := Validate(s); err != nil {
+			t.Errorf("%q is rejected with %v", s, err)
+		}
+	}
+
+	for _, test := range []struct {
+		s        string
+		offset   int
+		fragment string
+		err      error
+	}{
+		{"ab🇯", 2, "🇯", ErrUnpairedRegionalIndicator},
+		{"🇯🇵🇫", 8, "🇫", ErrUnpairedRegionalIndicator},
+		{"🏴\U000E0067\U000E0062", 4, "\U000E0067\U000E0062", ErrUnterminatedTag},
+		{"🏴\U000E0067\U000E0062 x", 4, "\U000E0067\U000E0062", ErrUnterminatedTag},
+		{"a\U000E0067\U000E007F", 1, "\U000E0067\U000E007F", ErrStrayTag},
+		{"👩‍", 4, "‍", ErrDanglingZWJ},
+		{"👩‍a", 4, "‍", ErrDanglingZWJ},
+		{"👩‍❤️‍👨‍", 20, "‍", ErrDanglingZWJ},
+		{"a ‍👩", 2, "‍", ErrDanglingZWJ},
+		{"a🏽", 1, "🏽", ErrModifierWithoutBase},
+		{"😀🏽", 4, "🏽", ErrModifierWithoutBase},
+		{"😀‍🏽", 7, "🏽", ErrModifierWithoutBase},
+		{"x️", 1, "️", ErrStrayVariationSelector},
+		{"😀︎", 4, "︎", ErrStrayVariationSelector},
+		{"👍️️", 7, "️", ErrStrayVariationSelector},
+		{"a⃣", 1, "⃣", ErrStrayKeycap},
+	} {
+		err := Validate(test.s)
+		var serr *SequenceError
+		if !errors.As(err, &serr) || !errors.Is(err, test.err) {
+			t.Errorf("%q is rejected with %v instead of %v", test.s, err, test.err)
+			continue
+		}
+		if serr.Offset != test.offset || serr.Fragment != test.fragment {
+			t.Errorf("%q is rejected at %d %+q instead of %d %+q", test.s, serr.Offset, serr.Fragment, test.offset, test.fragment)
+		}
+	}
+}
+
+func Test_SequenceErrorMessage(t *testing.T) {
+	err := Validate("ab🇯")
+	expected := `emoji: regional indicator without its pair at byte 2: "\U0001f1ef"`
+	if err == nil || err.Error() != expected {
+		t.Errorf("error message is %v instead of %s", err, expected)
+	}
+}
@@ -0,0 +1,8 @@
+// DO NOT EDIT
+// generated by: go run gen/main.go
+
+package emoji
+
+import "unicode"
+
+var variationBase = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0x23, Hi: 0x2a, Stride: 0x7}, {Lo: 0x30, Hi: 0x39, Stride: 0x1}, {Lo: 0xa9, Hi: 0xae, Stride: 0x5}, {Lo: 0x203c, Hi: 0x2049, Stride: 0xd}, {Lo: 0x2122, Hi: 0x2139, Stride: 0x17}, {Lo: 0x2194, Hi: 0x2199, Stride: 0x1}, {Lo: 0x21a9, Hi: 0x21aa, Stride: 0x1}, {Lo: 0x231a, Hi: 0x231b, Stride: 0x1}, {Lo: 0x2328, Hi: 0x23cf, Stride: 0xa7}, {Lo: 0x23e9, Hi: 0x23f3, Stride: 0x1}, {Lo: 0x23f8, Hi: 0x23fa, Stride: 0x1}, {Lo: 0x24c2, Hi: 0x25aa, Stride: 0xe8}, {Lo: 0x25ab, Hi: 0x25b6, Stride: 0xb}, {Lo: 0x25c0, Hi: 0x25fb, Stride: 0x3b}, {Lo: 0x25fc, Hi: 0x25fe, Stride: 0x1}, {Lo: 0x2600, Hi: 0x2604, Stride: 0x1}, {Lo: 0x260e, Hi: 0x2614, Stride: 0x3}, {Lo: 0x2615, Hi: 0x2618, Stride: 0x3}, {Lo: 0x261d, Hi: 0x2620, Stride: 0x3}, {Lo: 0x2622, Hi: 0x2623, Stride: 0x1}, {Lo: 0x2626, Hi: 0x262e, Stride: 0x4}, {Lo: 0x262f, Hi: 0x2638, Stride: 0x9}, {Lo: 0x2639, Hi: 0x263a, Stride: 0x1}, {Lo: 0x2640, Hi: 0x2642, Stride: 0x2}, {Lo: 0x2648, Hi: 0x2653, Stride: 0x1}, {Lo: 0x265f, Hi: 0x2660, Stride: 0x1}, {Lo: 0x2663, Hi: 0x2665, Stride: 0x2}, {Lo: 0x2666, Hi: 0x2668, Stride: 0x2}, {Lo: 0x267b, Hi: 0x267e, Stride: 0x3}, {Lo: 0x267f, Hi: 0x2692, Stride: 0x13}, {Lo: 0x2693, Hi: 0x2697, Stride: 0x1}, {Lo: 0x2699, Hi: 0x269b, Stride: 0x2}, {Lo: 0x269c, Hi: 0x26a0, Stride: 0x4}, {Lo: 0x26a1, Hi: 0x26a7, Stride: 0x6}, {Lo: 0x26aa, Hi: 0x26ab, Stride: 0x1}, {Lo: 0x26b0, Hi: 0x26b1, Stride: 0x1}, {Lo: 0x26bd, Hi: 0x26be, Stride: 0x1}, {Lo: 0x26c4, Hi: 0x26c5, Stride: 0x1}, {Lo: 0x26c8, Hi: 0x26ce, Stride: 0x6}, {Lo: 0x26cf, Hi: 0x26d3, Stride: 0x2}, {Lo: 0x26d4, Hi: 0x26e9, Stride: 0x15}, {Lo: 0x26ea, Hi: 0x26f0, Stride: 0x6}, {Lo: 0x26f1, Hi: 0x26f5, Stride: 0x1}, {Lo: 0x26f7, Hi: 0x26fa, Stride: 0x1}, {Lo: 0x26fd, Hi: 0x2702, Stride: 0x5}, {Lo: 0x2705, Hi: 0x2708, Stride: 0x3}, {Lo: 0x2709, Hi: 0x270d, Stride: 0x1}, {Lo: 0x270f, Hi: 0x2712, Stride: 0x3}, {Lo: 0x2714, Hi: 0x2716, Stride: 0x2}, {Lo: 0x271d, Hi: 0x2721, Stride: 0x4}, {Lo: 0x2728, Hi: 0x2733, Stride: 0xb}, {Lo: 0x2734, Hi: 0x2744, Stride: 0x10}, {Lo: 0x2747, Hi: 0x274c, Stride: 0x5}, {Lo: 0x274e, Hi: 0x2753, Stride: 0x5}, {Lo: 0x2754, Hi: 0x2755, Stride: 0x1}, {Lo: 0x2757, Hi: 0x2763, Stride: 0xc}, {Lo: 0x2764, Hi: 0x2795, Stride: 0x31}, {Lo: 0x2796, Hi: 0x2797, Stride: 0x1}, {Lo: 0x27a1, Hi: 0x27bf, Stride: 0xf}, {Lo: 0x2934, Hi: 0x2935, Stride: 0x1}, {Lo: 0x2b05, Hi: 0x2b07, Stride: 0x1}, {Lo: 0x2b1b, Hi: 0x2b1c, Stride: 0x1}, {Lo: 0x2b50, Hi: 0x2b55, Stride: 0x5}, {Lo: 0x3030, Hi: 0x303d, Stride: 0xd}, {Lo: 0x3297, Hi: 0x3299, Stride: 0x2}}, R32: []unicode.Range32{{Lo: 0x1f004, Hi: 0x1f170, Stride: 0x16c}, {Lo: 0x1f171, Hi: 0x1f17e, Stride: 0xd}, {Lo: 0x1f17f, Hi: 0x1f202, Stride: 0x83}, {Lo: 0x1f21a, Hi: 0x1f22f, Stride: 0x15}, {Lo: 0x1f237, Hi: 0x1f30d, Stride: 0xd6}, {Lo: 0x1f30e, Hi: 0x1f30f, Stride: 0x1}, {Lo: 0x1f315, Hi: 0x1f31c, Stride: 0x7}, {Lo: 0x1f321, Hi: 0x1f324, Stride: 0x3}, {Lo: 0x1f325, Hi: 0x1f32c, Stride: 0x1}, {Lo: 0x1f336, Hi: 0x1f378, Stride: 0x42}, {Lo: 0x1f37d, Hi: 0x1f393, Stride: 0x16}, {Lo: 0x1f396, Hi: 0x1f397, Stride: 0x1}, {Lo: 0x1f399, Hi: 0x1f39b, Stride: 0x1}, {Lo: 0x1f39e, Hi: 0x1f39f, Stride: 0x1}, {Lo: 0x1f3a7, Hi: 0x1f3ac, Stride: 0x5}, {Lo: 0x1f3ad, Hi: 0x1f3ae, Stride: 0x1}, {Lo: 0x1f3c2, Hi: 0x1f3c6, Stride: 0x2}, {Lo: 0x1f3ca, Hi: 0x1f3ce, Stride: 0x1}, {Lo: 0x1f3d4, Hi: 0x1f3e0, Stride: 0x1}, {Lo: 0x1f3ed, Hi: 0x1f3f3, Stride: 0x6}, {Lo: 0x1f3f5, Hi: 0x1f3f7, Stride: 0x2}, {Lo: 0x1f408, Hi: 0x1f415, Stride: 0xd}, {Lo: 0x1f41f, Hi: 0x1f426, Stride: 0x7}, {Lo: 0x1f43f, Hi: 0x1f441, Stride: 0x2}, {Lo: 0x1f442, Hi: 0x1f446, Stride: 0x4}, {Lo: 0x1f447, Hi: 0x1f449, Stride: 0x1}, {Lo: 0x1f44d, Hi: 0x1f44e, Stride: 0x1}, {Lo: 0x1f453, Hi: 0x1f46a, Stride: 0x17}, {Lo: 0x1f47d, Hi: 0x1f4a3, Stride: 0x26}, {Lo: 0x1f4b0, Hi: 0x1f4b3, Stride: 0x3}, {Lo: 0x1f4bb, Hi: 0x1f4bf, Stride: 0x4}, {Lo: 0x1f4cb, Hi: 0x1f4da, Stride: 0xf}, {Lo: 0x1f4df, Hi: 0x1f4e4, Stride: 0x5}, {Lo: 0x1f4e5, Hi: 0x1f4e6, Stride: 0x1}, {Lo: 0x1f4ea, Hi: 0x1f4ed, Stride: 0x1}, {Lo: 0x1f4f7, Hi: 0x1f4f9, Stride: 0x2}, {Lo: 0x1f4fa, Hi: 0x1f4fb, Stride: 0x1}, {Lo: 0x1f4fd, Hi: 0x1f508, Stride: 0xb}, {Lo: 0x1f50d, Hi: 0x1f512, Stride: 0x5}, {Lo: 0x1f513, Hi: 0x1f549, Stride: 0x36}, {Lo: 0x1f54a, Hi: 0x1f550, Stride: 0x6}, {Lo: 0x1f551, Hi: 0x1f567, Stride: 0x1}, {Lo: 0x1f56f, Hi: 0x1f570, Stride: 0x1}, {Lo: 0x1f573, Hi: 0x1f579, Stride: 0x1}, {Lo: 0x1f587, Hi: 0x1f58a, Stride: 0x3}, {Lo: 0x1f58b, Hi: 0x1f58d, Stride: 0x1}, {Lo: 0x1f590, Hi: 0x1f5a5, Stride: 0x15}, {Lo: 0x1f5a8, Hi: 0x1f5b1, Stride: 0x9}, {Lo: 0x1f5b2, Hi: 0x1f5bc, Stride: 0xa}, {Lo: 0x1f5c2, Hi: 0x1f5c4, Stride: 0x1}, {Lo: 0x1f5d1, Hi: 0x1f5d3, Stride: 0x1}, {Lo: 0x1f5dc, Hi: 0x1f5de, Stride: 0x1}, {Lo: 0x1f5e1, Hi: 0x1f5e3, Stride: 0x2}, {Lo: 0x1f5e8, Hi: 0x1f5ef, Stride: 0x7}, {Lo: 0x1f5f3, Hi: 0x1f5fa, Stride: 0x7}, {Lo: 0x1f610, Hi: 0x1f687, Stride: 0x77}, {Lo: 0x1f68d, Hi: 0x1f691, Stride: 0x4}, {Lo: 0x1f694, Hi: 0x1f698, Stride: 0x4}, {Lo: 0x1f6ad, Hi: 0x1f6b2, Stride: 0x5}, {Lo: 0x1f6b9, Hi: 0x1f6ba, Stride: 0x1}, {Lo: 0x1f6bc, Hi: 0x1f6cb, Stride: 0xf}, {Lo: 0x1f6cd, Hi: 0x1f6cf, Stride: 0x1}, {Lo: 0x1f6e0, Hi: 0x1f6e5, Stride: 0x1}, {Lo: 0x1f6e9, Hi: 0x1f6f0, Stride: 0x7}, {Lo: 0x1f6f3, Hi: 0x1f6f3, Stride: 0x1}}, LatinOffset: 3}
@@ -0,0 +1,103 @@
+package emoji
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Version is an emoji version such as 13.0
+// as listed in https://www.unicode.org/reports/tr51/#Versioning
+type Version struct {
+	Major int
+	Minor int
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Less reports whether v is older than o
+func (v Version) Less(o Version) bool {
+	return v.Major < o.Major || (v.Major == o.Major && v.Minor < o.Minor)
+}
+
+// ErrInvalidVersion is returned by ParseVersion for malformed versions
+var ErrInvalidVersion = errors.New("emoji: invalid version")
+
+// ErrUnknownVersion is returned by TablesOf for versions without tables
+var ErrUnknownVersion = errors.New("emoji: no tables for this version")
+
+// ParseVersion parses a version such as "13.0", "E13.0" as written in emoji-test.txt or "13"
+func ParseVersion(s string) (Version, error) {
+	var v Version
+	s = strings.TrimPrefix(s, "E")
+	if !strings.ContainsRune(s, '.') {
+		s += ".0"
+	}
+	var rest string
+	n, _ := fmt.Sscanf(s, "%d.%d%s", &v.Major, &v.Minor, &rest)
+	if n != 2 || v.Major < 0 || v.Minor < 0 {
+		return Version{}, ErrInvalidVersion
+	}
+	return v, nil
+}
+
+// DefaultVersion is the emoji version of the package level tables and functions
+var DefaultVersion = Version{Major: 13, Minor: 0}
+
+// Tables holds the property tables and the RGI emoji of one emoji version
+// the package level tables and functions use DefaultVersion, other versions are selected with TablesOf
+type Tables struct {
+	Version              Version
+	Emoji                *unicode.RangeTable
+	EmojiPresentation    *unicode.RangeTable
+	EmojiModifier        *unicode.RangeTable
+	EmojiModifierBase    *unicode.RangeTable
+	EmojiComponent       *unicode.RangeTable
+	ExtendedPictographic *unicode.RangeTable
+	// rgi is the sorted list of all RGI emoji
+	rgi []string
+}
+
+// IsRGI reports whether b is exactly one RGI emoji of this version
+func (t *Tables) IsRGI(b []byte) bool {
+	i := sort.Search(len(t.rgi), func(i int) bool { return t.rgi[i] >= string(b) })
+	return i < len(t.rgi) && t.rgi[i] == string(b)
+}
+
+// IsRGIString is the string equivalent of IsRGI
+func (t *Tables) IsRGIString(s string) bool {
+	i := sort.SearchStrings(t.rgi, s)
+	return i < len(t.rgi) && t.rgi[i] == s
+}
+
+// tables lists the Tables of every version sorted by version
+var tables []*Tables
+
+// registerTables is called by the generated tablesX_Y.go files
+func registerTables(t *Tables) {
+	tables = append(tables, t)
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Version.Less(tables[j].Version) })
+}
+
+// Versions returns every emoji version with Tables, oldest first
+func Versions() []Version {
+	versions := make([]Version, len(tables))
+	for i, t := range tables {
+		versions[i] = t.Version
+	}
+	return versions
+}
+
+// TablesOf returns the Tables of the emoji version v
+func TablesOf(v Version) (*Tables, error) {
+	for _, t := range tables {
+		if t.Version == v {
+			return t, nil
+		}
+	}
+	return nil, ErrUnknownVersion
+}
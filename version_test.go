@@ -0,0 +1,94 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func Test_ParseVersion(t *testing.T) {
+	tests := []struct {
+		s       string
+		version Version
+		err     error
+	}{
+		{"13.0", Version{13, 0}, nil},
+		{"E15.1", Version{15, 1}, nil},
+		{"E0.6", Version{0, 6}, nil},
+		{"12", Version{12, 0}, nil},
+		{"", Version{}, ErrInvalidVersion},
+		{"13.x", Version{}, ErrInvalidVersion},
+		{"13.0.1", Version{}, ErrInvalidVersion},
+	}
+	for _, test := range tests {
+		v, err := ParseVersion(test.s)
+		if v != test.version || err != test.err {
+			t.Errorf("ParseVersion(%q) returned %v %v not %v %v", test.s, v, err, test.version, test.err)
+		}
+		if err == nil && v.String() != test.version.String() {
+			t.Errorf("%v String returned %q", v, v.String())
+		}
+	}
+	if !(Version{13, 0}).Less(Version{13, 1}) || !(Version{2, 0}).Less(Version{13, 0}) || (Version{13, 0}).Less(Version{13, 0}) {
+		t.Errorf("Less is not ordering versions")
+	}
+}
+
+func Test_Versions(t *testing.T) {
+	if v := Versions(); !reflect.DeepEqual(v, []Version{{13, 0}, {15, 1}}) {
+		t.Errorf("Versions returned %v", v)
+	}
+	for _, v := range Versions() {
+		tables, err := TablesOf(v)
+		if err != nil || tables.Version != v {
+			t.Errorf("TablesOf(%v) returned %v %v", v, tables, err)
+		}
+	}
+	if _, err := TablesOf(Version{1, 0}); err != ErrUnknownVersion {
+		t.Errorf("TablesOf(1.0) returned %v", err)
+	}
+	if tables, _ := TablesOf(DefaultVersion); tables != Tables13_0 || tables.Emoji != Emoji || len(tables.rgi) != len(rgiSequences) {
+		t.Errorf("TablesOf(DefaultVersion) does not use the package tables")
+	}
+}
+
+func Test_Tables15_1(t *testing.T) {
+	tests := []struct {
+		s      string
+		rgi13  bool
+		rgi151 bool
+	}{
+		{"😀", true, true},
+		{"🫠", false, true},
+		{"🫶🏽", false, true},
+		{"🐦‍🔥", false, true},
+		{"🧑‍🧑‍🧒", false, true},
+		{"🇯🇵", true, true},
+		{"🇦🇦", false, false},
+	}
+	for _, test := range tests {
+		if ok := Tables13_0.IsRGIString(test.s); ok != test.rgi13 {
+			t.Errorf("Tables13_0.IsRGIString(%q) returned %v", test.s, ok)
+		}
+		if ok := Tables15_1.IsRGI([]byte(test.s)); ok != test.rgi151 {
+			t.Errorf("Tables15_1.IsRGI(%q) returned %v", test.s, ok)
+		}
+	}
+	if !unicode.Is(Tables15_1.EmojiPresentation, '🫠') || unicode.Is(Tables13_0.EmojiPresentation, '🫠') {
+		t.Errorf("🫠 is an emoji presentation character since 14.0")
+	}
+	if !unicode.Is(Tables15_1.EmojiModifierBase, '🫶') {
+		t.Errorf("🫶 is an emoji modifier base since 14.0")
+	}
+	// emoji are never removed
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if unicode.Is(Tables13_0.Emoji, r) && !unicode.Is(Tables15_1.Emoji, r) {
+			t.Errorf("%U is not an emoji in 15.1", r)
+		}
+	}
+	for _, s := range Tables13_0.rgi {
+		if !Tables15_1.IsRGIString(s) {
+			t.Errorf("%q is not RGI in 15.1", s)
+		}
+	}
+}
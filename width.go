@@ -0,0 +1,78 @@
+package emoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// Width returns the number of columns s takes in a monospace terminal
+// emoji with an emoji presentation take 2 columns, emoji with a text presentation
+// and other characters follow their East Asian Width, combining and control characters take none
+func Width(s string) int {
+	var w int
+	for len(s) > 0 {
+		g, n := glyphWidth(s)
+		w += n
+		s = s[len(g):]
+	}
+	return w
+}
+
+// Truncate shortens s to at most cols columns, replacing the end with tail if anything was cut
+// such as Truncate("👩‍👩‍👧 family", 6, "…") == "👩‍👩‍👧 fa…"
+// s is never cut inside an emoji sequence nor between a character and its combining marks
+func Truncate(s string, cols int, tail string) string {
+	if Width(s) <= cols {
+		return s
+	}
+	limit := cols - Width(tail)
+	if limit < 0 {
+		tail = ""
+		limit = cols
+	}
+	var b strings.Builder
+	var w int
+	for len(s) > 0 {
+		g, n := glyphWidth(s)
+		if w+n > limit {
+			break
+		}
+		b.WriteString(g)
+		w += n
+		s = s[len(g):]
+	}
+	b.WriteString(tail)
+	return b.String()
+}
+
+// glyphWidth returns the first emoji or character of s and its width
+func glyphWidth(s string) (string, int) {
+	g, ok, _ := DecodeString(s)
+	if ok {
+		if PresentationOf(g) == EmojiStyle {
+			return g, 2
+		}
+		r, _ := utf8.DecodeRuneInString(g)
+		return g, runeWidth(r)
+	}
+	r, n := utf8.DecodeRuneInString(s)
+	return s[:n], runeWidth(r)
+}
+
+// runeWidth returns the width of r according to its East Asian Width
+func runeWidth(r rune) int {
+	switch {
+	case r == utf8.RuneError:
+		return 1
+	case unicode.IsControl(r), unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	}
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,67 @@
+package emoji
+
+import "testing"
+
+func Test_Width(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"😀", 2},
+		{"👩‍👩‍👧", 2},
+		{"👩‍👩‍👧 family", 9},
+		{"🇯🇵", 2},
+		{"1️⃣", 2},
+		{"1⃣", 2},
+		{"1", 1},
+		{"👍🏽", 2},
+		{"🏴󠁧󠁢󠁳󠁣󠁴󠁿", 2},
+		{"☺", 1},
+		{"☺️", 2},
+		{"☺︎", 1},
+		{"⌚︎", 2},
+		{"日本", 4},
+		{"ｆｕｌｌ", 8},
+		{"é", 1},
+		{"a\tb", 2},
+		{"​", 0},
+	}
+	for _, test := range tests {
+		if w := Width(test.s); w != test.width {
+			t.Errorf("Width(%q) returned %d not %d", test.s, w, test.width)
+		}
+	}
+}
+
+func Test_Truncate(t *testing.T) {
+	tests := []struct {
+		s        string
+		cols     int
+		tail     string
+		expected string
+	}{
+		{"abc", 3, "…", "abc"},
+		{"abcd", 3, "…", "ab…"},
+		{"👩‍👩‍👧 family", 6, "…", "👩‍👩‍👧 fa…"},
+		{"👩‍👩‍👧👩‍👩‍👧", 3, "", "👩‍👩‍👧"},
+		{"👩‍👩‍👧👩‍👩‍👧", 1, "", ""},
+		{"a👩‍👩‍👧", 2, "", "a"},
+		{"🇯🇵🇫🇷", 3, "", "🇯🇵"},
+		{"1️⃣2️⃣", 3, "", "1️⃣"},
+		{"éé", 1, "", "é"},
+		{"日本語", 5, "...", "日..."},
+		{"abcdef", 2, "...", "ab"},
+		{"abc", 0, "…", ""},
+	}
+	for _, test := range tests {
+		s := Truncate(test.s, test.cols, test.tail)
+		if s != test.expected {
+			t.Errorf("Truncate(%q, %d, %q) returned %q not %q", test.s, test.cols, test.tail, s, test.expected)
+		}
+		if w := Width(s); w > test.cols {
+			t.Errorf("Truncate(%q, %d, %q) is %d columns wide", test.s, test.cols, test.tail, w)
+		}
+	}
+}